@@ -2854,6 +2854,101 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "Zero",
+			val:  cadence.Fix128{Value: big.NewInt(0)},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Fix128","value":"0.000000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), 0])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Fix128 type ID (99)
+				0x18, 0x63,
+				// tag big num
+				0xc2,
+				// bytes, 0 bytes follow
+				0x40,
+			},
+		},
+		{
+			name: "-1.5",
+			val:  cadence.Fix128{Value: new(big.Int).Mul(big.NewInt(-15), new(big.Int).Exp(big.NewInt(10), big.NewInt(23), nil))},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Fix128","value":"-1.500000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), -1500000000000000000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Fix128 type ID (99)
+				0x18, 0x63,
+				// tag negative big num
+				0xc3,
+				// bytes, 11 bytes follow
+				0x4b,
+				// -1500000000000000000000000
+				0x01, 0x3d, 0xa3, 0x29, 0xb6, 0x33, 0x64, 0x71, 0x7f, 0xff, 0xff,
+			},
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "1.0",
+			val:  cadence.UFix128{Value: new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"UFix128","value":"1.000000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 1000000000000000000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// UFix128 type ID (100)
+				0x18, 0x64,
+				// tag big num
+				0xc2,
+				// bytes, 10 bytes follow
+				0x4a,
+				// 1000000000000000000000000
+				0xd3, 0xc2, 0x1b, 0xce, 0xcc, 0xed, 0xa1, 0x00, 0x00, 0x00,
+			},
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -5799,6 +5894,114 @@ func TestEncodeInclusiveRange(t *testing.T) {
 	)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		val          cadence.Value
+		expectedVal  cadence.Value
+		expectedCBOR []byte
+	}
+
+	setType := cadence.NewSetType(cadence.StringType)
+
+	emptySet := testCase{
+		name:        "Empty",
+		val:         cadence.NewSet([]cadence.Value{}).WithType(setType),
+		expectedVal: cadence.NewSet([]cadence.Value{}).WithType(setType),
+		expectedCBOR: []byte{
+			// language=json, format=json-cdc
+			// {"type":"Set","value":[]}
+			//
+			// language=edn, format=ccf
+			// 130([146(137(1)), []])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// type (Set<String>)
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// String type ID (1)
+			0x01,
+			// array data without inlined type definition
+			// array, 0 items follow
+			0x80,
+		},
+	}
+
+	sortedSet := testCase{
+		name: "Sorted",
+		val: cadence.NewSet([]cadence.Value{
+			cadence.String("c"),
+			cadence.String("a"),
+			cadence.String("b"),
+		}).WithType(setType),
+		expectedVal: cadence.NewSet([]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+			cadence.String("c"),
+		}).WithType(setType),
+		expectedCBOR: []byte{
+			// language=json, format=json-cdc
+			// {"type":"Set","value":[{"type":"String","value":"a"},{"type":"String","value":"b"},{"type":"String","value":"c"}]}
+			//
+			// language=edn, format=ccf
+			// 130([146(137(1)), ["a", "b", "c"]])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// type (Set<String>)
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// String type ID (1)
+			0x01,
+			// array data without inlined type definition
+			// array, 3 items follow
+			0x83,
+			// string, 1 bytes follow
+			0x61,
+			// a
+			0x61,
+			// string, 1 bytes follow
+			0x61,
+			// b
+			0x62,
+			// string, 1 bytes follow
+			0x61,
+			// c
+			0x63,
+		},
+	}
+
+	test := func(tc testCase) {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCBOR := testEncode(t, tc.val, tc.expectedCBOR)
+			testDecode(t, actualCBOR, tc.expectedVal)
+		})
+	}
+
+	for _, tc := range []testCase{
+		emptySet,
+		sortedSet,
+	} {
+		test(tc)
+	}
+}
+
 func TestEncodeEvent(t *testing.T) {
 
 	t.Parallel()
@@ -7938,6 +8141,8 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		ccf.SimpleTypeWord256:                          cadence.Word256Type,
 		ccf.SimpleTypeFix64:                            cadence.Fix64Type,
 		ccf.SimpleTypeUFix64:                           cadence.UFix64Type,
+		ccf.SimpleTypeFix128:                           cadence.Fix128Type,
+		ccf.SimpleTypeUFix128:                          cadence.UFix128Type,
 		ccf.SimpleTypeBlock:                            cadence.BlockType,
 		ccf.SimpleTypePath:                             cadence.PathType,
 		ccf.SimpleTypeCapabilityPath:                   cadence.CapabilityPathType,
@@ -8266,6 +8471,43 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<String>", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.SetType{
+					ElementType: cadence.StringType,
+				},
+			},
+			[]byte{
+				// language=json, format=json-cdc
+				// {"type":"Type","value":{"staticType":{"kind":"Set","type":{"kind":"String"}}}}
+				//
+				// language=edn, format=ccf
+				// 130([137(41), 195(185(1))])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 elements follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Meta type ID (41)
+				0x18, 0x29,
+				// tag
+				0xd8, ccf.CBORTagSetTypeValue,
+				// tag
+				0xd8, ccf.CBORTagSimpleTypeValue,
+				// String type (1)
+				0x01,
+			},
+		)
+
+	})
+
 	t.Run("with static struct with no field", func(t *testing.T) {
 		t.Parallel()
 
@@ -13855,6 +14097,56 @@ func TestDecodeInvalidData(t *testing.T) {
 				0x5,
 			},
 		},
+		{
+			name: "unsorted Set elements",
+			data: []byte{
+				// language=edn, format=ccf
+				// 130([146(137(1)), ["b", "a"]])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// type (Set<String>)
+				// tag
+				0xd8, ccf.CBORTagSetType,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// String type ID (1)
+				0x01,
+				// array, 2 items follow
+				0x82,
+				// string, 1 bytes follow
+				0x61,
+				// b
+				0x62,
+				// string, 1 bytes follow
+				0x61,
+				// a
+				0x61,
+			},
+		},
+		{
+			name: "nil element type in Set type",
+			data: []byte{
+				// language=edn, format=ccf
+				// 130([146(nil), []])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// type (Set<nil>)
+				// tag
+				0xd8, ccf.CBORTagSetType,
+				// null
+				0xf6,
+				// array, 0 items follow
+				0x80,
+			},
+		},
 		{
 			name: "invalid array head in InclusiveRange value",
 			data: []byte{
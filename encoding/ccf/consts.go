@@ -54,7 +54,7 @@ const (
 	_
 
 	// CBOR tag numbers (136-183) for types
-	// inline types (145-159 are reserved)
+	// inline types (147-159 are reserved)
 	CBORTagTypeRef
 	CBORTagSimpleType
 	CBORTagOptionalType
@@ -65,7 +65,7 @@ const (
 	CBORTagIntersectionType
 	CBORTagCapabilityType
 	CBORTagInclusiveRangeType
-	_
+	CBORTagSetType
 	_
 	_
 	_
@@ -109,7 +109,7 @@ const (
 	_
 
 	// CBOR tag numbers (184-231) for type value
-	// non-composite and non-interface type values (194-207 are reserved)
+	// non-composite and non-interface type values (196-207 are reserved)
 	CBORTagTypeValueRef
 	CBORTagSimpleTypeValue
 	CBORTagOptionalTypeValue
@@ -121,7 +121,7 @@ const (
 	CBORTagCapabilityTypeValue
 	CBORTagFunctionTypeValue
 	CBORTagInclusiveRangeTypeValue // InclusiveRange is stored as a composite value.
-	_
+	CBORTagSetTypeValue
 	_
 	_
 	_
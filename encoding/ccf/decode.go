@@ -359,6 +359,7 @@ func (d *Decoder) decodeTypeAndValue(types *cadenceTypeByCCFTypeID) (cadence.Val
 //	/ path-capability-value
 //	/ id-capability-value
 //	/ inclusiverange-value
+//	/ set-value
 //	/ function-value
 //	/ type-value
 //
@@ -399,6 +400,8 @@ func (d *Decoder) decodeTypeAndValue(types *cadenceTypeByCCFTypeID) (cadence.Val
 //	/ word256-value
 //	/ fix64-value
 //	/ ufix64-value
+//	/ fix128-value
+//	/ ufix128-value
 func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (cadence.Value, error) {
 	if t == nil {
 		return nil, fmt.Errorf("unexpected nil type")
@@ -493,6 +496,12 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case cadence.UFix64Type:
 		return d.decodeUFix64()
 
+	case cadence.Fix128Type:
+		return d.decodeFix128()
+
+	case cadence.UFix128Type:
+		return d.decodeUFix128()
+
 	case cadence.StoragePathType:
 		return d.decodePath()
 
@@ -553,6 +562,9 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case *cadence.InclusiveRangeType:
 		return d.decodeInclusiveRange(t, types)
 
+	case *cadence.SetType:
+		return d.decodeSet(t, types)
+
 	default:
 		nt, err := d.dec.NextType()
 		if err != nil {
@@ -1010,6 +1022,28 @@ func (d *Decoder) decodeUFix64() (cadence.Value, error) {
 	return cadence.NewMeteredUFix64FromRawFixedPointNumber(d.gauge, i)
 }
 
+// decodeFix128 decodes fix128-value as
+// language=CDDL
+// fix128-value = bigint
+func (d *Decoder) decodeFix128() (cadence.Value, error) {
+	i, err := d.dec.DecodeBigInt()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredFix128FromRawFixedPointNumber(d.gauge, i)
+}
+
+// decodeUFix128 decodes ufix128-value as
+// language=CDDL
+// ufix128-value = bigint .ge 0
+func (d *Decoder) decodeUFix128() (cadence.Value, error) {
+	i, err := d.dec.DecodeBigInt()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredUFix128FromRawFixedPointNumber(d.gauge, i)
+}
+
 // decodeOptional decodes encoded optional-value as
 // language=CDDL
 // optional-value = nil / value
@@ -1164,6 +1198,66 @@ func (d *Decoder) decodeDictionary(typ *cadence.DictionaryType, types *cadenceTy
 	return value.WithType(typ), nil
 }
 
+// decodeSet decodes encoded set-value as
+// language=CDDL
+// set-value = [* value]
+func (d *Decoder) decodeSet(typ *cadence.SetType, types *cadenceTypeByCCFTypeID) (cadence.Value, error) {
+	// Decode array length.
+	n, err := d.dec.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	elementCount := int(n)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		elementCount,
+		func() ([]cadence.Value, error) {
+			elements := make([]cadence.Value, elementCount)
+
+			// previousElementRawBytes is used to determine if set elements are sorted
+			var previousElementRawBytes []byte
+
+			for i := 0; i < elementCount; i++ {
+
+				// Decode element as raw bytes to check that elements are sorted.
+				elementRawBytes, err := d.dec.DecodeRawBytes()
+				if err != nil {
+					return nil, err
+				}
+
+				// "Deterministic CCF Encoding Requirements" in CCF specs:
+				//
+				//   "set-value elements MUST be sorted."
+				if !bytesAreSortedBytewise(previousElementRawBytes, elementRawBytes) {
+					return nil, fmt.Errorf("encoded set-value elements are not sorted")
+				}
+
+				previousElementRawBytes = elementRawBytes
+
+				// decode element from raw bytes
+				elementDecoder := d.dm.NewDecoder(d.gauge, elementRawBytes)
+				element, err := elementDecoder.decodeValue(typ.ElementType, types)
+				if err != nil {
+					return nil, err
+				}
+
+				elements[i] = element
+			}
+
+			// Like dictionary keys, uniqueness of set elements
+			// is not checked here, but delegated to Cadence runtime.
+			return elements, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.WithType(typ), nil
+}
+
 // decodeComposite decodes encoded composite-value as
 // language=CDDL
 // composite-value = [* (field: value)]
@@ -1474,6 +1568,7 @@ func (d *Decoder) decodeCapability(typ *cadence.CapabilityType, types *cadenceTy
 //	/ constsized-array-type-value
 //	/ dict-type-value
 //	/ inclusiverange-type-value
+//	/ set-type-value
 //	/ struct-type-value
 //	/ resource-type-value
 //	/ contract-type-value
@@ -1517,6 +1612,9 @@ func (d *Decoder) decodeTypeValue(visited *cadenceTypeByCCFTypeID) (cadence.Type
 	case CBORTagInclusiveRangeTypeValue:
 		return d.decodeInclusiveRangeType(visited, d.decodeTypeValue)
 
+	case CBORTagSetTypeValue:
+		return d.decodeSetType(visited, d.decodeTypeValue)
+
 	case CBORTagCapabilityTypeValue:
 		return d.decodeCapabilityType(visited, d.decodeNullableTypeValue)
 
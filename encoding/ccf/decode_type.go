@@ -46,6 +46,7 @@ type decodeTypeFn func(types *cadenceTypeByCCFTypeID) (cadence.Type, error)
 //	/ intersection-type
 //	/ capability-type
 //	/ inclusiverange-type
+//	/ set-type
 //	/ type-ref
 //
 // All exported Cadence types needs to be handled in this function,
@@ -75,6 +76,9 @@ func (d *Decoder) decodeInlineType(types *cadenceTypeByCCFTypeID) (cadence.Type,
 	case CBORTagInclusiveRangeType:
 		return d.decodeInclusiveRangeType(types, d.decodeInlineType)
 
+	case CBORTagSetType:
+		return d.decodeSetType(types, d.decodeInlineType)
+
 	case CBORTagReferenceType:
 		return d.decodeReferenceType(types, d.decodeInlineType)
 
@@ -307,6 +311,36 @@ func (d *Decoder) decodeInclusiveRangeType(
 	return cadence.NewMeteredInclusiveRangeType(d.gauge, elementType), nil
 }
 
+// decodeSetType decodes set-type or set-type-value as
+// language=CDDL
+// set-type =
+//
+//	; cbor-tag-set-type
+//	#6.146(inline-type)
+//
+// set-type-value =
+//
+//	; cbor-tag-set-type-value
+//	#6.195(type-value)
+//
+// NOTE: decodeTypeFn is responsible for decoding inline-type or type-value.
+func (d *Decoder) decodeSetType(
+	types *cadenceTypeByCCFTypeID,
+	decodeTypeFn decodeTypeFn,
+) (cadence.Type, error) {
+	// element 0: element type (inline-type or type-value)
+	elementType, err := decodeTypeFn(types)
+	if err != nil {
+		return nil, err
+	}
+
+	if elementType == nil {
+		return nil, errors.New("unexpected nil type as set element type")
+	}
+
+	return cadence.NewMeteredSetType(d.gauge, elementType), nil
+}
+
 // decodeCapabilityType decodes capability-type or capability-type-value as
 // language=CDDL
 // capability-type =
@@ -379,6 +379,7 @@ func (e *Encoder) encodeTypeDefs(types []cadence.Type, tids ccfTypeIDByCadenceTy
 //	/ path-capability-value
 //	/ id-capability-value
 //	/ inclusiverange-value
+//	/ set-value
 //	/ function-value
 //	/ type-value
 //
@@ -419,6 +420,8 @@ func (e *Encoder) encodeTypeDefs(types []cadence.Type, tids ccfTypeIDByCadenceTy
 //	/ word256-value
 //	/ fix64-value
 //	/ ufix64-value
+//	/ fix128-value
+//	/ ufix128-value
 //
 // IMPORTANT:
 // "Valid CCF Encoding Requirements" in CCF Specification states:
@@ -558,6 +561,12 @@ func (e *Encoder) encodeValue(
 	case cadence.UFix64:
 		return e.encodeUFix64(v)
 
+	case cadence.Fix128:
+		return e.encodeFix128(v)
+
+	case cadence.UFix128:
+		return e.encodeUFix128(v)
+
 	case cadence.Array:
 		return e.encodeArray(v, tids)
 
@@ -567,6 +576,9 @@ func (e *Encoder) encodeValue(
 	case *cadence.InclusiveRange:
 		return e.encodeInclusiveRange(v, tids)
 
+	case cadence.Set:
+		return e.encodeSet(v, tids)
+
 	case cadence.Struct:
 		return e.encodeStruct(v, tids)
 
@@ -753,6 +765,20 @@ func (e *Encoder) encodeUInt64(v cadence.UInt64) error {
 	return e.enc.EncodeUint64(uint64(v))
 }
 
+// encodeFix128 encodes cadence.Fix128 as
+// language=CDDL
+// fix128-value = bigint
+func (e *Encoder) encodeFix128(v cadence.Fix128) error {
+	return e.enc.EncodeBigInt(v.Value)
+}
+
+// encodeUFix128 encodes cadence.UFix128 as
+// language=CDDL
+// ufix128-value = bigint .ge 0
+func (e *Encoder) encodeUFix128(v cadence.UFix128) error {
+	return e.enc.EncodeBigInt(v.Value)
+}
+
 // encodeUInt128 encodes cadence.UInt128 as
 // language=CDDL
 // uint128-value = bigint .ge 0
@@ -1006,6 +1032,115 @@ func (e *Encoder) encodeInclusiveRange(v *cadence.InclusiveRange, tids ccfTypeID
 	return e.encodeValue(v.Step, staticElementType, tids)
 }
 
+// encodeSet encodes cadence.Set as
+// language=CDDL
+// set-value = [* value]
+func (e *Encoder) encodeSet(v cadence.Set, tids ccfTypeIDByCadenceType) error {
+	if len(v.Elements) > 1 {
+		return e.encodeSortedSet(v, tids)
+	}
+
+	staticElementType := v.SetType.ElementType
+
+	// Encode array head with number of elements.
+	err := e.enc.EncodeArrayHead(uint64(len(v.Elements)))
+	if err != nil {
+		return err
+	}
+
+	for _, element := range v.Elements {
+		// Encode element as value.
+		err = e.encodeValue(element, staticElementType, tids)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeSortedSet encodes cadence.Set as
+// language=CDDL
+// set-value = [* value]
+func (e *Encoder) encodeSortedSet(v cadence.Set, tids ccfTypeIDByCadenceType) error {
+	// "Deterministic CCF Encoding Requirements" in CCF specs:
+	//
+	//   "set-value elements MUST be sorted."
+
+	// Use a new buffer for sorting elements.
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	// Encode and sort elements.
+	sortedElements, err := encodeAndSortSetElements(buf, v, tids, e.em)
+	if err != nil {
+		return err
+	}
+
+	// Encode array head with number of elements.
+	err = e.enc.EncodeArrayHead(uint64(len(v.Elements)))
+	if err != nil {
+		return err
+	}
+
+	for _, element := range sortedElements {
+		err = e.enc.EncodeRawBytes(element)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeAndSortSetElements(
+	buf *bytes.Buffer,
+	v cadence.Set,
+	tids ccfTypeIDByCadenceType,
+	em *encMode,
+) (
+	[][]byte,
+	error,
+) {
+	staticElementType := v.SetType.ElementType
+
+	lengths := make([]int, len(v.Elements))
+
+	e := em.NewEncoder(buf)
+
+	for i, element := range v.Elements {
+
+		off := buf.Len()
+
+		// Encode element as value.
+		err := e.encodeValue(element, staticElementType, tids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get encoded element length (must flush first).
+		e.enc.Flush()
+		lengths[i] = buf.Len() - off
+	}
+
+	// Reslice buf for encoded elements by offset and length.
+	encodedElements := make([][]byte, len(v.Elements))
+	b := buf.Bytes()
+	off := 0
+	for i, length := range lengths {
+		encodedElements[i] = b[off : off+length]
+		off += length
+	}
+	if off != len(b) {
+		// Sanity check
+		panic(cadenceErrors.NewUnexpectedError("encoded set elements' offset %d doesn't match buffer length %d", off, len(b)))
+	}
+
+	sort.Sort(bytewiseEncodedValueSorter(encodedElements))
+
+	return encodedElements, nil
+}
+
 // encodeStruct encodes cadence.Struct as
 // language=CDDL
 // composite-value = [* (field: value)]
@@ -1234,6 +1369,7 @@ func (e *Encoder) encodeFunction(typ *cadence.FunctionType, visited ccfTypeIDByC
 //	/ intersection-type-value
 //	/ capability-type-value
 //	/ inclusiverange-type-value
+//	/ set-type-value
 //	/ type-value-ref
 //
 // TypeValue is used differently from inline type or type definition.
@@ -1287,6 +1423,9 @@ func (e *Encoder) encodeTypeValue(typ cadence.Type, visited ccfTypeIDByCadenceTy
 	case *cadence.InclusiveRangeType:
 		return e.encodeInclusiveRangeTypeValue(typ, visited)
 
+	case *cadence.SetType:
+		return e.encodeSetTypeValue(typ, visited)
+
 	case *cadence.StructInterfaceType:
 		return e.encodeStructInterfaceTypeValue(typ, visited)
 
@@ -1432,6 +1571,22 @@ func (e *Encoder) encodeInclusiveRangeTypeValue(typ *cadence.InclusiveRangeType,
 	)
 }
 
+// encodeSetTypeValue encodes cadence.SetType as
+// language=CDDL
+// set-type-value =
+//
+//	; cbor-tag-set-type-value
+//	#6.195(type-value)
+func (e *Encoder) encodeSetTypeValue(typ *cadence.SetType, visited ccfTypeIDByCadenceType) error {
+	rawTagNum := []byte{0xd8, CBORTagSetTypeValue}
+	return e.encodeSetTypeWithRawTag(
+		typ,
+		visited,
+		e.encodeTypeValue,
+		rawTagNum,
+	)
+}
+
 // encodeReferenceTypeValue encodes cadence.ReferenceType as
 // language=CDDL
 // reference-type-value =
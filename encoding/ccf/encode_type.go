@@ -40,6 +40,7 @@ type encodeTypeFn func(typ cadence.Type, tids ccfTypeIDByCadenceType) error
 //	/ intersection-type
 //	/ capability-type
 //	/ inclusiverange-type
+//	/ set-type
 //	/ type-ref
 //
 // All exported Cadence types need to be supported by this function,
@@ -66,6 +67,9 @@ func (e *Encoder) encodeInlineType(typ cadence.Type, tids ccfTypeIDByCadenceType
 	case *cadence.InclusiveRangeType:
 		return e.encodeInclusiveRangeType(typ, tids)
 
+	case *cadence.SetType:
+		return e.encodeSetType(typ, tids)
+
 	case cadence.CompositeType, cadence.InterfaceType:
 		id, err := tids.id(typ)
 		if err != nil {
@@ -337,6 +341,43 @@ func (e *Encoder) encodeInclusiveRangeTypeWithRawTag(
 	return encodeTypeFn(typ.ElementType, tids)
 }
 
+// encodeSetType encodes cadence.SetType as
+// language=CDDL
+// set-type =
+//
+// ; cbor-tag-set-type
+// #6.146(inline-type)
+func (e *Encoder) encodeSetType(
+	typ *cadence.SetType,
+	tids ccfTypeIDByCadenceType,
+) error {
+	rawTagNum := []byte{0xd8, CBORTagSetType}
+	return e.encodeSetTypeWithRawTag(
+		typ,
+		tids,
+		e.encodeInlineType,
+		rawTagNum,
+	)
+}
+
+// encodeSetTypeWithRawTag encodes cadence.SetType
+// with given tag number and encode type function.
+func (e *Encoder) encodeSetTypeWithRawTag(
+	typ *cadence.SetType,
+	tids ccfTypeIDByCadenceType,
+	encodeTypeFn encodeTypeFn,
+	rawTagNumber []byte,
+) error {
+	// Encode CBOR tag number.
+	err := e.enc.EncodeRawBytes(rawTagNumber)
+	if err != nil {
+		return err
+	}
+
+	// Encode element type with given encodeTypeFn
+	return encodeTypeFn(typ.ElementType, tids)
+}
+
 // encodeReferenceType encodes cadence.ReferenceType as
 // language=CDDL
 // reference-type =
@@ -93,6 +93,22 @@ func (x bytewiseKeyValuePairSorter) Less(i, j int) bool {
 	return bytes.Compare(x[i].encodedKey, x[j].encodedKey) <= 0
 }
 
+// bytewiseEncodedValueSorter
+
+type bytewiseEncodedValueSorter [][]byte
+
+func (x bytewiseEncodedValueSorter) Len() int {
+	return len(x)
+}
+
+func (x bytewiseEncodedValueSorter) Swap(i, j int) {
+	x[i], x[j] = x[j], x[i]
+}
+
+func (x bytewiseEncodedValueSorter) Less(i, j int) bool {
+	return bytes.Compare(x[i], x[j]) <= 0
+}
+
 // bytewiseCadenceTypeInPlaceSorter
 
 // bytewiseCadenceTypeInPlaceSorter is used to sort Cadence types by Cadence type ID.
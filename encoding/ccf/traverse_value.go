@@ -96,6 +96,11 @@ func (ct *compositeTypes) traverseValue(v cadence.Value) {
 			ct.traverseValue(pair.Value)
 		}
 
+	case cadence.Set:
+		for _, element := range v.Elements {
+			ct.traverseValue(element)
+		}
+
 	case cadence.Struct:
 		for _, field := range v.Fields {
 			ct.traverseValue(field)
@@ -138,6 +143,9 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 		checkValueRuntimeType := ct.traverseType(typ.ElementType)
 		return checkKeyRuntimeType || checkValueRuntimeType
 
+	case *cadence.SetType:
+		return ct.traverseType(typ.ElementType)
+
 	case *cadence.CapabilityType:
 		return ct.traverseType(typ.BorrowType)
 
@@ -217,6 +225,8 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 		cadence.Word256Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
 		cadence.PathType,
 		cadence.StoragePathType,
 		cadence.PublicPathType,
@@ -217,10 +217,16 @@ func (d *Decoder) decodeJSON(v any) cadence.Value {
 		return d.decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return d.decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return d.decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return d.decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return d.decodeArray(valueJSON)
 	case dictionaryTypeStr:
 		return d.decodeDictionary(valueJSON)
+	case setTypeStr:
+		return d.decodeSet(valueJSON)
 	case resourceTypeStr:
 		return d.decodeResource(valueJSON)
 	case structTypeStr:
@@ -637,6 +643,26 @@ func (d *Decoder) decodeUFix64(valueJSON any) cadence.UFix64 {
 	return v
 }
 
+func (d *Decoder) decodeFix128(valueJSON any) cadence.Fix128 {
+	v, err := cadence.NewMeteredFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid Fix128: %w", err))
+	}
+	return v
+}
+
+func (d *Decoder) decodeUFix128(valueJSON any) cadence.UFix128 {
+	v, err := cadence.NewMeteredUFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid UFix128: %w", err))
+	}
+	return v
+}
+
 func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	v := toSlice(valueJSON)
 
@@ -682,6 +708,27 @@ func (d *Decoder) decodeDictionary(valueJSON any) cadence.Dictionary {
 	return value
 }
 
+func (d *Decoder) decodeSet(valueJSON any) cadence.Set {
+	v := toSlice(valueJSON)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		len(v),
+		func() ([]cadence.Value, error) {
+			elements := make([]cadence.Value, len(v))
+			for i, val := range v {
+				elements[i] = d.decodeJSON(val)
+			}
+			return elements, nil
+		},
+	)
+
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid set: %w", err))
+	}
+	return value
+}
+
 func (d *Decoder) decodeKeyValuePair(valueJSON any) cadence.KeyValuePair {
 	obj := toObject(valueJSON)
 
@@ -1287,6 +1334,11 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 			d.gauge,
 			d.decodeType(obj.Get(elementKey), results),
 		)
+	case "Set":
+		return cadence.NewMeteredSetType(
+			d.gauge,
+			d.decodeType(obj.Get(typeKey), results),
+		)
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.NewMeteredConstantSizedArrayType(
@@ -30,6 +30,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/format"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -256,8 +257,11 @@ const (
 	word256TypeStr        = "Word256"
 	fix64TypeStr          = "Fix64"
 	ufix64TypeStr         = "UFix64"
+	fix128TypeStr         = "Fix128"
+	ufix128TypeStr        = "UFix128"
 	arrayTypeStr          = "Array"
 	dictionaryTypeStr     = "Dictionary"
+	setTypeStr            = "Set"
 	structTypeStr         = "Struct"
 	resourceTypeStr       = "Resource"
 	attachmentTypeStr     = "Attachment"
@@ -331,10 +335,16 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareFix64(v)
 	case cadence.UFix64:
 		return prepareUFix64(v)
+	case cadence.Fix128:
+		return prepareFix128(v)
+	case cadence.UFix128:
+		return prepareUFix128(v)
 	case cadence.Array:
 		return prepareArray(v)
 	case cadence.Dictionary:
 		return prepareDictionary(v)
+	case cadence.Set:
+		return prepareSet(v)
 	case *cadence.InclusiveRange:
 		return prepareInclusiveRange(v)
 	case cadence.Struct:
@@ -563,6 +573,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: format.Fix128(v.Value),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: format.UFix128(v.Value),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -592,6 +616,19 @@ func prepareDictionary(v cadence.Dictionary) jsonValue {
 	}
 }
 
+func prepareSet(v cadence.Set) jsonValue {
+	elements := make([]jsonValue, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elements[i] = Prepare(element)
+	}
+
+	return jsonValueObject{
+		Type:  setTypeStr,
+		Value: elements,
+	}
+}
+
 func prepareInclusiveRange(v *cadence.InclusiveRange) jsonValue {
 	return jsonValueObject{
 		Type: inclusiveRangeTypeStr,
@@ -821,6 +858,11 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 			Kind:        "InclusiveRange",
 			ElementType: prepareType(typ.ElementType, results),
 		}
+	case *cadence.SetType:
+		return jsonUnaryType{
+			Kind: "Set",
+			Type: prepareType(typ.ElementType, results),
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
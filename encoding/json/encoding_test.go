@@ -724,6 +724,52 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Fix128{Value: big.NewInt(0)},
+			// language=json
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"-0.000000000000000000000001",
+			cadence.Fix128{Value: big.NewInt(-1)},
+			// language=json
+			`{"type":"Fix128","value":"-0.000000000000000000000001"}`,
+		},
+		{
+			"Max",
+			cadence.Fix128{Value: sema.Fix128TypeMaxBig},
+			// language=json
+			`{"type":"Fix128","value":"170141183460469.231731687303715884105727"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.UFix128{Value: big.NewInt(0)},
+			// language=json
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UFix128TypeMaxBig},
+			// language=json
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -1443,6 +1489,44 @@ func TestEncodeStruct(t *testing.T) {
 	testAllEncodeAndDecode(t, simpleStruct, resourceStruct)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		"Empty",
+		cadence.NewSet([]cadence.Value{}),
+		// language=json
+		`{"type":"Set","value":[]}`,
+	}
+
+	simpleSet := encodeTest{
+		"Simple",
+		cadence.NewSet([]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+		}),
+		// language=json
+		`
+          {
+            "type": "Set",
+            "value": [
+              {
+                "type": "String",
+                "value": "a"
+              },
+              {
+                "type": "String",
+                "value": "b"
+              }
+            ]
+          }
+        `,
+	}
+
+	testAllEncodeAndDecode(t, emptySet, simpleSet)
+}
+
 func TestEncodeInclusiveRange(t *testing.T) {
 
 	t.Parallel()
@@ -1891,6 +1975,33 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<String>", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.SetType{
+					ElementType: cadence.StringType,
+				},
+			},
+			// language=json
+			`
+              {
+                "type": "Type",
+                "value": {
+                  "staticType": {
+                    "kind": "Set",
+                    "type": {
+                      "kind": "String"
+                    }
+                  }
+                }
+              }
+            `,
+		)
+
+	})
+
 	t.Run("with static struct", func(t *testing.T) {
 
 		testEncodeAndDecode(
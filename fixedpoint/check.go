@@ -54,8 +54,37 @@ const UFix64TypeMaxFractional = math.MaxUint64 % uint64(Fix64Factor)
 var UFix64TypeMinFractionalBig = new(big.Int).SetUint64(UFix64TypeMinFractional)
 var UFix64TypeMaxFractionalBig = new(big.Int).SetUint64(UFix64TypeMaxFractional)
 
+const Fix128Scale = 24
+
+var Fix128FactorBig = new(big.Int).Exp(big.NewInt(10), big.NewInt(Fix128Scale), nil)
+
+// Fix128
+
+// Fix128TypeMinBig and Fix128TypeMaxBig are the bounds of the raw (scaled) value
+var Fix128TypeMinBig = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+var Fix128TypeMaxBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+var Fix128TypeMinIntBig = new(big.Int).Quo(Fix128TypeMinBig, Fix128FactorBig)
+var Fix128TypeMaxIntBig = new(big.Int).Quo(Fix128TypeMaxBig, Fix128FactorBig)
+
+var Fix128TypeMinFractionalBig = new(big.Int).Rem(Fix128TypeMinBig, Fix128FactorBig)
+var Fix128TypeMaxFractionalBig = new(big.Int).Rem(Fix128TypeMaxBig, Fix128FactorBig)
+
+// UFix128
+
+// UFix128TypeMinBig and UFix128TypeMaxBig are the bounds of the raw (scaled) value
+var UFix128TypeMinBig = new(big.Int)
+var UFix128TypeMaxBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+var UFix128TypeMinIntBig = new(big.Int)
+var UFix128TypeMaxIntBig = new(big.Int).Quo(UFix128TypeMaxBig, Fix128FactorBig)
+
+var UFix128TypeMinFractionalBig = new(big.Int)
+var UFix128TypeMaxFractionalBig = new(big.Int).Rem(UFix128TypeMaxBig, Fix128FactorBig)
+
 func init() {
 	Fix64TypeMinFractionalBig.Abs(Fix64TypeMinFractionalBig)
+	Fix128TypeMinFractionalBig.Abs(Fix128TypeMinFractionalBig)
 }
 
 func CheckRange(
@@ -0,0 +1,555 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fixedpoint
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// RoundingMode specifies how the exact result of an operation
+// is rounded to a representable fixed-point value.
+type RoundingMode uint8
+
+const (
+	// RoundTowardZero truncates the result, like the fixed-point arithmetic operators
+	RoundTowardZero RoundingMode = iota
+	// RoundAwayFromZero rounds to the value with the larger magnitude
+	RoundAwayFromZero
+	// RoundTowardNegativeInfinity rounds down (floor)
+	RoundTowardNegativeInfinity
+	// RoundTowardPositiveInfinity rounds up (ceiling)
+	RoundTowardPositiveInfinity
+	// RoundNearestHalfAwayFromZero rounds to the nearest value, and ties away from zero
+	RoundNearestHalfAwayFromZero
+	// RoundNearestHalfEven rounds to the nearest value, and ties to the value with an even last digit
+	RoundNearestHalfEven
+)
+
+var (
+	ErrOverflow         = errors.New("result is larger than the maximum value")
+	ErrUnderflow        = errors.New("result is smaller than the minimum value")
+	ErrDivisionByZero   = errors.New("division by zero")
+	ErrNegativeArgument = errors.New("argument must not be negative")
+	ErrZeroArgument     = errors.New("argument must not be zero")
+	ErrExponentTooLarge = errors.New("exponent is too large")
+)
+
+// Math provides mathematical functions for a fixed-point format.
+//
+// All values are represented as raw integers, i.e. the fixed-point value multiplied by the factor.
+//
+// The results of MulDiv, Div, Sqrt and PowInt are the exact results, rounded once using the given rounding mode.
+//
+// The results of Exp, Ln and Pow are irrational in general.
+// They are evaluated with increasing binary precision until the rounding of the exact result is determined,
+// up to MaxPrecision bits. If the result is still undetermined at that precision,
+// the exact result is assumed to be the rounding boundary the result is closest to,
+// which is the case for exact results, like Pow(4, 0.5).
+// The results are therefore deterministic and independent of the platform.
+type Math struct {
+	// Factor is the scale factor, i.e. 10^scale
+	Factor *big.Int
+	// Min is the minimum raw value
+	Min *big.Int
+	// Max is the maximum raw value
+	Max *big.Int
+}
+
+var Fix64Math = Math{
+	Factor: big.NewInt(Fix64Factor),
+	Min:    big.NewInt(math.MinInt64),
+	Max:    big.NewInt(math.MaxInt64),
+}
+
+var UFix64Math = Math{
+	Factor: big.NewInt(Fix64Factor),
+	Min:    big.NewInt(0),
+	Max:    new(big.Int).SetUint64(math.MaxUint64),
+}
+
+var Fix128Math = Math{
+	Factor: Fix128FactorBig,
+	Min:    Fix128TypeMinBig,
+	Max:    Fix128TypeMaxBig,
+}
+
+var UFix128Math = Math{
+	Factor: Fix128FactorBig,
+	Min:    UFix128TypeMinBig,
+	Max:    UFix128TypeMaxBig,
+}
+
+// MaxIntegerExponent is the maximum magnitude of the exponent of PowInt
+const MaxIntegerExponent = 4096
+
+// MinPrecision and MaxPrecision are the bounds of the binary precision
+// used to evaluate Exp, Ln and Pow
+const MinPrecision = 64
+const MaxPrecision = 1024
+
+// guardPrecision is the number of additional bits used in evaluations,
+// which absorb the rounding errors of the individual operations
+const guardPrecision = 64
+
+// maxExpArgument is a bound for the arguments of the exponential function,
+// beyond which the result is out of the range of all fixed-point types
+const maxExpArgument = 64
+
+// checkRange checks that the given raw value is in the range of the format
+func (m Math) checkRange(value *big.Int) (*big.Int, error) {
+	if value.Cmp(m.Min) < 0 {
+		return nil, ErrUnderflow
+	}
+	if value.Cmp(m.Max) > 0 {
+		return nil, ErrOverflow
+	}
+	return value, nil
+}
+
+// MulDiv returns x * y / z, i.e. the product of x and y divided by z,
+// without an intermediate rounding
+func (m Math) MulDiv(x, y, z *big.Int, mode RoundingMode) (*big.Int, error) {
+	if z.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	numerator := new(big.Int).Mul(x, y)
+	return m.checkRange(RoundQuotient(numerator, z, mode))
+}
+
+// Mul returns x * y
+func (m Math) Mul(x, y *big.Int, mode RoundingMode) (*big.Int, error) {
+	return m.MulDiv(x, y, m.Factor, mode)
+}
+
+// Div returns x / y
+func (m Math) Div(x, y *big.Int, mode RoundingMode) (*big.Int, error) {
+	return m.MulDiv(x, m.Factor, y, mode)
+}
+
+// Sqrt returns the square root of x
+func (m Math) Sqrt(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Sign() < 0 {
+		return nil, ErrNegativeArgument
+	}
+
+	// sqrt(x / factor) * factor = sqrt(x * factor)
+	n := new(big.Int).Mul(x, m.Factor)
+	root := new(big.Int).Sqrt(n)
+
+	remainder := new(big.Int).Mul(root, root)
+	remainder.Sub(n, remainder)
+	if remainder.Sign() == 0 {
+		return m.checkRange(root)
+	}
+
+	// The exact root is in (root, root + 1).
+	// It is never exactly root + 0.5, as (root + 0.5)^2 is not an integer,
+	// and it is above root + 0.5 iff n > root^2 + root
+	var fraction *big.Rat
+	if remainder.Cmp(root) > 0 {
+		fraction = big.NewRat(3, 4)
+	} else {
+		fraction = big.NewRat(1, 4)
+	}
+	fraction.Add(fraction, new(big.Rat).SetInt(root))
+
+	return m.checkRange(RoundQuotient(fraction.Num(), fraction.Denom(), mode))
+}
+
+// PowInt returns x raised to the power of the integer n
+func (m Math) PowInt(x *big.Int, n int64, mode RoundingMode) (*big.Int, error) {
+	if n == 0 {
+		return m.checkRange(new(big.Int).Set(m.Factor))
+	}
+
+	switch x.Sign() {
+	case 0:
+		if n < 0 {
+			return nil, ErrDivisionByZero
+		}
+		return m.checkRange(new(big.Int))
+
+	default:
+		// Powers of 1 and -1 do not grow
+		if new(big.Int).Abs(x).Cmp(m.Factor) == 0 {
+			result := new(big.Int).Set(m.Factor)
+			if x.Sign() < 0 && n%2 != 0 {
+				result.Neg(result)
+			}
+			return m.checkRange(result)
+		}
+	}
+
+	if n > MaxIntegerExponent || n < -MaxIntegerExponent {
+		return nil, ErrExponentTooLarge
+	}
+
+	var numerator, denominator *big.Int
+	if n > 0 {
+		// (x / factor)^n * factor = x^n / factor^(n-1)
+		numerator = new(big.Int).Exp(x, big.NewInt(n), nil)
+		denominator = new(big.Int).Exp(m.Factor, big.NewInt(n-1), nil)
+	} else {
+		// (factor / x)^-n * factor = factor^(1-n) / x^-n
+		numerator = new(big.Int).Exp(m.Factor, big.NewInt(1-n), nil)
+		denominator = new(big.Int).Exp(x, big.NewInt(-n), nil)
+		if denominator.Sign() < 0 {
+			numerator.Neg(numerator)
+			denominator.Neg(denominator)
+		}
+	}
+
+	return m.checkRange(RoundQuotient(numerator, denominator, mode))
+}
+
+// Exp returns e raised to the power of x
+func (m Math) Exp(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Sign() == 0 {
+		return m.checkRange(new(big.Int).Set(m.Factor))
+	}
+
+	return m.evaluate(
+		func(precision uint) (*big.Float, error) {
+			return expFloat(m.toFloat(x, precision))
+		},
+		mode,
+	)
+}
+
+// Ln returns the natural logarithm of x
+func (m Math) Ln(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	switch x.Sign() {
+	case -1:
+		return nil, ErrNegativeArgument
+	case 0:
+		return nil, ErrZeroArgument
+	}
+
+	if x.Cmp(m.Factor) == 0 {
+		return m.checkRange(new(big.Int))
+	}
+
+	return m.evaluate(
+		func(precision uint) (*big.Float, error) {
+			return lnFloat(m.toFloat(x, precision)), nil
+		},
+		mode,
+	)
+}
+
+// Pow returns x raised to the power of y, where x must not be negative
+func (m Math) Pow(x, y *big.Int, mode RoundingMode) (*big.Int, error) {
+	switch x.Sign() {
+	case -1:
+		return nil, ErrNegativeArgument
+
+	case 0:
+		switch y.Sign() {
+		case -1:
+			return nil, ErrDivisionByZero
+		case 0:
+			return m.checkRange(new(big.Int).Set(m.Factor))
+		default:
+			return m.checkRange(new(big.Int))
+		}
+	}
+
+	if y.Sign() == 0 || x.Cmp(m.Factor) == 0 {
+		return m.checkRange(new(big.Int).Set(m.Factor))
+	}
+
+	return m.evaluate(
+		func(precision uint) (*big.Float, error) {
+			// x^y = e^(y * ln(x))
+			exponent := lnFloat(m.toFloat(x, precision))
+			exponent.Mul(exponent, m.toFloat(y, precision))
+			return expFloat(exponent)
+		},
+		mode,
+	)
+}
+
+// workingPrecision returns the precision used to evaluate a result with the given precision.
+//
+// In addition to the guard bits, the precision is increased by the size of the factor,
+// as arguments close to 1 lose that many bits when subtracting 1 in the evaluation of logarithms
+func (m Math) workingPrecision(precision uint) uint {
+	return precision + guardPrecision + uint(m.Factor.BitLen())
+}
+
+// toFloat converts the given raw value to the fixed-point value it represents,
+// with the working precision for the given precision
+func (m Math) toFloat(value *big.Int, precision uint) *big.Float {
+	workingPrecision := m.workingPrecision(precision)
+	result := new(big.Float).SetPrec(workingPrecision).SetInt(value)
+	factor := new(big.Float).SetPrec(workingPrecision).SetInt(m.Factor)
+	return result.Quo(result, factor)
+}
+
+// errTinyResult is returned by an evaluated function
+// if the result is positive, but too small to be represented in any format
+var errTinyResult = errors.New("result is too small")
+
+// evaluate evaluates the given function with increasing precision,
+// until the rounding of the result is determined.
+//
+// The function must return the fixed-point value (not the raw value)
+// with a relative error of at most 2^-precision.
+func (m Math) evaluate(
+	f func(precision uint) (*big.Float, error),
+	mode RoundingMode,
+) (*big.Int, error) {
+
+	precision := uint(MinPrecision)
+	for {
+		value, err := f(precision)
+		if err == errTinyResult {
+			// The raw value is in (0, 1/2), which rounds like 1/4
+			return m.checkRange(RoundQuotient(big.NewInt(1), big.NewInt(4), mode))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value.Mul(value, new(big.Float).SetInt(m.Factor))
+
+		// The error bound is |value| * 2^-precision
+		errorBound := new(big.Float).Abs(value)
+		errorBound.SetMantExp(errorBound, -int(precision))
+
+		lower := new(big.Float).Sub(value, errorBound)
+		upper := new(big.Float).Add(value, errorBound)
+
+		lowerRat, _ := lower.Rat(nil)
+		upperRat, _ := upper.Rat(nil)
+
+		lowerResult := RoundQuotient(lowerRat.Num(), lowerRat.Denom(), mode)
+		upperResult := RoundQuotient(upperRat.Num(), upperRat.Denom(), mode)
+
+		if lowerResult.Cmp(upperResult) == 0 {
+			return m.checkRange(lowerResult)
+		}
+
+		if precision >= MaxPrecision {
+			// Assume the exact result is the rounding boundary in the interval
+			boundary := roundingBoundary(lowerRat, mode)
+			return m.checkRange(RoundQuotient(boundary.Num(), boundary.Denom(), mode))
+		}
+
+		precision *= 2
+	}
+}
+
+// roundingBoundary returns the smallest rounding boundary which is greater than the given value:
+// integers for directed rounding modes, and halfway points for rounding to nearest
+func roundingBoundary(value *big.Rat, mode RoundingMode) *big.Rat {
+	switch mode {
+	case RoundNearestHalfAwayFromZero, RoundNearestHalfEven:
+		// floor(value + 1/2) + 1/2
+		shifted := new(big.Rat).Add(value, big.NewRat(1, 2))
+		boundary := new(big.Rat).SetInt(RoundQuotient(shifted.Num(), shifted.Denom(), RoundTowardNegativeInfinity))
+		return boundary.Add(boundary, big.NewRat(1, 2))
+
+	default:
+		// floor(value) + 1
+		boundary := new(big.Rat).SetInt(RoundQuotient(value.Num(), value.Denom(), RoundTowardNegativeInfinity))
+		return boundary.Add(boundary, big.NewRat(1, 1))
+	}
+}
+
+// RoundQuotient returns the quotient of the given numerator and denominator,
+// rounded to an integer using the given rounding mode
+func RoundQuotient(numerator, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// The exact result is between quotient and quotient + sign,
+	// where quotient is the result truncated toward zero
+	sign := numerator.Sign() * denominator.Sign()
+
+	var awayFromZero bool
+
+	switch mode {
+	case RoundTowardZero:
+		awayFromZero = false
+
+	case RoundAwayFromZero:
+		awayFromZero = true
+
+	case RoundTowardNegativeInfinity:
+		awayFromZero = sign < 0
+
+	case RoundTowardPositiveInfinity:
+		awayFromZero = sign > 0
+
+	case RoundNearestHalfAwayFromZero, RoundNearestHalfEven:
+		// Compare the remainder with half of the denominator
+		doubledRemainder := new(big.Int).Abs(remainder)
+		doubledRemainder.Lsh(doubledRemainder, 1)
+
+		switch doubledRemainder.Cmp(new(big.Int).Abs(denominator)) {
+		case -1:
+			awayFromZero = false
+		case 1:
+			awayFromZero = true
+		case 0:
+			awayFromZero = mode == RoundNearestHalfAwayFromZero ||
+				quotient.Bit(0) != 0
+		}
+
+	default:
+		panic(errors.New("invalid rounding mode"))
+	}
+
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+
+	return quotient
+}
+
+// expFloat returns e^x, evaluated with the working precision of x.
+//
+// If x is larger than maxExpArgument, ErrOverflow is returned,
+// and if x is smaller than -maxExpArgument, errTinyResult is returned.
+func expFloat(x *big.Float) (*big.Float, error) {
+	if x.Cmp(big.NewFloat(maxExpArgument)) > 0 {
+		return nil, ErrOverflow
+	}
+	if x.Cmp(big.NewFloat(-maxExpArgument)) < 0 {
+		return nil, errTinyResult
+	}
+
+	workingPrecision := x.Prec()
+
+	// Reduce the argument: x = k * ln(2) + r, with |r| < ln(2)
+
+	ln2 := ln2Float(workingPrecision)
+
+	quotient := new(big.Float).SetPrec(workingPrecision).Quo(x, ln2)
+	k, _ := quotient.Int64()
+
+	r := new(big.Float).SetPrec(workingPrecision).SetInt64(k)
+	r.Mul(r, ln2)
+	r.Sub(x, r)
+
+	// Further reduce the argument: e^r = (e^(r / 2^squarings))^(2^squarings),
+	// so the series converges quickly
+
+	const squarings = 16
+	r.SetMantExp(r, -squarings)
+
+	// e^r = sum_{i >= 0} r^i / i!
+
+	sum := new(big.Float).SetPrec(workingPrecision).SetInt64(1)
+	term := new(big.Float).SetPrec(workingPrecision).SetInt64(1)
+	divisor := new(big.Float).SetPrec(workingPrecision)
+
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, divisor.SetInt64(i))
+		if isNegligible(term, sum, workingPrecision) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+
+	for i := 0; i < squarings; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	// e^x = 2^k * e^r
+
+	return sum.SetMantExp(sum, int(k)), nil
+}
+
+// lnFloat returns the natural logarithm of the positive x,
+// evaluated with the working precision of x
+func lnFloat(x *big.Float) *big.Float {
+	workingPrecision := x.Prec()
+
+	// Reduce the argument: x = m * 2^k, with m in [1/sqrt(2), sqrt(2)),
+	// so ln(x) = ln(m) + k * ln(2) does not suffer from cancellation
+
+	m := new(big.Float)
+	k := x.MantExp(m)
+	if m.Cmp(big.NewFloat(math.Sqrt2/2)) < 0 {
+		m.SetMantExp(m, 1)
+		k--
+	}
+
+	// ln(m) = 2 * atanh((m - 1) / (m + 1))
+
+	one := new(big.Float).SetPrec(workingPrecision).SetInt64(1)
+	numerator := new(big.Float).SetPrec(workingPrecision).Sub(m, one)
+	denominator := new(big.Float).SetPrec(workingPrecision).Add(m, one)
+	z := new(big.Float).SetPrec(workingPrecision).Quo(numerator, denominator)
+
+	result := doubledAtanh(z)
+
+	if k != 0 {
+		kLn2 := new(big.Float).SetPrec(workingPrecision).SetInt64(int64(k))
+		kLn2.Mul(kLn2, ln2Float(workingPrecision))
+		result.Add(result, kLn2)
+	}
+
+	return result
+}
+
+// ln2Float returns ln(2) = 2 * atanh(1/3), evaluated with the given working precision
+func ln2Float(workingPrecision uint) *big.Float {
+	z := new(big.Float).SetPrec(workingPrecision).SetInt64(1)
+	z.Quo(z, new(big.Float).SetPrec(workingPrecision).SetInt64(3))
+	return doubledAtanh(z)
+}
+
+// doubledAtanh returns 2 * atanh(z) = 2 * sum_{i >= 0} z^(2i+1) / (2i+1),
+// evaluated with the working precision of z, which must be small, i.e. |z| <= 1/3
+func doubledAtanh(z *big.Float) *big.Float {
+	workingPrecision := z.Prec()
+
+	zSquared := new(big.Float).SetPrec(workingPrecision).Mul(z, z)
+
+	sum := new(big.Float).SetPrec(workingPrecision).Set(z)
+	power := new(big.Float).SetPrec(workingPrecision).Set(z)
+	term := new(big.Float).SetPrec(workingPrecision)
+	divisor := new(big.Float).SetPrec(workingPrecision)
+
+	for i := int64(3); ; i += 2 {
+		power.Mul(power, zSquared)
+		term.Quo(power, divisor.SetInt64(i))
+		if isNegligible(term, sum, workingPrecision) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+
+	return sum.SetMantExp(sum, 1)
+}
+
+// isNegligible returns true if adding the given term to the given sum
+// does not affect the sum with the given precision
+func isNegligible(term, sum *big.Float, workingPrecision uint) bool {
+	return term.Sign() == 0 ||
+		sum.Sign() != 0 && term.MantExp(nil) < sum.MantExp(nil)-int(workingPrecision)-2
+}
@@ -0,0 +1,368 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fixedpoint
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var allRoundingModes = []RoundingMode{
+	RoundTowardZero,
+	RoundAwayFromZero,
+	RoundTowardNegativeInfinity,
+	RoundTowardPositiveInfinity,
+	RoundNearestHalfAwayFromZero,
+	RoundNearestHalfEven,
+}
+
+func TestRoundQuotient(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		numerator, denominator int64
+		// expected results, in the order of allRoundingModes
+		expected [6]int64
+	}
+
+	for _, test := range []testCase{
+		{6, 2, [6]int64{3, 3, 3, 3, 3, 3}},
+		{7, 2, [6]int64{3, 4, 3, 4, 4, 4}},
+		{5, 2, [6]int64{2, 3, 2, 3, 3, 2}},
+		{-7, 2, [6]int64{-3, -4, -4, -3, -4, -4}},
+		{-5, 2, [6]int64{-2, -3, -3, -2, -3, -2}},
+		{7, -2, [6]int64{-3, -4, -4, -3, -4, -4}},
+		{7, 3, [6]int64{2, 3, 2, 3, 2, 2}},
+		{8, 3, [6]int64{2, 3, 2, 3, 3, 3}},
+		{-8, 3, [6]int64{-2, -3, -3, -2, -3, -3}},
+		{1, 4, [6]int64{0, 1, 0, 1, 0, 0}},
+	} {
+		for i, mode := range allRoundingModes {
+			actual := RoundQuotient(big.NewInt(test.numerator), big.NewInt(test.denominator), mode)
+			assert.Equal(t,
+				test.expected[i],
+				actual.Int64(),
+				"%d / %d, mode %d", test.numerator, test.denominator, mode,
+			)
+		}
+	}
+}
+
+func TestMathMulDiv(t *testing.T) {
+
+	t.Parallel()
+
+	// 1.5 * 2.5 / 0.7 = 5.357142857142...
+	result, err := UFix64Math.MulDiv(
+		big.NewInt(1_50000000),
+		big.NewInt(2_50000000),
+		big.NewInt(70000000),
+		RoundAwayFromZero,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5_35714286), result.Int64())
+
+	// The intermediate product does not overflow
+	result, err = UFix64Math.MulDiv(
+		UFix64Math.Max,
+		UFix64Math.Max,
+		UFix64Math.Max,
+		RoundTowardZero,
+	)
+	require.NoError(t, err)
+	assert.Zero(t, UFix64Math.Max.Cmp(result))
+
+	_, err = UFix64Math.MulDiv(
+		UFix64Math.Max,
+		big.NewInt(2),
+		big.NewInt(1),
+		RoundTowardZero,
+	)
+	assert.Equal(t, ErrOverflow, err)
+
+	_, err = Fix64Math.MulDiv(
+		Fix64Math.Min,
+		big.NewInt(2),
+		big.NewInt(1),
+		RoundTowardZero,
+	)
+	assert.Equal(t, ErrUnderflow, err)
+
+	_, err = Fix64Math.MulDiv(
+		big.NewInt(1),
+		big.NewInt(1),
+		big.NewInt(0),
+		RoundTowardZero,
+	)
+	assert.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestMathDiv(t *testing.T) {
+
+	t.Parallel()
+
+	// -2 / 3 = -0.666666666...
+	for mode, expected := range map[RoundingMode]int64{
+		RoundTowardZero:              -66666666,
+		RoundAwayFromZero:            -66666667,
+		RoundTowardNegativeInfinity:  -66666667,
+		RoundTowardPositiveInfinity:  -66666666,
+		RoundNearestHalfAwayFromZero: -66666667,
+		RoundNearestHalfEven:         -66666667,
+	} {
+		result, err := Fix64Math.Div(big.NewInt(-2_00000000), big.NewInt(3_00000000), mode)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result.Int64())
+	}
+
+	_, err := Fix64Math.Div(big.NewInt(1), big.NewInt(0), RoundTowardZero)
+	assert.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestMathSqrt(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		x        int64
+		mode     RoundingMode
+		expected int64
+	}
+
+	for _, test := range []testCase{
+		// sqrt(4) = 2
+		{4_00000000, RoundAwayFromZero, 2_00000000},
+		// sqrt(2) = 1.41421356237...
+		{2_00000000, RoundTowardZero, 1_41421356},
+		{2_00000000, RoundAwayFromZero, 1_41421357},
+		{2_00000000, RoundNearestHalfEven, 1_41421356},
+		// sqrt(3) = 1.73205080756...
+		{3_00000000, RoundNearestHalfAwayFromZero, 1_73205081},
+		// sqrt(0.00000001) = 0.0001
+		{1, RoundTowardZero, 10000},
+		{0, RoundAwayFromZero, 0},
+	} {
+		result, err := UFix64Math.Sqrt(big.NewInt(test.x), test.mode)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, result.Int64(), "sqrt(%d)", test.x)
+	}
+
+	_, err := Fix64Math.Sqrt(big.NewInt(-1), RoundTowardZero)
+	assert.Equal(t, ErrNegativeArgument, err)
+}
+
+func TestMathPowInt(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		x        int64
+		n        int64
+		mode     RoundingMode
+		expected int64
+	}
+
+	for _, test := range []testCase{
+		// 1.5^10 = 57.6650390625
+		{1_50000000, 10, RoundNearestHalfAwayFromZero, 57_66503906},
+		{1_50000000, 10, RoundTowardPositiveInfinity, 57_66503907},
+		// 0.5^9 = 0.001953125
+		{50000000, 9, RoundNearestHalfEven, 195312},
+		{50000000, 9, RoundNearestHalfAwayFromZero, 195313},
+		// (-2)^3 = -8
+		{-2_00000000, 3, RoundTowardZero, -8_00000000},
+		// (-2)^-3 = -0.125
+		{-2_00000000, -3, RoundTowardZero, -12500000},
+		// 3^-1 = 0.333333333...
+		{3_00000000, -1, RoundTowardPositiveInfinity, 33333334},
+		// 0.1^9 = 0.000000001
+		{10000000, 9, RoundTowardZero, 0},
+		{10000000, 9, RoundAwayFromZero, 1},
+		{10000000, 9, RoundNearestHalfEven, 0},
+		// x^0 = 1
+		{0, 0, RoundTowardZero, 1_00000000},
+		{7_00000000, 0, RoundTowardZero, 1_00000000},
+		// 0^n = 0
+		{0, 5, RoundTowardZero, 0},
+		// (-1)^n does not grow
+		{-1_00000000, math.MaxInt64, RoundTowardZero, -1_00000000},
+	} {
+		result, err := Fix64Math.PowInt(big.NewInt(test.x), test.n, test.mode)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, result.Int64(), "%d^%d", test.x, test.n)
+	}
+
+	_, err := Fix64Math.PowInt(big.NewInt(2_00000000), 64, RoundTowardZero)
+	assert.Equal(t, ErrOverflow, err)
+
+	_, err = Fix64Math.PowInt(big.NewInt(0), -1, RoundTowardZero)
+	assert.Equal(t, ErrDivisionByZero, err)
+
+	_, err = Fix64Math.PowInt(big.NewInt(1_00000001), MaxIntegerExponent+1, RoundTowardZero)
+	assert.Equal(t, ErrExponentTooLarge, err)
+}
+
+func TestMathExp(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		x        int64
+		mode     RoundingMode
+		expected int64
+	}
+
+	for _, test := range []testCase{
+		{0, RoundAwayFromZero, 1_00000000},
+		// e = 2.71828182845...
+		{1_00000000, RoundTowardZero, 2_71828182},
+		{1_00000000, RoundNearestHalfEven, 2_71828183},
+		// e^-1 = 0.36787944117...
+		{-1_00000000, RoundTowardZero, 36787944},
+		{-1_00000000, RoundTowardPositiveInfinity, 36787945},
+		// e^20 = 485165195.40979...
+		{20_00000000, RoundNearestHalfEven, 485165195_40979028},
+		// e^-100 is tiny
+		{-100_00000000, RoundTowardZero, 0},
+		{-100_00000000, RoundAwayFromZero, 1},
+		{-100_00000000, RoundNearestHalfEven, 0},
+	} {
+		result, err := Fix64Math.Exp(big.NewInt(test.x), test.mode)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, result.Int64(), "exp(%d)", test.x)
+	}
+
+	_, err := Fix64Math.Exp(big.NewInt(26_00000000), RoundTowardZero)
+	assert.Equal(t, ErrOverflow, err)
+
+	_, err = Fix64Math.Exp(Fix64Math.Max, RoundTowardZero)
+	assert.Equal(t, ErrOverflow, err)
+}
+
+func TestMathLn(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		x        int64
+		mode     RoundingMode
+		expected int64
+	}
+
+	for _, test := range []testCase{
+		{1_00000000, RoundAwayFromZero, 0},
+		// ln(2) = 0.69314718055...
+		{2_00000000, RoundTowardZero, 69314718},
+		{2_00000000, RoundAwayFromZero, 69314719},
+		// ln(0.5) = -0.69314718055...
+		{50000000, RoundTowardNegativeInfinity, -69314719},
+		{50000000, RoundTowardPositiveInfinity, -69314718},
+		// ln(1.00000001) = 0.0000000099999999...
+		{1_00000001, RoundTowardZero, 0},
+		{1_00000001, RoundNearestHalfEven, 1},
+		// ln(0.00000001) = -18.42068074395...
+		{1, RoundNearestHalfEven, -18_42068074},
+	} {
+		result, err := Fix64Math.Ln(big.NewInt(test.x), test.mode)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, result.Int64(), "ln(%d)", test.x)
+	}
+
+	_, err := Fix64Math.Ln(big.NewInt(0), RoundTowardZero)
+	assert.Equal(t, ErrZeroArgument, err)
+
+	_, err = Fix64Math.Ln(big.NewInt(-1), RoundTowardZero)
+	assert.Equal(t, ErrNegativeArgument, err)
+}
+
+func TestMathPow(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		x, y     int64
+		mode     RoundingMode
+		expected int64
+	}
+
+	for _, test := range []testCase{
+		// Exact results are not affected by directed rounding
+		{4_00000000, 50000000, RoundTowardZero, 2_00000000},
+		{4_00000000, 50000000, RoundAwayFromZero, 2_00000000},
+		{2_00000000, 10_00000000, RoundTowardNegativeInfinity, 1024_00000000},
+		{2_00000000, -2_00000000, RoundTowardPositiveInfinity, 25000000},
+		// 2^0.5 = 1.41421356237...
+		{2_00000000, 50000000, RoundTowardZero, 1_41421356},
+		{2_00000000, 50000000, RoundAwayFromZero, 1_41421357},
+		// 1.0001^10000 = 2.71814592682...
+		{1_00010000, 10000_00000000, RoundNearestHalfEven, 2_71814593},
+		{0, 2_00000000, RoundTowardZero, 0},
+		{0, 0, RoundTowardZero, 1_00000000},
+		{1_00000000, 1000_00000000, RoundTowardZero, 1_00000000},
+		// 0.5^1000 is tiny
+		{50000000, 1000_00000000, RoundAwayFromZero, 1},
+	} {
+		result, err := UFix64Math.Pow(big.NewInt(test.x), big.NewInt(test.y), test.mode)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, result.Int64(), "%d^%d", test.x, test.y)
+	}
+
+	_, err := UFix64Math.Pow(big.NewInt(0), big.NewInt(-1), RoundTowardZero)
+	assert.Equal(t, ErrDivisionByZero, err)
+
+	_, err = UFix64Math.Pow(big.NewInt(10_00000000), big.NewInt(12_00000000), RoundTowardZero)
+	assert.Equal(t, ErrOverflow, err)
+}
+
+func TestMathTranscendentalConsistency(t *testing.T) {
+
+	t.Parallel()
+
+	// The results must be within one unit of the float64 results,
+	// and the results with directed rounding must bracket the exact result
+
+	for x := int64(-20_00000000); x <= 20_00000000; x += 1_23456789 {
+		lower, err := Fix64Math.Exp(big.NewInt(x), RoundTowardNegativeInfinity)
+		require.NoError(t, err)
+		upper, err := Fix64Math.Exp(big.NewInt(x), RoundTowardPositiveInfinity)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1), new(big.Int).Sub(upper, lower).Int64())
+
+		expected := math.Exp(float64(x)/Fix64Factor) * Fix64Factor
+		require.InDelta(t, expected, float64(lower.Int64()), 1+expected*1e-14)
+	}
+
+	for x := int64(1); x <= 1000_00000000; x = x*3 + 7 {
+		lower, err := Fix64Math.Ln(big.NewInt(x), RoundTowardNegativeInfinity)
+		require.NoError(t, err)
+		upper, err := Fix64Math.Ln(big.NewInt(x), RoundTowardPositiveInfinity)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1), new(big.Int).Sub(upper, lower).Int64())
+
+		expected := math.Log(float64(x)/Fix64Factor) * Fix64Factor
+		require.InDelta(t, expected, float64(lower.Int64()), 1)
+	}
+}
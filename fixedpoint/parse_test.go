@@ -57,3 +57,73 @@ func TestParseFixedPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFix128(t *testing.T) {
+
+	t.Parallel()
+
+	type result struct {
+		value string
+		err   bool
+	}
+
+	for input, expectedResult := range map[string]result{
+		"0.000000000000000000000001": {value: "1"},
+		"-1.5":                       {value: "-1500000000000000000000000"},
+		"170141183460469.231731687303715884105727":  {value: "170141183460469231731687303715884105727"},
+		"-170141183460469.231731687303715884105728": {value: "-170141183460469231731687303715884105728"},
+		"170141183460469.231731687303715884105728":  {err: true},
+		"-170141183460469.231731687303715884105729": {err: true},
+		"0.0000000000000000000000001":               {err: true},
+	} {
+		input := input
+		expectedResult := expectedResult
+
+		t.Run(input, func(t *testing.T) {
+
+			t.Parallel()
+
+			value, err := ParseFix128(input)
+			if expectedResult.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedResult.value, value.String())
+		})
+	}
+}
+
+func TestParseUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	type result struct {
+		value string
+		err   bool
+	}
+
+	for input, expectedResult := range map[string]result{
+		"0.000000000000000000000001": {value: "1"},
+		"1.5":                        {value: "1500000000000000000000000"},
+		"340282366920938.463463374607431768211455": {value: "340282366920938463463374607431768211455"},
+		"340282366920938.463463374607431768211456": {err: true},
+		"-1.0": {err: true},
+	} {
+		input := input
+		expectedResult := expectedResult
+
+		t.Run(input, func(t *testing.T) {
+
+			t.Parallel()
+
+			value, err := ParseUFix128(input)
+			if expectedResult.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedResult.value, value.String())
+		})
+	}
+}
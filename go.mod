@@ -20,7 +20,7 @@ require (
 	github.com/texttheater/golang-levenshtein/levenshtein v0.0.0-20200805054039-cae8b0eaed6c
 	github.com/tidwall/pretty v1.2.1
 	github.com/turbolent/prettier v0.0.0-20220320183459-661cc755135d
-	go.opentelemetry.io/otel v1.8.0
+	go.opentelemetry.io/otel v1.14.0
 	go.uber.org/goleak v1.1.10
 	golang.org/x/crypto v0.1.0
 	golang.org/x/mod v0.14.0
@@ -33,12 +33,17 @@ require (
 	github.com/SaveTheRbtz/mph v0.1.1-0.20240117162131-4166ec7869bc
 	github.com/k0kubun/pp v3.0.1+incompatible
 	github.com/onflow/crypto v0.25.0
+	go.opentelemetry.io/otel/sdk v1.14.0
+	go.opentelemetry.io/otel/trace v1.14.0
 	golang.org/x/exp v0.0.0-20240103183307-be819d1f06fc
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/fxamacker/circlehash v0.3.0 // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/itchyny/timefmt-go v0.1.5 // indirect
 	github.com/k0kubun/colorstring v0.0.0-20150214042306-9440f1994b88 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.0 // indirect
@@ -58,5 +63,4 @@ require (
 	golang.org/x/sys v0.15.0 // indirect
 	golang.org/x/term v0.6.0 // indirect
 	gonum.org/v1/gonum v0.6.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
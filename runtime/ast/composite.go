@@ -50,10 +50,11 @@ func IsResourceDestructionDefaultEvent(identifier string) bool {
 }
 
 type CompositeDeclaration struct {
-	Members      *Members
-	DocString    string
-	Conformances []*NominalType
-	Identifier   Identifier
+	Members           *Members
+	TypeParameterList *TypeParameterList
+	DocString         string
+	Conformances      []*NominalType
+	Identifier        Identifier
 	Range
 	Access        Access
 	CompositeKind common.CompositeKind
@@ -69,6 +70,7 @@ func NewCompositeDeclaration(
 	access Access,
 	compositeKind common.CompositeKind,
 	identifier Identifier,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 	docString string,
@@ -77,13 +79,14 @@ func NewCompositeDeclaration(
 	common.UseMemory(memoryGauge, common.CompositeDeclarationMemoryUsage)
 
 	return &CompositeDeclaration{
-		Access:        access,
-		CompositeKind: compositeKind,
-		Identifier:    identifier,
-		Conformances:  conformances,
-		Members:       members,
-		DocString:     docString,
-		Range:         declarationRange,
+		Access:            access,
+		CompositeKind:     compositeKind,
+		Identifier:        identifier,
+		TypeParameterList: typeParameterList,
+		Conformances:      conformances,
+		Members:           members,
+		DocString:         docString,
+		Range:             declarationRange,
 	}
 }
 
@@ -145,6 +148,7 @@ func (d *CompositeDeclaration) Doc() prettier.Doc {
 		d.CompositeKind,
 		false,
 		d.Identifier.Identifier,
+		d.TypeParameterList,
 		d.Conformances,
 		d.Members,
 	)
@@ -195,6 +199,7 @@ func CompositeDocument(
 	kind common.CompositeKind,
 	isInterface bool,
 	identifier string,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 ) prettier.Doc {
@@ -227,6 +232,13 @@ func CompositeDocument(
 		prettier.Text(identifier),
 	)
 
+	if !typeParameterList.IsEmpty() {
+		doc = append(
+			doc,
+			typeParameterList.Doc(),
+		)
+	}
+
 	if len(conformances) > 0 {
 
 		conformancesDoc := prettier.Concat{
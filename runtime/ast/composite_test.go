@@ -429,6 +429,7 @@ func TestCompositeDeclaration_MarshalJSON(t *testing.T) {
 				"StartPos": {"Offset": 1, "Line": 2, "Column": 3},
 				"EndPos": {"Offset": 2, "Line": 2, "Column": 4}
             },
+            "TypeParameterList": null,
             "Conformances": [
                 {
                     "Type": "NominalType",
@@ -771,6 +772,55 @@ resource AB: CD, EF {}`,
 		)
 	})
 
+	t.Run("type parameters, conformances", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &CompositeDeclaration{
+			Access:        AccessAll,
+			CompositeKind: common.CompositeKindStructure,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			TypeParameterList: &TypeParameterList{
+				TypeParameters: []*TypeParameter{
+					{
+						Identifier: Identifier{
+							Identifier: "T",
+						},
+						TypeBound: &TypeAnnotation{
+							Type: &NominalType{
+								Identifier: Identifier{
+									Identifier: "AnyStruct",
+								},
+							},
+						},
+					},
+					{
+						Identifier: Identifier{
+							Identifier: "U",
+						},
+					},
+				},
+			},
+			Conformances: []*NominalType{
+				{
+					Identifier: Identifier{
+						Identifier: "CD",
+					},
+				},
+			},
+			Members: NewMembers(nil, []Declaration{}),
+		}
+
+		require.Equal(
+			t,
+			`access(all)
+struct AB<T: AnyStruct, U>: CD {}`,
+			decl.String(),
+		)
+	})
+
 	t.Run("members, conformances", func(t *testing.T) {
 
 		t.Parallel()
@@ -0,0 +1,1310 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// ProgramDecodingError is returned when an encoded program is malformed
+type ProgramDecodingError struct {
+	Err error
+}
+
+var _ errors.UserError = ProgramDecodingError{}
+
+func (ProgramDecodingError) IsUserError() {}
+
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+func (ProgramDecodingError) ErrorCode() errors.ErrorCode {
+	return 1010
+}
+
+func (e ProgramDecodingError) Error() string {
+	return fmt.Sprintf("failed to decode program: %s", e.Err.Error())
+}
+
+func (e ProgramDecodingError) Unwrap() error {
+	return e.Err
+}
+
+// programCBORDecMode is the CBOR decoding mode for programs.
+// The nesting of expressions and types is limited by the decoder, like by the parser,
+// but statements and declarations may be deeply nested, e.g. in chains of else-if statements.
+var programCBORDecMode = func() cbor.DecMode {
+	decMode, err := cbor.DecOptions{
+		IntDec:           cbor.IntDecConvertNone,
+		MaxArrayElements: math.MaxInt64,
+		MaxMapPairs:      math.MaxInt64,
+		MaxNestedLevels:  math.MaxInt16,
+	}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return decMode
+}()
+
+// DecodeProgram decodes a program which was encoded using EncodeProgram.
+func DecodeProgram(memoryGauge common.MemoryGauge, data []byte) (program *Program, err error) {
+	err = decodeProgram(memoryGauge, data, func(decoder *programDecoder, _ []byte) {
+		declarations := decodeElements[Declaration](decoder)
+		program = NewProgram(memoryGauge, declarations)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return program, nil
+}
+
+// DecodeProgramSourceHash returns the hash of the source code of a program
+// which was encoded using EncodeProgram. The declarations of the program are not decoded.
+// The hash is nil if it was unknown when the program was encoded.
+func DecodeProgramSourceHash(data []byte) (sourceHash []byte, err error) {
+	err = decodeProgram(nil, data, func(_ *programDecoder, hash []byte) {
+		sourceHash = hash
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sourceHash, nil
+}
+
+// decodeProgram decodes the header of the given encoded program,
+// i.e. the prefix, the version, and the source hash,
+// and then calls the given function to decode the rest of the program.
+// Decoding errors are recovered and returned.
+func decodeProgram(
+	memoryGauge common.MemoryGauge,
+	data []byte,
+	decodeBody func(decoder *programDecoder, sourceHash []byte),
+) (err error) {
+
+	if !IsEncodedProgram(data) {
+		return ProgramDecodingError{
+			Err: fmt.Errorf("missing encoded program prefix"),
+		}
+	}
+
+	decoder := &programDecoder{
+		dec:         programCBORDecMode.NewByteStreamDecoder(data[len(encodedProgramMagic):]),
+		memoryGauge: memoryGauge,
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			decodingErr, ok := r.(ProgramDecodingError)
+			if !ok {
+				panic(r)
+			}
+			err = decodingErr
+		}
+	}()
+
+	version := decoder.decodeUint()
+	if version != EncodedProgramVersion {
+		decoder.fail("unsupported version: expected %d, got %d", EncodedProgramVersion, version)
+	}
+
+	sourceHash := decoder.decodeBytes()
+
+	decodeBody(decoder, sourceHash)
+
+	return nil
+}
+
+type programDecoder struct {
+	dec         *cbor.StreamDecoder
+	memoryGauge common.MemoryGauge
+	// expressionDepth is the depth of the currently decoded expression (if >0),
+	// counted like the parser counts the depth of the parsed expression
+	expressionDepth int
+	// typeDepth is the depth of the currently decoded type (if >0),
+	// counted like the parser counts the depth of the parsed type
+	typeDepth int
+}
+
+func (d *programDecoder) fail(message string, args ...any) {
+	panic(ProgramDecodingError{
+		Err: fmt.Errorf(message, args...),
+	})
+}
+
+func (d *programDecoder) must(err error) {
+	if err != nil {
+		panic(ProgramDecodingError{Err: err})
+	}
+}
+
+// decodeNil decodes nil, if the next value is nil,
+// and returns whether nil was decoded
+func (d *programDecoder) decodeNil() bool {
+	ty, err := d.dec.NextType()
+	d.must(err)
+	if ty != cbor.NilType {
+		return false
+	}
+	d.must(d.dec.DecodeNil())
+	return true
+}
+
+func (d *programDecoder) decodeArrayHead() int {
+	length, err := d.dec.DecodeArrayHead()
+	d.must(err)
+	return int(length)
+}
+
+func (d *programDecoder) expectArrayHead(expected int) {
+	length := d.decodeArrayHead()
+	if length != expected {
+		d.fail("invalid array length: expected %d, got %d", expected, length)
+	}
+}
+
+func (d *programDecoder) decodeUint() uint64 {
+	value, err := d.dec.DecodeUint64()
+	d.must(err)
+	return value
+}
+
+func (d *programDecoder) decodeInt() int {
+	value, err := d.dec.DecodeInt64()
+	d.must(err)
+	return int(value)
+}
+
+func (d *programDecoder) decodeBool() bool {
+	value, err := d.dec.DecodeBool()
+	d.must(err)
+	return value
+}
+
+func (d *programDecoder) decodeString() string {
+	value, err := d.dec.DecodeString()
+	d.must(err)
+	return value
+}
+
+func (d *programDecoder) decodeBytes() []byte {
+	if d.decodeNil() {
+		return nil
+	}
+	value, err := d.dec.DecodeBytes()
+	d.must(err)
+	return value
+}
+
+func (d *programDecoder) decodeBigInt() *big.Int {
+	if d.decodeNil() {
+		return nil
+	}
+	value, err := d.dec.DecodeBigInt()
+	d.must(err)
+	return value
+}
+
+func (d *programDecoder) decodeStrings() []string {
+	if d.decodeNil() {
+		return nil
+	}
+	length := d.decodeArrayHead()
+	values := make([]string, length)
+	for i := 0; i < length; i++ {
+		values[i] = d.decodeString()
+	}
+	return values
+}
+
+func (d *programDecoder) decodePosition() Position {
+	d.expectArrayHead(3)
+	offset := d.decodeInt()
+	line := d.decodeInt()
+	column := d.decodeInt()
+	return NewPosition(d.memoryGauge, offset, line, column)
+}
+
+func (d *programDecoder) decodeOptionalPosition() *Position {
+	if d.decodeNil() {
+		return nil
+	}
+	pos := d.decodePosition()
+	return &pos
+}
+
+func (d *programDecoder) decodeRange() Range {
+	d.expectArrayHead(2)
+	startPos := d.decodePosition()
+	endPos := d.decodePosition()
+	return NewRange(d.memoryGauge, startPos, endPos)
+}
+
+func (d *programDecoder) decodeIdentifier() Identifier {
+	d.expectArrayHead(2)
+	identifier := d.decodeString()
+	pos := d.decodePosition()
+	return NewIdentifier(d.memoryGauge, identifier, pos)
+}
+
+func (d *programDecoder) decodeOptionalIdentifier() *Identifier {
+	if d.decodeNil() {
+		return nil
+	}
+	identifier := d.decodeIdentifier()
+	return &identifier
+}
+
+func (d *programDecoder) decodeIdentifiers() []Identifier {
+	if d.decodeNil() {
+		return nil
+	}
+	length := d.decodeArrayHead()
+	identifiers := make([]Identifier, length)
+	for i := 0; i < length; i++ {
+		identifiers[i] = d.decodeIdentifier()
+	}
+	return identifiers
+}
+
+func (d *programDecoder) decodeLocation() common.Location {
+	length := d.decodeArrayHead()
+	if length < 1 {
+		d.fail("invalid import location")
+	}
+
+	kind := encodedLocationKind(d.decodeUint())
+	switch kind {
+	case encodedLocationKindIdentifier:
+		if length != 2 {
+			d.fail("invalid identifier location")
+		}
+		return common.IdentifierLocation(d.decodeString())
+
+	case encodedLocationKindString:
+		if length != 2 {
+			d.fail("invalid string location")
+		}
+		return common.NewStringLocation(d.memoryGauge, d.decodeString())
+
+	case encodedLocationKindAddress:
+		if length != 3 {
+			d.fail("invalid address location")
+		}
+		address, err := common.BytesToAddress(d.decodeBytes())
+		d.must(err)
+		name := d.decodeString()
+		return common.NewAddressLocation(d.memoryGauge, address, name)
+
+	default:
+		d.fail("unsupported import location kind: %d", kind)
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (d *programDecoder) decodeTransfer() *Transfer {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(2)
+	operation := TransferOperation(d.decodeUint())
+	pos := d.decodePosition()
+	return NewTransfer(d.memoryGauge, operation, pos)
+}
+
+func (d *programDecoder) decodeTypeAnnotation() *TypeAnnotation {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(3)
+	isResource := d.decodeBool()
+	ty := decodeElement[Type](d)
+	startPos := d.decodePosition()
+	return NewTypeAnnotation(d.memoryGauge, isResource, ty, startPos)
+}
+
+func (d *programDecoder) decodeTypeAnnotations() []*TypeAnnotation {
+	if d.decodeNil() {
+		return nil
+	}
+	length := d.decodeArrayHead()
+	typeAnnotations := make([]*TypeAnnotation, length)
+	for i := 0; i < length; i++ {
+		typeAnnotations[i] = d.decodeTypeAnnotation()
+	}
+	return typeAnnotations
+}
+
+func (d *programDecoder) decodeParameterList() *ParameterList {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(2)
+
+	var parameters []*Parameter
+	if !d.decodeNil() {
+		length := d.decodeArrayHead()
+		parameters = make([]*Parameter, length)
+		for i := 0; i < length; i++ {
+			parameters[i] = d.decodeParameter()
+		}
+	}
+
+	astRange := d.decodeRange()
+
+	return NewParameterList(d.memoryGauge, parameters, astRange)
+}
+
+func (d *programDecoder) decodeParameter() *Parameter {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(5)
+	label := d.decodeString()
+	identifier := d.decodeIdentifier()
+	typeAnnotation := d.decodeTypeAnnotation()
+	defaultArgument := decodeElement[Expression](d)
+	startPos := d.decodePosition()
+	return NewParameter(
+		d.memoryGauge,
+		label,
+		identifier,
+		typeAnnotation,
+		defaultArgument,
+		startPos,
+	)
+}
+
+func (d *programDecoder) decodeTypeParameterList() *TypeParameterList {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(2)
+
+	var typeParameters []*TypeParameter
+	if !d.decodeNil() {
+		length := d.decodeArrayHead()
+		typeParameters = make([]*TypeParameter, length)
+		for i := 0; i < length; i++ {
+			if d.decodeNil() {
+				continue
+			}
+			d.expectArrayHead(2)
+			identifier := d.decodeIdentifier()
+			typeBound := d.decodeTypeAnnotation()
+			typeParameters[i] = NewTypeParameter(d.memoryGauge, identifier, typeBound)
+		}
+	}
+
+	astRange := d.decodeRange()
+
+	return NewTypeParameterList(d.memoryGauge, typeParameters, astRange)
+}
+
+func (d *programDecoder) decodeMembers() *Members {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(1)
+	declarations := decodeElements[Declaration](d)
+	return NewMembers(d.memoryGauge, declarations)
+}
+
+func (d *programDecoder) decodeNominalTypes() []*NominalType {
+	return decodeElements[*NominalType](d)
+}
+
+func (d *programDecoder) decodeBlock() *Block {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(2)
+	statements := decodeElements[Statement](d)
+	astRange := d.decodeRange()
+	return NewBlock(d.memoryGauge, statements, astRange)
+}
+
+func (d *programDecoder) decodeConditions() *Conditions {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(1)
+	conditions := Conditions(decodeElements[Condition](d))
+	return &conditions
+}
+
+func (d *programDecoder) decodeFunctionBlock() *FunctionBlock {
+	if d.decodeNil() {
+		return nil
+	}
+	d.expectArrayHead(3)
+	block := d.decodeBlock()
+	preConditions := d.decodeConditions()
+	postConditions := d.decodeConditions()
+	return NewFunctionBlock(d.memoryGauge, block, preConditions, postConditions)
+}
+
+func (d *programDecoder) decodeArguments() Arguments {
+	if d.decodeNil() {
+		return nil
+	}
+	length := d.decodeArrayHead()
+	arguments := make(Arguments, length)
+	for i := 0; i < length; i++ {
+		if d.decodeNil() {
+			continue
+		}
+		d.expectArrayHead(5)
+		label := d.decodeString()
+		labelStartPos := d.decodeOptionalPosition()
+		labelEndPos := d.decodeOptionalPosition()
+		expression := decodeElement[Expression](d)
+		argument := NewArgument(
+			d.memoryGauge,
+			label,
+			labelStartPos,
+			labelEndPos,
+			expression,
+		)
+		argument.TrailingSeparatorPos = d.decodePosition()
+		arguments[i] = argument
+	}
+	return arguments
+}
+
+func decodeElements[T any](d *programDecoder) []T {
+	if d.decodeNil() {
+		return nil
+	}
+	length := d.decodeArrayHead()
+	elements := make([]T, length)
+	for i := 0; i < length; i++ {
+		elements[i] = decodeElement[T](d)
+	}
+	return elements
+}
+
+// decodeElement decodes an element, which is expected to be of type T,
+// or nil, if the element is absent
+func decodeElement[T any](d *programDecoder) T {
+	var result T
+
+	element := d.decodeElement()
+	if element == nil {
+		return result
+	}
+
+	result, ok := element.(T)
+	if !ok {
+		d.fail("unexpected element: %T", element)
+	}
+
+	return result
+}
+
+// decodeLeftElement decodes an element, which is expected to be of type T,
+// and which the parser parses as the left side of a left denotation,
+// i.e. at the same depth as the enclosing expression or type
+func decodeLeftElement[T any](d *programDecoder, depth *int) T {
+	*depth--
+	defer func() {
+		*depth++
+	}()
+	return decodeElement[T](d)
+}
+
+func (d *programDecoder) decodeElement() any {
+	if d.decodeNil() {
+		return nil
+	}
+
+	length := d.decodeArrayHead()
+	if length < 1 {
+		d.fail("invalid element")
+	}
+
+	kind := encodedElementKind(d.decodeUint())
+
+	// Reject programs which are nested more deeply than the parser allows
+
+	switch {
+	case kind >= encodedElementKindBoolExpression && kind <= encodedElementKindAttachExpression:
+		if d.expressionDepth == ExpressionDepthLimit {
+			d.fail("reached max expression depth limit %d", ExpressionDepthLimit)
+		}
+		d.expressionDepth++
+		defer func() {
+			d.expressionDepth--
+		}()
+
+	case kind >= encodedElementKindNominalType && kind <= encodedElementKindInstantiationType:
+		if d.typeDepth == TypeDepthLimit {
+			d.fail("reached max type depth limit %d", TypeDepthLimit)
+		}
+		d.typeDepth++
+		defer func() {
+			d.typeDepth--
+		}()
+	}
+
+	fields := func(count int) {
+		if length != count+1 {
+			d.fail(
+				"invalid element of kind %d: expected %d fields, got %d",
+				kind,
+				count,
+				length-1,
+			)
+		}
+	}
+
+	switch kind {
+
+	// Declarations
+
+	case encodedElementKindImportDeclaration:
+		fields(5)
+		identifiers := d.decodeIdentifiers()
+		location := d.decodeLocation()
+		hash := d.decodeBytes()
+		declRange := d.decodeRange()
+		locationPos := d.decodePosition()
+		return NewImportDeclaration(
+			d.memoryGauge,
+			identifiers,
+			location,
+			hash,
+			declRange,
+			locationPos,
+		)
+
+	case encodedElementKindCompositeDeclaration:
+		fields(8)
+		access := decodeElement[Access](d)
+		compositeKind := common.CompositeKind(d.decodeUint())
+		identifier := d.decodeIdentifier()
+		typeParameterList := d.decodeTypeParameterList()
+		conformances := d.decodeNominalTypes()
+		members := d.decodeMembers()
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewCompositeDeclaration(
+			d.memoryGauge,
+			access,
+			compositeKind,
+			identifier,
+			typeParameterList,
+			conformances,
+			members,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindInterfaceDeclaration:
+		fields(7)
+		access := decodeElement[Access](d)
+		compositeKind := common.CompositeKind(d.decodeUint())
+		identifier := d.decodeIdentifier()
+		conformances := d.decodeNominalTypes()
+		members := d.decodeMembers()
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewInterfaceDeclaration(
+			d.memoryGauge,
+			access,
+			compositeKind,
+			identifier,
+			conformances,
+			members,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindAttachmentDeclaration:
+		fields(7)
+		access := decodeElement[Access](d)
+		identifier := d.decodeIdentifier()
+		baseType := decodeElement[*NominalType](d)
+		conformances := d.decodeNominalTypes()
+		members := d.decodeMembers()
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewAttachmentDeclaration(
+			d.memoryGauge,
+			access,
+			identifier,
+			baseType,
+			conformances,
+			members,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindFunctionDeclaration:
+		fields(10)
+		access := decodeElement[Access](d)
+		purity := FunctionPurity(d.decodeUint())
+		flags := FunctionDeclarationFlags(d.decodeUint())
+		identifier := d.decodeIdentifier()
+		typeParameterList := d.decodeTypeParameterList()
+		parameterList := d.decodeParameterList()
+		returnTypeAnnotation := d.decodeTypeAnnotation()
+		functionBlock := d.decodeFunctionBlock()
+		startPos := d.decodePosition()
+		docString := d.decodeString()
+		return NewFunctionDeclaration(
+			d.memoryGauge,
+			access,
+			purity,
+			flags&FunctionDeclarationFlagsIsStatic != 0,
+			flags&FunctionDeclarationFlagsIsNative != 0,
+			identifier,
+			typeParameterList,
+			parameterList,
+			returnTypeAnnotation,
+			functionBlock,
+			startPos,
+			docString,
+		)
+
+	case encodedElementKindSpecialFunctionDeclaration:
+		fields(2)
+		declarationKind := common.DeclarationKind(d.decodeUint())
+		functionDeclaration := decodeElement[*FunctionDeclaration](d)
+		return NewSpecialFunctionDeclaration(
+			d.memoryGauge,
+			declarationKind,
+			functionDeclaration,
+		)
+
+	case encodedElementKindFieldDeclaration:
+		fields(7)
+		access := decodeElement[Access](d)
+		flags := FieldDeclarationFlags(d.decodeUint())
+		variableKind := VariableKind(d.decodeUint())
+		identifier := d.decodeIdentifier()
+		typeAnnotation := d.decodeTypeAnnotation()
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewFieldDeclaration(
+			d.memoryGauge,
+			access,
+			flags&FieldDeclarationFlagsIsStatic != 0,
+			flags&FieldDeclarationFlagsIsNative != 0,
+			variableKind,
+			identifier,
+			typeAnnotation,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindEnumCaseDeclaration:
+		fields(4)
+		access := decodeElement[Access](d)
+		identifier := d.decodeIdentifier()
+		docString := d.decodeString()
+		startPos := d.decodePosition()
+		return NewEnumCaseDeclaration(
+			d.memoryGauge,
+			access,
+			identifier,
+			docString,
+			startPos,
+		)
+
+	case encodedElementKindEntitlementDeclaration:
+		fields(4)
+		access := decodeElement[Access](d)
+		identifier := d.decodeIdentifier()
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewEntitlementDeclaration(
+			d.memoryGauge,
+			access,
+			identifier,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindEntitlementMappingDeclaration:
+		fields(5)
+		access := decodeElement[Access](d)
+		identifier := d.decodeIdentifier()
+		elements := decodeElements[EntitlementMapElement](d)
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewEntitlementMappingDeclaration(
+			d.memoryGauge,
+			access,
+			identifier,
+			elements,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindTransactionDeclaration:
+		fields(8)
+		parameterList := d.decodeParameterList()
+		fieldDeclarations := decodeElements[*FieldDeclaration](d)
+		prepare := decodeElement[*SpecialFunctionDeclaration](d)
+		preConditions := d.decodeConditions()
+		postConditions := d.decodeConditions()
+		execute := decodeElement[*SpecialFunctionDeclaration](d)
+		docString := d.decodeString()
+		declRange := d.decodeRange()
+		return NewTransactionDeclaration(
+			d.memoryGauge,
+			parameterList,
+			fieldDeclarations,
+			prepare,
+			preConditions,
+			postConditions,
+			execute,
+			docString,
+			declRange,
+		)
+
+	case encodedElementKindVariableDeclaration:
+		fields(10)
+		access := decodeElement[Access](d)
+		isConstant := d.decodeBool()
+		identifier := d.decodeIdentifier()
+		typeAnnotation := d.decodeTypeAnnotation()
+		value := decodeElement[Expression](d)
+		transfer := d.decodeTransfer()
+		startPos := d.decodePosition()
+		secondTransfer := d.decodeTransfer()
+		secondValue := decodeElement[Expression](d)
+		docString := d.decodeString()
+		variableDeclaration := NewVariableDeclaration(
+			d.memoryGauge,
+			access,
+			isConstant,
+			identifier,
+			typeAnnotation,
+			value,
+			transfer,
+			startPos,
+			secondTransfer,
+			secondValue,
+			docString,
+		)
+
+		// Restore the parent link, like the parser
+		if castingExpression, ok := value.(*CastingExpression); ok {
+			castingExpression.ParentVariableDeclaration = variableDeclaration
+		}
+
+		return variableDeclaration
+
+	case encodedElementKindPragmaDeclaration:
+		fields(2)
+		expression := decodeElement[Expression](d)
+		declRange := d.decodeRange()
+		return NewPragmaDeclaration(d.memoryGauge, expression, declRange)
+
+	// Statements
+
+	case encodedElementKindReturnStatement:
+		fields(2)
+		expression := decodeElement[Expression](d)
+		stmtRange := d.decodeRange()
+		return NewReturnStatement(d.memoryGauge, expression, stmtRange)
+
+	case encodedElementKindBreakStatement:
+		fields(1)
+		return NewBreakStatement(d.memoryGauge, d.decodeRange())
+
+	case encodedElementKindContinueStatement:
+		fields(1)
+		return NewContinueStatement(d.memoryGauge, d.decodeRange())
+
+	case encodedElementKindIfStatement:
+		fields(4)
+		test := decodeElement[IfStatementTest](d)
+		thenBlock := d.decodeBlock()
+		elseBlock := d.decodeBlock()
+		startPos := d.decodePosition()
+		ifStatement := NewIfStatement(
+			d.memoryGauge,
+			test,
+			thenBlock,
+			elseBlock,
+			startPos,
+		)
+
+		// Restore the parent link, like the parser
+		if variableDeclaration, ok := test.(*VariableDeclaration); ok {
+			variableDeclaration.ParentIfStatement = ifStatement
+		}
+
+		return ifStatement
+
+	case encodedElementKindGuardStatement:
+		fields(3)
+		test := decodeElement[*VariableDeclaration](d)
+		elseBlock := d.decodeBlock()
+		startPos := d.decodePosition()
+		guardStatement := NewGuardStatement(
+			d.memoryGauge,
+			test,
+			elseBlock,
+			startPos,
+		)
+
+		// Restore the parent link, like the parser
+		if test != nil {
+			test.ParentGuardStatement = guardStatement
+		}
+
+		return guardStatement
+
+	case encodedElementKindWhileStatement:
+		fields(3)
+		test := decodeElement[Expression](d)
+		block := d.decodeBlock()
+		startPos := d.decodePosition()
+		return NewWhileStatement(d.memoryGauge, test, block, startPos)
+
+	case encodedElementKindForStatement:
+		fields(5)
+		identifier := d.decodeIdentifier()
+		index := d.decodeOptionalIdentifier()
+		block := d.decodeBlock()
+		value := decodeElement[Expression](d)
+		startPos := d.decodePosition()
+		return NewForStatement(
+			d.memoryGauge,
+			identifier,
+			index,
+			block,
+			value,
+			startPos,
+		)
+
+	case encodedElementKindEmitStatement:
+		fields(2)
+		invocation := decodeElement[*InvocationExpression](d)
+		startPos := d.decodePosition()
+		return NewEmitStatement(d.memoryGauge, invocation, startPos)
+
+	case encodedElementKindAssignmentStatement:
+		fields(3)
+		target := decodeElement[Expression](d)
+		transfer := d.decodeTransfer()
+		value := decodeElement[Expression](d)
+		return NewAssignmentStatement(d.memoryGauge, target, transfer, value)
+
+	case encodedElementKindSwapStatement:
+		fields(2)
+		left := decodeElement[Expression](d)
+		right := decodeElement[Expression](d)
+		return NewSwapStatement(d.memoryGauge, left, right)
+
+	case encodedElementKindExpressionStatement:
+		fields(1)
+		return NewExpressionStatement(d.memoryGauge, decodeElement[Expression](d))
+
+	case encodedElementKindSwitchStatement:
+		fields(3)
+		expression := decodeElement[Expression](d)
+
+		var cases []*SwitchCase
+		if !d.decodeNil() {
+			length := d.decodeArrayHead()
+			cases = make([]*SwitchCase, length)
+			for i := 0; i < length; i++ {
+				if d.decodeNil() {
+					continue
+				}
+				d.expectArrayHead(3)
+				caseExpression := decodeElement[Expression](d)
+				statements := decodeElements[Statement](d)
+				caseRange := d.decodeRange()
+				cases[i] = &SwitchCase{
+					Expression: caseExpression,
+					Statements: statements,
+					Range:      caseRange,
+				}
+			}
+		}
+
+		stmtRange := d.decodeRange()
+		return NewSwitchStatement(d.memoryGauge, expression, cases, stmtRange)
+
+	case encodedElementKindRemoveStatement:
+		fields(3)
+		attachment := decodeElement[*NominalType](d)
+		value := decodeElement[Expression](d)
+		startPos := d.decodePosition()
+		return NewRemoveStatement(d.memoryGauge, attachment, value, startPos)
+
+	// Expressions
+
+	case encodedElementKindBoolExpression:
+		fields(2)
+		value := d.decodeBool()
+		exprRange := d.decodeRange()
+		return NewBoolExpression(d.memoryGauge, value, exprRange)
+
+	case encodedElementKindNilExpression:
+		fields(1)
+		return NewNilExpression(d.memoryGauge, d.decodePosition())
+
+	case encodedElementKindStringExpression:
+		fields(2)
+		value := d.decodeString()
+		exprRange := d.decodeRange()
+		return NewStringExpression(d.memoryGauge, value, exprRange)
+
+	case encodedElementKindStringTemplateExpression:
+		fields(3)
+		values := d.decodeStrings()
+		expressions := decodeElements[Expression](d)
+		exprRange := d.decodeRange()
+		return NewStringTemplateExpression(d.memoryGauge, values, expressions, exprRange)
+
+	case encodedElementKindIntegerExpression:
+		fields(4)
+		literal := d.decodeBytes()
+		value := d.decodeBigInt()
+		base := d.decodeInt()
+		tokenRange := d.decodeRange()
+		return NewIntegerExpression(d.memoryGauge, literal, value, base, tokenRange)
+
+	case encodedElementKindFixedPointExpression:
+		fields(6)
+		literal := d.decodeBytes()
+		isNegative := d.decodeBool()
+		integer := d.decodeBigInt()
+		fractional := d.decodeBigInt()
+		scale := uint(d.decodeUint())
+		tokenRange := d.decodeRange()
+		return NewFixedPointExpression(
+			d.memoryGauge,
+			literal,
+			isNegative,
+			integer,
+			fractional,
+			scale,
+			tokenRange,
+		)
+
+	case encodedElementKindArrayExpression:
+		fields(2)
+		values := decodeElements[Expression](d)
+		tokenRange := d.decodeRange()
+		return NewArrayExpression(d.memoryGauge, values, tokenRange)
+
+	case encodedElementKindDictionaryExpression:
+		fields(2)
+
+		var entries []DictionaryEntry
+		if !d.decodeNil() {
+			length := d.decodeArrayHead()
+			entries = make([]DictionaryEntry, length)
+			for i := 0; i < length; i++ {
+				d.expectArrayHead(2)
+				key := decodeElement[Expression](d)
+				value := decodeElement[Expression](d)
+				entries[i] = NewDictionaryEntry(d.memoryGauge, key, value)
+			}
+		}
+
+		tokenRange := d.decodeRange()
+		return NewDictionaryExpression(d.memoryGauge, entries, tokenRange)
+
+	case encodedElementKindIdentifierExpression:
+		fields(1)
+		return NewIdentifierExpression(d.memoryGauge, d.decodeIdentifier())
+
+	case encodedElementKindInvocationExpression:
+		fields(5)
+		invokedExpression := decodeLeftElement[Expression](d, &d.expressionDepth)
+		typeArguments := d.decodeTypeAnnotations()
+		arguments := d.decodeArguments()
+		argumentsStartPos := d.decodePosition()
+		endPos := d.decodePosition()
+		return NewInvocationExpression(
+			d.memoryGauge,
+			invokedExpression,
+			typeArguments,
+			arguments,
+			argumentsStartPos,
+			endPos,
+		)
+
+	case encodedElementKindMemberExpression:
+		fields(4)
+		expression := decodeLeftElement[Expression](d, &d.expressionDepth)
+		optional := d.decodeBool()
+		accessPos := d.decodePosition()
+		identifier := d.decodeIdentifier()
+		return NewMemberExpression(
+			d.memoryGauge,
+			expression,
+			optional,
+			accessPos,
+			identifier,
+		)
+
+	case encodedElementKindIndexExpression:
+		fields(3)
+		target := decodeLeftElement[Expression](d, &d.expressionDepth)
+		index := decodeElement[Expression](d)
+		tokenRange := d.decodeRange()
+		return NewIndexExpression(d.memoryGauge, target, index, tokenRange)
+
+	case encodedElementKindConditionalExpression:
+		fields(3)
+		test := decodeLeftElement[Expression](d, &d.expressionDepth)
+		thenExpression := decodeElement[Expression](d)
+		elseExpression := decodeElement[Expression](d)
+		return NewConditionalExpression(
+			d.memoryGauge,
+			test,
+			thenExpression,
+			elseExpression,
+		)
+
+	case encodedElementKindUnaryExpression:
+		fields(3)
+		operation := Operation(d.decodeUint())
+		expression := decodeElement[Expression](d)
+		startPos := d.decodePosition()
+		return NewUnaryExpression(d.memoryGauge, operation, expression, startPos)
+
+	case encodedElementKindBinaryExpression:
+		fields(3)
+		operation := Operation(d.decodeUint())
+		left := decodeLeftElement[Expression](d, &d.expressionDepth)
+		right := decodeElement[Expression](d)
+		return NewBinaryExpression(d.memoryGauge, operation, left, right)
+
+	case encodedElementKindFunctionExpression:
+		fields(5)
+		purity := FunctionPurity(d.decodeUint())
+		parameterList := d.decodeParameterList()
+		returnTypeAnnotation := d.decodeTypeAnnotation()
+		functionBlock := d.decodeFunctionBlock()
+		startPos := d.decodePosition()
+		return NewFunctionExpression(
+			d.memoryGauge,
+			purity,
+			parameterList,
+			returnTypeAnnotation,
+			functionBlock,
+			startPos,
+		)
+
+	case encodedElementKindCastingExpression:
+		fields(3)
+		expression := decodeLeftElement[Expression](d, &d.expressionDepth)
+		operation := Operation(d.decodeUint())
+		typeAnnotation := d.decodeTypeAnnotation()
+		return NewCastingExpression(
+			d.memoryGauge,
+			expression,
+			operation,
+			typeAnnotation,
+			nil,
+		)
+
+	case encodedElementKindCreateExpression:
+		fields(2)
+		invocation := decodeElement[*InvocationExpression](d)
+		startPos := d.decodePosition()
+		return NewCreateExpression(d.memoryGauge, invocation, startPos)
+
+	case encodedElementKindDestroyExpression:
+		fields(2)
+		expression := decodeElement[Expression](d)
+		startPos := d.decodePosition()
+		return NewDestroyExpression(d.memoryGauge, expression, startPos)
+
+	case encodedElementKindReferenceExpression:
+		fields(2)
+		expression := decodeElement[Expression](d)
+		startPos := d.decodePosition()
+		return NewReferenceExpression(d.memoryGauge, expression, startPos)
+
+	case encodedElementKindForceExpression:
+		fields(2)
+		expression := decodeLeftElement[Expression](d, &d.expressionDepth)
+		endPos := d.decodePosition()
+		return NewForceExpression(d.memoryGauge, expression, endPos)
+
+	case encodedElementKindPathExpression:
+		fields(3)
+		domain := d.decodeIdentifier()
+		identifier := d.decodeIdentifier()
+		startPos := d.decodePosition()
+		return NewPathExpression(d.memoryGauge, domain, identifier, startPos)
+
+	case encodedElementKindVoidExpression:
+		fields(1)
+		exprRange := d.decodeRange()
+		return NewVoidExpression(d.memoryGauge, exprRange.StartPos, exprRange.EndPos)
+
+	case encodedElementKindAttachExpression:
+		fields(3)
+		base := decodeElement[Expression](d)
+		attachment := decodeElement[*InvocationExpression](d)
+		startPos := d.decodePosition()
+		return NewAttachExpression(d.memoryGauge, base, attachment, startPos)
+
+	// Types
+
+	case encodedElementKindNominalType:
+		fields(2)
+		identifier := d.decodeIdentifier()
+		nestedIdentifiers := d.decodeIdentifiers()
+		return NewNominalType(d.memoryGauge, identifier, nestedIdentifiers)
+
+	case encodedElementKindOptionalType:
+		fields(2)
+		ty := decodeLeftElement[Type](d, &d.typeDepth)
+		endPos := d.decodePosition()
+		return NewOptionalType(d.memoryGauge, ty, endPos)
+
+	case encodedElementKindVariableSizedType:
+		fields(2)
+		ty := decodeElement[Type](d)
+		astRange := d.decodeRange()
+		return NewVariableSizedType(d.memoryGauge, ty, astRange)
+
+	case encodedElementKindConstantSizedType:
+		fields(3)
+		ty := decodeElement[Type](d)
+		size := decodeElement[*IntegerExpression](d)
+		astRange := d.decodeRange()
+		return NewConstantSizedType(d.memoryGauge, ty, size, astRange)
+
+	case encodedElementKindDictionaryType:
+		fields(3)
+		keyType := decodeElement[Type](d)
+		valueType := decodeElement[Type](d)
+		astRange := d.decodeRange()
+		return NewDictionaryType(d.memoryGauge, keyType, valueType, astRange)
+
+	case encodedElementKindFunctionType:
+		fields(4)
+		purity := FunctionPurity(d.decodeUint())
+		parameterTypes := d.decodeTypeAnnotations()
+		returnType := d.decodeTypeAnnotation()
+		astRange := d.decodeRange()
+		return NewFunctionType(
+			d.memoryGauge,
+			purity,
+			parameterTypes,
+			returnType,
+			astRange,
+		)
+
+	case encodedElementKindReferenceType:
+		fields(4)
+		authorization := decodeElement[Authorization](d)
+		legacyAuthorized := d.decodeBool()
+		ty := decodeElement[Type](d)
+		startPos := d.decodePosition()
+		referenceType := NewReferenceType(d.memoryGauge, authorization, ty, startPos)
+		referenceType.LegacyAuthorized = legacyAuthorized
+		return referenceType
+
+	case encodedElementKindIntersectionType:
+		fields(3)
+		legacyRestrictedType := decodeElement[Type](d)
+		types := d.decodeNominalTypes()
+		astRange := d.decodeRange()
+		intersectionType := NewIntersectionType(d.memoryGauge, types, astRange)
+		intersectionType.LegacyRestrictedType = legacyRestrictedType
+		return intersectionType
+
+	case encodedElementKindInstantiationType:
+		fields(4)
+		ty := decodeLeftElement[Type](d, &d.typeDepth)
+		typeArguments := d.decodeTypeAnnotations()
+		typeArgumentsStartPos := d.decodePosition()
+		endPos := d.decodePosition()
+		return NewInstantiationType(
+			d.memoryGauge,
+			ty,
+			typeArguments,
+			typeArgumentsStartPos,
+			endPos,
+		)
+
+	// Access and authorization
+
+	case encodedElementKindPrimitiveAccess:
+		fields(1)
+		return PrimitiveAccess(d.decodeUint())
+
+	case encodedElementKindEntitlementAccess:
+		fields(1)
+		return NewEntitlementAccess(decodeElement[EntitlementSet](d))
+
+	case encodedElementKindMappedAccess:
+		fields(2)
+		entitlementMap := decodeElement[*NominalType](d)
+		startPos := d.decodePosition()
+		return NewMappedAccess(entitlementMap, startPos)
+
+	case encodedElementKindConjunctiveEntitlementSet:
+		fields(1)
+		return NewConjunctiveEntitlementSet(d.decodeNominalTypes())
+
+	case encodedElementKindDisjunctiveEntitlementSet:
+		fields(1)
+		return NewDisjunctiveEntitlementSet(d.decodeNominalTypes())
+
+	// Other
+
+	case encodedElementKindTestCondition:
+		fields(2)
+		test := decodeElement[Expression](d)
+		message := decodeElement[Expression](d)
+		return &TestCondition{
+			Test:    test,
+			Message: message,
+		}
+
+	case encodedElementKindEmitCondition:
+		fields(2)
+		invocation := decodeElement[*InvocationExpression](d)
+		startPos := d.decodePosition()
+		return (*EmitCondition)(NewEmitStatement(d.memoryGauge, invocation, startPos))
+
+	case encodedElementKindEntitlementMapRelation:
+		fields(2)
+		input := decodeElement[*NominalType](d)
+		output := decodeElement[*NominalType](d)
+		return NewEntitlementMapRelation(d.memoryGauge, input, output)
+
+	default:
+		d.fail("unsupported element kind: %d", kind)
+		panic(errors.NewUnreachableError())
+	}
+}
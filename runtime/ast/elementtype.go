@@ -59,6 +59,7 @@ const (
 	ElementTypeSwapStatement
 	ElementTypeExpressionStatement
 	ElementTypeRemoveStatement
+	ElementTypeGuardStatement
 
 	// Expressions
 
@@ -85,4 +86,5 @@ const (
 	ElementTypeForceExpression
 	ElementTypePathExpression
 	ElementTypeAttachExpression
+	ElementTypeStringTemplateExpression
 )
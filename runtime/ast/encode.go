@@ -0,0 +1,1002 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Programs can be encoded into a compact binary format,
+// so that they can be loaded without lexing and parsing the source code.
+//
+// The encoding starts with the magic prefix encodedProgramMagic,
+// followed by a CBOR sequence of the encoding version, the hash of the source code
+// the program was parsed from (a byte string, or nil if unknown), and the declarations of the program.
+//
+// Every element (declaration, statement, expression, type, access, etc.) is encoded
+// as a CBOR array, whose first item is the kind of the element (encodedElementKind),
+// and whose remaining items are the fields of the element.
+// Other values which are part of an element (positions, identifiers, parameter lists, etc.)
+// are encoded as CBOR arrays of their fields.
+//
+// Absent values (e.g. optional sub-elements) are encoded as CBOR nil.
+//
+// The encoding is deterministic: encoding the same program always produces the same bytes.
+
+// encodedProgramMagic is the prefix of all encoded programs.
+// Source code never starts with a NUL byte,
+// so encoded programs can be distinguished from source code.
+var encodedProgramMagic = []byte{0x00, 0xCA, 0xDE, 0x0C}
+
+// EncodedProgramVersion is the current version of the program encoding.
+// NOTE: increment when the encoding changes in a backward-incompatible way
+//
+// Version history:
+//   - 1: Initial version
+//   - 2: Added the hash of the source code
+const EncodedProgramVersion = 2
+
+// programCBOREncMode is the CBOR encoding mode for programs.
+// Big integers are always encoded as CBOR bignums, so they are decoded as big integers again.
+var programCBOREncMode = func() cbor.EncMode {
+	options := cbor.CanonicalEncOptions()
+	options.BigIntConvert = cbor.BigIntConvertNone
+	encMode, err := options.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return encMode
+}()
+
+// IsEncodedProgram returns true if the given code is an encoded program,
+// and false if it is source code.
+func IsEncodedProgram(code []byte) bool {
+	return bytes.HasPrefix(code, encodedProgramMagic)
+}
+
+// encodedElementKind is the kind of encoded element.
+//
+// NOTE: The values are part of the encoding format.
+// DO *NOT* change or reuse existing values, only append new ones.
+type encodedElementKind uint64
+
+const (
+	// Declarations
+
+	encodedElementKindImportDeclaration             encodedElementKind = 1
+	encodedElementKindCompositeDeclaration          encodedElementKind = 2
+	encodedElementKindInterfaceDeclaration          encodedElementKind = 3
+	encodedElementKindAttachmentDeclaration         encodedElementKind = 4
+	encodedElementKindFunctionDeclaration           encodedElementKind = 5
+	encodedElementKindSpecialFunctionDeclaration    encodedElementKind = 6
+	encodedElementKindFieldDeclaration              encodedElementKind = 7
+	encodedElementKindEnumCaseDeclaration           encodedElementKind = 8
+	encodedElementKindEntitlementDeclaration        encodedElementKind = 9
+	encodedElementKindEntitlementMappingDeclaration encodedElementKind = 10
+	encodedElementKindTransactionDeclaration        encodedElementKind = 11
+	encodedElementKindVariableDeclaration           encodedElementKind = 12
+	encodedElementKindPragmaDeclaration             encodedElementKind = 13
+
+	// Statements
+
+	encodedElementKindReturnStatement     encodedElementKind = 20
+	encodedElementKindBreakStatement      encodedElementKind = 21
+	encodedElementKindContinueStatement   encodedElementKind = 22
+	encodedElementKindIfStatement         encodedElementKind = 23
+	encodedElementKindGuardStatement      encodedElementKind = 24
+	encodedElementKindWhileStatement      encodedElementKind = 25
+	encodedElementKindForStatement        encodedElementKind = 26
+	encodedElementKindEmitStatement       encodedElementKind = 27
+	encodedElementKindAssignmentStatement encodedElementKind = 28
+	encodedElementKindSwapStatement       encodedElementKind = 29
+	encodedElementKindExpressionStatement encodedElementKind = 30
+	encodedElementKindSwitchStatement     encodedElementKind = 31
+	encodedElementKindRemoveStatement     encodedElementKind = 32
+
+	// Expressions
+
+	encodedElementKindBoolExpression           encodedElementKind = 40
+	encodedElementKindNilExpression            encodedElementKind = 41
+	encodedElementKindStringExpression         encodedElementKind = 42
+	encodedElementKindStringTemplateExpression encodedElementKind = 43
+	encodedElementKindIntegerExpression        encodedElementKind = 44
+	encodedElementKindFixedPointExpression     encodedElementKind = 45
+	encodedElementKindArrayExpression          encodedElementKind = 46
+	encodedElementKindDictionaryExpression     encodedElementKind = 47
+	encodedElementKindIdentifierExpression     encodedElementKind = 48
+	encodedElementKindInvocationExpression     encodedElementKind = 49
+	encodedElementKindMemberExpression         encodedElementKind = 50
+	encodedElementKindIndexExpression          encodedElementKind = 51
+	encodedElementKindConditionalExpression    encodedElementKind = 52
+	encodedElementKindUnaryExpression          encodedElementKind = 53
+	encodedElementKindBinaryExpression         encodedElementKind = 54
+	encodedElementKindFunctionExpression       encodedElementKind = 55
+	encodedElementKindCastingExpression        encodedElementKind = 56
+	encodedElementKindCreateExpression         encodedElementKind = 57
+	encodedElementKindDestroyExpression        encodedElementKind = 58
+	encodedElementKindReferenceExpression      encodedElementKind = 59
+	encodedElementKindForceExpression          encodedElementKind = 60
+	encodedElementKindPathExpression           encodedElementKind = 61
+	encodedElementKindVoidExpression           encodedElementKind = 62
+	encodedElementKindAttachExpression         encodedElementKind = 63
+
+	// Types
+
+	encodedElementKindNominalType       encodedElementKind = 70
+	encodedElementKindOptionalType      encodedElementKind = 71
+	encodedElementKindVariableSizedType encodedElementKind = 72
+	encodedElementKindConstantSizedType encodedElementKind = 73
+	encodedElementKindDictionaryType    encodedElementKind = 74
+	encodedElementKindFunctionType      encodedElementKind = 75
+	encodedElementKindReferenceType     encodedElementKind = 76
+	encodedElementKindIntersectionType  encodedElementKind = 77
+	encodedElementKindInstantiationType encodedElementKind = 78
+
+	// Access and authorization
+
+	encodedElementKindPrimitiveAccess           encodedElementKind = 80
+	encodedElementKindEntitlementAccess         encodedElementKind = 81
+	encodedElementKindMappedAccess              encodedElementKind = 82
+	encodedElementKindConjunctiveEntitlementSet encodedElementKind = 83
+	encodedElementKindDisjunctiveEntitlementSet encodedElementKind = 84
+
+	// Other
+
+	encodedElementKindTestCondition          encodedElementKind = 90
+	encodedElementKindEmitCondition          encodedElementKind = 91
+	encodedElementKindEntitlementMapRelation encodedElementKind = 92
+)
+
+// encodedLocationKind is the kind of encoded import location.
+//
+// NOTE: The values are part of the encoding format.
+// DO *NOT* change or reuse existing values, only append new ones.
+type encodedLocationKind uint64
+
+const (
+	encodedLocationKindIdentifier encodedLocationKind = 1
+	encodedLocationKindString     encodedLocationKind = 2
+	encodedLocationKindAddress    encodedLocationKind = 3
+)
+
+// EncodeProgram encodes the given program into the binary program format.
+//
+// The given source hash is the hash of the source code the program was parsed from,
+// and is stored in the encoding, see DecodeProgramSourceHash.
+// Hashes of imported code, e.g. for pinned imports, are defined over the source code,
+// so the encoding must carry the hash to be used in place of the source code.
+// The hash may be nil if it is unknown.
+func EncodeProgram(program *Program, sourceHash []byte) (result []byte, err error) {
+	var buffer bytes.Buffer
+
+	buffer.Write(encodedProgramMagic)
+
+	encoder := &programEncoder{
+		enc: programCBOREncMode.NewStreamEncoder(&buffer),
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			encodingErr, ok := r.(programEncodingError)
+			if !ok {
+				panic(r)
+			}
+			result = nil
+			err = encodingErr.err
+		}
+	}()
+
+	encoder.encodeUint(EncodedProgramVersion)
+	encoder.encodeBytes(sourceHash)
+
+	encodeElements(encoder, program.Declarations())
+
+	encoder.must(encoder.enc.Flush())
+
+	return buffer.Bytes(), nil
+}
+
+// programEncodingError is used to abort the encoding,
+// it is recovered from in EncodeProgram
+type programEncodingError struct {
+	err error
+}
+
+type programEncoder struct {
+	enc *cbor.StreamEncoder
+}
+
+func (e *programEncoder) must(err error) {
+	if err != nil {
+		panic(programEncodingError{err: err})
+	}
+}
+
+func (e *programEncoder) encodeArrayHead(length int) {
+	e.must(e.enc.EncodeArrayHead(uint64(length)))
+}
+
+func (e *programEncoder) encodeNil() {
+	e.must(e.enc.EncodeNil())
+}
+
+func (e *programEncoder) encodeUint(value uint64) {
+	e.must(e.enc.EncodeUint64(value))
+}
+
+func (e *programEncoder) encodeInt(value int) {
+	e.must(e.enc.EncodeInt(value))
+}
+
+func (e *programEncoder) encodeBool(value bool) {
+	e.must(e.enc.EncodeBool(value))
+}
+
+func (e *programEncoder) encodeString(value string) {
+	e.must(e.enc.EncodeString(value))
+}
+
+func (e *programEncoder) encodeBytes(value []byte) {
+	if value == nil {
+		e.encodeNil()
+		return
+	}
+	e.must(e.enc.EncodeBytes(value))
+}
+
+func (e *programEncoder) encodeBigInt(value *big.Int) {
+	if value == nil {
+		e.encodeNil()
+		return
+	}
+	e.must(e.enc.EncodeBigInt(value))
+}
+
+func (e *programEncoder) encodeStrings(values []string) {
+	e.encodeArrayHead(len(values))
+	for _, value := range values {
+		e.encodeString(value)
+	}
+}
+
+func (e *programEncoder) encodePosition(pos Position) {
+	e.encodeArrayHead(3)
+	e.encodeInt(pos.Offset)
+	e.encodeInt(pos.Line)
+	e.encodeInt(pos.Column)
+}
+
+func (e *programEncoder) encodeOptionalPosition(pos *Position) {
+	if pos == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodePosition(*pos)
+}
+
+func (e *programEncoder) encodeRange(r Range) {
+	e.encodeArrayHead(2)
+	e.encodePosition(r.StartPos)
+	e.encodePosition(r.EndPos)
+}
+
+func (e *programEncoder) encodeIdentifier(identifier Identifier) {
+	e.encodeArrayHead(2)
+	e.encodeString(identifier.Identifier)
+	e.encodePosition(identifier.Pos)
+}
+
+func (e *programEncoder) encodeOptionalIdentifier(identifier *Identifier) {
+	if identifier == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeIdentifier(*identifier)
+}
+
+func (e *programEncoder) encodeIdentifiers(identifiers []Identifier) {
+	if identifiers == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(len(identifiers))
+	for _, identifier := range identifiers {
+		e.encodeIdentifier(identifier)
+	}
+}
+
+func (e *programEncoder) encodeLocation(location common.Location) {
+	switch location := location.(type) {
+	case common.IdentifierLocation:
+		e.encodeArrayHead(2)
+		e.encodeUint(uint64(encodedLocationKindIdentifier))
+		e.encodeString(string(location))
+
+	case common.StringLocation:
+		e.encodeArrayHead(2)
+		e.encodeUint(uint64(encodedLocationKindString))
+		e.encodeString(string(location))
+
+	case common.AddressLocation:
+		e.encodeArrayHead(3)
+		e.encodeUint(uint64(encodedLocationKindAddress))
+		e.encodeBytes(location.Address.Bytes())
+		e.encodeString(location.Name)
+
+	default:
+		panic(programEncodingError{
+			err: fmt.Errorf("unsupported import location: %T", location),
+		})
+	}
+}
+
+func (e *programEncoder) encodeTransfer(transfer *Transfer) {
+	if transfer == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(2)
+	e.encodeUint(uint64(transfer.Operation))
+	e.encodePosition(transfer.Pos)
+}
+
+func (e *programEncoder) encodeTypeAnnotation(typeAnnotation *TypeAnnotation) {
+	if typeAnnotation == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(3)
+	e.encodeBool(typeAnnotation.IsResource)
+	encodeOptionalElement(e, typeAnnotation.Type)
+	e.encodePosition(typeAnnotation.StartPos)
+}
+
+func (e *programEncoder) encodeTypeAnnotations(typeAnnotations []*TypeAnnotation) {
+	if typeAnnotations == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(len(typeAnnotations))
+	for _, typeAnnotation := range typeAnnotations {
+		e.encodeTypeAnnotation(typeAnnotation)
+	}
+}
+
+func (e *programEncoder) encodeNominalTypes(types []*NominalType) {
+	if types == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(len(types))
+	for _, ty := range types {
+		encodeOptionalElement(e, ty)
+	}
+}
+
+func (e *programEncoder) encodeParameterList(parameterList *ParameterList) {
+	if parameterList == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(2)
+	if parameterList.Parameters == nil {
+		e.encodeNil()
+	} else {
+		e.encodeArrayHead(len(parameterList.Parameters))
+		for _, parameter := range parameterList.Parameters {
+			e.encodeParameter(parameter)
+		}
+	}
+	e.encodeRange(parameterList.Range)
+}
+
+func (e *programEncoder) encodeParameter(parameter *Parameter) {
+	if parameter == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(5)
+	e.encodeString(parameter.Label)
+	e.encodeIdentifier(parameter.Identifier)
+	e.encodeTypeAnnotation(parameter.TypeAnnotation)
+	encodeOptionalElement(e, parameter.DefaultArgument)
+	e.encodePosition(parameter.StartPos)
+}
+
+func (e *programEncoder) encodeTypeParameterList(typeParameterList *TypeParameterList) {
+	if typeParameterList == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(2)
+	if typeParameterList.TypeParameters == nil {
+		e.encodeNil()
+	} else {
+		e.encodeArrayHead(len(typeParameterList.TypeParameters))
+		for _, typeParameter := range typeParameterList.TypeParameters {
+			if typeParameter == nil {
+				e.encodeNil()
+				continue
+			}
+			e.encodeArrayHead(2)
+			e.encodeIdentifier(typeParameter.Identifier)
+			e.encodeTypeAnnotation(typeParameter.TypeBound)
+		}
+	}
+	e.encodeRange(typeParameterList.Range)
+}
+
+func (e *programEncoder) encodeMembers(members *Members) {
+	if members == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(1)
+	encodeElements(e, members.Declarations())
+}
+
+func encodeElements[T comparable](e *programEncoder, elements []T) {
+	if elements == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(len(elements))
+	for _, element := range elements {
+		encodeOptionalElement(e, element)
+	}
+}
+
+func (e *programEncoder) encodeBlock(block *Block) {
+	if block == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(2)
+	encodeElements(e, block.Statements)
+	e.encodeRange(block.Range)
+}
+
+func (e *programEncoder) encodeConditions(conditions *Conditions) {
+	if conditions == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(1)
+	encodeElements(e, *conditions)
+}
+
+func (e *programEncoder) encodeFunctionBlock(functionBlock *FunctionBlock) {
+	if functionBlock == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(3)
+	e.encodeBlock(functionBlock.Block)
+	e.encodeConditions(functionBlock.PreConditions)
+	e.encodeConditions(functionBlock.PostConditions)
+}
+
+func (e *programEncoder) encodeArguments(arguments Arguments) {
+	if arguments == nil {
+		e.encodeNil()
+		return
+	}
+	e.encodeArrayHead(len(arguments))
+	for _, argument := range arguments {
+		if argument == nil {
+			e.encodeNil()
+			continue
+		}
+		e.encodeArrayHead(5)
+		e.encodeString(argument.Label)
+		e.encodeOptionalPosition(argument.LabelStartPos)
+		e.encodeOptionalPosition(argument.LabelEndPos)
+		encodeOptionalElement(e, argument.Expression)
+		e.encodePosition(argument.TrailingSeparatorPos)
+	}
+}
+
+// encodeOptionalElement encodes the given element,
+// or nil if the element is absent (i.e. a nil interface or a nil pointer)
+func encodeOptionalElement[T comparable](e *programEncoder, element T) {
+	var empty T
+	if element == empty {
+		e.encodeNil()
+		return
+	}
+	e.encodeElement(element)
+}
+
+func (e *programEncoder) encodeElementHead(kind encodedElementKind, fieldCount int) {
+	e.encodeArrayHead(fieldCount + 1)
+	e.encodeUint(uint64(kind))
+}
+
+func (e *programEncoder) encodeElement(element any) {
+	switch element := element.(type) {
+
+	// Declarations
+
+	case *ImportDeclaration:
+		e.encodeElementHead(encodedElementKindImportDeclaration, 5)
+		e.encodeIdentifiers(element.Identifiers)
+		e.encodeLocation(element.Location)
+		e.encodeBytes(element.Hash)
+		e.encodeRange(element.Range)
+		e.encodePosition(element.LocationPos)
+
+	case *CompositeDeclaration:
+		e.encodeElementHead(encodedElementKindCompositeDeclaration, 8)
+		encodeOptionalElement(e, element.Access)
+		e.encodeUint(uint64(element.CompositeKind))
+		e.encodeIdentifier(element.Identifier)
+		e.encodeTypeParameterList(element.TypeParameterList)
+		e.encodeNominalTypes(element.Conformances)
+		e.encodeMembers(element.Members)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *InterfaceDeclaration:
+		e.encodeElementHead(encodedElementKindInterfaceDeclaration, 7)
+		encodeOptionalElement(e, element.Access)
+		e.encodeUint(uint64(element.CompositeKind))
+		e.encodeIdentifier(element.Identifier)
+		e.encodeNominalTypes(element.Conformances)
+		e.encodeMembers(element.Members)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *AttachmentDeclaration:
+		e.encodeElementHead(encodedElementKindAttachmentDeclaration, 7)
+		encodeOptionalElement(e, element.Access)
+		e.encodeIdentifier(element.Identifier)
+		encodeOptionalElement(e, element.BaseType)
+		e.encodeNominalTypes(element.Conformances)
+		e.encodeMembers(element.Members)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *FunctionDeclaration:
+		e.encodeElementHead(encodedElementKindFunctionDeclaration, 10)
+		encodeOptionalElement(e, element.Access)
+		e.encodeUint(uint64(element.Purity))
+		e.encodeUint(uint64(element.Flags))
+		e.encodeIdentifier(element.Identifier)
+		e.encodeTypeParameterList(element.TypeParameterList)
+		e.encodeParameterList(element.ParameterList)
+		e.encodeTypeAnnotation(element.ReturnTypeAnnotation)
+		e.encodeFunctionBlock(element.FunctionBlock)
+		e.encodePosition(element.StartPos)
+		e.encodeString(element.DocString)
+
+	case *SpecialFunctionDeclaration:
+		e.encodeElementHead(encodedElementKindSpecialFunctionDeclaration, 2)
+		e.encodeUint(uint64(element.Kind))
+		encodeOptionalElement(e, element.FunctionDeclaration)
+
+	case *FieldDeclaration:
+		e.encodeElementHead(encodedElementKindFieldDeclaration, 7)
+		encodeOptionalElement(e, element.Access)
+		e.encodeUint(uint64(element.Flags))
+		e.encodeUint(uint64(element.VariableKind))
+		e.encodeIdentifier(element.Identifier)
+		e.encodeTypeAnnotation(element.TypeAnnotation)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *EnumCaseDeclaration:
+		e.encodeElementHead(encodedElementKindEnumCaseDeclaration, 4)
+		encodeOptionalElement(e, element.Access)
+		e.encodeIdentifier(element.Identifier)
+		e.encodeString(element.DocString)
+		e.encodePosition(element.StartPos)
+
+	case *EntitlementDeclaration:
+		e.encodeElementHead(encodedElementKindEntitlementDeclaration, 4)
+		encodeOptionalElement(e, element.Access)
+		e.encodeIdentifier(element.Identifier)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *EntitlementMappingDeclaration:
+		e.encodeElementHead(encodedElementKindEntitlementMappingDeclaration, 5)
+		encodeOptionalElement(e, element.Access)
+		e.encodeIdentifier(element.Identifier)
+		encodeElements(e, element.Elements)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *TransactionDeclaration:
+		e.encodeElementHead(encodedElementKindTransactionDeclaration, 8)
+		e.encodeParameterList(element.ParameterList)
+		encodeElements(e, element.Fields)
+		encodeOptionalElement(e, element.Prepare)
+		e.encodeConditions(element.PreConditions)
+		e.encodeConditions(element.PostConditions)
+		encodeOptionalElement(e, element.Execute)
+		e.encodeString(element.DocString)
+		e.encodeRange(element.Range)
+
+	case *VariableDeclaration:
+		e.encodeElementHead(encodedElementKindVariableDeclaration, 10)
+		encodeOptionalElement(e, element.Access)
+		e.encodeBool(element.IsConstant)
+		e.encodeIdentifier(element.Identifier)
+		e.encodeTypeAnnotation(element.TypeAnnotation)
+		encodeOptionalElement(e, element.Value)
+		e.encodeTransfer(element.Transfer)
+		e.encodePosition(element.StartPos)
+		e.encodeTransfer(element.SecondTransfer)
+		encodeOptionalElement(e, element.SecondValue)
+		e.encodeString(element.DocString)
+
+	case *PragmaDeclaration:
+		e.encodeElementHead(encodedElementKindPragmaDeclaration, 2)
+		encodeOptionalElement(e, element.Expression)
+		e.encodeRange(element.Range)
+
+	// Statements
+
+	case *ReturnStatement:
+		e.encodeElementHead(encodedElementKindReturnStatement, 2)
+		encodeOptionalElement(e, element.Expression)
+		e.encodeRange(element.Range)
+
+	case *BreakStatement:
+		e.encodeElementHead(encodedElementKindBreakStatement, 1)
+		e.encodeRange(element.Range)
+
+	case *ContinueStatement:
+		e.encodeElementHead(encodedElementKindContinueStatement, 1)
+		e.encodeRange(element.Range)
+
+	case *IfStatement:
+		e.encodeElementHead(encodedElementKindIfStatement, 4)
+		encodeOptionalElement(e, element.Test)
+		e.encodeBlock(element.Then)
+		e.encodeBlock(element.Else)
+		e.encodePosition(element.StartPos)
+
+	case *GuardStatement:
+		e.encodeElementHead(encodedElementKindGuardStatement, 3)
+		encodeOptionalElement(e, element.Test)
+		e.encodeBlock(element.Else)
+		e.encodePosition(element.StartPos)
+
+	case *WhileStatement:
+		e.encodeElementHead(encodedElementKindWhileStatement, 3)
+		encodeOptionalElement(e, element.Test)
+		e.encodeBlock(element.Block)
+		e.encodePosition(element.StartPos)
+
+	case *ForStatement:
+		e.encodeElementHead(encodedElementKindForStatement, 5)
+		e.encodeIdentifier(element.Identifier)
+		e.encodeOptionalIdentifier(element.Index)
+		e.encodeBlock(element.Block)
+		encodeOptionalElement(e, element.Value)
+		e.encodePosition(element.StartPos)
+
+	case *EmitStatement:
+		e.encodeElementHead(encodedElementKindEmitStatement, 2)
+		encodeOptionalElement(e, element.InvocationExpression)
+		e.encodePosition(element.StartPos)
+
+	case *AssignmentStatement:
+		e.encodeElementHead(encodedElementKindAssignmentStatement, 3)
+		encodeOptionalElement(e, element.Target)
+		e.encodeTransfer(element.Transfer)
+		encodeOptionalElement(e, element.Value)
+
+	case *SwapStatement:
+		e.encodeElementHead(encodedElementKindSwapStatement, 2)
+		encodeOptionalElement(e, element.Left)
+		encodeOptionalElement(e, element.Right)
+
+	case *ExpressionStatement:
+		e.encodeElementHead(encodedElementKindExpressionStatement, 1)
+		encodeOptionalElement(e, element.Expression)
+
+	case *SwitchStatement:
+		e.encodeElementHead(encodedElementKindSwitchStatement, 3)
+		encodeOptionalElement(e, element.Expression)
+		if element.Cases == nil {
+			e.encodeNil()
+		} else {
+			e.encodeArrayHead(len(element.Cases))
+			for _, switchCase := range element.Cases {
+				if switchCase == nil {
+					e.encodeNil()
+					continue
+				}
+				e.encodeArrayHead(3)
+				encodeOptionalElement(e, switchCase.Expression)
+				encodeElements(e, switchCase.Statements)
+				e.encodeRange(switchCase.Range)
+			}
+		}
+		e.encodeRange(element.Range)
+
+	case *RemoveStatement:
+		e.encodeElementHead(encodedElementKindRemoveStatement, 3)
+		encodeOptionalElement(e, element.Attachment)
+		encodeOptionalElement(e, element.Value)
+		e.encodePosition(element.StartPos)
+
+	// Expressions
+
+	case *BoolExpression:
+		e.encodeElementHead(encodedElementKindBoolExpression, 2)
+		e.encodeBool(element.Value)
+		e.encodeRange(element.Range)
+
+	case *NilExpression:
+		e.encodeElementHead(encodedElementKindNilExpression, 1)
+		e.encodePosition(element.Pos)
+
+	case *StringExpression:
+		e.encodeElementHead(encodedElementKindStringExpression, 2)
+		e.encodeString(element.Value)
+		e.encodeRange(element.Range)
+
+	case *StringTemplateExpression:
+		e.encodeElementHead(encodedElementKindStringTemplateExpression, 3)
+		e.encodeStrings(element.Values)
+		encodeElements(e, element.Expressions)
+		e.encodeRange(element.Range)
+
+	case *IntegerExpression:
+		e.encodeElementHead(encodedElementKindIntegerExpression, 4)
+		e.encodeBytes(element.PositiveLiteral)
+		e.encodeBigInt(element.Value)
+		e.encodeInt(element.Base)
+		e.encodeRange(element.Range)
+
+	case *FixedPointExpression:
+		e.encodeElementHead(encodedElementKindFixedPointExpression, 6)
+		e.encodeBytes(element.PositiveLiteral)
+		e.encodeBool(element.Negative)
+		e.encodeBigInt(element.UnsignedInteger)
+		e.encodeBigInt(element.Fractional)
+		e.encodeUint(uint64(element.Scale))
+		e.encodeRange(element.Range)
+
+	case *ArrayExpression:
+		e.encodeElementHead(encodedElementKindArrayExpression, 2)
+		encodeElements(e, element.Values)
+		e.encodeRange(element.Range)
+
+	case *DictionaryExpression:
+		e.encodeElementHead(encodedElementKindDictionaryExpression, 2)
+		if element.Entries == nil {
+			e.encodeNil()
+		} else {
+			e.encodeArrayHead(len(element.Entries))
+			for _, entry := range element.Entries {
+				e.encodeArrayHead(2)
+				encodeOptionalElement(e, entry.Key)
+				encodeOptionalElement(e, entry.Value)
+			}
+		}
+		e.encodeRange(element.Range)
+
+	case *IdentifierExpression:
+		e.encodeElementHead(encodedElementKindIdentifierExpression, 1)
+		e.encodeIdentifier(element.Identifier)
+
+	case *InvocationExpression:
+		e.encodeElementHead(encodedElementKindInvocationExpression, 5)
+		encodeOptionalElement(e, element.InvokedExpression)
+		e.encodeTypeAnnotations(element.TypeArguments)
+		e.encodeArguments(element.Arguments)
+		e.encodePosition(element.ArgumentsStartPos)
+		e.encodePosition(element.EndPos)
+
+	case *MemberExpression:
+		e.encodeElementHead(encodedElementKindMemberExpression, 4)
+		encodeOptionalElement(e, element.Expression)
+		e.encodeBool(element.Optional)
+		e.encodePosition(element.AccessPos)
+		e.encodeIdentifier(element.Identifier)
+
+	case *IndexExpression:
+		e.encodeElementHead(encodedElementKindIndexExpression, 3)
+		encodeOptionalElement(e, element.TargetExpression)
+		encodeOptionalElement(e, element.IndexingExpression)
+		e.encodeRange(element.Range)
+
+	case *ConditionalExpression:
+		e.encodeElementHead(encodedElementKindConditionalExpression, 3)
+		encodeOptionalElement(e, element.Test)
+		encodeOptionalElement(e, element.Then)
+		encodeOptionalElement(e, element.Else)
+
+	case *UnaryExpression:
+		e.encodeElementHead(encodedElementKindUnaryExpression, 3)
+		e.encodeUint(uint64(element.Operation))
+		encodeOptionalElement(e, element.Expression)
+		e.encodePosition(element.StartPos)
+
+	case *BinaryExpression:
+		e.encodeElementHead(encodedElementKindBinaryExpression, 3)
+		e.encodeUint(uint64(element.Operation))
+		encodeOptionalElement(e, element.Left)
+		encodeOptionalElement(e, element.Right)
+
+	case *FunctionExpression:
+		e.encodeElementHead(encodedElementKindFunctionExpression, 5)
+		e.encodeUint(uint64(element.Purity))
+		e.encodeParameterList(element.ParameterList)
+		e.encodeTypeAnnotation(element.ReturnTypeAnnotation)
+		e.encodeFunctionBlock(element.FunctionBlock)
+		e.encodePosition(element.StartPos)
+
+	case *CastingExpression:
+		// NOTE: the parent variable declaration is not encoded,
+		// it is restored when decoding the variable declaration
+		e.encodeElementHead(encodedElementKindCastingExpression, 3)
+		encodeOptionalElement(e, element.Expression)
+		e.encodeUint(uint64(element.Operation))
+		e.encodeTypeAnnotation(element.TypeAnnotation)
+
+	case *CreateExpression:
+		e.encodeElementHead(encodedElementKindCreateExpression, 2)
+		encodeOptionalElement(e, element.InvocationExpression)
+		e.encodePosition(element.StartPos)
+
+	case *DestroyExpression:
+		e.encodeElementHead(encodedElementKindDestroyExpression, 2)
+		encodeOptionalElement(e, element.Expression)
+		e.encodePosition(element.StartPos)
+
+	case *ReferenceExpression:
+		e.encodeElementHead(encodedElementKindReferenceExpression, 2)
+		encodeOptionalElement(e, element.Expression)
+		e.encodePosition(element.StartPos)
+
+	case *ForceExpression:
+		e.encodeElementHead(encodedElementKindForceExpression, 2)
+		encodeOptionalElement(e, element.Expression)
+		e.encodePosition(element.EndPos)
+
+	case *PathExpression:
+		e.encodeElementHead(encodedElementKindPathExpression, 3)
+		e.encodeIdentifier(element.Domain)
+		e.encodeIdentifier(element.Identifier)
+		e.encodePosition(element.StartPos)
+
+	case *VoidExpression:
+		e.encodeElementHead(encodedElementKindVoidExpression, 1)
+		e.encodeRange(element.Range)
+
+	case *AttachExpression:
+		e.encodeElementHead(encodedElementKindAttachExpression, 3)
+		encodeOptionalElement(e, element.Base)
+		encodeOptionalElement(e, element.Attachment)
+		e.encodePosition(element.StartPos)
+
+	// Types
+
+	case *NominalType:
+		e.encodeElementHead(encodedElementKindNominalType, 2)
+		e.encodeIdentifier(element.Identifier)
+		e.encodeIdentifiers(element.NestedIdentifiers)
+
+	case *OptionalType:
+		e.encodeElementHead(encodedElementKindOptionalType, 2)
+		encodeOptionalElement(e, element.Type)
+		e.encodePosition(element.EndPos)
+
+	case *VariableSizedType:
+		e.encodeElementHead(encodedElementKindVariableSizedType, 2)
+		encodeOptionalElement(e, element.Type)
+		e.encodeRange(element.Range)
+
+	case *ConstantSizedType:
+		e.encodeElementHead(encodedElementKindConstantSizedType, 3)
+		encodeOptionalElement(e, element.Type)
+		encodeOptionalElement(e, element.Size)
+		e.encodeRange(element.Range)
+
+	case *DictionaryType:
+		e.encodeElementHead(encodedElementKindDictionaryType, 3)
+		encodeOptionalElement(e, element.KeyType)
+		encodeOptionalElement(e, element.ValueType)
+		e.encodeRange(element.Range)
+
+	case *FunctionType:
+		e.encodeElementHead(encodedElementKindFunctionType, 4)
+		e.encodeUint(uint64(element.PurityAnnotation))
+		e.encodeTypeAnnotations(element.ParameterTypeAnnotations)
+		e.encodeTypeAnnotation(element.ReturnTypeAnnotation)
+		e.encodeRange(element.Range)
+
+	case *ReferenceType:
+		e.encodeElementHead(encodedElementKindReferenceType, 4)
+		encodeOptionalElement(e, element.Authorization)
+		e.encodeBool(element.LegacyAuthorized)
+		encodeOptionalElement(e, element.Type)
+		e.encodePosition(element.StartPos)
+
+	case *IntersectionType:
+		e.encodeElementHead(encodedElementKindIntersectionType, 3)
+		encodeOptionalElement(e, element.LegacyRestrictedType)
+		e.encodeNominalTypes(element.Types)
+		e.encodeRange(element.Range)
+
+	case *InstantiationType:
+		e.encodeElementHead(encodedElementKindInstantiationType, 4)
+		encodeOptionalElement(e, element.Type)
+		e.encodeTypeAnnotations(element.TypeArguments)
+		e.encodePosition(element.TypeArgumentsStartPos)
+		e.encodePosition(element.EndPos)
+
+	// Access and authorization
+
+	case PrimitiveAccess:
+		e.encodeElementHead(encodedElementKindPrimitiveAccess, 1)
+		e.encodeUint(uint64(element))
+
+	case EntitlementAccess:
+		e.encodeElementHead(encodedElementKindEntitlementAccess, 1)
+		encodeOptionalElement(e, element.EntitlementSet)
+
+	case *MappedAccess:
+		e.encodeElementHead(encodedElementKindMappedAccess, 2)
+		encodeOptionalElement(e, element.EntitlementMap)
+		e.encodePosition(element.StartPos)
+
+	case *ConjunctiveEntitlementSet:
+		e.encodeElementHead(encodedElementKindConjunctiveEntitlementSet, 1)
+		e.encodeNominalTypes(element.Elements)
+
+	case *DisjunctiveEntitlementSet:
+		e.encodeElementHead(encodedElementKindDisjunctiveEntitlementSet, 1)
+		e.encodeNominalTypes(element.Elements)
+
+	// Other
+
+	case *TestCondition:
+		e.encodeElementHead(encodedElementKindTestCondition, 2)
+		encodeOptionalElement(e, element.Test)
+		encodeOptionalElement(e, element.Message)
+
+	case *EmitCondition:
+		e.encodeElementHead(encodedElementKindEmitCondition, 2)
+		encodeOptionalElement(e, element.InvocationExpression)
+		e.encodePosition(element.StartPos)
+
+	case *EntitlementMapRelation:
+		e.encodeElementHead(encodedElementKindEntitlementMapRelation, 2)
+		encodeOptionalElement(e, element.Input)
+		encodeOptionalElement(e, element.Output)
+
+	default:
+		panic(programEncodingError{
+			err: errors.NewUnexpectedError("cannot encode unsupported element: %T", element),
+		})
+	}
+}
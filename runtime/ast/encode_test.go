@@ -0,0 +1,413 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+const testEncodedProgramCode = `
+  #pragma
+
+  import Foo from 0x1 hash 0xcafe
+  import "bar"
+  import Baz
+
+  access(all) let x: Int = 1
+  access(self) var y: [Int8; 3]? = nil
+
+  access(all) entitlement E
+  access(all) entitlement F
+
+  access(all) entitlement mapping M {
+      include Identity
+      E -> F
+  }
+
+  /// S is a struct
+  access(all) struct S<T: AnyStruct>: I {
+      access(all) let value: T
+      access(E | F) var count: UInt64
+      access(mapping M) let ref: auth(mapping M) &Int
+      static native fun nativeFunction()
+
+      init(value: T) {
+          pre {
+              true: "message"
+              emit Event(a: 1)
+          }
+          post {
+              result == nil
+          }
+          self.value = value
+          self.count = 0
+          self.ref = &1 as auth(mapping M) &Int
+      }
+
+      view access(all) fun get(): T {
+          return self.value
+      }
+  }
+
+  access(all) struct interface I {}
+
+  access(all) resource R: RI {
+      event ResourceDestroyed(id: UInt64 = self.uuid)
+  }
+
+  access(all) resource interface RI {}
+
+  access(all) attachment A for R: I {
+      access(all) fun foo(): auth(E, F) &R {
+          return base
+      }
+  }
+
+  access(all) enum Color: UInt8 {
+      access(all) case red
+      access(all) case green
+  }
+
+  access(all) event Event(a: Int)
+
+  access(all) fun unit() {
+      return ()
+  }
+
+  access(all) fun test(_ a: Int, b: {String: [Int]}): @R? {
+      let r <- create R()
+      var i = 0
+      while i < 10 {
+          i = i + 1
+          if i % 2 == 0 {
+              continue
+          } else if i > 8 {
+              break
+          }
+      }
+      for index, value in [1, 2, 3] {
+          i = -value
+      }
+      if let x = b["a"] {
+          i = x.length
+      }
+      guard let y = b["b"] else {
+          destroy r
+          return nil
+      }
+      switch i {
+      case 1:
+          i = 2
+      default:
+          i = 3
+      }
+      let r2 <- attach A() to <-r
+      remove A from r2
+      let s = "x \(i) y"
+      let dict = {"a": [1], "b": []}
+      let z = 1.5 + -0.25 + 0x1_0 + 0b101 + 0o7
+      let f = fun (x: Int): Int { return x }
+      let t = i > 0 ? f(x: i) : i!
+      let p = /storage/foo
+      let c = i as? Int
+      let d = i as! Int
+      let e: {I} = S(value: 1)
+      let g: fun(Int): String = fun (x: Int): String { return "" }
+      let h: Capability<&R>? = nil
+      var u: @R? <- nil
+      u <-> r2
+      u?.foo()
+      let w = true && false || !true
+      emit Event(a: 1)
+      destroy u
+      return <-r2
+  }
+
+  transaction(a: Int) {
+      let x: Int
+
+      prepare(signer: &Account) {
+          self.x = a
+      }
+
+      pre {
+          a > 0
+      }
+
+      execute {
+          log(self.x)
+      }
+
+      post {
+          self.x > 0
+      }
+  }
+`
+
+// testDepthLimitsProgramCode is a program whose expressions and types
+// are nested as deeply as the parser allows
+var testDepthLimitsProgramCode = `
+  let x = ` + strings.Repeat("!", ExpressionDepthLimit-1) + `true
+  let y: ` + strings.Repeat("[", TypeDepthLimit-1) + `Int` + strings.Repeat("]", TypeDepthLimit-1) + ` = []
+  let z: Int` + strings.Repeat("?", 2*TypeDepthLimit) + ` = a` + strings.Repeat(".b()[0]! + c", 2*ExpressionDepthLimit) + `
+
+  fun test() {
+      if true {} ` + strings.Repeat("else if true {} ", 2*ExpressionDepthLimit) + `
+  }
+`
+
+func parseTestProgram(t *testing.T, code []byte) *Program {
+	program, err := parser.ParseProgram(
+		nil,
+		code,
+		parser.Config{
+			StaticModifierEnabled: true,
+			NativeModifierEnabled: true,
+			TypeParametersEnabled: true,
+		},
+	)
+	require.NoError(t, err)
+	return program
+}
+
+func TestEncodeDecodeProgram(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, code []byte) {
+
+		program := parseTestProgram(t, code)
+
+		encoded, err := EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		require.True(t, IsEncodedProgram(encoded))
+		require.False(t, IsEncodedProgram(code))
+
+		decoded, err := DecodeProgram(nil, encoded)
+		require.NoError(t, err)
+
+		assert.Equal(t, program, decoded)
+
+		// Decoded program prettifies to the same source as the original program
+
+		assert.Equal(t,
+			Prettier(program),
+			Prettier(decoded),
+		)
+
+		// Encoding is deterministic
+
+		reencoded, err := EncodeProgram(decoded, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, encoded, reencoded)
+	}
+
+	t.Run("all elements", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, []byte(testEncodedProgramCode))
+	})
+
+	t.Run("depth limits", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, []byte(testDepthLimitsProgramCode))
+	})
+
+	for _, path := range []string{
+		"../stdlib/contracts/crypto.cdc",
+		"../stdlib/contracts/test.cdc",
+		"../sema/account.cdc",
+	} {
+		path := path
+
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			test(t, code)
+		})
+	}
+}
+
+func TestEncodeDecodeProgramSourceHash(t *testing.T) {
+
+	t.Parallel()
+
+	program := parseTestProgram(t, []byte(testEncodedProgramCode))
+
+	t.Run("known", func(t *testing.T) {
+		t.Parallel()
+
+		sourceHash := []byte{0x1, 0x2, 0x3}
+
+		encoded, err := EncodeProgram(program, sourceHash)
+		require.NoError(t, err)
+
+		decodedHash, err := DecodeProgramSourceHash(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, sourceHash, decodedHash)
+
+		decoded, err := DecodeProgram(nil, encoded)
+		require.NoError(t, err)
+		assert.Equal(t, program, decoded)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		decodedHash, err := DecodeProgramSourceHash(encoded)
+		require.NoError(t, err)
+		assert.Nil(t, decodedHash)
+	})
+
+	t.Run("source code", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeProgramSourceHash([]byte(testEncodedProgramCode))
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+	})
+}
+
+func TestDecodeProgramInvalid(t *testing.T) {
+
+	t.Parallel()
+
+	program := parseTestProgram(t, []byte(testEncodedProgramCode))
+
+	encoded, err := EncodeProgram(program, nil)
+	require.NoError(t, err)
+
+	t.Run("source code", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeProgram(nil, []byte(testEncodedProgramCode))
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		data := append([]byte{}, encoded...)
+		// The version directly follows the 4-byte prefix, and is encoded in one byte
+		data[4] = EncodedProgramVersion + 1
+
+		_, err := DecodeProgram(nil, data)
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+		require.ErrorContains(t, err, "unsupported version")
+	})
+
+	t.Run("version 1", func(t *testing.T) {
+		t.Parallel()
+
+		// Version 1 of the encoding had no source hash:
+		// The version was directly followed by the declarations.
+		// The version directly follows the 4-byte prefix, and is encoded in one byte,
+		// and the unknown source hash is encoded as CBOR nil
+
+		require.Equal(t, byte(0xf6), encoded[5])
+
+		data := append([]byte{}, encoded[:5]...)
+		data[4] = 1
+		data = append(data, encoded[6:]...)
+
+		_, err := DecodeProgram(nil, data)
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+		require.ErrorContains(t, err, "unsupported version: expected 2, got 1")
+
+		_, err = DecodeProgramSourceHash(data)
+		require.ErrorAs(t, err, &decodingErr)
+		require.ErrorContains(t, err, "unsupported version")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeProgram(nil, encoded[:len(encoded)/2])
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+	})
+
+	t.Run("expression depth limit", func(t *testing.T) {
+		t.Parallel()
+
+		program := parseTestProgram(t, []byte(testDepthLimitsProgramCode))
+
+		// Nest the expression, which is already at the limit, one level deeper
+
+		declaration := program.Declarations()[0].(*VariableDeclaration)
+		declaration.Value = NewUnaryExpression(
+			nil,
+			OperationNegate,
+			declaration.Value,
+			declaration.Value.StartPosition(),
+		)
+
+		encoded, err := EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		_, err = DecodeProgram(nil, encoded)
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+		require.ErrorContains(t, err, "expression depth limit")
+	})
+
+	t.Run("type depth limit", func(t *testing.T) {
+		t.Parallel()
+
+		program := parseTestProgram(t, []byte(testDepthLimitsProgramCode))
+
+		// Nest the type, which is already at the limit, one level deeper
+
+		declaration := program.Declarations()[1].(*VariableDeclaration)
+		typeAnnotation := declaration.TypeAnnotation
+		typeAnnotation.Type = NewVariableSizedType(
+			nil,
+			typeAnnotation.Type,
+			NewRangeFromPositioned(nil, typeAnnotation.Type),
+		)
+
+		encoded, err := EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		_, err = DecodeProgram(nil, encoded)
+		var decodingErr ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+		require.ErrorContains(t, err, "type depth limit")
+	})
+}
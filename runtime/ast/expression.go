@@ -33,6 +33,9 @@ import (
 
 const NilConstant = "nil"
 
+// ExpressionDepthLimit is the limit of how deeply nested an expression can get
+const ExpressionDepthLimit = 1 << 4
+
 type Expression interface {
 	Element
 	fmt.Stringer
@@ -220,6 +223,99 @@ func (*StringExpression) precedence() precedence {
 	return precedenceLiteral
 }
 
+// StringTemplateExpression
+
+// StringTemplateExpression is a string literal with interpolated expressions,
+// e.g. `"balance: \(vault.balance)"`.
+//
+// The values and expressions are interleaved:
+// `Values[0]`, `Expressions[0]`, `Values[1]`, ..., `Expressions[n-1]`, `Values[n]`,
+// i.e. there is always exactly one more value than there are expressions.
+type StringTemplateExpression struct {
+	Values      []string
+	Expressions []Expression
+	Range
+}
+
+var _ Expression = &StringTemplateExpression{}
+
+func NewStringTemplateExpression(
+	gauge common.MemoryGauge,
+	values []string,
+	expressions []Expression,
+	exprRange Range,
+) *StringTemplateExpression {
+	common.UseMemory(gauge, common.StringTemplateExpressionMemoryUsage)
+	return &StringTemplateExpression{
+		Values:      values,
+		Expressions: expressions,
+		Range:       exprRange,
+	}
+}
+
+var _ Element = &StringTemplateExpression{}
+var _ Expression = &StringTemplateExpression{}
+
+func (*StringTemplateExpression) ElementType() ElementType {
+	return ElementTypeStringTemplateExpression
+}
+
+func (*StringTemplateExpression) isExpression() {}
+
+func (*StringTemplateExpression) isIfStatementTest() {}
+
+func (e *StringTemplateExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Expressions)
+}
+
+func (e *StringTemplateExpression) String() string {
+	return Prettier(e)
+}
+
+const stringTemplateExpressionInterpolationStartDoc = prettier.Text(`\(`)
+
+func (e *StringTemplateExpression) Doc() prettier.Doc {
+	var builder strings.Builder
+
+	doc := make(prettier.Concat, 0, len(e.Values)+len(e.Expressions)*3)
+
+	builder.WriteByte('"')
+
+	for i, value := range e.Values {
+		writeEscapedString(&builder, value)
+
+		if i < len(e.Expressions) {
+			doc = append(
+				doc,
+				prettier.Text(builder.String()),
+				stringTemplateExpressionInterpolationStartDoc,
+				e.Expressions[i].Doc(),
+			)
+			builder.Reset()
+			builder.WriteByte(')')
+		}
+	}
+
+	builder.WriteByte('"')
+
+	return append(doc, prettier.Text(builder.String()))
+}
+
+func (e *StringTemplateExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringTemplateExpression
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+	}{
+		Type:  "StringTemplateExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*StringTemplateExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 // IntegerExpression
 
 type IntegerExpression struct {
@@ -180,6 +180,85 @@ func TestStringExpression_String(t *testing.T) {
 	)
 }
 
+func TestStringTemplateExpression_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	expr := &StringTemplateExpression{
+		Values: []string{"Hello, ", "!"},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "name",
+					Pos:        Position{Offset: 1, Line: 2, Column: 3},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 1, Line: 2, Column: 3},
+			EndPos:   Position{Offset: 4, Line: 5, Column: 6},
+		},
+	}
+
+	actual, err := json.Marshal(expr)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "StringTemplateExpression",
+            "Values": ["Hello, ", "!"],
+            "Expressions": [
+                {
+                    "Type": "IdentifierExpression",
+                    "Identifier": {
+                        "Identifier": "name",
+                        "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                        "EndPos": {"Offset": 4, "Line": 2, "Column": 6}
+                    },
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 4, "Line": 2, "Column": 6}
+                }
+            ],
+            "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+            "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestStringTemplateExpression_String(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t,
+		`"Hello, \(name)\n\(1 + 2)"`,
+		(&StringTemplateExpression{
+			Values: []string{"Hello, ", "\n", ""},
+			Expressions: []Expression{
+				&IdentifierExpression{
+					Identifier: Identifier{Identifier: "name"},
+				},
+				&BinaryExpression{
+					Operation: OperationPlus,
+					Left: &IntegerExpression{
+						PositiveLiteral: []byte("1"),
+						Value:           big.NewInt(1),
+						Base:            10,
+					},
+					Right: &IntegerExpression{
+						PositiveLiteral: []byte("2"),
+						Value:           big.NewInt(2),
+						Base:            10,
+					},
+				},
+			},
+		}).String(),
+	)
+}
+
 func TestIntegerExpression_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
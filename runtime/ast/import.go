@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/turbolent/prettier"
@@ -31,6 +32,8 @@ import (
 type ImportDeclaration struct {
 	Location    common.Location
 	Identifiers []Identifier
+	// Hash is the optional expected hash of the imported code
+	Hash []byte `json:",omitempty"`
 	Range
 	LocationPos Position
 }
@@ -42,6 +45,7 @@ func NewImportDeclaration(
 	gauge common.MemoryGauge,
 	identifiers []Identifier,
 	location common.Location,
+	hash []byte,
 	declRange Range,
 	locationPos Position,
 ) *ImportDeclaration {
@@ -50,6 +54,7 @@ func NewImportDeclaration(
 	return &ImportDeclaration{
 		Identifiers: identifiers,
 		Location:    location,
+		Hash:        hash,
 		Range:       declRange,
 		LocationPos: locationPos,
 	}
@@ -100,6 +105,7 @@ func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 
 const importDeclarationImportKeywordDoc = prettier.Text("import")
 const importDeclarationFromKeywordDoc = prettier.Text("from ")
+const importDeclarationHashKeywordDoc = prettier.Text(" hash ")
 
 var importDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -152,10 +158,20 @@ func (d *ImportDeclaration) Doc() prettier.Doc {
 		)
 	}
 
-	return append(
+	doc = append(
 		doc,
 		LocationDoc(d.Location),
 	)
+
+	if d.Hash != nil {
+		doc = append(
+			doc,
+			importDeclarationHashKeywordDoc,
+			prettier.Text("0x"+hex.EncodeToString(d.Hash)),
+		)
+	}
+
+	return doc
 }
 
 func (d *ImportDeclaration) String() string {
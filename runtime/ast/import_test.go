@@ -239,4 +239,26 @@ func TestImportDeclaration_String(t *testing.T) {
 			decl.String(),
 		)
 	})
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+			},
+			Location: common.AddressLocation{
+				Address: common.MustBytesToAddress([]byte{0x1}),
+			},
+			Hash: []byte{0xca, 0xfe},
+		}
+
+		require.Equal(
+			t,
+			`import foo from 0x1 hash 0xcafe`,
+			decl.String(),
+		)
+	})
 }
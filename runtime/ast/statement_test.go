@@ -502,6 +502,124 @@ func TestIfStatement_String(t *testing.T) {
 	})
 }
 
+func TestGuardStatement_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &GuardStatement{
+		Test: &VariableDeclaration{
+			Access:     AccessNotSpecified,
+			IsConstant: true,
+			Identifier: Identifier{
+				Identifier: "foo",
+				Pos:        Position{Offset: 1, Line: 2, Column: 3},
+			},
+			Transfer: &Transfer{
+				Operation: TransferOperationCopy,
+				Pos:       Position{Offset: 4, Line: 5, Column: 6},
+			},
+			Value: &BoolExpression{
+				Value: false,
+				Range: Range{
+					StartPos: Position{Offset: 7, Line: 8, Column: 9},
+					EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+				},
+			},
+			StartPos: Position{Offset: 13, Line: 14, Column: 15},
+		},
+		Else: &Block{
+			Statements: []Statement{},
+			Range: Range{
+				StartPos: Position{Offset: 16, Line: 17, Column: 18},
+				EndPos:   Position{Offset: 19, Line: 20, Column: 21},
+			},
+		},
+		StartPos: Position{Offset: 22, Line: 23, Column: 24},
+	}
+
+	actual, err := json.Marshal(stmt)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "GuardStatement",
+            "Test": {
+                "Type": "VariableDeclaration",
+                "Access": "AccessNotSpecified",
+                "IsConstant": true,
+                "Identifier": {
+                    "Identifier": "foo",
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+                },
+                "StartPos": {"Offset": 13, "Line": 14, "Column": 15},
+                "EndPos": {"Offset": 10, "Line": 11, "Column": 12},
+                "Value": {
+                    "Type": "BoolExpression",
+                    "Value": false,
+                    "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                    "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+                },
+                "Transfer": {
+                    "Type": "Transfer",
+                    "Operation": "TransferOperationCopy",
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                },
+                "TypeAnnotation": null,
+                "SecondTransfer": null,
+                "SecondValue": null,
+                "DocString": ""
+            },
+            "Else": {
+                "Type": "Block",
+                "Statements": [],
+                "StartPos": {"Offset": 16, "Line": 17, "Column": 18},
+                "EndPos": {"Offset": 19, "Line": 20, "Column": 21}
+            },
+            "StartPos": {"Offset": 22, "Line": 23, "Column": 24},
+            "EndPos":   {"Offset": 19, "Line": 20, "Column": 21}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestGuardStatement_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &GuardStatement{
+		Test: &VariableDeclaration{
+			Access:     AccessNotSpecified,
+			IsConstant: true,
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+			Transfer: &Transfer{
+				Operation: TransferOperationCopy,
+			},
+			Value: &IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "bar",
+				},
+			},
+		},
+		Else: &Block{
+			Statements: []Statement{
+				&ReturnStatement{},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"guard let foo = bar else {\n    return\n}",
+		stmt.String(),
+	)
+}
+
 func TestWhileStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -27,6 +27,14 @@ import (
 func QuoteString(s string) string {
 	var b strings.Builder
 	b.WriteByte('"')
+	writeEscapedString(&b, s)
+	b.WriteByte('"')
+	return b.String()
+}
+
+// writeEscapedString writes the given string to the builder,
+// escaping all characters which may not occur in a string literal as-is
+func writeEscapedString(b *strings.Builder, s string) {
 	for _, r := range s {
 		switch r {
 		case 0:
@@ -56,6 +64,4 @@ func QuoteString(s string) string {
 			}
 		}
 	}
-	b.WriteByte('"')
-	return b.String()
 }
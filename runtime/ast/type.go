@@ -30,6 +30,9 @@ import (
 
 const typeSeparatorSpaceDoc = prettier.Text(": ")
 
+// TypeDepthLimit is the limit of how deeply nested a type can get
+const TypeDepthLimit = 1 << 4
+
 // TypeAnnotation
 
 type TypeAnnotation struct {
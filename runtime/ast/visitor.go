@@ -101,6 +101,7 @@ type StatementVisitor[T any] interface {
 	VisitContinueStatement(*ContinueStatement) T
 	VisitBreakStatement(*BreakStatement) T
 	VisitIfStatement(*IfStatement) T
+	VisitGuardStatement(*GuardStatement) T
 	VisitForStatement(*ForStatement) T
 	VisitAssignmentStatement(*AssignmentStatement) T
 	VisitWhileStatement(*WhileStatement) T
@@ -126,6 +127,9 @@ func AcceptStatement[T any](statement Statement, visitor StatementVisitor[T]) (_
 	case ElementTypeIfStatement:
 		return visitor.VisitIfStatement(statement.(*IfStatement))
 
+	case ElementTypeGuardStatement:
+		return visitor.VisitGuardStatement(statement.(*GuardStatement))
+
 	case ElementTypeForStatement:
 		return visitor.VisitForStatement(statement.(*ForStatement))
 
@@ -183,6 +187,7 @@ type ExpressionVisitor[T any] interface {
 	VisitNilExpression(*NilExpression) T
 	VisitBoolExpression(*BoolExpression) T
 	VisitStringExpression(*StringExpression) T
+	VisitStringTemplateExpression(*StringTemplateExpression) T
 	VisitIntegerExpression(*IntegerExpression) T
 	VisitFixedPointExpression(*FixedPointExpression) T
 	VisitDictionaryExpression(*DictionaryExpression) T
@@ -219,6 +224,9 @@ func AcceptExpression[T any](expression Expression, visitor ExpressionVisitor[T]
 	case ElementTypeStringExpression:
 		return visitor.VisitStringExpression(expression.(*StringExpression))
 
+	case ElementTypeStringTemplateExpression:
+		return visitor.VisitStringTemplateExpression(expression.(*StringTemplateExpression))
+
 	case ElementTypeIntegerExpression:
 		return visitor.VisitIntegerExpression(expression.(*IntegerExpression))
 
@@ -0,0 +1,1145 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compiler compiles checked programs to bytecode (package bbq).
+package compiler
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/onflow/cadence/fixedpoint"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/constantkind"
+	"github.com/onflow/cadence/runtime/bbq/opcode"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Compiler compiles a checked program to bytecode.
+//
+// Currently, the compiler supports functions, control flow, structures, and arrays.
+// Other features, like resources or contracts, result in an UnsupportedError.
+type Compiler struct {
+	Program     *ast.Program
+	Elaboration *sema.Elaboration
+
+	functions       []*function
+	functionIndices map[string]uint16
+	// compositeTypes are the qualified identifiers of the composite types declared in the program
+	compositeTypes  map[*sema.CompositeType]string
+	constants       []*bbq.Constant
+	constantIndices map[constantKey]uint16
+	types           [][]byte
+	typeIndices     map[sema.TypeID]uint16
+	currentFunction *function
+}
+
+type constantKey struct {
+	data string
+	kind constantkind.ConstantKind
+}
+
+var _ ast.DeclarationVisitor[struct{}] = &Compiler{}
+var _ ast.StatementVisitor[struct{}] = &Compiler{}
+var _ ast.ExpressionVisitor[struct{}] = &Compiler{}
+
+func NewCompiler(program *ast.Program, elaboration *sema.Elaboration) *Compiler {
+	return &Compiler{
+		Program:         program,
+		Elaboration:     elaboration,
+		functionIndices: map[string]uint16{},
+		compositeTypes:  map[*sema.CompositeType]string{},
+		constantIndices: map[constantKey]uint16{},
+		typeIndices:     map[sema.TypeID]uint16{},
+	}
+}
+
+// Compile compiles the program.
+// Returns an UnsupportedError if the program uses a feature which is not supported yet.
+func (c *Compiler) Compile() (program *bbq.Program, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			unsupportedErr, ok := r.(*UnsupportedError)
+			if !ok {
+				panic(r)
+			}
+			err = unsupportedErr
+		}
+	}()
+
+	// Declare all functions first, so they can be referred to before their declaration
+
+	for _, declaration := range c.Program.Declarations() {
+		c.declareDeclaration(declaration)
+	}
+
+	for _, declaration := range c.Program.Declarations() {
+		ast.AcceptDeclaration[struct{}](declaration, c)
+	}
+
+	functions := make([]*bbq.Function, len(c.functions))
+	for i, function := range c.functions {
+		functions[i] = function.bbqFunction()
+	}
+
+	return &bbq.Program{
+		Functions: functions,
+		Constants: c.constants,
+		Types:     c.types,
+	}, nil
+}
+
+func unsupported(feature string, hasPosition ast.HasPosition) *UnsupportedError {
+	var astRange ast.Range
+	if hasPosition != nil {
+		astRange = ast.NewUnmeteredRangeFromPositioned(hasPosition)
+	}
+	return &UnsupportedError{
+		Feature: feature,
+		Range:   astRange,
+	}
+}
+
+// Declarations
+
+func (c *Compiler) declareDeclaration(declaration ast.Declaration) {
+	switch declaration := declaration.(type) {
+	case *ast.FunctionDeclaration:
+		c.declareFunction(declaration.Identifier.Identifier, declaration)
+
+	case *ast.CompositeDeclaration:
+		c.declareComposite(declaration)
+
+	case *ast.InterfaceDeclaration:
+		c.checkInterface(declaration)
+
+	case *ast.PragmaDeclaration:
+		// NO-OP
+
+	default:
+		panic(unsupported(declaration.DeclarationKind().Name()+" declarations", declaration))
+	}
+}
+
+func (c *Compiler) declareFunction(name string, hasPosition ast.HasPosition) {
+	if len(c.functions) == math.MaxUint16 {
+		panic(unsupported("more than 65535 functions", hasPosition))
+	}
+	c.functionIndices[name] = uint16(len(c.functions))
+	c.functions = append(c.functions, newFunction(name))
+}
+
+func (c *Compiler) declareComposite(declaration *ast.CompositeDeclaration) {
+	if declaration.Kind() != common.CompositeKindStructure {
+		panic(unsupported(declaration.DeclarationKind().Name()+" declarations", declaration))
+	}
+
+	compositeType := c.Elaboration.CompositeDeclarationType(declaration)
+	qualifiedIdentifier := compositeType.QualifiedIdentifier()
+	c.compositeTypes[compositeType] = qualifiedIdentifier
+
+	initializers := declaration.Members.Initializers()
+	if len(initializers) > 1 {
+		panic(unsupported("multiple initializers", declaration))
+	}
+
+	c.declareFunction(qualifiedIdentifier, declaration)
+
+	for _, member := range declaration.Members.Declarations() {
+		switch member := member.(type) {
+		case *ast.FieldDeclaration:
+			// NO-OP
+
+		case *ast.SpecialFunctionDeclaration:
+			if member.Kind != common.DeclarationKindInitializer {
+				panic(unsupported(member.Kind.Name()+" declarations", member))
+			}
+
+		case *ast.FunctionDeclaration:
+			c.declareFunction(
+				compositeFunctionName(qualifiedIdentifier, member.Identifier.Identifier),
+				member,
+			)
+
+		case *ast.CompositeDeclaration:
+			c.declareComposite(member)
+
+		case *ast.InterfaceDeclaration:
+			c.checkInterface(member)
+
+		default:
+			panic(unsupported("nested "+member.DeclarationKind().Name()+" declarations", member))
+		}
+	}
+}
+
+// checkInterface ensures that the interface declaration declares no code,
+// e.g. default functions, or conditions of functions and initializers
+func (c *Compiler) checkInterface(declaration *ast.InterfaceDeclaration) {
+	for _, function := range declaration.Members.Functions() {
+		if function.FunctionBlock != nil {
+			panic(unsupported("interface functions with code", function))
+		}
+	}
+	for _, specialFunction := range declaration.Members.SpecialFunctions() {
+		if specialFunction.FunctionDeclaration.FunctionBlock != nil {
+			panic(unsupported("interface initializers with code", specialFunction))
+		}
+	}
+	for _, nested := range declaration.Members.Interfaces() {
+		c.checkInterface(nested)
+	}
+	if len(declaration.Members.Composites()) > 0 {
+		panic(unsupported("composite declarations nested in interfaces", declaration))
+	}
+}
+
+func compositeFunctionName(qualifiedIdentifier string, functionName string) string {
+	return qualifiedIdentifier + "." + functionName
+}
+
+func (c *Compiler) VisitFunctionDeclaration(declaration *ast.FunctionDeclaration) (_ struct{}) {
+	if c.currentFunction != nil {
+		panic(unsupported("nested function declarations", declaration))
+	}
+
+	c.compileFunction(declaration.Identifier.Identifier, declaration, false)
+	return
+}
+
+func (c *Compiler) compileFunction(name string, declaration *ast.FunctionDeclaration, isCompositeFunction bool) {
+	if declaration.TypeParameterList != nil && !declaration.TypeParameterList.IsEmpty() {
+		panic(unsupported("generic functions", declaration))
+	}
+
+	function := c.functions[c.functionIndices[name]]
+	c.currentFunction = function
+	defer func() {
+		c.currentFunction = nil
+	}()
+
+	if isCompositeFunction {
+		function.declareLocal(sema.SelfIdentifier)
+		function.parameterCount++
+	}
+
+	c.declareParameters(function, declaration.ParameterList)
+
+	c.compileFunctionBlock(declaration.FunctionBlock)
+
+	function.emit(opcode.Return)
+}
+
+func (c *Compiler) declareParameters(function *function, parameterList *ast.ParameterList) {
+	if parameterList == nil {
+		return
+	}
+	for _, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument != nil {
+			panic(unsupported("default arguments", parameter.DefaultArgument))
+		}
+		function.declareLocal(parameter.Identifier.Identifier)
+		function.parameterCount++
+	}
+}
+
+func (c *Compiler) compileFunctionBlock(functionBlock *ast.FunctionBlock) {
+	if functionBlock == nil {
+		return
+	}
+	if functionBlock.PreConditions != nil || functionBlock.PostConditions != nil {
+		panic(unsupported("function conditions", functionBlock))
+	}
+	c.compileBlock(functionBlock.Block)
+}
+
+func (c *Compiler) compileBlock(block *ast.Block) {
+	if block == nil {
+		return
+	}
+
+	locals := c.currentFunction.locals
+	locals.PushNewWithCurrent()
+	defer locals.Pop()
+
+	for _, statement := range block.Statements {
+		ast.AcceptStatement[struct{}](statement, c)
+	}
+}
+
+func (c *Compiler) VisitCompositeDeclaration(declaration *ast.CompositeDeclaration) (_ struct{}) {
+	if c.currentFunction != nil {
+		panic(unsupported("nested composite declarations", declaration))
+	}
+
+	compositeType := c.Elaboration.CompositeDeclarationType(declaration)
+	qualifiedIdentifier := c.compositeTypes[compositeType]
+
+	c.compileInitializer(qualifiedIdentifier, compositeType, declaration.Members.Initializers())
+
+	for _, function := range declaration.Members.Functions() {
+		c.compileFunction(
+			compositeFunctionName(qualifiedIdentifier, function.Identifier.Identifier),
+			function,
+			true,
+		)
+	}
+
+	for _, nested := range declaration.Members.Composites() {
+		ast.AcceptDeclaration[struct{}](nested, c)
+	}
+
+	return
+}
+
+// compileInitializer compiles the initializer of the composite type,
+// which creates the composite value, initializes it, and returns it
+func (c *Compiler) compileInitializer(
+	qualifiedIdentifier string,
+	compositeType *sema.CompositeType,
+	initializers []*ast.SpecialFunctionDeclaration,
+) {
+	function := c.functions[c.functionIndices[qualifiedIdentifier]]
+	c.currentFunction = function
+	defer func() {
+		c.currentFunction = nil
+	}()
+
+	var declaration *ast.FunctionDeclaration
+	if len(initializers) > 0 {
+		declaration = initializers[0].FunctionDeclaration
+		c.declareParameters(function, declaration.ParameterList)
+	}
+
+	self := function.declareLocal(sema.SelfIdentifier)
+	function.self = self
+
+	function.emit(opcode.New, c.typeIndex(compositeType))
+	function.emit(opcode.SetLocal, self.index)
+
+	if declaration != nil {
+		c.compileFunctionBlock(declaration.FunctionBlock)
+	}
+
+	function.emit(opcode.GetLocal, self.index)
+	function.emit(opcode.ReturnValue)
+}
+
+func (c *Compiler) VisitInterfaceDeclaration(_ *ast.InterfaceDeclaration) (_ struct{}) {
+	// NO-OP: interfaces declare no code
+	return
+}
+
+func (c *Compiler) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) (_ struct{}) {
+	// NO-OP
+	return
+}
+
+func (c *Compiler) VisitSpecialFunctionDeclaration(declaration *ast.SpecialFunctionDeclaration) (_ struct{}) {
+	// Initializers are compiled as part of the composite declaration
+	panic(unsupported("special function declarations", declaration))
+}
+
+func (c *Compiler) VisitFieldDeclaration(_ *ast.FieldDeclaration) (_ struct{}) {
+	// Fields are initialized by the initializer
+	panic(errors.NewUnreachableError())
+}
+
+func (c *Compiler) VisitAttachmentDeclaration(declaration *ast.AttachmentDeclaration) (_ struct{}) {
+	panic(unsupported("attachment declarations", declaration))
+}
+
+func (c *Compiler) VisitEntitlementDeclaration(declaration *ast.EntitlementDeclaration) (_ struct{}) {
+	panic(unsupported("entitlement declarations", declaration))
+}
+
+func (c *Compiler) VisitEntitlementMappingDeclaration(declaration *ast.EntitlementMappingDeclaration) (_ struct{}) {
+	panic(unsupported("entitlement mapping declarations", declaration))
+}
+
+func (c *Compiler) VisitTransactionDeclaration(declaration *ast.TransactionDeclaration) (_ struct{}) {
+	panic(unsupported("transaction declarations", declaration))
+}
+
+func (c *Compiler) VisitEnumCaseDeclaration(declaration *ast.EnumCaseDeclaration) (_ struct{}) {
+	panic(unsupported("enum case declarations", declaration))
+}
+
+func (c *Compiler) VisitImportDeclaration(declaration *ast.ImportDeclaration) (_ struct{}) {
+	panic(unsupported("import declarations", declaration))
+}
+
+// Statements
+
+func (c *Compiler) VisitReturnStatement(statement *ast.ReturnStatement) (_ struct{}) {
+	function := c.currentFunction
+
+	if statement.Expression == nil {
+		// Initializers return the initialized composite value
+		if function.self != nil {
+			function.emit(opcode.GetLocal, function.self.index)
+			function.emit(opcode.ReturnValue)
+		} else {
+			function.emit(opcode.Return)
+		}
+		return
+	}
+
+	c.compileExpression(statement.Expression)
+
+	returnStatementTypes := c.Elaboration.ReturnStatementTypes(statement)
+	c.emitTransferAndConvert(returnStatementTypes.ValueType, returnStatementTypes.ReturnType)
+
+	function.emit(opcode.ReturnValue)
+	return
+}
+
+func (c *Compiler) VisitBreakStatement(statement *ast.BreakStatement) (_ struct{}) {
+	function := c.currentFunction
+	loop := function.currentLoop()
+	loop.breakJumps = append(loop.breakJumps, function.emit(opcode.Jump, 0))
+	return
+}
+
+func (c *Compiler) VisitContinueStatement(_ *ast.ContinueStatement) (_ struct{}) {
+	function := c.currentFunction
+	loop := function.currentLoop()
+	loop.continueJumps = append(loop.continueJumps, function.emit(opcode.Jump, 0))
+	return
+}
+
+func (c *Compiler) VisitIfStatement(statement *ast.IfStatement) (_ struct{}) {
+	function := c.currentFunction
+
+	locals := function.locals
+	locals.PushNewWithCurrent()
+	defer locals.Pop()
+
+	var elseJump int
+
+	switch test := statement.Test.(type) {
+	case ast.Expression:
+		c.compileExpression(test)
+		elseJump = function.emit(opcode.JumpIfFalse, 0)
+
+	case *ast.VariableDeclaration:
+		// Optional binding: declare the variable
+		// with the unwrapped value, if the value is not nil
+
+		if test.SecondValue != nil {
+			panic(unsupported("second values", test.SecondValue))
+		}
+
+		c.compileExpression(test.Value)
+
+		variableDeclarationTypes := c.Elaboration.VariableDeclarationTypes(test)
+		c.emitTransferAndConvert(
+			variableDeclarationTypes.ValueType,
+			&sema.OptionalType{
+				Type: variableDeclarationTypes.TargetType,
+			},
+		)
+
+		temporary := function.declareLocal("")
+		function.emit(opcode.SetLocal, temporary.index)
+		function.emit(opcode.GetLocal, temporary.index)
+		elseJump = function.emit(opcode.JumpIfNil, 0)
+		function.emit(opcode.GetLocal, temporary.index)
+		function.emit(opcode.Unwrap)
+
+		variable := function.declareLocal(test.Identifier.Identifier)
+		function.emit(opcode.SetLocal, variable.index)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	c.compileBlock(statement.Then)
+
+	if statement.Else == nil {
+		function.patchJump(elseJump)
+		return
+	}
+
+	endJump := function.emit(opcode.Jump, 0)
+	function.patchJump(elseJump)
+
+	c.compileBlock(statement.Else)
+
+	function.patchJump(endJump)
+	return
+}
+
+func (c *Compiler) VisitWhileStatement(statement *ast.WhileStatement) (_ struct{}) {
+	function := c.currentFunction
+
+	start := function.offset()
+
+	c.compileExpression(statement.Test)
+	endJump := function.emit(opcode.JumpIfFalse, 0)
+
+	function.pushLoop()
+
+	c.compileBlock(statement.Block)
+	function.emit(opcode.Jump, start)
+
+	function.patchJump(endJump)
+	function.popLoop(start)
+
+	return
+}
+
+func (c *Compiler) VisitForStatement(statement *ast.ForStatement) (_ struct{}) {
+	function := c.currentFunction
+
+	locals := function.locals
+	locals.PushNewWithCurrent()
+	defer locals.Pop()
+
+	// Iterate over a copy of the value
+
+	c.compileExpression(statement.Value)
+	function.emit(opcode.Transfer)
+
+	iterator := function.declareIterator()
+	function.emit(opcode.IteratorStart, iterator)
+
+	var counter *local
+	if statement.Index != nil {
+		counter = function.declareLocal("")
+		c.emitIntConstant(0)
+		function.emit(opcode.SetLocal, counter.index)
+	}
+
+	start := function.offset()
+
+	endJump := function.emit(opcode.IteratorNext, iterator, 0)
+
+	function.pushLoop()
+
+	locals.PushNewWithCurrent()
+
+	if statement.Index != nil {
+		index := function.declareLocal(statement.Index.Identifier)
+		function.emit(opcode.GetLocal, counter.index)
+		function.emit(opcode.SetLocal, index.index)
+	}
+
+	element := function.declareLocal(statement.Identifier.Identifier)
+	function.emit(opcode.SetLocal, element.index)
+
+	c.compileBlock(statement.Block)
+
+	locals.Pop()
+
+	next := function.offset()
+
+	if counter != nil {
+		function.emit(opcode.GetLocal, counter.index)
+		c.emitIntConstant(1)
+		function.emit(opcode.Add)
+		function.emit(opcode.SetLocal, counter.index)
+	}
+
+	function.emit(opcode.Jump, start)
+
+	// IteratorNext jumps to the end of the loop if there are no more elements.
+	// The jump target is its second operand
+	function.patchJumpTo(endJump+2, function.offset())
+	function.popLoop(next)
+
+	return
+}
+
+func (c *Compiler) VisitExpressionStatement(statement *ast.ExpressionStatement) (_ struct{}) {
+	c.compileExpression(statement.Expression)
+	c.currentFunction.emit(opcode.Drop)
+	return
+}
+
+func (c *Compiler) VisitVariableDeclaration(declaration *ast.VariableDeclaration) (_ struct{}) {
+	function := c.currentFunction
+	if function == nil {
+		panic(unsupported("global variable declarations", declaration))
+	}
+
+	if declaration.SecondValue != nil {
+		panic(unsupported("second values", declaration.SecondValue))
+	}
+	if declaration.Transfer.Operation != ast.TransferOperationCopy {
+		panic(unsupported("resource moves", declaration.Transfer))
+	}
+
+	c.compileExpression(declaration.Value)
+
+	variableDeclarationTypes := c.Elaboration.VariableDeclarationTypes(declaration)
+	c.emitTransferAndConvert(variableDeclarationTypes.ValueType, variableDeclarationTypes.TargetType)
+
+	// NOTE: declare the local after compiling the value,
+	// the value may refer to a variable with the same name in an outer scope
+	local := function.declareLocal(declaration.Identifier.Identifier)
+	function.emit(opcode.SetLocal, local.index)
+
+	return
+}
+
+func (c *Compiler) VisitAssignmentStatement(statement *ast.AssignmentStatement) (_ struct{}) {
+	function := c.currentFunction
+
+	if statement.Transfer.Operation != ast.TransferOperationCopy {
+		panic(unsupported("resource moves", statement.Transfer))
+	}
+
+	assignmentStatementTypes := c.Elaboration.AssignmentStatementTypes(statement)
+
+	compileValue := func() {
+		c.compileExpression(statement.Value)
+		c.emitTransferAndConvert(assignmentStatementTypes.ValueType, assignmentStatementTypes.TargetType)
+	}
+
+	switch target := statement.Target.(type) {
+	case *ast.IdentifierExpression:
+		local := function.findLocal(target.Identifier.Identifier)
+		if local == nil {
+			panic(unsupported("assignments to global variables", target))
+		}
+		compileValue()
+		function.emit(opcode.SetLocal, local.index)
+
+	case *ast.MemberExpression:
+		if target.Optional {
+			panic(unsupported("optional chaining", target))
+		}
+		c.compileExpression(target.Expression)
+		compileValue()
+		function.emit(opcode.SetField, c.stringConstantIndex(target.Identifier.Identifier))
+
+	case *ast.IndexExpression:
+		c.compileExpression(target.TargetExpression)
+		c.compileExpression(target.IndexingExpression)
+		compileValue()
+		function.emit(opcode.SetIndex)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	return
+}
+
+func (c *Compiler) VisitSwapStatement(statement *ast.SwapStatement) (_ struct{}) {
+	panic(unsupported("swap statements", statement))
+}
+
+func (c *Compiler) VisitSwitchStatement(statement *ast.SwitchStatement) (_ struct{}) {
+	panic(unsupported("switch statements", statement))
+}
+
+func (c *Compiler) VisitGuardStatement(statement *ast.GuardStatement) (_ struct{}) {
+	panic(unsupported("guard statements", statement))
+}
+
+func (c *Compiler) VisitEmitStatement(statement *ast.EmitStatement) (_ struct{}) {
+	panic(unsupported("emit statements", statement))
+}
+
+func (c *Compiler) VisitRemoveStatement(statement *ast.RemoveStatement) (_ struct{}) {
+	panic(unsupported("remove statements", statement))
+}
+
+// Expressions
+
+func (c *Compiler) compileExpression(expression ast.Expression) {
+	ast.AcceptExpression[struct{}](expression, c)
+}
+
+func (c *Compiler) VisitVoidExpression(_ *ast.VoidExpression) (_ struct{}) {
+	c.currentFunction.emit(opcode.Void)
+	return
+}
+
+func (c *Compiler) VisitNilExpression(_ *ast.NilExpression) (_ struct{}) {
+	c.currentFunction.emit(opcode.Nil)
+	return
+}
+
+func (c *Compiler) VisitBoolExpression(expression *ast.BoolExpression) (_ struct{}) {
+	if expression.Value {
+		c.currentFunction.emit(opcode.True)
+	} else {
+		c.currentFunction.emit(opcode.False)
+	}
+	return
+}
+
+func (c *Compiler) VisitStringExpression(expression *ast.StringExpression) (_ struct{}) {
+	kind := constantkind.FromSemaType(c.Elaboration.StringExpressionType(expression))
+	index := c.constantIndex(kind, []byte(expression.Value))
+	c.currentFunction.emit(opcode.GetConstant, index)
+	return
+}
+
+func (c *Compiler) VisitIntegerExpression(expression *ast.IntegerExpression) (_ struct{}) {
+	integerType := c.Elaboration.IntegerExpressionType(expression)
+
+	kind := constantkind.FromSemaType(integerType)
+
+	var data []byte
+	switch {
+	case kind == constantkind.Address:
+		data = expression.Value.Bytes()
+	case kind.IsInteger():
+		data = encodeBigInt(expression.Value)
+	default:
+		panic(unsupported("literals of type "+integerType.QualifiedString(), expression))
+	}
+
+	index := c.constantIndex(kind, data)
+	c.currentFunction.emit(opcode.GetConstant, index)
+	return
+}
+
+func (c *Compiler) VisitFixedPointExpression(expression *ast.FixedPointExpression) (_ struct{}) {
+	fixedPointType := c.Elaboration.FixedPointExpression(expression)
+
+	var kind constantkind.ConstantKind
+	if fixedPointType == sema.FixedPointType {
+		// Like in the interpreter, the type of unconstrained literals depends on the sign
+		if expression.Negative {
+			kind = constantkind.Fix64
+		} else {
+			kind = constantkind.UFix64
+		}
+	} else {
+		kind = constantkind.FromSemaType(fixedPointType)
+	}
+
+	if kind != constantkind.Fix64 && kind != constantkind.UFix64 {
+		panic(unsupported("literals of type "+fixedPointType.QualifiedString(), expression))
+	}
+
+	value := fixedpoint.ConvertToFixedPointBigInt(
+		expression.Negative,
+		expression.UnsignedInteger,
+		expression.Fractional,
+		expression.Scale,
+		sema.Fix64Scale,
+	)
+
+	index := c.constantIndex(kind, encodeBigInt(value))
+	c.currentFunction.emit(opcode.GetConstant, index)
+	return
+}
+
+// encodeBigInt encodes the given integer as the sign, followed by the magnitude,
+// see constantkind.ConstantKind
+func encodeBigInt(value *big.Int) []byte {
+	var sign byte
+	if value.Sign() < 0 {
+		sign = 1
+	}
+	return append([]byte{sign}, value.Bytes()...)
+}
+
+func (c *Compiler) emitIntConstant(value int64) {
+	index := c.constantIndex(constantkind.Int, encodeBigInt(big.NewInt(value)))
+	c.currentFunction.emit(opcode.GetConstant, index)
+}
+
+func (c *Compiler) VisitArrayExpression(expression *ast.ArrayExpression) (_ struct{}) {
+	function := c.currentFunction
+
+	arrayExpressionTypes := c.Elaboration.ArrayExpressionTypes(expression)
+	arrayType := arrayExpressionTypes.ArrayType
+	elementType := arrayType.ElementType(false)
+
+	for i, value := range expression.Values {
+		c.compileExpression(value)
+		c.emitTransferAndConvert(arrayExpressionTypes.ArgumentTypes[i], elementType)
+	}
+
+	if len(expression.Values) > math.MaxUint16 {
+		panic(unsupported("array literals with more than 65535 elements", expression))
+	}
+
+	function.emit(
+		opcode.NewArray,
+		c.typeIndex(arrayType),
+		uint16(len(expression.Values)),
+	)
+	return
+}
+
+func (c *Compiler) VisitIdentifierExpression(expression *ast.IdentifierExpression) (_ struct{}) {
+	function := c.currentFunction
+	name := expression.Identifier.Identifier
+
+	local := function.findLocal(name)
+	if local != nil {
+		function.emit(opcode.GetLocal, local.index)
+		return
+	}
+
+	if _, ok := c.functionIndices[name]; ok {
+		panic(unsupported("functions as values", expression))
+	}
+
+	// The identifier refers to a predeclared value, e.g. a function of the standard library
+
+	function.emit(opcode.GetGlobal, c.stringConstantIndex(name))
+	return
+}
+
+func (c *Compiler) VisitInvocationExpression(expression *ast.InvocationExpression) (_ struct{}) {
+	function := c.currentFunction
+
+	if len(expression.TypeArguments) > 0 {
+		panic(unsupported("type arguments", expression))
+	}
+
+	invocationExpressionTypes := c.Elaboration.InvocationExpressionTypes(expression)
+
+	compileArguments := func() {
+		argumentTypes := invocationExpressionTypes.ArgumentTypes
+		parameterTypes := invocationExpressionTypes.TypeParameterTypes
+
+		for i, argument := range expression.Arguments {
+			c.compileExpression(argument.Expression)
+			if i < len(parameterTypes) {
+				c.emitTransferAndConvert(argumentTypes[i], parameterTypes[i])
+			} else {
+				function.emit(opcode.Transfer)
+			}
+		}
+	}
+
+	argumentCount := len(expression.Arguments)
+	if argumentCount > math.MaxUint16 {
+		panic(unsupported("more than 65535 arguments", expression))
+	}
+
+	switch invokedExpression := expression.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		name := invokedExpression.Identifier.Identifier
+		if function.findLocal(name) == nil {
+			// Functions and composite types declared in the program are invoked directly
+			if functionIndex, ok := c.functionIndices[name]; ok {
+				compileArguments()
+				function.emit(opcode.Invoke, functionIndex)
+				return
+			}
+		}
+
+	case *ast.MemberExpression:
+		if invokedExpression.Optional {
+			panic(unsupported("optional chaining", invokedExpression))
+		}
+
+		memberAccessInfo, ok := c.Elaboration.MemberExpressionMemberAccessInfo(invokedExpression)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if memberAccessInfo.Member.DeclarationKind == common.DeclarationKindFunction {
+			c.compileExpression(invokedExpression.Expression)
+			compileArguments()
+
+			memberName := invokedExpression.Identifier.Identifier
+
+			// Functions of composite types declared in the program are invoked directly,
+			// all other functions, e.g. functions of interfaces or built-in functions,
+			// are looked up on the receiver
+
+			if compositeType, ok := memberAccessInfo.AccessedType.(*sema.CompositeType); ok {
+				if qualifiedIdentifier, ok := c.compositeTypes[compositeType]; ok {
+					functionName := compositeFunctionName(qualifiedIdentifier, memberName)
+					if functionIndex, ok := c.functionIndices[functionName]; ok {
+						function.emit(opcode.Invoke, functionIndex)
+						return
+					}
+				}
+			}
+
+			function.emit(
+				opcode.InvokeMethod,
+				c.stringConstantIndex(memberName),
+				uint16(argumentCount),
+			)
+			return
+		}
+	}
+
+	c.compileExpression(expression.InvokedExpression)
+	compileArguments()
+	function.emit(opcode.InvokeValue, uint16(argumentCount))
+	return
+}
+
+func (c *Compiler) VisitMemberExpression(expression *ast.MemberExpression) (_ struct{}) {
+	if expression.Optional {
+		panic(unsupported("optional chaining", expression))
+	}
+
+	memberAccessInfo, ok := c.Elaboration.MemberExpressionMemberAccessInfo(expression)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	if memberAccessInfo.ReturnReference {
+		panic(unsupported("member access through references", expression))
+	}
+
+	c.compileExpression(expression.Expression)
+	c.currentFunction.emit(opcode.GetField, c.stringConstantIndex(expression.Identifier.Identifier))
+	return
+}
+
+func (c *Compiler) VisitIndexExpression(expression *ast.IndexExpression) (_ struct{}) {
+	indexExpressionTypes := c.Elaboration.IndexExpressionTypes(expression)
+	if indexExpressionTypes.ReturnReference {
+		panic(unsupported("index access through references", expression))
+	}
+
+	c.compileExpression(expression.TargetExpression)
+	c.compileExpression(expression.IndexingExpression)
+	c.currentFunction.emit(opcode.GetIndex)
+	return
+}
+
+func (c *Compiler) VisitUnaryExpression(expression *ast.UnaryExpression) (_ struct{}) {
+	function := c.currentFunction
+
+	switch expression.Operation {
+	case ast.OperationNegate:
+		c.compileExpression(expression.Expression)
+		function.emit(opcode.Not)
+
+	case ast.OperationMinus:
+		c.compileExpression(expression.Expression)
+		function.emit(opcode.Negate)
+
+	default:
+		panic(unsupported("unary operation "+expression.Operation.Symbol(), expression))
+	}
+
+	return
+}
+
+var binaryOpcodes = map[ast.Operation]opcode.Opcode{
+	ast.OperationPlus:              opcode.Add,
+	ast.OperationMinus:             opcode.Subtract,
+	ast.OperationMul:               opcode.Multiply,
+	ast.OperationDiv:               opcode.Divide,
+	ast.OperationMod:               opcode.Mod,
+	ast.OperationBitwiseOr:         opcode.BitwiseOr,
+	ast.OperationBitwiseXor:        opcode.BitwiseXor,
+	ast.OperationBitwiseAnd:        opcode.BitwiseAnd,
+	ast.OperationBitwiseLeftShift:  opcode.BitwiseLeftShift,
+	ast.OperationBitwiseRightShift: opcode.BitwiseRightShift,
+	ast.OperationLess:              opcode.Less,
+	ast.OperationLessEqual:         opcode.LessOrEqual,
+	ast.OperationGreater:           opcode.Greater,
+	ast.OperationGreaterEqual:      opcode.GreaterOrEqual,
+	ast.OperationEqual:             opcode.Equal,
+	ast.OperationNotEqual:          opcode.NotEqual,
+}
+
+func (c *Compiler) VisitBinaryExpression(expression *ast.BinaryExpression) (_ struct{}) {
+	function := c.currentFunction
+
+	switch expression.Operation {
+	case ast.OperationAnd:
+		c.compileExpression(expression.Left)
+		falseJump := function.emit(opcode.JumpIfFalse, 0)
+		c.compileExpression(expression.Right)
+		endJump := function.emit(opcode.Jump, 0)
+		function.patchJump(falseJump)
+		function.emit(opcode.False)
+		function.patchJump(endJump)
+
+	case ast.OperationOr:
+		c.compileExpression(expression.Left)
+		falseJump := function.emit(opcode.JumpIfFalse, 0)
+		function.emit(opcode.True)
+		endJump := function.emit(opcode.Jump, 0)
+		function.patchJump(falseJump)
+		c.compileExpression(expression.Right)
+		function.patchJump(endJump)
+
+	case ast.OperationNilCoalesce:
+		// The left value is stored in a temporary local,
+		// so it can be tested and unwrapped
+		temporary := function.declareLocal("")
+
+		c.compileExpression(expression.Left)
+		function.emit(opcode.SetLocal, temporary.index)
+		function.emit(opcode.GetLocal, temporary.index)
+		nilJump := function.emit(opcode.JumpIfNil, 0)
+		function.emit(opcode.GetLocal, temporary.index)
+		function.emit(opcode.Unwrap)
+		endJump := function.emit(opcode.Jump, 0)
+
+		function.patchJump(nilJump)
+		c.compileExpression(expression.Right)
+		binaryExpressionTypes := c.Elaboration.BinaryExpressionTypes(expression)
+		c.emitConvert(binaryExpressionTypes.RightType, binaryExpressionTypes.ResultType)
+
+		function.patchJump(endJump)
+
+	default:
+		op, ok := binaryOpcodes[expression.Operation]
+		if !ok {
+			panic(unsupported("binary operation "+expression.Operation.Symbol(), expression))
+		}
+		c.compileExpression(expression.Left)
+		c.compileExpression(expression.Right)
+		function.emit(op)
+	}
+
+	return
+}
+
+func (c *Compiler) VisitConditionalExpression(expression *ast.ConditionalExpression) (_ struct{}) {
+	function := c.currentFunction
+
+	c.compileExpression(expression.Test)
+	elseJump := function.emit(opcode.JumpIfFalse, 0)
+	c.compileExpression(expression.Then)
+	endJump := function.emit(opcode.Jump, 0)
+	function.patchJump(elseJump)
+	c.compileExpression(expression.Else)
+	function.patchJump(endJump)
+
+	return
+}
+
+func (c *Compiler) VisitForceExpression(expression *ast.ForceExpression) (_ struct{}) {
+	c.compileExpression(expression.Expression)
+	c.currentFunction.emit(opcode.Unwrap)
+	return
+}
+
+func (c *Compiler) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) (_ struct{}) {
+	panic(unsupported("string templates", expression))
+}
+
+func (c *Compiler) VisitDictionaryExpression(expression *ast.DictionaryExpression) (_ struct{}) {
+	panic(unsupported("dictionary literals", expression))
+}
+
+func (c *Compiler) VisitPathExpression(expression *ast.PathExpression) (_ struct{}) {
+	panic(unsupported("path literals", expression))
+}
+
+func (c *Compiler) VisitFunctionExpression(expression *ast.FunctionExpression) (_ struct{}) {
+	panic(unsupported("function expressions", expression))
+}
+
+func (c *Compiler) VisitCreateExpression(expression *ast.CreateExpression) (_ struct{}) {
+	panic(unsupported("create expressions", expression))
+}
+
+func (c *Compiler) VisitReferenceExpression(expression *ast.ReferenceExpression) (_ struct{}) {
+	panic(unsupported("reference expressions", expression))
+}
+
+func (c *Compiler) VisitDestroyExpression(expression *ast.DestroyExpression) (_ struct{}) {
+	panic(unsupported("destroy expressions", expression))
+}
+
+func (c *Compiler) VisitCastingExpression(expression *ast.CastingExpression) (_ struct{}) {
+	panic(unsupported("casting expressions", expression))
+}
+
+func (c *Compiler) VisitAttachExpression(expression *ast.AttachExpression) (_ struct{}) {
+	panic(unsupported("attach expressions", expression))
+}
+
+// Conversion
+
+func (c *Compiler) emitTransferAndConvert(valueType, targetType sema.Type) {
+	c.currentFunction.emit(
+		opcode.TransferAndConvert,
+		c.typeIndex(valueType),
+		c.typeIndex(targetType),
+	)
+}
+
+func (c *Compiler) emitConvert(valueType, targetType sema.Type) {
+	c.currentFunction.emit(
+		opcode.Convert,
+		c.typeIndex(valueType),
+		c.typeIndex(targetType),
+	)
+}
+
+// Constants and types
+
+func (c *Compiler) constantIndex(kind constantkind.ConstantKind, data []byte) uint16 {
+	key := constantKey{
+		kind: kind,
+		data: string(data),
+	}
+
+	if index, ok := c.constantIndices[key]; ok {
+		return index
+	}
+
+	if len(c.constants) == math.MaxUint16 {
+		panic(unsupported("more than 65535 constants", nil))
+	}
+
+	index := uint16(len(c.constants))
+	c.constants = append(c.constants, &bbq.Constant{
+		Kind: kind,
+		Data: data,
+	})
+	c.constantIndices[key] = index
+	return index
+}
+
+func (c *Compiler) stringConstantIndex(value string) uint16 {
+	return c.constantIndex(constantkind.String, []byte(value))
+}
+
+func (c *Compiler) typeIndex(ty sema.Type) uint16 {
+	typeID := ty.ID()
+
+	if index, ok := c.typeIndices[typeID]; ok {
+		return index
+	}
+
+	if len(c.types) == math.MaxUint16 {
+		panic(unsupported("more than 65535 types", nil))
+	}
+
+	staticType := interpreter.ConvertSemaToStaticType(nil, ty)
+	data, err := interpreter.StaticTypeToBytes(staticType)
+	if err != nil {
+		panic(unsupported("type "+ty.QualifiedString(), nil))
+	}
+
+	index := uint16(len(c.types))
+	c.types = append(c.types, data)
+	c.typeIndices[typeID] = index
+	return index
+}
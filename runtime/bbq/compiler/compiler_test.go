@@ -0,0 +1,214 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/constantkind"
+	"github.com/onflow/cadence/runtime/bbq/opcode"
+	"github.com/onflow/cadence/runtime/tests/checker"
+)
+
+func compile(t *testing.T, code string) *bbq.Program {
+	checker, err := checker.ParseAndCheck(t, code)
+	require.NoError(t, err)
+
+	program, err := NewCompiler(checker.Program, checker.Elaboration).Compile()
+	require.NoError(t, err)
+
+	return program
+}
+
+func printFunction(t *testing.T, function *bbq.Function) string {
+	var builder strings.Builder
+	err := opcode.PrintInstructions(&builder, function.Code)
+	require.NoError(t, err)
+	return builder.String()
+}
+
+func TestCompileSimple(t *testing.T) {
+
+	t.Parallel()
+
+	program := compile(t, `
+      fun inc(a: Int): Int {
+          let mod = 1
+          return a + mod
+      }
+    `)
+
+	require.Len(t, program.Functions, 1)
+
+	function := program.Functions[0]
+	assert.Equal(t, "inc", function.Name)
+	assert.Equal(t, uint16(1), function.ParameterCount)
+	assert.Equal(t, uint16(2), function.LocalCount)
+
+	assert.Equal(t,
+		`0: GetConstant 0
+3: TransferAndConvert 0 0
+8: SetLocal 1
+11: GetLocal 0
+14: GetLocal 1
+17: Add
+18: TransferAndConvert 0 0
+23: ReturnValue
+24: Return
+`,
+		printFunction(t, function),
+	)
+
+	assert.Equal(t,
+		[]*bbq.Constant{
+			{
+				Kind: constantkind.Int,
+				Data: []byte{0, 1},
+			},
+		},
+		program.Constants,
+	)
+	assert.Len(t, program.Types, 1)
+}
+
+func TestCompileWhileLoop(t *testing.T) {
+
+	t.Parallel()
+
+	program := compile(t, `
+      fun test() {
+          var i = 0
+          while true {
+              if i > 2 {
+                  break
+              }
+              i = i + 1
+              continue
+          }
+      }
+    `)
+
+	require.Len(t, program.Functions, 1)
+
+	assert.Equal(t,
+		`0: GetConstant 0
+3: TransferAndConvert 0 0
+8: SetLocal 0
+11: True
+12: JumpIfFalse 49
+15: GetLocal 0
+18: GetConstant 1
+21: Greater
+22: JumpIfFalse 28
+25: Jump 49
+28: GetLocal 0
+31: GetConstant 2
+34: Add
+35: TransferAndConvert 0 0
+40: SetLocal 0
+43: Jump 11
+46: Jump 11
+49: Return
+`,
+		printFunction(t, program.Functions[0]),
+	)
+}
+
+func TestCompileComposite(t *testing.T) {
+
+	t.Parallel()
+
+	program := compile(t, `
+      struct S {
+          var x: Int
+
+          init(x: Int) {
+              self.x = x
+          }
+
+          fun getX(): Int {
+              return self.x
+          }
+      }
+
+      fun test(): Int {
+          return S(x: 1).getX()
+      }
+    `)
+
+	require.Len(t, program.Functions, 3)
+
+	names := make([]string, len(program.Functions))
+	for i, function := range program.Functions {
+		names[i] = function.Name
+	}
+	assert.Equal(t, []string{"S", "S.getX", "test"}, names)
+
+	initializer := program.Functions[0]
+	assert.Equal(t, uint16(1), initializer.ParameterCount)
+	assert.Equal(t, uint16(2), initializer.LocalCount)
+
+	method := program.Functions[1]
+	assert.Equal(t, uint16(1), method.ParameterCount)
+}
+
+func TestCompileUnsupported(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      resource R {}
+    `)
+	require.NoError(t, err)
+
+	_, err = NewCompiler(checker.Program, checker.Elaboration).Compile()
+	require.Error(t, err)
+
+	var unsupportedErr *UnsupportedError
+	require.ErrorAs(t, err, &unsupportedErr)
+}
+
+func TestCompileUnsupportedInterfaceInitializerConditions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      struct interface I {
+          init(a: Bool) {
+              pre { a }
+          }
+      }
+
+      struct S: I {
+          init(a: Bool) {}
+      }
+    `)
+	require.NoError(t, err)
+
+	_, err = NewCompiler(checker.Program, checker.Elaboration).Compile()
+	require.Error(t, err)
+
+	var unsupportedErr *UnsupportedError
+	require.ErrorAs(t, err, &unsupportedErr)
+}
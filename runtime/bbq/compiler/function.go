@@ -0,0 +1,151 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compiler
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/onflow/cadence/runtime/activations"
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/opcode"
+)
+
+// function is the state of a function which is being compiled
+type function struct {
+	name           string
+	code           []byte
+	locals         *activations.Activations[*local]
+	loops          []*loop
+	localCount     uint16
+	parameterCount uint16
+	iteratorCount  uint16
+	// self is the local which holds the composite value, in initializers
+	self *local
+}
+
+type local struct {
+	index uint16
+}
+
+// loop is the state of a loop which is being compiled
+type loop struct {
+	// breakJumps are the offsets of the jump instructions of the break statements
+	breakJumps []int
+	// continueJumps are the offsets of the jump instructions of the continue statements
+	continueJumps []int
+}
+
+func newFunction(name string) *function {
+	return &function{
+		name:   name,
+		locals: activations.NewActivations[*local](nil),
+	}
+}
+
+func (f *function) declareLocal(name string) *local {
+	if f.localCount == math.MaxUint16 {
+		panic(&UnsupportedError{
+			Feature: "more than 65535 locals in a function",
+		})
+	}
+	// NOTE: semantic analysis already checked possible invalid redeclaration
+	local := &local{
+		index: f.localCount,
+	}
+	f.localCount++
+	if name != "" {
+		f.locals.Set(name, local)
+	}
+	return local
+}
+
+func (f *function) findLocal(name string) *local {
+	return f.locals.Find(name)
+}
+
+func (f *function) declareIterator() uint16 {
+	slot := f.iteratorCount
+	f.iteratorCount++
+	return slot
+}
+
+// emit appends the instruction with the given operands to the code,
+// and returns the offset of the instruction
+func (f *function) emit(op opcode.Opcode, operands ...uint16) int {
+	offset := len(f.code)
+	f.code = append(f.code, byte(op))
+	for _, operand := range operands {
+		f.code = binary.BigEndian.AppendUint16(f.code, operand)
+	}
+	if len(f.code) > math.MaxUint16 {
+		panic(&UnsupportedError{
+			Feature: "functions with more than 65535 bytes of code",
+		})
+	}
+	return offset
+}
+
+// offset returns the offset of the next instruction
+func (f *function) offset() uint16 {
+	return uint16(len(f.code))
+}
+
+// patchJump sets the target of the jump instruction at the given offset to the next instruction
+func (f *function) patchJump(instructionOffset int) {
+	f.patchJumpTo(instructionOffset, f.offset())
+}
+
+// patchJumpTo sets the target of the jump instruction at the given offset
+func (f *function) patchJumpTo(instructionOffset int, target uint16) {
+	binary.BigEndian.PutUint16(f.code[instructionOffset+1:], target)
+}
+
+func (f *function) pushLoop() *loop {
+	loop := &loop{}
+	f.loops = append(f.loops, loop)
+	return loop
+}
+
+// popLoop removes the current loop and patches the jumps of its break and continue statements
+func (f *function) popLoop(continueTarget uint16) {
+	lastIndex := len(f.loops) - 1
+	loop := f.loops[lastIndex]
+	f.loops = f.loops[:lastIndex]
+
+	for _, jump := range loop.breakJumps {
+		f.patchJump(jump)
+	}
+	for _, jump := range loop.continueJumps {
+		f.patchJumpTo(jump, continueTarget)
+	}
+}
+
+func (f *function) currentLoop() *loop {
+	return f.loops[len(f.loops)-1]
+}
+
+func (f *function) bbqFunction() *bbq.Function {
+	return &bbq.Function{
+		Name:           f.name,
+		Code:           f.code,
+		ParameterCount: f.parameterCount,
+		LocalCount:     f.localCount,
+	}
+}
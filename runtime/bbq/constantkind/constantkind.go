@@ -0,0 +1,186 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package constantkind
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=ConstantKind
+
+// ConstantKind is the kind of a constant of a program,
+// which determines how the constant's data is decoded.
+//
+// The data of strings and characters is the UTF-8 encoding,
+// the data of addresses are the address bytes,
+// and the data of numbers is a sign byte (1 if negative, 0 otherwise),
+// followed by the big-endian magnitude of the integer value
+// (for fixed-point numbers, the scaled integer value).
+type ConstantKind uint8
+
+const (
+	Unknown ConstantKind = iota
+	String
+	Character
+	Address
+
+	// Integers
+	Int
+	Int8
+	Int16
+	Int32
+	Int64
+	Int128
+	Int256
+	UInt
+	UInt8
+	UInt16
+	UInt32
+	UInt64
+	UInt128
+	UInt256
+	Word8
+	Word16
+	Word32
+	Word64
+	Word128
+	Word256
+
+	// Fixed-point numbers
+	Fix64
+	UFix64
+)
+
+// FromSemaType returns the constant kind for literals of the given type.
+// Returns Unknown if literals of the type cannot be represented as a constant.
+func FromSemaType(ty sema.Type) ConstantKind {
+	switch ty {
+	case sema.StringType:
+		return String
+	case sema.CharacterType:
+		return Character
+	case sema.TheAddressType:
+		return Address
+
+	// Integers
+	case sema.IntType, sema.IntegerType, sema.SignedIntegerType:
+		return Int
+	case sema.Int8Type:
+		return Int8
+	case sema.Int16Type:
+		return Int16
+	case sema.Int32Type:
+		return Int32
+	case sema.Int64Type:
+		return Int64
+	case sema.Int128Type:
+		return Int128
+	case sema.Int256Type:
+		return Int256
+	case sema.UIntType:
+		return UInt
+	case sema.UInt8Type:
+		return UInt8
+	case sema.UInt16Type:
+		return UInt16
+	case sema.UInt32Type:
+		return UInt32
+	case sema.UInt64Type:
+		return UInt64
+	case sema.UInt128Type:
+		return UInt128
+	case sema.UInt256Type:
+		return UInt256
+	case sema.Word8Type:
+		return Word8
+	case sema.Word16Type:
+		return Word16
+	case sema.Word32Type:
+		return Word32
+	case sema.Word64Type:
+		return Word64
+	case sema.Word128Type:
+		return Word128
+	case sema.Word256Type:
+		return Word256
+
+	// Fixed-point numbers
+	case sema.Fix64Type, sema.SignedFixedPointType:
+		return Fix64
+	case sema.UFix64Type:
+		return UFix64
+
+	default:
+		return Unknown
+	}
+}
+
+// IsInteger returns true if the kind is an integer kind.
+func (k ConstantKind) IsInteger() bool {
+	return k >= Int && k <= Word256
+}
+
+// IntegerSemaType returns the type of integers of the kind.
+func (k ConstantKind) IntegerSemaType() sema.Type {
+	switch k {
+	case Int:
+		return sema.IntType
+	case Int8:
+		return sema.Int8Type
+	case Int16:
+		return sema.Int16Type
+	case Int32:
+		return sema.Int32Type
+	case Int64:
+		return sema.Int64Type
+	case Int128:
+		return sema.Int128Type
+	case Int256:
+		return sema.Int256Type
+	case UInt:
+		return sema.UIntType
+	case UInt8:
+		return sema.UInt8Type
+	case UInt16:
+		return sema.UInt16Type
+	case UInt32:
+		return sema.UInt32Type
+	case UInt64:
+		return sema.UInt64Type
+	case UInt128:
+		return sema.UInt128Type
+	case UInt256:
+		return sema.UInt256Type
+	case Word8:
+		return sema.Word8Type
+	case Word16:
+		return sema.Word16Type
+	case Word32:
+		return sema.Word32Type
+	case Word64:
+		return sema.Word64Type
+	case Word128:
+		return sema.Word128Type
+	case Word256:
+		return sema.Word256Type
+	}
+
+	panic(errors.NewUnreachableError())
+}
@@ -0,0 +1,169 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package opcode
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=Opcode
+
+// Opcode is the operation code of an instruction.
+//
+// Instructions are encoded as the opcode, a single byte,
+// followed by its operands, which are unsigned 16-bit big-endian integers.
+type Opcode byte
+
+const (
+	Unknown Opcode = iota
+
+	// Control flow
+
+	// Return returns from the current function, with the result Void
+	Return
+	// ReturnValue returns the top of the stack from the current function
+	ReturnValue
+	// Jump jumps to the target offset (operand 1)
+	Jump
+	// JumpIfFalse pops a boolean and jumps to the target offset (operand 1) if it is false
+	JumpIfFalse
+	// JumpIfNil pops an optional and jumps to the target offset (operand 1) if it is nil
+	JumpIfNil
+
+	// Invocation
+
+	// Invoke invokes the function of the program with the given index (operand 1).
+	// The arguments are on the stack, the receiver of composite functions first
+	Invoke
+	// InvokeMethod invokes the function member with the given name (operand 1: constant index)
+	// of the receiver, with the given number of arguments (operand 2).
+	// The receiver and the arguments are on the stack
+	InvokeMethod
+	// InvokeValue invokes the function value below the given number of arguments (operand 1)
+	InvokeValue
+
+	// Values
+
+	True
+	False
+	Nil
+	Void
+	// GetConstant pushes the constant with the given index (operand 1)
+	GetConstant
+	// NewArray pops the given number of elements (operand 2)
+	// and pushes a new array of the given type (operand 1: type index)
+	NewArray
+	// New pushes a new composite value of the given type (operand 1: type index), with no fields
+	New
+
+	// Variables
+
+	// GetLocal pushes the local with the given index (operand 1)
+	GetLocal
+	// SetLocal pops a value and sets the local with the given index (operand 1)
+	SetLocal
+	// GetGlobal pushes the predeclared value with the given name (operand 1: constant index)
+	GetGlobal
+
+	// Members
+
+	// GetField pops a value and pushes its member with the given name (operand 1: constant index)
+	GetField
+	// SetField pops a value and a target, and sets the member with the given name (operand 1: constant index)
+	SetField
+	// GetIndex pops an index and a target, and pushes the element at the index
+	GetIndex
+	// SetIndex pops a value, an index and a target, and sets the element at the index
+	SetIndex
+
+	// Conversion
+
+	// Transfer pops a value and pushes a copy of it
+	Transfer
+	// TransferAndConvert pops a value and pushes a copy of it, converted from the value type (operand 1: type index)
+	// to the target type (operand 2: type index)
+	TransferAndConvert
+	// Convert pops a value and pushes it converted from the value type (operand 1: type index)
+	// to the target type (operand 2: type index)
+	Convert
+	// Unwrap pops an optional and pushes its inner value, or fails if it is nil
+	Unwrap
+
+	// Operators
+
+	Add
+	Subtract
+	Multiply
+	Divide
+	Mod
+	BitwiseOr
+	BitwiseXor
+	BitwiseAnd
+	BitwiseLeftShift
+	BitwiseRightShift
+	Less
+	LessOrEqual
+	Greater
+	GreaterOrEqual
+	Equal
+	NotEqual
+	Not
+	Negate
+
+	// Iteration
+
+	// IteratorStart pops an iterable value and starts an iterator in the given slot (operand 1)
+	IteratorStart
+	// IteratorNext pushes the next element of the iterator in the given slot (operand 1),
+	// or jumps to the target offset (operand 2) if there are no more elements
+	IteratorNext
+
+	// Stack
+
+	Drop
+	Dup
+)
+
+// OperandCount returns the number of operands of the instruction with the opcode.
+func (o Opcode) OperandCount() int {
+	switch o {
+	case Jump,
+		JumpIfFalse,
+		JumpIfNil,
+		Invoke,
+		InvokeValue,
+		GetConstant,
+		New,
+		GetLocal,
+		SetLocal,
+		GetGlobal,
+		GetField,
+		SetField,
+		IteratorStart:
+
+		return 1
+
+	case InvokeMethod,
+		NewArray,
+		TransferAndConvert,
+		Convert,
+		IteratorNext:
+
+		return 2
+
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package opcode
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// DecodeOperand decodes the operand at the given offset of the code.
+func DecodeOperand(code []byte, offset int) uint16 {
+	return binary.BigEndian.Uint16(code[offset:])
+}
+
+// PrintInstructions writes a human-readable representation of the instructions in the given code,
+// one instruction per line, prefixed with its offset.
+func PrintInstructions(builder *strings.Builder, code []byte) error {
+	offset := 0
+	for offset < len(code) {
+		op := Opcode(code[offset])
+
+		operandCount := op.OperandCount()
+		end := offset + 1 + operandCount*2
+		if end > len(code) {
+			return fmt.Errorf("incomplete instruction %s at offset %d", op, offset)
+		}
+
+		_, _ = fmt.Fprintf(builder, "%d: %s", offset, op)
+
+		for i := 0; i < operandCount; i++ {
+			operand := DecodeOperand(code, offset+1+i*2)
+			_, _ = fmt.Fprintf(builder, " %d", operand)
+		}
+
+		builder.WriteByte('\n')
+
+		offset = end
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bbq contains the bytecode representation of programs,
+// which is produced by the compiler (package compiler),
+// and executed by the virtual machine (package vm).
+package bbq
+
+import (
+	"github.com/onflow/cadence/runtime/bbq/constantkind"
+)
+
+// Program is a compiled program.
+type Program struct {
+	Functions []*Function
+	Constants []*Constant
+	// Types are the encoded static types used by the instructions
+	Types [][]byte
+}
+
+// Function is a compiled function.
+//
+// The functions of composite types are named by the qualified identifier of the composite type,
+// followed by a dot and the name of the function, e.g. `S.foo`.
+// The receiver is passed as the first argument.
+// The initializer of a composite type is named by the qualified identifier of the composite type,
+// and returns the new composite value.
+type Function struct {
+	Name string
+	Code []byte
+	// ParameterCount is the number of parameters, including the receiver, if any
+	ParameterCount uint16
+	// LocalCount is the number of locals, including the parameters
+	LocalCount uint16
+}
+
+// Constant is a constant used by the instructions of a program.
+type Constant struct {
+	Data []byte
+	Kind constantkind.ConstantKind
+}
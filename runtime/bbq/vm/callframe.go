@@ -0,0 +1,54 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vm
+
+import (
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/opcode"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// callFrame is the state of a function invocation
+type callFrame struct {
+	parent    *callFrame
+	function  *bbq.Function
+	locals    []interpreter.Value
+	iterators []interpreter.ValueIterator
+	// ip is the offset of the next instruction
+	ip int
+}
+
+func (f *callFrame) readOpcode() opcode.Opcode {
+	op := opcode.Opcode(f.function.Code[f.ip])
+	f.ip++
+	return op
+}
+
+func (f *callFrame) readOperand() uint16 {
+	operand := opcode.DecodeOperand(f.function.Code, f.ip)
+	f.ip += 2
+	return operand
+}
+
+func (f *callFrame) setIterator(slot uint16, iterator interpreter.ValueIterator) {
+	for int(slot) >= len(f.iterators) {
+		f.iterators = append(f.iterators, nil)
+	}
+	f.iterators[slot] = iterator
+}
@@ -0,0 +1,607 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vm implements a stack-based virtual machine which executes bytecode (package bbq).
+//
+// The virtual machine uses the values of the interpreter (package interpreter),
+// and an interpreter as the context for storage, types, and built-in functions,
+// so the results of programs are identical to the results of interpreting them.
+package vm
+
+import (
+	"math/big"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/constantkind"
+	"github.com/onflow/cadence/runtime/bbq/opcode"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// VM executes the functions of a compiled program
+type VM struct {
+	program         *bbq.Program
+	interpreter     *interpreter.Interpreter
+	functionIndices map[string]uint16
+	constants       []interpreter.Value
+	types           []interpreter.StaticType
+	stack           []interpreter.Value
+	callFrame       *callFrame
+	locationRange   interpreter.LocationRange
+}
+
+// NewVM returns a virtual machine which executes the given program.
+//
+// The given interpreter is used as the context for the execution,
+// e.g. for storage, type information, and built-in functions.
+func NewVM(program *bbq.Program, inter *interpreter.Interpreter) *VM {
+	functionIndices := make(map[string]uint16, len(program.Functions))
+	for i, function := range program.Functions {
+		functionIndices[function.Name] = uint16(i)
+	}
+
+	return &VM{
+		program:         program,
+		interpreter:     inter,
+		functionIndices: functionIndices,
+		constants:       make([]interpreter.Value, len(program.Constants)),
+		types:           make([]interpreter.StaticType, len(program.Types)),
+		locationRange: interpreter.LocationRange{
+			Location: inter.Location,
+		},
+	}
+}
+
+// Invoke invokes the function with the given name.
+// Functions of composite types are named by the qualified identifier of the type,
+// followed by a dot and the name of the function, and expect the receiver as the first argument.
+func (vm *VM) Invoke(name string, arguments ...interpreter.Value) (result interpreter.Value, err error) {
+
+	// recover internal panics and return them as an error
+	defer vm.interpreter.RecoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	functionIndex, ok := vm.functionIndices[name]
+	if !ok {
+		return nil, interpreter.NotDeclaredError{
+			ExpectedKind: common.DeclarationKindFunction,
+			Name:         name,
+		}
+	}
+
+	function := vm.program.Functions[functionIndex]
+	if len(arguments) != int(function.ParameterCount) {
+		return nil, interpreter.ArgumentCountError{
+			ParameterCount: int(function.ParameterCount),
+			ArgumentCount:  len(arguments),
+		}
+	}
+
+	// Reset the state, in case a previous invocation was aborted
+	vm.stack = vm.stack[:0]
+	vm.callFrame = nil
+
+	vm.push(arguments...)
+	vm.pushCallFrame(function)
+	vm.run(nil)
+
+	return vm.pop(), nil
+}
+
+// Stack
+
+func (vm *VM) push(values ...interpreter.Value) {
+	vm.stack = append(vm.stack, values...)
+}
+
+func (vm *VM) pop() interpreter.Value {
+	lastIndex := len(vm.stack) - 1
+	value := vm.stack[lastIndex]
+	vm.stack[lastIndex] = nil
+	vm.stack = vm.stack[:lastIndex]
+	return value
+}
+
+func (vm *VM) peek() interpreter.Value {
+	return vm.stack[len(vm.stack)-1]
+}
+
+// popN pops the given number of values, and returns them in the order they were pushed
+func (vm *VM) popN(count int) []interpreter.Value {
+	start := len(vm.stack) - count
+	values := make([]interpreter.Value, count)
+	copy(values, vm.stack[start:])
+	for i := start; i < len(vm.stack); i++ {
+		vm.stack[i] = nil
+	}
+	vm.stack = vm.stack[:start]
+	return values
+}
+
+// Call frames
+
+// pushCallFrame pushes a call frame for the given function,
+// with the arguments on the stack as the parameters
+func (vm *VM) pushCallFrame(function *bbq.Function) {
+	vm.interpreter.ReportComputation(common.ComputationKindFunctionInvocation, 1)
+
+	locals := make([]interpreter.Value, function.LocalCount)
+	copy(locals, vm.popN(int(function.ParameterCount)))
+
+	vm.callFrame = &callFrame{
+		parent:   vm.callFrame,
+		function: function,
+		locals:   locals,
+	}
+}
+
+// run executes instructions until the call frame with the given parent returns
+func (vm *VM) run(parent *callFrame) {
+	for vm.callFrame != parent {
+		vm.step()
+	}
+}
+
+func (vm *VM) step() {
+	frame := vm.callFrame
+	op := frame.readOpcode()
+
+	switch op {
+	case opcode.Return:
+		vm.callFrame = frame.parent
+		vm.push(interpreter.Void)
+
+	case opcode.ReturnValue:
+		vm.callFrame = frame.parent
+
+	case opcode.Jump:
+		target := int(frame.readOperand())
+		if target < frame.ip {
+			// Jumping backwards is the next iteration of a loop
+			vm.interpreter.ReportComputation(common.ComputationKindLoop, 1)
+		}
+		frame.ip = target
+
+	case opcode.JumpIfFalse:
+		target := int(frame.readOperand())
+		if !bool(vm.pop().(interpreter.BoolValue)) {
+			frame.ip = target
+		}
+
+	case opcode.JumpIfNil:
+		target := int(frame.readOperand())
+		if _, ok := vm.pop().(interpreter.NilValue); ok {
+			frame.ip = target
+		}
+
+	case opcode.Invoke:
+		function := vm.program.Functions[frame.readOperand()]
+		vm.pushCallFrame(function)
+
+	case opcode.InvokeMethod:
+		name := vm.stringConstant(frame.readOperand())
+		argumentCount := int(frame.readOperand())
+		vm.invokeMethod(name, argumentCount)
+
+	case opcode.InvokeValue:
+		argumentCount := int(frame.readOperand())
+		arguments := vm.popN(argumentCount)
+		function := vm.pop().(interpreter.FunctionValue)
+		vm.push(vm.invokeFunctionValue(function, arguments))
+
+	case opcode.True:
+		vm.push(interpreter.TrueValue)
+
+	case opcode.False:
+		vm.push(interpreter.FalseValue)
+
+	case opcode.Nil:
+		vm.push(interpreter.Nil)
+
+	case opcode.Void:
+		vm.push(interpreter.Void)
+
+	case opcode.GetConstant:
+		vm.push(vm.constant(frame.readOperand()))
+
+	case opcode.NewArray:
+		arrayType := vm.staticType(frame.readOperand()).(interpreter.ArrayStaticType)
+		count := int(frame.readOperand())
+		elements := vm.popN(count)
+		vm.push(
+			interpreter.NewArrayValue(
+				vm.interpreter,
+				vm.locationRange,
+				arrayType,
+				common.ZeroAddress,
+				elements...,
+			),
+		)
+
+	case opcode.New:
+		vm.push(vm.newComposite(frame.readOperand()))
+
+	case opcode.GetLocal:
+		vm.push(frame.locals[frame.readOperand()])
+
+	case opcode.SetLocal:
+		frame.locals[frame.readOperand()] = vm.pop()
+
+	case opcode.GetGlobal:
+		name := vm.stringConstant(frame.readOperand())
+		variable := vm.interpreter.FindVariable(name)
+		if variable == nil {
+			panic(errors.NewUnexpectedError("undeclared global: %s", name))
+		}
+		vm.push(variable.GetValue())
+
+	case opcode.GetField:
+		name := vm.stringConstant(frame.readOperand())
+		value := vm.pop()
+		member := vm.interpreter.GetMember(value, vm.locationRange, name)
+		if member == nil {
+			panic(interpreter.UseBeforeInitializationError{
+				Name:          name,
+				LocationRange: vm.locationRange,
+			})
+		}
+		vm.push(member)
+
+	case opcode.SetField:
+		name := vm.stringConstant(frame.readOperand())
+		value := vm.pop()
+		target := vm.pop().(interpreter.MemberAccessibleValue)
+		target.SetMember(vm.interpreter, vm.locationRange, name, value)
+
+	case opcode.GetIndex:
+		index := vm.pop()
+		target := vm.pop().(interpreter.ValueIndexableValue)
+		vm.push(target.GetKey(vm.interpreter, vm.locationRange, index))
+
+	case opcode.SetIndex:
+		value := vm.pop()
+		index := vm.pop()
+		target := vm.pop().(interpreter.ValueIndexableValue)
+		target.SetKey(vm.interpreter, vm.locationRange, index, value)
+
+	case opcode.Transfer:
+		vm.push(vm.transfer(vm.pop()))
+
+	case opcode.TransferAndConvert:
+		valueType := vm.semaType(frame.readOperand())
+		targetType := vm.semaType(frame.readOperand())
+		value := vm.transfer(vm.pop())
+		vm.push(vm.interpreter.ConvertAndBox(vm.locationRange, value, valueType, targetType))
+
+	case opcode.Convert:
+		valueType := vm.semaType(frame.readOperand())
+		targetType := vm.semaType(frame.readOperand())
+		vm.push(vm.interpreter.ConvertAndBox(vm.locationRange, vm.pop(), valueType, targetType))
+
+	case opcode.Unwrap:
+		switch value := vm.pop().(type) {
+		case *interpreter.SomeValue:
+			vm.push(value.InnerValue(vm.interpreter, vm.locationRange))
+		case interpreter.NilValue:
+			panic(interpreter.ForceNilError{
+				LocationRange: vm.locationRange,
+			})
+		default:
+			vm.push(value)
+		}
+
+	case opcode.Add:
+		right := vm.pop().(interpreter.NumberValue)
+		left := vm.pop().(interpreter.NumberValue)
+		vm.push(left.Plus(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Subtract:
+		right := vm.pop().(interpreter.NumberValue)
+		left := vm.pop().(interpreter.NumberValue)
+		vm.push(left.Minus(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Multiply:
+		right := vm.pop().(interpreter.NumberValue)
+		left := vm.pop().(interpreter.NumberValue)
+		vm.push(left.Mul(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Divide:
+		right := vm.pop().(interpreter.NumberValue)
+		left := vm.pop().(interpreter.NumberValue)
+		vm.push(left.Div(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Mod:
+		right := vm.pop().(interpreter.NumberValue)
+		left := vm.pop().(interpreter.NumberValue)
+		vm.push(left.Mod(vm.interpreter, right, vm.locationRange))
+
+	case opcode.BitwiseOr:
+		right := vm.pop().(interpreter.IntegerValue)
+		left := vm.pop().(interpreter.IntegerValue)
+		vm.push(left.BitwiseOr(vm.interpreter, right, vm.locationRange))
+
+	case opcode.BitwiseXor:
+		right := vm.pop().(interpreter.IntegerValue)
+		left := vm.pop().(interpreter.IntegerValue)
+		vm.push(left.BitwiseXor(vm.interpreter, right, vm.locationRange))
+
+	case opcode.BitwiseAnd:
+		right := vm.pop().(interpreter.IntegerValue)
+		left := vm.pop().(interpreter.IntegerValue)
+		vm.push(left.BitwiseAnd(vm.interpreter, right, vm.locationRange))
+
+	case opcode.BitwiseLeftShift:
+		right := vm.pop().(interpreter.IntegerValue)
+		left := vm.pop().(interpreter.IntegerValue)
+		vm.push(left.BitwiseLeftShift(vm.interpreter, right, vm.locationRange))
+
+	case opcode.BitwiseRightShift:
+		right := vm.pop().(interpreter.IntegerValue)
+		left := vm.pop().(interpreter.IntegerValue)
+		vm.push(left.BitwiseRightShift(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Less:
+		right := vm.pop().(interpreter.ComparableValue)
+		left := vm.pop().(interpreter.ComparableValue)
+		vm.push(left.Less(vm.interpreter, right, vm.locationRange))
+
+	case opcode.LessOrEqual:
+		right := vm.pop().(interpreter.ComparableValue)
+		left := vm.pop().(interpreter.ComparableValue)
+		vm.push(left.LessEqual(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Greater:
+		right := vm.pop().(interpreter.ComparableValue)
+		left := vm.pop().(interpreter.ComparableValue)
+		vm.push(left.Greater(vm.interpreter, right, vm.locationRange))
+
+	case opcode.GreaterOrEqual:
+		right := vm.pop().(interpreter.ComparableValue)
+		left := vm.pop().(interpreter.ComparableValue)
+		vm.push(left.GreaterEqual(vm.interpreter, right, vm.locationRange))
+
+	case opcode.Equal:
+		right := vm.pop()
+		left := vm.pop()
+		vm.push(vm.equal(left, right))
+
+	case opcode.NotEqual:
+		right := vm.pop()
+		left := vm.pop()
+		vm.push(!vm.equal(left, right))
+
+	case opcode.Not:
+		vm.push(vm.pop().(interpreter.BoolValue).Negate(vm.interpreter))
+
+	case opcode.Negate:
+		vm.push(vm.pop().(interpreter.NumberValue).Negate(vm.interpreter, vm.locationRange))
+
+	case opcode.IteratorStart:
+		slot := frame.readOperand()
+		iterable := vm.pop().(interpreter.IterableValue)
+		frame.setIterator(slot, iterable.Iterator(vm.interpreter, vm.locationRange))
+
+	case opcode.IteratorNext:
+		slot := frame.readOperand()
+		target := int(frame.readOperand())
+		element := frame.iterators[slot].Next(vm.interpreter, vm.locationRange)
+		if element == nil {
+			frame.iterators[slot] = nil
+			frame.ip = target
+		} else {
+			vm.push(element)
+		}
+
+	case opcode.Drop:
+		vm.pop()
+
+	case opcode.Dup:
+		vm.push(vm.peek())
+
+	default:
+		panic(errors.NewUnexpectedError("invalid opcode: %s", op))
+	}
+}
+
+func (vm *VM) transfer(value interpreter.Value) interpreter.Value {
+	return value.Transfer(
+		vm.interpreter,
+		vm.locationRange,
+		atree.Address{},
+		false,
+		nil,
+		nil,
+	)
+}
+
+func (vm *VM) equal(left, right interpreter.Value) interpreter.BoolValue {
+	left = vm.interpreter.Unbox(vm.locationRange, left)
+	right = vm.interpreter.Unbox(vm.locationRange, right)
+
+	leftEquatable, ok := left.(interpreter.EquatableValue)
+	if !ok {
+		return interpreter.FalseValue
+	}
+
+	return interpreter.AsBoolValue(
+		leftEquatable.Equal(vm.interpreter, vm.locationRange, right),
+	)
+}
+
+func (vm *VM) newComposite(typeIndex uint16) *interpreter.CompositeValue {
+	staticType := vm.staticType(typeIndex).(*interpreter.CompositeStaticType)
+	compositeType := vm.interpreter.MustConvertStaticToSemaType(staticType).(*sema.CompositeType)
+
+	return interpreter.NewCompositeValue(
+		vm.interpreter,
+		vm.locationRange,
+		staticType.Location,
+		staticType.QualifiedIdentifier,
+		compositeType.Kind,
+		nil,
+		common.ZeroAddress,
+	)
+}
+
+// invokeMethod invokes the function with the given name on the receiver below the arguments on the stack
+func (vm *VM) invokeMethod(name string, argumentCount int) {
+	arguments := vm.popN(argumentCount)
+	receiver := vm.pop()
+
+	// Functions of composite types declared in the program are executed by the VM,
+	// e.g. when the function is invoked through an interface type
+
+	if compositeValue, ok := receiver.(*interpreter.CompositeValue); ok &&
+		compositeValue.Location != nil &&
+		vm.interpreter.Location != nil &&
+		compositeValue.Location.ID() == vm.interpreter.Location.ID() {
+
+		functionName := compositeValue.QualifiedIdentifier + "." + name
+		if functionIndex, ok := vm.functionIndices[functionName]; ok {
+			vm.push(receiver)
+			vm.push(arguments...)
+			vm.pushCallFrame(vm.program.Functions[functionIndex])
+			return
+		}
+	}
+
+	member := vm.interpreter.GetMember(receiver, vm.locationRange, name)
+	function, ok := member.(interpreter.FunctionValue)
+	if !ok {
+		panic(errors.NewUnexpectedError("missing function: %s", name))
+	}
+
+	vm.push(vm.invokeFunctionValue(function, arguments))
+}
+
+// invokeFunctionValue invokes a function value which is not part of the program,
+// e.g. a built-in function, using the interpreter
+func (vm *VM) invokeFunctionValue(function interpreter.FunctionValue, arguments []interpreter.Value) interpreter.Value {
+	argumentTypes := make([]sema.Type, len(arguments))
+	for i, argument := range arguments {
+		argumentTypes[i] = vm.interpreter.MustConvertStaticToSemaType(argument.StaticType(vm.interpreter))
+	}
+
+	invocation := interpreter.NewInvocation(
+		vm.interpreter,
+		nil,
+		nil,
+		nil,
+		arguments,
+		argumentTypes,
+		nil,
+		vm.locationRange,
+	)
+
+	result, err := vm.interpreter.InvokeFunction(function, invocation)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Constants and types
+
+func (vm *VM) constant(index uint16) interpreter.Value {
+	value := vm.constants[index]
+	if value == nil {
+		value = vm.decodeConstant(vm.program.Constants[index])
+		vm.constants[index] = value
+	}
+	return value
+}
+
+func (vm *VM) stringConstant(index uint16) string {
+	return string(vm.program.Constants[index].Data)
+}
+
+func (vm *VM) decodeConstant(constant *bbq.Constant) interpreter.Value {
+	data := constant.Data
+
+	switch kind := constant.Kind; kind {
+	case constantkind.String:
+		return interpreter.NewUnmeteredStringValue(string(data))
+
+	case constantkind.Character:
+		return interpreter.NewUnmeteredCharacterValue(string(data))
+
+	case constantkind.Address:
+		return interpreter.NewAddressValueFromBytes(
+			vm.interpreter,
+			func() []byte {
+				return data
+			},
+		)
+
+	case constantkind.Fix64:
+		value := decodeBigInt(data)
+		return interpreter.NewFix64Value(
+			vm.interpreter,
+			func() int64 {
+				return value.Int64()
+			},
+		)
+
+	case constantkind.UFix64:
+		value := decodeBigInt(data)
+		return interpreter.NewUFix64Value(
+			vm.interpreter,
+			func() uint64 {
+				return value.Uint64()
+			},
+		)
+
+	default:
+		if !kind.IsInteger() {
+			panic(errors.NewUnexpectedError("invalid constant kind: %s", kind))
+		}
+		return vm.interpreter.NewIntegerValueFromBigInt(decodeBigInt(data), kind.IntegerSemaType())
+	}
+}
+
+// decodeBigInt decodes an integer encoded as the sign, followed by the magnitude,
+// see constantkind.ConstantKind
+func decodeBigInt(data []byte) *big.Int {
+	value := new(big.Int).SetBytes(data[1:])
+	if data[0] == 1 {
+		value.Neg(value)
+	}
+	return value
+}
+
+func (vm *VM) staticType(index uint16) interpreter.StaticType {
+	staticType := vm.types[index]
+	if staticType == nil {
+		var err error
+		staticType, err = interpreter.StaticTypeFromBytes(vm.program.Types[index])
+		if err != nil {
+			panic(err)
+		}
+		vm.types[index] = staticType
+	}
+	return staticType
+}
+
+func (vm *VM) semaType(index uint16) sema.Type {
+	return vm.interpreter.MustConvertStaticToSemaType(vm.staticType(index))
+}
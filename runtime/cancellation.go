@@ -0,0 +1,68 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// executionCanceler aborts the execution
+// when the context is done, or when the deadline is exceeded.
+type executionCanceler struct {
+	context  context.Context
+	deadline time.Time
+}
+
+// newExecutionCanceler returns an execution canceler for the given context and deadline.
+// It returns nil if the execution can not be canceled,
+// i.e. if neither a cancelable context nor a deadline is given.
+func newExecutionCanceler(ctx context.Context, deadline time.Time) *executionCanceler {
+	if ctx != nil && ctx.Done() == nil {
+		ctx = nil
+	}
+	if ctx == nil && deadline.IsZero() {
+		return nil
+	}
+	return &executionCanceler{
+		context:  ctx,
+		deadline: deadline,
+	}
+}
+
+// check aborts the execution if it was canceled.
+// The cancellation is reported as an external error, as it is not caused by the program
+func (canceler *executionCanceler) check() {
+	if canceler.context != nil {
+		err := canceler.context.Err()
+		if err != nil {
+			panic(errors.NewExternalError(ExecutionCanceledError{
+				Err: err,
+			}))
+		}
+	}
+
+	if !canceler.deadline.IsZero() && !time.Now().Before(canceler.deadline) {
+		panic(errors.NewExternalError(ExecutionCanceledError{
+			Err: context.DeadlineExceeded,
+		}))
+	}
+}
@@ -0,0 +1,250 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeExecutionCancellation(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	newRuntimeInterface := func(onWrite func(owner, key, value []byte)) *TestRuntimeInterface {
+		return &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, onWrite),
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{{0x1}}, nil
+			},
+		}
+	}
+
+	t.Run("context canceled, loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main() {
+                      while true {}
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Context:   ctx,
+			},
+		)
+		RequireError(t, err)
+
+		var canceledErr ExecutionCanceledError
+		require.ErrorAs(t, err, &canceledErr)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("context deadline, recursion", func(t *testing.T) {
+
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main() {
+                      while true {
+                          f(1)
+                      }
+                  }
+
+                  access(all) fun f(_ n: Int): Int {
+                      return n > 100 ? n : f(n + 1)
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Context:   ctx,
+			},
+		)
+		RequireError(t, err)
+
+		var canceledErr ExecutionCanceledError
+		require.ErrorAs(t, err, &canceledErr)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main() {
+                      while true {}
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Deadline:  time.Now().Add(10 * time.Millisecond),
+			},
+		)
+		RequireError(t, err)
+
+		var canceledErr ExecutionCanceledError
+		require.ErrorAs(t, err, &canceledErr)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("not a user error", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Cancellation is not deterministic,
+		// so hosts must be able to distinguish it from failures of the program
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main() {
+                      while true {}
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Deadline:  time.Now().Add(10 * time.Millisecond),
+			},
+		)
+		RequireError(t, err)
+
+		assert.False(t, errors.IsUserError(err))
+		externalErr, ok := errors.GetExternalError(err)
+		require.True(t, ok)
+		require.ErrorAs(t, externalErr, &ExecutionCanceledError{})
+
+		_, err = runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main() {
+                      panic("failed")
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Deadline:  time.Now().Add(time.Minute),
+			},
+		)
+		RequireError(t, err)
+
+		assert.True(t, errors.IsUserError(err))
+		_, ok = errors.GetExternalError(err)
+		require.False(t, ok)
+	})
+
+	t.Run("not canceled", func(t *testing.T) {
+
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main(): Int {
+                      var i = 0
+                      while i < 10 {
+                          i = i + 1
+                      }
+                      return i
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(nil),
+				Location:  common.ScriptLocation{},
+				Context:   ctx,
+				Deadline:  time.Now().Add(time.Minute),
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(10), result)
+	})
+
+	t.Run("storage untouched", func(t *testing.T) {
+
+		t.Parallel()
+
+		var writes int
+
+		runtimeInterface := newRuntimeInterface(func(_, _, _ []byte) {
+			writes++
+		})
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(`
+                  transaction {
+                      prepare(signer: auth(Storage) &Account) {
+                          signer.storage.save(1, to: /storage/x)
+                          while true {}
+                      }
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.TransactionLocation{},
+				Deadline:  time.Now().Add(10 * time.Millisecond),
+			},
+		)
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &ExecutionCanceledError{})
+		assert.Equal(t, 0, writes)
+	})
+}
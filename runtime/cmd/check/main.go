@@ -34,6 +34,7 @@ import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/cmd"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/pretty"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
@@ -99,10 +100,63 @@ type benchResult struct {
 }
 
 type result struct {
-	Path     string       `json:"path"`
-	Bench    *benchResult `json:"bench,omitempty"`
-	BenchStr string       `json:"-"`
-	Error    string       `json:"error,omitempty"`
+	Path     string        `json:"path"`
+	Bench    *benchResult  `json:"bench,omitempty"`
+	BenchStr string        `json:"-"`
+	Error    string        `json:"error,omitempty"`
+	Errors   []errorResult `json:"errors,omitempty"`
+}
+
+type errorResult struct {
+	// Code is the stable error code, if any
+	Code string `json:"code,omitempty"`
+	// Category is the category of the error code, if any
+	Category string        `json:"category,omitempty"`
+	Message  string        `json:"message"`
+	Location string        `json:"location,omitempty"`
+	StartPos *ast.Position `json:"startPos,omitempty"`
+	EndPos   *ast.Position `json:"endPos,omitempty"`
+}
+
+// errorResults returns the results for all errors contained in the given error,
+// i.e. the given error itself, or the child errors of a parent error.
+func errorResults(err error, location common.Location) []errorResult {
+	if hasLocation, ok := err.(common.HasLocation); ok {
+		importLocation := hasLocation.ImportLocation()
+		if importLocation != nil {
+			location = importLocation
+		}
+	}
+
+	if parentError, ok := err.(errors.ParentError); ok {
+		var results []errorResult
+		for _, childErr := range parentError.ChildErrors() {
+			results = append(results, errorResults(childErr, location)...)
+		}
+		return results
+	}
+
+	res := errorResult{
+		Message: err.Error(),
+	}
+
+	if location != nil {
+		res.Location = location.String()
+	}
+
+	if code, ok := errors.GetErrorCode(err); ok {
+		res.Code = code.String()
+		res.Category = code.Category().String()
+	}
+
+	if positioned, ok := err.(ast.HasPosition); ok {
+		startPos := positioned.StartPosition()
+		endPos := positioned.EndPosition(nil)
+		res.StartPos = &startPos
+		res.EndPos = &endPos
+	}
+
+	return []errorResult{res}
 }
 
 type output interface {
@@ -267,6 +321,7 @@ func runPath(
 				panic(printErr)
 			}
 			res.Error = builder.String()
+			res.Errors = errorResults(err, location)
 		}
 	}()
 
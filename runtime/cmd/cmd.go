@@ -30,6 +30,7 @@ import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/meter"
 	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/cadence/runtime/pretty"
 	"github.com/onflow/cadence/runtime/sema"
@@ -168,7 +169,17 @@ func PrepareChecker(
 	return checker, must
 }
 
-func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*interpreter.Interpreter, *sema.Checker, func(error)) {
+// PrepareInterpreter prepares an interpreter for the program in the given file, and interprets it.
+// If a meter is given, the computation and memory used by the interpreter is metered.
+func PrepareInterpreter(
+	filename string,
+	debugger *interpreter.Debugger,
+	usageMeter *meter.Meter,
+) (
+	*interpreter.Interpreter,
+	*sema.Checker,
+	func(error),
+) {
 
 	codes := map[common.Location][]byte{}
 
@@ -216,6 +227,16 @@ func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*inter
 		},
 	}
 
+	if usageMeter != nil {
+		config.MemoryGauge = usageMeter
+		config.OnMeterComputation = func(compKind common.ComputationKind, intensity uint) {
+			err := usageMeter.MeterComputation(compKind, intensity)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	inter, err := interpreter.NewInterpreter(
 		interpreter.ProgramFromChecker(checker),
 		checker.Location,
@@ -19,26 +19,39 @@
 package execute
 
 import (
+	"os"
+
 	"github.com/onflow/cadence/runtime/cmd"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/meter"
 )
 
 // Execute parses the given filename and prints any syntax errors.
 // If there are no syntax errors, the program is interpreted.
 // If after the interpretation a global function `main` is defined, it will be called.
 // The program may call the function `log` to print a value.
-func Execute(args []string, debugger *interpreter.Debugger) {
+// If a meter is given, the computation and memory usage is metered and reported.
+func Execute(args []string, debugger *interpreter.Debugger, usageMeter *meter.Meter) {
 
 	if len(args) < 1 {
 		cmd.ExitWithError("no input file")
 	}
 
-	inter, _, must := cmd.PrepareInterpreter(args[0], debugger)
+	inter, _, must := cmd.PrepareInterpreter(args[0], debugger, usageMeter)
 
 	if !inter.Globals.Contains("main") {
+		reportUsage(usageMeter)
 		return
 	}
 
 	_, err := inter.Invoke("main")
+	reportUsage(usageMeter)
 	must(err)
 }
+
+func reportUsage(usageMeter *meter.Meter) {
+	if usageMeter == nil {
+		return
+	}
+	_, _ = usageMeter.Report().WriteTo(os.Stderr)
+}
@@ -19,15 +19,27 @@
 package main
 
 import (
+	"flag"
 	"os"
 	"os/signal"
 
+	"github.com/onflow/cadence/runtime/cmd"
 	"github.com/onflow/cadence/runtime/cmd/execute"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/meter"
 )
 
+var meterFlag = flag.Bool("meter", false, "meter computation and memory, and print a usage report")
+var meterWeightsFlag = flag.String("meterWeights", "", "JSON or YAML file with the weights of computation and memory kinds (implies -meter)")
+var computationLimitFlag = flag.Uint64("computationLimit", 0, "limit of the weighted computation (implies -meter)")
+var memoryLimitFlag = flag.Uint64("memoryLimit", 0, "limit of the weighted memory (implies -meter)")
+
 func main() {
-	if len(os.Args) > 1 {
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) > 0 {
 		// TODO: also make the REPL support the interactive debugger
 
 		signals := make(chan os.Signal, 1)
@@ -44,7 +56,7 @@ func main() {
 			}
 		}()
 
-		execute.Execute(os.Args[1:], debugger)
+		execute.Execute(args, debugger, newMeter())
 	} else {
 		repl, err := execute.NewConsoleREPL()
 		if err != nil {
@@ -53,3 +65,31 @@ func main() {
 		repl.Run()
 	}
 }
+
+// newMeter returns a meter configured by the flags, or nil if metering is not enabled
+func newMeter() *meter.Meter {
+	if !*meterFlag &&
+		*meterWeightsFlag == "" &&
+		*computationLimitFlag == 0 &&
+		*memoryLimitFlag == 0 {
+
+		return nil
+	}
+
+	weights := meter.DefaultWeights()
+	if *meterWeightsFlag != "" {
+		var err error
+		weights, err = meter.LoadWeights(*meterWeightsFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+	}
+
+	return meter.New(
+		weights,
+		meter.Limits{
+			Computation: *computationLimitFlag,
+			Memory:      *memoryLimitFlag,
+		},
+	)
+}
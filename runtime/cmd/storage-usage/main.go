@@ -0,0 +1,285 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// A utility program that reports the storage used by accounts in a state dump in JSON Lines format,
+// attributed to the account's storage domains, stored values, and their nested values
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+type stringSlice []string
+
+func (s stringSlice) String() string {
+	return strings.Join(s, ", ")
+}
+
+func (s *stringSlice) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+var addressesFlag stringSlice
+
+func init() {
+	flag.Var(&addressesFlag, "addresses", "only report the storage usage of the given addresses")
+}
+
+var gzipFlag = flag.Bool("gzip", false, "set true if input file is gzipped")
+var depthFlag = flag.Int("depth", 1, "depth of nested values (fields, entries, elements) to report")
+var sortFlag = flag.String("sort", "size", "sort order of the report: size or name")
+var jsonFlag = flag.Bool("json", false, "output the report in JSON format")
+
+const keyPartCount = 3
+
+type ledgerKey struct {
+	owner string
+	key   string
+}
+
+// ledger is a read-only atree.Ledger for the registers of the state dump
+
+type ledger map[ledgerKey][]byte
+
+var _ atree.Ledger = ledger{}
+
+func (l ledger) GetValue(owner, key []byte) ([]byte, error) {
+	return l[ledgerKey{owner: string(owner), key: string(key)}], nil
+}
+
+func (l ledger) SetValue(_, _, _ []byte) error {
+	return errors.New("unexpected SetValue call")
+}
+
+func (l ledger) ValueExists(owner, key []byte) (bool, error) {
+	return len(l[ledgerKey{owner: string(owner), key: string(key)}]) > 0, nil
+}
+
+func (l ledger) AllocateStorageIndex(_ []byte) (atree.StorageIndex, error) {
+	return atree.StorageIndex{}, errors.New("unexpected AllocateStorageIndex call")
+}
+
+type encodedKeyPart struct {
+	Value string
+}
+
+type encodedKey struct {
+	KeyParts []encodedKeyPart
+}
+
+type encodedEntry struct {
+	Value string
+	Key   encodedKey
+}
+
+func main() {
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 1 {
+		log.Fatal("missing path argument")
+	}
+
+	if *sortFlag != "size" && *sortFlag != "name" {
+		log.Fatalf("Invalid sort order: %s", *sortFlag)
+	}
+
+	addresses := make(map[common.Address]struct{}, len(addressesFlag))
+
+	for _, hexAddress := range addressesFlag {
+		address, err := common.HexToAddress(hexAddress)
+		if err != nil {
+			log.Fatalf("Invalid address: %s", hexAddress)
+		}
+		addresses[address] = struct{}{}
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	registers, owners := read(file, addresses)
+
+	storage := runtime.NewStorage(registers, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		nil,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	if err != nil {
+		log.Fatalf("Failed to create interpreter: %s", err)
+	}
+
+	usages := make([]*runtime.StorageUsage, 0, len(owners))
+
+	for _, owner := range owners {
+		usage, err := storage.AccountUsage(inter, owner, *depthFlag)
+		if err != nil {
+			log.Fatalf("Failed to get storage usage of %s: %s", owner, err)
+		}
+
+		switch *sortFlag {
+		case "size":
+			usage.SortBySize()
+		case "name":
+			usage.SortByName()
+		}
+
+		usages = append(usages, usage)
+	}
+
+	if *sortFlag == "size" {
+		sort.SliceStable(usages, func(i, j int) bool {
+			return usages[i].Size > usages[j].Size
+		})
+	}
+
+	if *jsonFlag {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(usages)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	for i, usage := range usages {
+		if i > 0 {
+			_, _ = os.Stdout.WriteString("\n")
+		}
+		_, err := usage.WriteTo(os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// read reads the registers of the given addresses from the state dump,
+// or of all addresses, if none are given.
+// It returns the registers, and the owners of the registers, sorted by address.
+func read(file *os.File, addresses map[common.Address]struct{}) (ledger, []common.Address) {
+
+	var inputReader io.Reader = file
+	if *gzipFlag {
+		gzipReader, err := gzip.NewReader(inputReader)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer gzipReader.Close()
+		inputReader = gzipReader
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(inputReader))
+
+	registers := ledger{}
+	owners := map[common.Address]struct{}{}
+
+	for line := 0; ; line++ {
+		var e encodedEntry
+
+		err := decoder.Decode(&e)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			log.Fatal(err)
+		}
+
+		if len(e.Key.KeyParts) < keyPartCount {
+			continue
+		}
+
+		var keyParts [keyPartCount][]byte
+		for i := 0; i < keyPartCount; i++ {
+			keyPart := e.Key.KeyParts[i].Value
+			keyParts[i], err = hex.DecodeString(keyPart)
+			if err != nil {
+				log.Fatalf(
+					"Failed to hex-decode key part %d on line %d (%s): %s",
+					i, line, keyPart, err,
+				)
+			}
+		}
+
+		// Skip registers which are not owned by an account
+		if len(keyParts[0]) != common.AddressLength {
+			continue
+		}
+
+		owner := common.MustBytesToAddress(keyParts[0])
+
+		if len(addresses) > 0 {
+			if _, ok := addresses[owner]; !ok {
+				continue
+			}
+		}
+
+		data, err := hex.DecodeString(e.Value)
+		if err != nil {
+			log.Fatalf("Invalid value on line %d: %s", line, err)
+		}
+
+		// Ignore empty registers
+		if len(data) == 0 {
+			continue
+		}
+
+		// The controller (second key part) is not used for account registers
+		registers[ledgerKey{
+			owner: string(keyParts[0]),
+			key:   string(keyParts[2]),
+		}] = data
+
+		owners[owner] = struct{}{}
+	}
+
+	sortedOwners := make([]common.Address, 0, len(owners))
+	for owner := range owners { //nolint:maprange
+		sortedOwners = append(sortedOwners, owner)
+	}
+	sort.Slice(sortedOwners, func(i, j int) bool {
+		return bytes.Compare(sortedOwners[i][:], sortedOwners[j][:]) < 0
+	})
+
+	return registers, sortedOwners
+}
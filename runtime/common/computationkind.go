@@ -84,8 +84,8 @@ const (
 	_
 	_
 	_
-	_
-	_
+	ComputationKindCreateSetValue
+	ComputationKindTransferSetValue
 	_
 	_
 	_
@@ -110,8 +110,8 @@ const (
 	_
 	_
 	ComputationKindEncodeValue
-	_
-	_
+	ComputationKindStorageQueryKey
+	ComputationKindStorageQueryValueRead
 	_
 	_
 	_
@@ -142,4 +142,16 @@ const (
 	// RLP
 	ComputationKindSTDLIBRLPDecodeString
 	ComputationKindSTDLIBRLPDecodeList
+	ComputationKindSTDLIBRLPEncodeString
+	ComputationKindSTDLIBRLPEncodeList
+	ComputationKindSTDLIBABIEncode
+	ComputationKindSTDLIBABIDecode
+	ComputationKindSTDLIBFixedPointMathMulDiv
+	ComputationKindSTDLIBFixedPointMathDiv
+	ComputationKindSTDLIBFixedPointMathSqrt
+	ComputationKindSTDLIBFixedPointMathPowInt
+	ComputationKindSTDLIBFixedPointMathPow
+	ComputationKindSTDLIBFixedPointMathExp
+	ComputationKindSTDLIBFixedPointMathLn
+	ComputationKindSTDLIBCryptoMerkleProofHash
 )
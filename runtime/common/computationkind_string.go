@@ -21,12 +21,28 @@ func _() {
 	_ = x[ComputationKindCreateDictionaryValue-1040]
 	_ = x[ComputationKindTransferDictionaryValue-1041]
 	_ = x[ComputationKindDestroyDictionaryValue-1042]
+	_ = x[ComputationKindCreateSetValue-1055]
+	_ = x[ComputationKindTransferSetValue-1056]
 	_ = x[ComputationKindEncodeValue-1080]
+	_ = x[ComputationKindStorageQueryKey-1081]
+	_ = x[ComputationKindStorageQueryValueRead-1082]
 	_ = x[ComputationKindSTDLIBPanic-1100]
 	_ = x[ComputationKindSTDLIBAssert-1101]
 	_ = x[ComputationKindSTDLIBRevertibleRandom-1102]
 	_ = x[ComputationKindSTDLIBRLPDecodeString-1108]
 	_ = x[ComputationKindSTDLIBRLPDecodeList-1109]
+	_ = x[ComputationKindSTDLIBRLPEncodeString-1110]
+	_ = x[ComputationKindSTDLIBRLPEncodeList-1111]
+	_ = x[ComputationKindSTDLIBABIEncode-1112]
+	_ = x[ComputationKindSTDLIBABIDecode-1113]
+	_ = x[ComputationKindSTDLIBFixedPointMathMulDiv-1114]
+	_ = x[ComputationKindSTDLIBFixedPointMathDiv-1115]
+	_ = x[ComputationKindSTDLIBFixedPointMathSqrt-1116]
+	_ = x[ComputationKindSTDLIBFixedPointMathPowInt-1117]
+	_ = x[ComputationKindSTDLIBFixedPointMathPow-1118]
+	_ = x[ComputationKindSTDLIBFixedPointMathExp-1119]
+	_ = x[ComputationKindSTDLIBFixedPointMathLn-1120]
+	_ = x[ComputationKindSTDLIBCryptoMerkleProofHash-1121]
 }
 
 const (
@@ -35,9 +51,10 @@ const (
 	_ComputationKind_name_2 = "CreateCompositeValueTransferCompositeValueDestroyCompositeValue"
 	_ComputationKind_name_3 = "CreateArrayValueTransferArrayValueDestroyArrayValue"
 	_ComputationKind_name_4 = "CreateDictionaryValueTransferDictionaryValueDestroyDictionaryValue"
-	_ComputationKind_name_5 = "EncodeValue"
-	_ComputationKind_name_6 = "STDLIBPanicSTDLIBAssertSTDLIBRevertibleRandom"
-	_ComputationKind_name_7 = "STDLIBRLPDecodeStringSTDLIBRLPDecodeList"
+	_ComputationKind_name_5 = "CreateSetValueTransferSetValue"
+	_ComputationKind_name_6 = "EncodeValueStorageQueryKeyStorageQueryValueRead"
+	_ComputationKind_name_7 = "STDLIBPanicSTDLIBAssertSTDLIBRevertibleRandom"
+	_ComputationKind_name_8 = "STDLIBRLPDecodeStringSTDLIBRLPDecodeListSTDLIBRLPEncodeStringSTDLIBRLPEncodeListSTDLIBABIEncodeSTDLIBABIDecodeSTDLIBFixedPointMathMulDivSTDLIBFixedPointMathDivSTDLIBFixedPointMathSqrtSTDLIBFixedPointMathPowIntSTDLIBFixedPointMathPowSTDLIBFixedPointMathExpSTDLIBFixedPointMathLnSTDLIBCryptoMerkleProofHash"
 )
 
 var (
@@ -45,8 +62,10 @@ var (
 	_ComputationKind_index_2 = [...]uint8{0, 20, 42, 63}
 	_ComputationKind_index_3 = [...]uint8{0, 16, 34, 51}
 	_ComputationKind_index_4 = [...]uint8{0, 21, 44, 66}
-	_ComputationKind_index_6 = [...]uint8{0, 11, 23, 45}
-	_ComputationKind_index_7 = [...]uint8{0, 21, 40}
+	_ComputationKind_index_5 = [...]uint8{0, 14, 30}
+	_ComputationKind_index_6 = [...]uint8{0, 11, 26, 47}
+	_ComputationKind_index_7 = [...]uint8{0, 11, 23, 45}
+	_ComputationKind_index_8 = [...]uint16{0, 21, 40, 61, 80, 95, 110, 136, 159, 183, 209, 232, 255, 277, 304}
 )
 
 func (i ComputationKind) String() string {
@@ -65,14 +84,18 @@ func (i ComputationKind) String() string {
 	case 1040 <= i && i <= 1042:
 		i -= 1040
 		return _ComputationKind_name_4[_ComputationKind_index_4[i]:_ComputationKind_index_4[i+1]]
-	case i == 1080:
-		return _ComputationKind_name_5
+	case 1055 <= i && i <= 1056:
+		i -= 1055
+		return _ComputationKind_name_5[_ComputationKind_index_5[i]:_ComputationKind_index_5[i+1]]
+	case 1080 <= i && i <= 1082:
+		i -= 1080
+		return _ComputationKind_name_6[_ComputationKind_index_6[i]:_ComputationKind_index_6[i+1]]
 	case 1100 <= i && i <= 1102:
 		i -= 1100
-		return _ComputationKind_name_6[_ComputationKind_index_6[i]:_ComputationKind_index_6[i+1]]
-	case 1108 <= i && i <= 1109:
-		i -= 1108
 		return _ComputationKind_name_7[_ComputationKind_index_7[i]:_ComputationKind_index_7[i+1]]
+	case 1108 <= i && i <= 1121:
+		i -= 1108
+		return _ComputationKind_name_8[_ComputationKind_index_8[i]:_ComputationKind_index_8[i+1]]
 	default:
 		return "ComputationKind(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
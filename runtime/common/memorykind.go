@@ -34,6 +34,7 @@ const (
 	MemoryKindNumberValue
 	MemoryKindArrayValueBase
 	MemoryKindDictionaryValueBase
+	MemoryKindSetValueBase
 	MemoryKindCompositeValueBase
 	MemoryKindSimpleCompositeValueBase
 	MemoryKindOptionalValue
@@ -69,6 +70,7 @@ const (
 	MemoryKindConstantSizedStaticType
 	MemoryKindDictionaryStaticType
 	MemoryKindInclusiveRangeStaticType
+	MemoryKindSetStaticType
 	MemoryKindOptionalStaticType
 	MemoryKindIntersectionStaticType
 	MemoryKindEntitlementSetStaticAccess
@@ -90,6 +92,7 @@ const (
 	MemoryKindCadenceArrayValueLength
 	MemoryKindCadenceDictionaryValue
 	MemoryKindCadenceInclusiveRangeValue
+	MemoryKindCadenceSetValue
 	MemoryKindCadenceKeyValuePair
 	MemoryKindCadenceStructValueBase
 	MemoryKindCadenceStructValueSize
@@ -114,6 +117,7 @@ const (
 	MemoryKindCadenceConstantSizedArrayType
 	MemoryKindCadenceDictionaryType
 	MemoryKindCadenceInclusiveRangeType
+	MemoryKindCadenceSetType
 	MemoryKindCadenceField
 	MemoryKindCadenceParameter
 	MemoryKindCadenceTypeParameter
@@ -144,6 +148,7 @@ const (
 	MemoryKindInvocation
 	MemoryKindStorageMap
 	MemoryKindStorageKey
+	MemoryKindValueSlice
 
 	// Tokens
 
@@ -194,6 +199,7 @@ const (
 	MemoryKindSwitchStatement
 	MemoryKindWhileStatement
 	MemoryKindRemoveStatement
+	MemoryKindGuardStatement
 
 	MemoryKindBooleanExpression
 	MemoryKindVoidExpression
@@ -218,6 +224,7 @@ const (
 	MemoryKindForceExpression
 	MemoryKindPathExpression
 	MemoryKindAttachExpression
+	MemoryKindStringTemplateExpression
 
 	MemoryKindConstantSizedType
 	MemoryKindDictionaryType
@@ -248,6 +255,7 @@ const (
 	MemoryKindEntitlementRelationSemaType
 	MemoryKindCapabilitySemaType
 	MemoryKindInclusiveRangeSemaType
+	MemoryKindSetSemaType
 
 	// ordered-map
 	MemoryKindOrderedMap
@@ -15,204 +15,212 @@ func _() {
 	_ = x[MemoryKindNumberValue-4]
 	_ = x[MemoryKindArrayValueBase-5]
 	_ = x[MemoryKindDictionaryValueBase-6]
-	_ = x[MemoryKindCompositeValueBase-7]
-	_ = x[MemoryKindSimpleCompositeValueBase-8]
-	_ = x[MemoryKindOptionalValue-9]
-	_ = x[MemoryKindTypeValue-10]
-	_ = x[MemoryKindPathValue-11]
-	_ = x[MemoryKindCapabilityValue-12]
-	_ = x[MemoryKindStorageReferenceValue-13]
-	_ = x[MemoryKindEphemeralReferenceValue-14]
-	_ = x[MemoryKindInterpretedFunctionValue-15]
-	_ = x[MemoryKindHostFunctionValue-16]
-	_ = x[MemoryKindBoundFunctionValue-17]
-	_ = x[MemoryKindBigInt-18]
-	_ = x[MemoryKindSimpleCompositeValue-19]
-	_ = x[MemoryKindPublishedValue-20]
-	_ = x[MemoryKindStorageCapabilityControllerValue-21]
-	_ = x[MemoryKindAccountCapabilityControllerValue-22]
-	_ = x[MemoryKindAtreeArrayDataSlab-23]
-	_ = x[MemoryKindAtreeArrayMetaDataSlab-24]
-	_ = x[MemoryKindAtreeArrayElementOverhead-25]
-	_ = x[MemoryKindAtreeMapDataSlab-26]
-	_ = x[MemoryKindAtreeMapMetaDataSlab-27]
-	_ = x[MemoryKindAtreeMapElementOverhead-28]
-	_ = x[MemoryKindAtreeMapPreAllocatedElement-29]
-	_ = x[MemoryKindAtreeEncodedSlab-30]
-	_ = x[MemoryKindPrimitiveStaticType-31]
-	_ = x[MemoryKindCompositeStaticType-32]
-	_ = x[MemoryKindInterfaceStaticType-33]
-	_ = x[MemoryKindVariableSizedStaticType-34]
-	_ = x[MemoryKindConstantSizedStaticType-35]
-	_ = x[MemoryKindDictionaryStaticType-36]
-	_ = x[MemoryKindInclusiveRangeStaticType-37]
-	_ = x[MemoryKindOptionalStaticType-38]
-	_ = x[MemoryKindIntersectionStaticType-39]
-	_ = x[MemoryKindEntitlementSetStaticAccess-40]
-	_ = x[MemoryKindEntitlementMapStaticAccess-41]
-	_ = x[MemoryKindReferenceStaticType-42]
-	_ = x[MemoryKindCapabilityStaticType-43]
-	_ = x[MemoryKindFunctionStaticType-44]
-	_ = x[MemoryKindCadenceVoidValue-45]
-	_ = x[MemoryKindCadenceOptionalValue-46]
-	_ = x[MemoryKindCadenceBoolValue-47]
-	_ = x[MemoryKindCadenceStringValue-48]
-	_ = x[MemoryKindCadenceCharacterValue-49]
-	_ = x[MemoryKindCadenceAddressValue-50]
-	_ = x[MemoryKindCadenceIntValue-51]
-	_ = x[MemoryKindCadenceNumberValue-52]
-	_ = x[MemoryKindCadenceArrayValueBase-53]
-	_ = x[MemoryKindCadenceArrayValueLength-54]
-	_ = x[MemoryKindCadenceDictionaryValue-55]
-	_ = x[MemoryKindCadenceInclusiveRangeValue-56]
-	_ = x[MemoryKindCadenceKeyValuePair-57]
-	_ = x[MemoryKindCadenceStructValueBase-58]
-	_ = x[MemoryKindCadenceStructValueSize-59]
-	_ = x[MemoryKindCadenceResourceValueBase-60]
-	_ = x[MemoryKindCadenceAttachmentValueBase-61]
-	_ = x[MemoryKindCadenceResourceValueSize-62]
-	_ = x[MemoryKindCadenceAttachmentValueSize-63]
-	_ = x[MemoryKindCadenceEventValueBase-64]
-	_ = x[MemoryKindCadenceEventValueSize-65]
-	_ = x[MemoryKindCadenceContractValueBase-66]
-	_ = x[MemoryKindCadenceContractValueSize-67]
-	_ = x[MemoryKindCadenceEnumValueBase-68]
-	_ = x[MemoryKindCadenceEnumValueSize-69]
-	_ = x[MemoryKindCadencePathValue-70]
-	_ = x[MemoryKindCadenceTypeValue-71]
-	_ = x[MemoryKindCadenceCapabilityValue-72]
-	_ = x[MemoryKindCadenceFunctionValue-73]
-	_ = x[MemoryKindCadenceOptionalType-74]
-	_ = x[MemoryKindCadenceVariableSizedArrayType-75]
-	_ = x[MemoryKindCadenceConstantSizedArrayType-76]
-	_ = x[MemoryKindCadenceDictionaryType-77]
-	_ = x[MemoryKindCadenceInclusiveRangeType-78]
-	_ = x[MemoryKindCadenceField-79]
-	_ = x[MemoryKindCadenceParameter-80]
-	_ = x[MemoryKindCadenceTypeParameter-81]
-	_ = x[MemoryKindCadenceStructType-82]
-	_ = x[MemoryKindCadenceResourceType-83]
-	_ = x[MemoryKindCadenceAttachmentType-84]
-	_ = x[MemoryKindCadenceEventType-85]
-	_ = x[MemoryKindCadenceContractType-86]
-	_ = x[MemoryKindCadenceStructInterfaceType-87]
-	_ = x[MemoryKindCadenceResourceInterfaceType-88]
-	_ = x[MemoryKindCadenceContractInterfaceType-89]
-	_ = x[MemoryKindCadenceFunctionType-90]
-	_ = x[MemoryKindCadenceEntitlementSetAccess-91]
-	_ = x[MemoryKindCadenceEntitlementMapAccess-92]
-	_ = x[MemoryKindCadenceReferenceType-93]
-	_ = x[MemoryKindCadenceIntersectionType-94]
-	_ = x[MemoryKindCadenceCapabilityType-95]
-	_ = x[MemoryKindCadenceEnumType-96]
-	_ = x[MemoryKindRawString-97]
-	_ = x[MemoryKindAddressLocation-98]
-	_ = x[MemoryKindBytes-99]
-	_ = x[MemoryKindVariable-100]
-	_ = x[MemoryKindCompositeTypeInfo-101]
-	_ = x[MemoryKindCompositeField-102]
-	_ = x[MemoryKindInvocation-103]
-	_ = x[MemoryKindStorageMap-104]
-	_ = x[MemoryKindStorageKey-105]
-	_ = x[MemoryKindTypeToken-106]
-	_ = x[MemoryKindErrorToken-107]
-	_ = x[MemoryKindSpaceToken-108]
-	_ = x[MemoryKindProgram-109]
-	_ = x[MemoryKindIdentifier-110]
-	_ = x[MemoryKindArgument-111]
-	_ = x[MemoryKindBlock-112]
-	_ = x[MemoryKindFunctionBlock-113]
-	_ = x[MemoryKindParameter-114]
-	_ = x[MemoryKindParameterList-115]
-	_ = x[MemoryKindTypeParameter-116]
-	_ = x[MemoryKindTypeParameterList-117]
-	_ = x[MemoryKindTransfer-118]
-	_ = x[MemoryKindMembers-119]
-	_ = x[MemoryKindTypeAnnotation-120]
-	_ = x[MemoryKindDictionaryEntry-121]
-	_ = x[MemoryKindFunctionDeclaration-122]
-	_ = x[MemoryKindCompositeDeclaration-123]
-	_ = x[MemoryKindAttachmentDeclaration-124]
-	_ = x[MemoryKindInterfaceDeclaration-125]
-	_ = x[MemoryKindEntitlementDeclaration-126]
-	_ = x[MemoryKindEntitlementMappingElement-127]
-	_ = x[MemoryKindEntitlementMappingDeclaration-128]
-	_ = x[MemoryKindEnumCaseDeclaration-129]
-	_ = x[MemoryKindFieldDeclaration-130]
-	_ = x[MemoryKindTransactionDeclaration-131]
-	_ = x[MemoryKindImportDeclaration-132]
-	_ = x[MemoryKindVariableDeclaration-133]
-	_ = x[MemoryKindSpecialFunctionDeclaration-134]
-	_ = x[MemoryKindPragmaDeclaration-135]
-	_ = x[MemoryKindAssignmentStatement-136]
-	_ = x[MemoryKindBreakStatement-137]
-	_ = x[MemoryKindContinueStatement-138]
-	_ = x[MemoryKindEmitStatement-139]
-	_ = x[MemoryKindExpressionStatement-140]
-	_ = x[MemoryKindForStatement-141]
-	_ = x[MemoryKindIfStatement-142]
-	_ = x[MemoryKindReturnStatement-143]
-	_ = x[MemoryKindSwapStatement-144]
-	_ = x[MemoryKindSwitchStatement-145]
-	_ = x[MemoryKindWhileStatement-146]
-	_ = x[MemoryKindRemoveStatement-147]
-	_ = x[MemoryKindBooleanExpression-148]
-	_ = x[MemoryKindVoidExpression-149]
-	_ = x[MemoryKindNilExpression-150]
-	_ = x[MemoryKindStringExpression-151]
-	_ = x[MemoryKindIntegerExpression-152]
-	_ = x[MemoryKindFixedPointExpression-153]
-	_ = x[MemoryKindArrayExpression-154]
-	_ = x[MemoryKindDictionaryExpression-155]
-	_ = x[MemoryKindIdentifierExpression-156]
-	_ = x[MemoryKindInvocationExpression-157]
-	_ = x[MemoryKindMemberExpression-158]
-	_ = x[MemoryKindIndexExpression-159]
-	_ = x[MemoryKindConditionalExpression-160]
-	_ = x[MemoryKindUnaryExpression-161]
-	_ = x[MemoryKindBinaryExpression-162]
-	_ = x[MemoryKindFunctionExpression-163]
-	_ = x[MemoryKindCastingExpression-164]
-	_ = x[MemoryKindCreateExpression-165]
-	_ = x[MemoryKindDestroyExpression-166]
-	_ = x[MemoryKindReferenceExpression-167]
-	_ = x[MemoryKindForceExpression-168]
-	_ = x[MemoryKindPathExpression-169]
-	_ = x[MemoryKindAttachExpression-170]
-	_ = x[MemoryKindConstantSizedType-171]
-	_ = x[MemoryKindDictionaryType-172]
-	_ = x[MemoryKindFunctionType-173]
-	_ = x[MemoryKindInstantiationType-174]
-	_ = x[MemoryKindNominalType-175]
-	_ = x[MemoryKindOptionalType-176]
-	_ = x[MemoryKindReferenceType-177]
-	_ = x[MemoryKindIntersectionType-178]
-	_ = x[MemoryKindVariableSizedType-179]
-	_ = x[MemoryKindPosition-180]
-	_ = x[MemoryKindRange-181]
-	_ = x[MemoryKindElaboration-182]
-	_ = x[MemoryKindActivation-183]
-	_ = x[MemoryKindActivationEntries-184]
-	_ = x[MemoryKindVariableSizedSemaType-185]
-	_ = x[MemoryKindConstantSizedSemaType-186]
-	_ = x[MemoryKindDictionarySemaType-187]
-	_ = x[MemoryKindOptionalSemaType-188]
-	_ = x[MemoryKindIntersectionSemaType-189]
-	_ = x[MemoryKindReferenceSemaType-190]
-	_ = x[MemoryKindEntitlementSemaType-191]
-	_ = x[MemoryKindEntitlementMapSemaType-192]
-	_ = x[MemoryKindEntitlementRelationSemaType-193]
-	_ = x[MemoryKindCapabilitySemaType-194]
-	_ = x[MemoryKindInclusiveRangeSemaType-195]
-	_ = x[MemoryKindOrderedMap-196]
-	_ = x[MemoryKindOrderedMapEntryList-197]
-	_ = x[MemoryKindOrderedMapEntry-198]
-	_ = x[MemoryKindLast-199]
+	_ = x[MemoryKindSetValueBase-7]
+	_ = x[MemoryKindCompositeValueBase-8]
+	_ = x[MemoryKindSimpleCompositeValueBase-9]
+	_ = x[MemoryKindOptionalValue-10]
+	_ = x[MemoryKindTypeValue-11]
+	_ = x[MemoryKindPathValue-12]
+	_ = x[MemoryKindCapabilityValue-13]
+	_ = x[MemoryKindStorageReferenceValue-14]
+	_ = x[MemoryKindEphemeralReferenceValue-15]
+	_ = x[MemoryKindInterpretedFunctionValue-16]
+	_ = x[MemoryKindHostFunctionValue-17]
+	_ = x[MemoryKindBoundFunctionValue-18]
+	_ = x[MemoryKindBigInt-19]
+	_ = x[MemoryKindSimpleCompositeValue-20]
+	_ = x[MemoryKindPublishedValue-21]
+	_ = x[MemoryKindStorageCapabilityControllerValue-22]
+	_ = x[MemoryKindAccountCapabilityControllerValue-23]
+	_ = x[MemoryKindAtreeArrayDataSlab-24]
+	_ = x[MemoryKindAtreeArrayMetaDataSlab-25]
+	_ = x[MemoryKindAtreeArrayElementOverhead-26]
+	_ = x[MemoryKindAtreeMapDataSlab-27]
+	_ = x[MemoryKindAtreeMapMetaDataSlab-28]
+	_ = x[MemoryKindAtreeMapElementOverhead-29]
+	_ = x[MemoryKindAtreeMapPreAllocatedElement-30]
+	_ = x[MemoryKindAtreeEncodedSlab-31]
+	_ = x[MemoryKindPrimitiveStaticType-32]
+	_ = x[MemoryKindCompositeStaticType-33]
+	_ = x[MemoryKindInterfaceStaticType-34]
+	_ = x[MemoryKindVariableSizedStaticType-35]
+	_ = x[MemoryKindConstantSizedStaticType-36]
+	_ = x[MemoryKindDictionaryStaticType-37]
+	_ = x[MemoryKindInclusiveRangeStaticType-38]
+	_ = x[MemoryKindSetStaticType-39]
+	_ = x[MemoryKindOptionalStaticType-40]
+	_ = x[MemoryKindIntersectionStaticType-41]
+	_ = x[MemoryKindEntitlementSetStaticAccess-42]
+	_ = x[MemoryKindEntitlementMapStaticAccess-43]
+	_ = x[MemoryKindReferenceStaticType-44]
+	_ = x[MemoryKindCapabilityStaticType-45]
+	_ = x[MemoryKindFunctionStaticType-46]
+	_ = x[MemoryKindCadenceVoidValue-47]
+	_ = x[MemoryKindCadenceOptionalValue-48]
+	_ = x[MemoryKindCadenceBoolValue-49]
+	_ = x[MemoryKindCadenceStringValue-50]
+	_ = x[MemoryKindCadenceCharacterValue-51]
+	_ = x[MemoryKindCadenceAddressValue-52]
+	_ = x[MemoryKindCadenceIntValue-53]
+	_ = x[MemoryKindCadenceNumberValue-54]
+	_ = x[MemoryKindCadenceArrayValueBase-55]
+	_ = x[MemoryKindCadenceArrayValueLength-56]
+	_ = x[MemoryKindCadenceDictionaryValue-57]
+	_ = x[MemoryKindCadenceInclusiveRangeValue-58]
+	_ = x[MemoryKindCadenceSetValue-59]
+	_ = x[MemoryKindCadenceKeyValuePair-60]
+	_ = x[MemoryKindCadenceStructValueBase-61]
+	_ = x[MemoryKindCadenceStructValueSize-62]
+	_ = x[MemoryKindCadenceResourceValueBase-63]
+	_ = x[MemoryKindCadenceAttachmentValueBase-64]
+	_ = x[MemoryKindCadenceResourceValueSize-65]
+	_ = x[MemoryKindCadenceAttachmentValueSize-66]
+	_ = x[MemoryKindCadenceEventValueBase-67]
+	_ = x[MemoryKindCadenceEventValueSize-68]
+	_ = x[MemoryKindCadenceContractValueBase-69]
+	_ = x[MemoryKindCadenceContractValueSize-70]
+	_ = x[MemoryKindCadenceEnumValueBase-71]
+	_ = x[MemoryKindCadenceEnumValueSize-72]
+	_ = x[MemoryKindCadencePathValue-73]
+	_ = x[MemoryKindCadenceTypeValue-74]
+	_ = x[MemoryKindCadenceCapabilityValue-75]
+	_ = x[MemoryKindCadenceFunctionValue-76]
+	_ = x[MemoryKindCadenceOptionalType-77]
+	_ = x[MemoryKindCadenceVariableSizedArrayType-78]
+	_ = x[MemoryKindCadenceConstantSizedArrayType-79]
+	_ = x[MemoryKindCadenceDictionaryType-80]
+	_ = x[MemoryKindCadenceInclusiveRangeType-81]
+	_ = x[MemoryKindCadenceSetType-82]
+	_ = x[MemoryKindCadenceField-83]
+	_ = x[MemoryKindCadenceParameter-84]
+	_ = x[MemoryKindCadenceTypeParameter-85]
+	_ = x[MemoryKindCadenceStructType-86]
+	_ = x[MemoryKindCadenceResourceType-87]
+	_ = x[MemoryKindCadenceAttachmentType-88]
+	_ = x[MemoryKindCadenceEventType-89]
+	_ = x[MemoryKindCadenceContractType-90]
+	_ = x[MemoryKindCadenceStructInterfaceType-91]
+	_ = x[MemoryKindCadenceResourceInterfaceType-92]
+	_ = x[MemoryKindCadenceContractInterfaceType-93]
+	_ = x[MemoryKindCadenceFunctionType-94]
+	_ = x[MemoryKindCadenceEntitlementSetAccess-95]
+	_ = x[MemoryKindCadenceEntitlementMapAccess-96]
+	_ = x[MemoryKindCadenceReferenceType-97]
+	_ = x[MemoryKindCadenceIntersectionType-98]
+	_ = x[MemoryKindCadenceCapabilityType-99]
+	_ = x[MemoryKindCadenceEnumType-100]
+	_ = x[MemoryKindRawString-101]
+	_ = x[MemoryKindAddressLocation-102]
+	_ = x[MemoryKindBytes-103]
+	_ = x[MemoryKindVariable-104]
+	_ = x[MemoryKindCompositeTypeInfo-105]
+	_ = x[MemoryKindCompositeField-106]
+	_ = x[MemoryKindInvocation-107]
+	_ = x[MemoryKindStorageMap-108]
+	_ = x[MemoryKindStorageKey-109]
+	_ = x[MemoryKindValueSlice-110]
+	_ = x[MemoryKindTypeToken-111]
+	_ = x[MemoryKindErrorToken-112]
+	_ = x[MemoryKindSpaceToken-113]
+	_ = x[MemoryKindProgram-114]
+	_ = x[MemoryKindIdentifier-115]
+	_ = x[MemoryKindArgument-116]
+	_ = x[MemoryKindBlock-117]
+	_ = x[MemoryKindFunctionBlock-118]
+	_ = x[MemoryKindParameter-119]
+	_ = x[MemoryKindParameterList-120]
+	_ = x[MemoryKindTypeParameter-121]
+	_ = x[MemoryKindTypeParameterList-122]
+	_ = x[MemoryKindTransfer-123]
+	_ = x[MemoryKindMembers-124]
+	_ = x[MemoryKindTypeAnnotation-125]
+	_ = x[MemoryKindDictionaryEntry-126]
+	_ = x[MemoryKindFunctionDeclaration-127]
+	_ = x[MemoryKindCompositeDeclaration-128]
+	_ = x[MemoryKindAttachmentDeclaration-129]
+	_ = x[MemoryKindInterfaceDeclaration-130]
+	_ = x[MemoryKindEntitlementDeclaration-131]
+	_ = x[MemoryKindEntitlementMappingElement-132]
+	_ = x[MemoryKindEntitlementMappingDeclaration-133]
+	_ = x[MemoryKindEnumCaseDeclaration-134]
+	_ = x[MemoryKindFieldDeclaration-135]
+	_ = x[MemoryKindTransactionDeclaration-136]
+	_ = x[MemoryKindImportDeclaration-137]
+	_ = x[MemoryKindVariableDeclaration-138]
+	_ = x[MemoryKindSpecialFunctionDeclaration-139]
+	_ = x[MemoryKindPragmaDeclaration-140]
+	_ = x[MemoryKindAssignmentStatement-141]
+	_ = x[MemoryKindBreakStatement-142]
+	_ = x[MemoryKindContinueStatement-143]
+	_ = x[MemoryKindEmitStatement-144]
+	_ = x[MemoryKindExpressionStatement-145]
+	_ = x[MemoryKindForStatement-146]
+	_ = x[MemoryKindIfStatement-147]
+	_ = x[MemoryKindReturnStatement-148]
+	_ = x[MemoryKindSwapStatement-149]
+	_ = x[MemoryKindSwitchStatement-150]
+	_ = x[MemoryKindWhileStatement-151]
+	_ = x[MemoryKindRemoveStatement-152]
+	_ = x[MemoryKindGuardStatement-153]
+	_ = x[MemoryKindBooleanExpression-154]
+	_ = x[MemoryKindVoidExpression-155]
+	_ = x[MemoryKindNilExpression-156]
+	_ = x[MemoryKindStringExpression-157]
+	_ = x[MemoryKindIntegerExpression-158]
+	_ = x[MemoryKindFixedPointExpression-159]
+	_ = x[MemoryKindArrayExpression-160]
+	_ = x[MemoryKindDictionaryExpression-161]
+	_ = x[MemoryKindIdentifierExpression-162]
+	_ = x[MemoryKindInvocationExpression-163]
+	_ = x[MemoryKindMemberExpression-164]
+	_ = x[MemoryKindIndexExpression-165]
+	_ = x[MemoryKindConditionalExpression-166]
+	_ = x[MemoryKindUnaryExpression-167]
+	_ = x[MemoryKindBinaryExpression-168]
+	_ = x[MemoryKindFunctionExpression-169]
+	_ = x[MemoryKindCastingExpression-170]
+	_ = x[MemoryKindCreateExpression-171]
+	_ = x[MemoryKindDestroyExpression-172]
+	_ = x[MemoryKindReferenceExpression-173]
+	_ = x[MemoryKindForceExpression-174]
+	_ = x[MemoryKindPathExpression-175]
+	_ = x[MemoryKindAttachExpression-176]
+	_ = x[MemoryKindStringTemplateExpression-177]
+	_ = x[MemoryKindConstantSizedType-178]
+	_ = x[MemoryKindDictionaryType-179]
+	_ = x[MemoryKindFunctionType-180]
+	_ = x[MemoryKindInstantiationType-181]
+	_ = x[MemoryKindNominalType-182]
+	_ = x[MemoryKindOptionalType-183]
+	_ = x[MemoryKindReferenceType-184]
+	_ = x[MemoryKindIntersectionType-185]
+	_ = x[MemoryKindVariableSizedType-186]
+	_ = x[MemoryKindPosition-187]
+	_ = x[MemoryKindRange-188]
+	_ = x[MemoryKindElaboration-189]
+	_ = x[MemoryKindActivation-190]
+	_ = x[MemoryKindActivationEntries-191]
+	_ = x[MemoryKindVariableSizedSemaType-192]
+	_ = x[MemoryKindConstantSizedSemaType-193]
+	_ = x[MemoryKindDictionarySemaType-194]
+	_ = x[MemoryKindOptionalSemaType-195]
+	_ = x[MemoryKindIntersectionSemaType-196]
+	_ = x[MemoryKindReferenceSemaType-197]
+	_ = x[MemoryKindEntitlementSemaType-198]
+	_ = x[MemoryKindEntitlementMapSemaType-199]
+	_ = x[MemoryKindEntitlementRelationSemaType-200]
+	_ = x[MemoryKindCapabilitySemaType-201]
+	_ = x[MemoryKindInclusiveRangeSemaType-202]
+	_ = x[MemoryKindSetSemaType-203]
+	_ = x[MemoryKindOrderedMap-204]
+	_ = x[MemoryKindOrderedMapEntryList-205]
+	_ = x[MemoryKindOrderedMapEntry-206]
+	_ = x[MemoryKindLast-207]
 }
 
-const _MemoryKind_name = "UnknownAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueTypeValuePathValueCapabilityValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValuePublishedValueStorageCapabilityControllerValueAccountCapabilityControllerValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeInclusiveRangeStaticTypeOptionalStaticTypeIntersectionStaticTypeEntitlementSetStaticAccessEntitlementMapStaticAccessReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceInclusiveRangeValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceAttachmentValueBaseCadenceResourceValueSizeCadenceAttachmentValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceFunctionValueCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceInclusiveRangeTypeCadenceFieldCadenceParameterCadenceTypeParameterCadenceStructTypeCadenceResourceTypeCadenceAttachmentTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceEntitlementSetAccessCadenceEntitlementMapAccessCadenceReferenceTypeCadenceIntersectionTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyTypeTokenErrorTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTypeParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationAttachmentDeclarationInterfaceDeclarationEntitlementDeclarationEntitlementMappingElementEntitlementMappingDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementRemoveStatementBooleanExpressionVoidExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionAttachExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeIntersectionTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeIntersectionSemaTypeReferenceSemaTypeEntitlementSemaTypeEntitlementMapSemaTypeEntitlementRelationSemaTypeCapabilitySemaTypeInclusiveRangeSemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
+const _MemoryKind_name = "UnknownAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseSetValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueTypeValuePathValueCapabilityValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValuePublishedValueStorageCapabilityControllerValueAccountCapabilityControllerValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeInclusiveRangeStaticTypeSetStaticTypeOptionalStaticTypeIntersectionStaticTypeEntitlementSetStaticAccessEntitlementMapStaticAccessReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceInclusiveRangeValueCadenceSetValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceAttachmentValueBaseCadenceResourceValueSizeCadenceAttachmentValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceFunctionValueCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceInclusiveRangeTypeCadenceSetTypeCadenceFieldCadenceParameterCadenceTypeParameterCadenceStructTypeCadenceResourceTypeCadenceAttachmentTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceEntitlementSetAccessCadenceEntitlementMapAccessCadenceReferenceTypeCadenceIntersectionTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyValueSliceTypeTokenErrorTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTypeParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationAttachmentDeclarationInterfaceDeclarationEntitlementDeclarationEntitlementMappingElementEntitlementMappingDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementRemoveStatementGuardStatementBooleanExpressionVoidExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionAttachExpressionStringTemplateExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeIntersectionTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeIntersectionSemaTypeReferenceSemaTypeEntitlementSemaTypeEntitlementMapSemaTypeEntitlementRelationSemaTypeCapabilitySemaTypeInclusiveRangeSemaTypeSetSemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
 
-var _MemoryKind_index = [...]uint16{0, 7, 19, 30, 44, 55, 69, 88, 106, 130, 143, 152, 161, 176, 197, 220, 244, 261, 279, 285, 305, 319, 351, 383, 401, 423, 448, 464, 484, 507, 534, 550, 569, 588, 607, 630, 653, 673, 697, 715, 737, 763, 789, 808, 828, 846, 862, 882, 898, 916, 937, 956, 971, 989, 1010, 1033, 1055, 1081, 1100, 1122, 1144, 1168, 1194, 1218, 1244, 1265, 1286, 1310, 1334, 1354, 1374, 1390, 1406, 1428, 1448, 1467, 1496, 1525, 1546, 1571, 1583, 1599, 1619, 1636, 1655, 1676, 1692, 1711, 1737, 1765, 1793, 1812, 1839, 1866, 1886, 1909, 1930, 1945, 1954, 1969, 1974, 1982, 1999, 2013, 2023, 2033, 2043, 2052, 2062, 2072, 2079, 2089, 2097, 2102, 2115, 2124, 2137, 2150, 2167, 2175, 2182, 2196, 2211, 2230, 2250, 2271, 2291, 2313, 2338, 2367, 2386, 2402, 2424, 2441, 2460, 2486, 2503, 2522, 2536, 2553, 2566, 2585, 2597, 2608, 2623, 2636, 2651, 2665, 2680, 2697, 2711, 2724, 2740, 2757, 2777, 2792, 2812, 2832, 2852, 2868, 2883, 2904, 2919, 2935, 2953, 2970, 2986, 3003, 3022, 3037, 3051, 3067, 3084, 3098, 3110, 3127, 3138, 3150, 3163, 3179, 3196, 3204, 3209, 3220, 3230, 3247, 3268, 3289, 3307, 3323, 3343, 3360, 3379, 3401, 3428, 3446, 3468, 3478, 3497, 3512, 3516}
+var _MemoryKind_index = [...]uint16{0, 7, 19, 30, 44, 55, 69, 88, 100, 118, 142, 155, 164, 173, 188, 209, 232, 256, 273, 291, 297, 317, 331, 363, 395, 413, 435, 460, 476, 496, 519, 546, 562, 581, 600, 619, 642, 665, 685, 709, 722, 740, 762, 788, 814, 833, 853, 871, 887, 907, 923, 941, 962, 981, 996, 1014, 1035, 1058, 1080, 1106, 1121, 1140, 1162, 1184, 1208, 1234, 1258, 1284, 1305, 1326, 1350, 1374, 1394, 1414, 1430, 1446, 1468, 1488, 1507, 1536, 1565, 1586, 1611, 1625, 1637, 1653, 1673, 1690, 1709, 1730, 1746, 1765, 1791, 1819, 1847, 1866, 1893, 1920, 1940, 1963, 1984, 1999, 2008, 2023, 2028, 2036, 2053, 2067, 2077, 2087, 2097, 2107, 2116, 2126, 2136, 2143, 2153, 2161, 2166, 2179, 2188, 2201, 2214, 2231, 2239, 2246, 2260, 2275, 2294, 2314, 2335, 2355, 2377, 2402, 2431, 2450, 2466, 2488, 2505, 2524, 2550, 2567, 2586, 2600, 2617, 2630, 2649, 2661, 2672, 2687, 2700, 2715, 2729, 2744, 2758, 2775, 2789, 2802, 2818, 2835, 2855, 2870, 2890, 2910, 2930, 2946, 2961, 2982, 2997, 3013, 3031, 3048, 3064, 3081, 3100, 3115, 3129, 3145, 3169, 3186, 3200, 3212, 3229, 3240, 3252, 3265, 3281, 3298, 3306, 3311, 3322, 3332, 3349, 3370, 3391, 3409, 3425, 3445, 3462, 3481, 3503, 3530, 3548, 3570, 3581, 3591, 3610, 3625, 3629}
 
 func (i MemoryKind) String() string {
 	if i >= MemoryKind(len(_MemoryKind_index)-1) {
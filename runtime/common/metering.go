@@ -88,30 +88,32 @@ var (
 	SwitchStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindSwitchStatement)
 	WhileStatementMemoryUsage      = NewConstantMemoryUsage(MemoryKindWhileStatement)
 	RemoveStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindRemoveStatement)
+	GuardStatementMemoryUsage      = NewConstantMemoryUsage(MemoryKindGuardStatement)
 
 	// AST Expressions
 
-	BooleanExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindBooleanExpression)
-	VoidExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindVoidExpression)
-	NilExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindNilExpression)
-	StringExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindStringExpression)
-	IntegerExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIntegerExpression)
-	FixedPointExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
-	IdentifierExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
-	InvocationExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindInvocationExpression)
-	MemberExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindMemberExpression)
-	IndexExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindIndexExpression)
-	ConditionalExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindConditionalExpression)
-	UnaryExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindUnaryExpression)
-	BinaryExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindBinaryExpression)
-	FunctionExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindFunctionExpression)
-	CastingExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindCastingExpression)
-	CreateExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindCreateExpression)
-	DestroyExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindDestroyExpression)
-	ReferenceExpressionMemoryUsage   = NewConstantMemoryUsage(MemoryKindReferenceExpression)
-	ForceExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindForceExpression)
-	PathExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindPathExpression)
-	AttachExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindAttachExpression)
+	BooleanExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindBooleanExpression)
+	VoidExpressionMemoryUsage           = NewConstantMemoryUsage(MemoryKindVoidExpression)
+	NilExpressionMemoryUsage            = NewConstantMemoryUsage(MemoryKindNilExpression)
+	StringExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindStringExpression)
+	StringTemplateExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindStringTemplateExpression)
+	IntegerExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindIntegerExpression)
+	FixedPointExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
+	IdentifierExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
+	InvocationExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindInvocationExpression)
+	MemberExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindMemberExpression)
+	IndexExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindIndexExpression)
+	ConditionalExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindConditionalExpression)
+	UnaryExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindUnaryExpression)
+	BinaryExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindBinaryExpression)
+	FunctionExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionExpression)
+	CastingExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindCastingExpression)
+	CreateExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindCreateExpression)
+	DestroyExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindDestroyExpression)
+	ReferenceExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindReferenceExpression)
+	ForceExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindForceExpression)
+	PathExpressionMemoryUsage           = NewConstantMemoryUsage(MemoryKindPathExpression)
+	AttachExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindAttachExpression)
 
 	// AST Types
 
@@ -140,6 +142,7 @@ var (
 	CompositeTypeInfoMemoryUsage                = NewConstantMemoryUsage(MemoryKindCompositeTypeInfo)
 	CompositeFieldMemoryUsage                   = NewConstantMemoryUsage(MemoryKindCompositeField)
 	DictionaryValueBaseMemoryUsage              = NewConstantMemoryUsage(MemoryKindDictionaryValueBase)
+	SetValueBaseMemoryUsage                     = NewConstantMemoryUsage(MemoryKindSetValueBase)
 	ArrayValueBaseMemoryUsage                   = NewConstantMemoryUsage(MemoryKindArrayValueBase)
 	CompositeValueBaseMemoryUsage               = NewConstantMemoryUsage(MemoryKindCompositeValueBase)
 	AddressValueMemoryUsage                     = NewConstantMemoryUsage(MemoryKindAddressValue)
@@ -171,6 +174,7 @@ var (
 	FunctionStaticTypeMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionStaticType)
 	EntitlementMapStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementMapStaticAccess)
 	InclusiveRangeStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindInclusiveRangeStaticType)
+	SetStaticTypeMemoryUsage            = NewConstantMemoryUsage(MemoryKindSetStaticType)
 
 	// Sema types
 
@@ -185,6 +189,7 @@ var (
 	EntitlementRelationSemaTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementRelationSemaType)
 	CapabilitySemaTypeMemoryUsage          = NewConstantMemoryUsage(MemoryKindCapabilitySemaType)
 	InclusiveRangeSemaTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindInclusiveRangeSemaType)
+	SetSemaTypeMemoryUsage                 = NewConstantMemoryUsage(MemoryKindSetSemaType)
 
 	// Storage related memory usages
 
@@ -197,6 +202,7 @@ var (
 
 	CadenceDictionaryValueMemoryUsage     = NewConstantMemoryUsage(MemoryKindCadenceDictionaryValue)
 	CadenceInclusiveRangeValueMemoryUsage = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeValue)
+	CadenceSetValueMemoryUsage            = NewConstantMemoryUsage(MemoryKindCadenceSetValue)
 	CadenceArrayValueBaseMemoryUsage      = NewConstantMemoryUsage(MemoryKindCadenceArrayValueBase)
 	CadenceStructValueBaseMemoryUsage     = NewConstantMemoryUsage(MemoryKindCadenceStructValueBase)
 	CadenceResourceValueBaseMemoryUsage   = NewConstantMemoryUsage(MemoryKindCadenceResourceValueBase)
@@ -223,6 +229,7 @@ var (
 	CadenceContractTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceContractType)
 	CadenceDictionaryTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceDictionaryType)
 	CadenceInclusiveRangeTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeType)
+	CadenceSetTypeMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceSetType)
 	CadenceEnumTypeMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceEnumType)
 	CadenceEventTypeMemoryUsage              = NewConstantMemoryUsage(MemoryKindCadenceEventType)
 	CadenceFunctionTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceFunctionType)
@@ -270,6 +277,7 @@ var (
 	IntersectionStaticTypeStringMemoryUsage          = NewRawStringMemoryUsage(2)  // {}
 	IntersectionStaticTypeSeparatorStringMemoryUsage = NewRawStringMemoryUsage(2)  // ,
 	InclusiveRangeStaticTypeStringMemoryUsage        = NewRawStringMemoryUsage(16) // InclusiveRange<>
+	SetStaticTypeStringMemoryUsage                   = NewRawStringMemoryUsage(5)  // Set<>
 )
 
 func UseMemory(gauge MemoryGauge, usage MemoryUsage) {
@@ -800,6 +808,13 @@ func NewDictionaryExpressionMemoryUsage(length int) MemoryUsage {
 	}
 }
 
+func NewValueSliceMemoryUsage(length int) MemoryUsage {
+	return MemoryUsage{
+		Kind:   MemoryKindValueSlice,
+		Amount: uint64(length),
+	}
+}
+
 func NewMembersMemoryUsage(length int) MemoryUsage {
 	return MemoryUsage{
 		Kind: MemoryKindMembers,
@@ -83,6 +83,11 @@ func (compiler *Compiler) VisitIfStatement(_ *ast.IfStatement) ir.Stmt {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitGuardStatement(_ *ast.GuardStatement) ir.Stmt {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitWhileStatement(_ *ast.WhileStatement) ir.Stmt {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -155,6 +160,11 @@ func (compiler *Compiler) VisitNilExpression(_ *ast.NilExpression) ir.Expr {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitStringTemplateExpression(_ *ast.StringTemplateExpression) ir.Expr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitIntegerExpression(expression *ast.IntegerExpression) ir.Expr {
 	var value []byte
 
@@ -39,4 +39,6 @@ type Config struct {
 	AttachmentsEnabled bool
 	// LegacyContractUpgradeEnabled enabled specifies whether to use the old parser when parsing an old contract
 	LegacyContractUpgradeEnabled bool
+	// TypeParametersEnabled specifies if functions and composite types may declare type parameters
+	TypeParametersEnabled bool
 }
@@ -19,6 +19,9 @@
 package runtime
 
 import (
+	"context"
+	"time"
+
 	"github.com/onflow/cadence/runtime/ast"
 )
 
@@ -27,6 +30,18 @@ type Context struct {
 	Location       Location
 	Environment    Environment
 	CoverageReport *CoverageReport
+	// ReadWriteSet, if set, records the state accessed by the executor
+	ReadWriteSet *ReadWriteSet
+	// ProgramCache, if set, caches the programs loaded by the executor
+	ProgramCache *ProgramCache
+	// Context, if set, aborts the execution when it is done
+	Context context.Context
+	// Deadline, if set, aborts the execution when it is exceeded
+	Deadline time.Time
+	// SpanExporter, if set, traces the execution as a tree of spans,
+	// and exports them when the execution is finished.
+	// The traces are also still reported to the Interface
+	SpanExporter SpanExporter
 }
 
 // CodesAndPrograms collects the source code and AST for each location.
@@ -41,6 +41,7 @@ type interpreterContractFunctionExecutor struct {
 	functionName     string
 	arguments        []cadence.Value
 	argumentTypes    []sema.Type
+	tracer           *spanTracingInterface
 	executeOnce      sync.Once
 	preprocessOnce   sync.Once
 }
@@ -103,7 +104,17 @@ func (executor *interpreterContractFunctionExecutor) preprocess() (err error) {
 		codesAndPrograms,
 	)
 
-	runtimeInterface := context.Interface
+	runtimeInterface := withReadWriteSetRecording(
+		context.Interface,
+		context.ReadWriteSet,
+	)
+	runtimeInterface = withProgramCache(runtimeInterface, context.ProgramCache)
+	runtimeInterface, executor.tracer = withSpanTracing(
+		runtimeInterface,
+		context.SpanExporter,
+		"contractFunction."+executor.functionName,
+		location,
+	)
 
 	storage := NewStorage(runtimeInterface, runtimeInterface)
 	executor.storage = storage
@@ -118,12 +129,20 @@ func (executor *interpreterContractFunctionExecutor) preprocess() (err error) {
 		storage,
 		context.CoverageReport,
 	)
+	environment.ConfigureExecution(ExecutionOptions{
+		Context:  context.Context,
+		Deadline: context.Deadline,
+	})
 	executor.environment = environment
 
 	return nil
 }
 
 func (executor *interpreterContractFunctionExecutor) execute() (val cadence.Value, err error) {
+	defer func() {
+		executor.tracer.finish(executor.context.Context, err)
+	}()
+
 	err = executor.Preprocess()
 	if err != nil {
 		return nil, err
@@ -83,8 +83,10 @@ func newContractRemovalTransaction(contractName string) string {
 }
 
 func newContractDeploymentTransactor(t *testing.T) func(code string) error {
-	rt := NewTestInterpreterRuntimeWithAttachments()
+	return newContractDeploymentTransactorWithRuntime(t, NewTestInterpreterRuntimeWithAttachments())
+}
 
+func newContractDeploymentTransactorWithRuntime(t *testing.T, rt TestInterpreterRuntime) func(code string) error {
 	accountCodes := map[Location][]byte{}
 	var events []cadence.Event
 	runtimeInterface := &TestRuntimeInterface{
@@ -1604,19 +1606,19 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
 		RequireError(t, err)
 
-		const expectedError = "error: mismatching field `a` in `Test`\n" +
+		const expectedError = "error[E8003]: mismatching field `a` in `Test`\n" +
 			" --> 0000000000000042.Test:3:35\n" +
 			"  |\n" +
 			"3 |                 access(all) var a: Int\n" +
 			"  |                                    ^^^ incompatible type annotations. expected `String`, found `Int`\n" +
 			"\n" +
-			"error: found new field `b` in `Test`\n" +
+			"error[E8005]: found new field `b` in `Test`\n" +
 			" --> 0000000000000042.Test:4:32\n" +
 			"  |\n" +
 			"4 |                 access(all) var b: String\n" +
 			"  |                                 ^\n" +
 			"\n" +
-			"error: trying to convert structure interface `TestStruct` to a structure\n" +
+			"error[E8007]: trying to convert structure interface `TestStruct` to a structure\n" +
 			"  --> 0000000000000042.Test:11:35\n" +
 			"   |\n" +
 			"11 |                 access(all) struct TestStruct {\n" +
@@ -1712,7 +1714,7 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
 		RequireError(t, err)
 
-		assert.Contains(t, err.Error(), "error: field add has non-storable type: fun(Int, Int): Int")
+		assert.Contains(t, err.Error(), "error[E2034]: field add has non-storable type: fun(Int, Int): Int")
 	})
 
 	t.Run("Test conformance", func(t *testing.T) {
@@ -2954,3 +2956,132 @@ func TestRuntimeContractUpdateProgramCaching(t *testing.T) {
 		)
 	})
 }
+
+func TestRuntimeContractUpdateTypeParameterChanges(t *testing.T) {
+
+	t.Parallel()
+
+	config := DefaultTestInterpreterConfig
+	config.TypeParametersEnabled = true
+
+	testDeployAndUpdate := func(t *testing.T, name string, oldCode string, newCode string) error {
+		executeTransaction := newContractDeploymentTransactorWithRuntime(
+			t,
+			NewTestInterpreterRuntimeWithConfig(config),
+		)
+		err := executeTransaction(newContractAddTransaction(name, oldCode))
+		require.NoError(t, err)
+
+		return executeTransaction(newContractUpdateTransaction(name, newCode))
+	}
+
+	const oldCode = `
+        access(all) contract Test {
+            access(all) struct Box<T: AnyStruct> {
+                access(all) let value: T
+                init(value: T) {
+                    self.value = value
+                }
+            }
+        }
+    `
+
+	t.Run("unchanged", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := testDeployAndUpdate(t, "Test", oldCode, oldCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("changed type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: Integer> {
+                    access(all) let value: T
+                    init(value: T) {
+                        self.value = value
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		var typeParameterMismatchError *stdlib.TypeParameterMismatchError
+		require.ErrorAs(t, cause, &typeParameterMismatchError)
+		assert.Equal(t, "Box", typeParameterMismatchError.DeclName)
+	})
+
+	t.Run("renamed type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<U: AnyStruct> {
+                    access(all) let value: U
+                    init(value: U) {
+                        self.value = value
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
+
+		// The fields referring to the type parameter are also reported as mismatching
+		updateErr := getContractUpdateError(t, err, "Test")
+		var typeParameterMismatchError *stdlib.TypeParameterMismatchError
+		require.ErrorAs(t, updateErr, &typeParameterMismatchError)
+	})
+
+	t.Run("added type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: AnyStruct, U> {
+                    access(all) let value: T
+                    init(value: T) {
+                        self.value = value
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		var typeParameterMismatchError *stdlib.TypeParameterMismatchError
+		require.ErrorAs(t, cause, &typeParameterMismatchError)
+	})
+
+	t.Run("removed type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box {
+                    access(all) let value: AnyStruct
+                    init(value: AnyStruct) {
+                        self.value = value
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode)
+
+		// The fields referring to the type parameter are also reported as mismatching
+		updateErr := getContractUpdateError(t, err, "Test")
+		var typeParameterMismatchError *stdlib.TypeParameterMismatchError
+		require.ErrorAs(t, updateErr, &typeParameterMismatchError)
+	})
+}
@@ -20,6 +20,7 @@ package runtime
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
@@ -108,6 +109,10 @@ func ExportMeteredType(
 			return cadence.Fix64Type
 		case sema.UFix64Type:
 			return cadence.UFix64Type
+		case sema.Fix128Type:
+			return cadence.Fix128Type
+		case sema.UFix128Type:
+			return cadence.UFix128Type
 		case sema.PathType:
 			return cadence.PathType
 		case sema.StoragePathType:
@@ -260,6 +265,8 @@ func ExportMeteredType(
 			return exportCapabilityType(gauge, t, results)
 		case *sema.InclusiveRangeType:
 			return exportInclusiveRangeType(gauge, t, results)
+		case *sema.SetType:
+			return exportSetType(gauge, t, results)
 		}
 
 		panic(fmt.Sprintf("cannot export type %s", t))
@@ -301,10 +308,14 @@ func exportCompositeType(
 	results map[sema.TypeID]cadence.Type,
 ) (result cadence.CompositeType) {
 
+	// NOTE: the members of an instantiation of a generic composite type
+	// are instantiated on demand, so they must be accessed through MemberMap
+	members := t.MemberMap()
+
 	fieldMembers := make([]*sema.Member, 0, len(t.Fields))
 
 	for _, identifier := range t.Fields {
-		member, ok := t.Members.Get(identifier)
+		member, ok := members.Get(identifier)
 
 		if !ok {
 			panic(errors.NewUnreachableError())
@@ -324,7 +335,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredStructType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			fields,
 			nil,
 		)
@@ -333,7 +344,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredResourceType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			fields,
 			nil,
 		)
@@ -343,7 +354,7 @@ func exportCompositeType(
 			gauge,
 			t.Location,
 			ExportMeteredType(gauge, t.GetBaseType(), results),
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			fields,
 			nil,
 		)
@@ -352,7 +363,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredEventType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			fields,
 			nil,
 		)
@@ -361,7 +372,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredContractType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			fields,
 			nil,
 		)
@@ -370,7 +381,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredEnumType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			t.QualifiedIdentifierWithTypeArguments(),
 			ExportMeteredType(gauge, t.EnumRawType, results),
 			fields,
 			nil,
@@ -496,6 +507,19 @@ func exportInclusiveRangeType(
 	)
 }
 
+func exportSetType(
+	gauge common.MemoryGauge,
+	t *sema.SetType,
+	results map[sema.TypeID]cadence.Type,
+) *cadence.SetType {
+	convertedElementType := ExportMeteredType(gauge, t.ElementType, results)
+
+	return cadence.NewMeteredSetType(
+		gauge,
+		convertedElementType,
+	)
+}
+
 func exportFunctionType(
 	gauge common.MemoryGauge,
 	t *sema.FunctionType,
@@ -649,6 +673,16 @@ func importInterfaceType(memoryGauge common.MemoryGauge, t cadence.InterfaceType
 }
 
 func importCompositeType(memoryGauge common.MemoryGauge, t cadence.CompositeType) *interpreter.CompositeStaticType {
+	if t.CompositeTypeLocation() == nil {
+		qualifiedIdentifier := t.CompositeTypeQualifiedIdentifier()
+		if strings.HasPrefix(qualifiedIdentifier, sema.DictionaryEntryTypeName+"<") {
+			staticType := importDictionaryEntryType(memoryGauge, t)
+			if staticType != nil {
+				return staticType
+			}
+		}
+	}
+
 	return interpreter.NewCompositeStaticTypeComputeTypeID(
 		memoryGauge,
 		t.CompositeTypeLocation(),
@@ -656,6 +690,37 @@ func importCompositeType(memoryGauge common.MemoryGauge, t cadence.CompositeType
 	)
 }
 
+// importDictionaryEntryType imports an instantiation of the generic `DictionaryEntry` type.
+// The type is exported with its type arguments in the qualified identifier, e.g. `DictionaryEntry<String,Int>`,
+// but the type arguments are obtained from the types of the key and value fields.
+// Returns nil if the type does not have the fields.
+func importDictionaryEntryType(memoryGauge common.MemoryGauge, t cadence.CompositeType) *interpreter.CompositeStaticType {
+	var keyType, valueType cadence.Type
+
+	for _, field := range t.CompositeFields() {
+		switch field.Identifier {
+		case sema.DictionaryEntryTypeKeyFieldName:
+			keyType = field.Type
+		case sema.DictionaryEntryTypeValueFieldName:
+			valueType = field.Type
+		}
+	}
+
+	if keyType == nil || valueType == nil {
+		return nil
+	}
+
+	return interpreter.NewInstantiatedCompositeStaticType(
+		memoryGauge,
+		nil,
+		sema.DictionaryEntryTypeName,
+		[]interpreter.StaticType{
+			ImportType(memoryGauge, keyType),
+			ImportType(memoryGauge, valueType),
+		},
+	)
+}
+
 func importAuthorization(memoryGauge common.MemoryGauge, auth cadence.Authorization) interpreter.Authorization {
 	switch auth := auth.(type) {
 	case cadence.Unauthorized:
@@ -711,6 +776,11 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 			memoryGauge,
 			ImportType(memoryGauge, t.ElementType),
 		)
+	case *cadence.SetType:
+		return interpreter.NewSetStaticType(
+			memoryGauge,
+			ImportType(memoryGauge, t.ElementType),
+		)
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
@@ -202,6 +202,10 @@ func exportValueWithInterpreter(
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.NewMeteredFix128FromRawFixedPointNumber(inter, v.BigInt)
+	case interpreter.UFix128Value:
+		return cadence.NewMeteredUFix128FromRawFixedPointNumber(inter, v.BigInt)
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(
 			v,
@@ -223,6 +227,13 @@ func exportValueWithInterpreter(
 			locationRange,
 			seenReferences,
 		)
+	case *interpreter.SetValue:
+		return exportSetValue(
+			v,
+			inter,
+			locationRange,
+			seenReferences,
+		)
 	case interpreter.AddressValue:
 		return cadence.NewMeteredAddress(inter, v), nil
 	case interpreter.PathValue:
@@ -606,6 +617,56 @@ func exportDictionaryValue(
 	return dictionary.WithType(exportType), err
 }
 
+func exportSetValue(
+	v *interpreter.SetValue,
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	seenReferences seenReferences,
+) (
+	cadence.Set,
+	error,
+) {
+	set, err := cadence.NewMeteredSet(
+		inter,
+		v.Count(),
+		func() ([]cadence.Value, error) {
+			var err error
+			elements := make([]cadence.Value, 0, v.Count())
+
+			v.Iterate(inter, func(element interpreter.Value) (resume bool) {
+
+				var convertedElement cadence.Value
+				convertedElement, err = exportValueWithInterpreter(
+					element,
+					inter,
+					locationRange,
+					seenReferences,
+				)
+				if err != nil {
+					return false
+				}
+
+				elements = append(elements, convertedElement)
+
+				return true
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return elements, nil
+		},
+	)
+	if err != nil {
+		return cadence.Set{}, err
+	}
+
+	exportType := ExportType(v.SemaType(inter), map[sema.TypeID]cadence.Type{}).(*cadence.SetType)
+
+	return set.WithType(exportType), err
+}
+
 func exportCompositeValueAsInclusiveRange(
 	v interpreter.Value,
 	inclusiveRangeType *sema.InclusiveRangeType,
@@ -830,12 +891,18 @@ func (i valueImporter) importValue(value cadence.Value, expectedType sema.Type)
 		return i.importFix64(v), nil
 	case cadence.UFix64:
 		return i.importUFix64(v), nil
+	case cadence.Fix128:
+		return i.importFix128(v), nil
+	case cadence.UFix128:
+		return i.importUFix128(v), nil
 	case cadence.Path:
 		return i.importPathValue(v), nil
 	case cadence.Array:
 		return i.importArrayValue(v, expectedType)
 	case cadence.Dictionary:
 		return i.importDictionaryValue(v, expectedType)
+	case cadence.Set:
+		return i.importSetValue(v, expectedType)
 	case cadence.Struct:
 		return i.importCompositeValue(
 			common.CompositeKindStructure,
@@ -924,6 +991,24 @@ func (i valueImporter) importUInt64(v cadence.UInt64) interpreter.UInt64Value {
 	)
 }
 
+func (i valueImporter) importFix128(v cadence.Fix128) interpreter.Fix128Value {
+	return interpreter.NewFix128Value(
+		i.inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func (i valueImporter) importUFix128(v cadence.UFix128) interpreter.UFix128Value {
+	return interpreter.NewUFix128Value(
+		i.inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
 func (i valueImporter) importUInt128(v cadence.UInt128) interpreter.UInt128Value {
 	return interpreter.NewUInt128ValueFromBigInt(
 		i.inter,
@@ -1360,6 +1445,69 @@ func (i valueImporter) importDictionaryValue(
 	), nil
 }
 
+func (i valueImporter) importSetValue(
+	v cadence.Set,
+	expectedType sema.Type,
+) (
+	*interpreter.SetValue,
+	error,
+) {
+	elements := make([]interpreter.Value, len(v.Elements))
+
+	var elementType sema.Type
+
+	setType, ok := expectedType.(*sema.SetType)
+	if ok {
+		elementType = setType.ElementType
+	}
+
+	inter := i.inter
+	locationRange := i.locationRange
+
+	for elementIndex, element := range v.Elements {
+		value, err := i.importValue(element, elementType)
+		if err != nil {
+			return nil, err
+		}
+		elements[elementIndex] = value
+	}
+
+	var setStaticType *interpreter.SetStaticType
+	if setType != nil {
+		setStaticType = interpreter.ConvertSemaSetTypeToStaticSetType(inter, setType)
+	} else {
+		types := make([]sema.Type, len(elements))
+
+		for i, element := range elements {
+			typ, err := inter.ConvertStaticToSemaType(element.StaticType(inter))
+			if err != nil {
+				return nil, err
+			}
+			types[i] = typ
+		}
+
+		elementSuperType := sema.LeastCommonSuperType(types...)
+
+		if !sema.IsSubType(elementSuperType, sema.HashableStructType) {
+			return nil, errors.NewDefaultUserError(
+				"cannot import set: elements do not belong to the same type",
+			)
+		}
+
+		setStaticType = interpreter.NewSetStaticType(
+			inter,
+			interpreter.ConvertSemaToStaticType(inter, elementSuperType),
+		)
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		locationRange,
+		setStaticType,
+		elements...,
+	), nil
+}
+
 func (i valueImporter) importInclusiveRangeValue(
 	v *cadence.InclusiveRange,
 	expectedType sema.Type,
@@ -21,6 +21,7 @@ package runtime_test
 import (
 	_ "embed"
 	"fmt"
+	"math/big"
 	"testing"
 	"unicode/utf8"
 
@@ -411,6 +412,16 @@ func TestRuntimeExportValue(t *testing.T) {
 			value:    interpreter.NewUnmeteredUFix64Value(123000000),
 			expected: cadence.UFix64(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    interpreter.NewUnmeteredFix128Value(big.NewInt(-123000000)),
+			expected: cadence.Fix128{Value: big.NewInt(-123000000)},
+		},
+		{
+			label:    "UFix128",
+			value:    interpreter.NewUnmeteredUFix128Value(big.NewInt(123000000)),
+			expected: cadence.UFix128{Value: big.NewInt(123000000)},
+		},
 		{
 			label: "Path",
 			value: interpreter.PathValue{
@@ -853,6 +864,16 @@ func TestRuntimeImportValue(t *testing.T) {
 			value:    cadence.UFix64(123000000),
 			expected: interpreter.NewUnmeteredUFix64Value(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    cadence.Fix128{Value: big.NewInt(-123000000)},
+			expected: interpreter.NewUnmeteredFix128Value(big.NewInt(-123000000)),
+		},
+		{
+			label:    "UFix128",
+			value:    cadence.UFix128{Value: big.NewInt(123000000)},
+			expected: interpreter.NewUnmeteredUFix128Value(big.NewInt(123000000)),
+		},
 		{
 			label: "Path",
 			value: cadence.Path{
@@ -1414,6 +1435,15 @@ func TestRuntimeImportRuntimeType(t *testing.T) {
 				ElementType: interpreter.PrimitiveStaticTypeInt,
 			},
 		},
+		{
+			label: "Set",
+			actual: &cadence.SetType{
+				ElementType: cadence.StringType,
+			},
+			expected: &interpreter.SetStaticType{
+				ElementType: interpreter.PrimitiveStaticTypeString,
+			},
+		},
 	} {
 		test(tt)
 	}
@@ -1555,6 +1585,163 @@ func TestExportInclusiveRangeValue(t *testing.T) {
 	})
 }
 
+func TestExportSetValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+		access(all) fun main(): Set<String> {
+			return Set(["a", "b", "a"])
+		}
+	`
+
+	actual := exportValueFromScript(t, script)
+
+	require.IsType(t, cadence.Set{}, actual)
+	set := actual.(cadence.Set)
+
+	assert.Equal(t, cadence.NewSetType(cadence.StringType), set.SetType)
+	assert.ElementsMatch(t,
+		[]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+		},
+		set.Elements,
+	)
+}
+
+func TestImportSetValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("expected set type", func(t *testing.T) {
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		})
+
+		inter := NewTestInterpreter(t)
+
+		actual, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			value,
+			&sema.SetType{
+				ElementType: sema.IntegerType,
+			},
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSetValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.SetStaticType{
+					ElementType: interpreter.PrimitiveStaticTypeInteger,
+				},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			actual,
+		)
+	})
+
+	t.Run("import with broader type - AnyStruct", func(t *testing.T) {
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		})
+
+		inter := NewTestInterpreter(t)
+
+		actual, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			value,
+			sema.AnyStructType,
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSetValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.SetStaticType{
+					ElementType: interpreter.PrimitiveStaticTypeInt,
+				},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			actual,
+		)
+	})
+
+	t.Run("mixed element types", func(t *testing.T) {
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.String("a"),
+		})
+
+		inter := NewTestInterpreter(t)
+
+		actual, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			value,
+			sema.AnyStructType,
+		)
+		require.NoError(t, err)
+
+		setValue, ok := actual.(*interpreter.SetValue)
+		require.True(t, ok)
+
+		assert.Equal(
+			t,
+			interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeHashableStruct),
+			setValue.Type,
+		)
+		assert.Equal(t, 2, setValue.Count())
+	})
+
+	t.Run("invalid, non-hashable elements", func(t *testing.T) {
+		t.Parallel()
+
+		value := cadence.NewSet([]cadence.Value{
+			cadence.NewArray([]cadence.Value{
+				cadence.NewInt(1),
+			}),
+		})
+
+		inter := NewTestInterpreter(t)
+
+		_, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			value,
+			sema.AnyStructType,
+		)
+		RequireError(t, err)
+
+		var userError errors.DefaultUserError
+		require.ErrorAs(t, err, &userError)
+		require.Contains(t, userError.Error(), "cannot import set")
+	})
+}
+
 func TestImportInclusiveRangeValue(t *testing.T) {
 
 	t.Parallel()
@@ -1717,6 +1904,82 @@ func TestRuntimeExportStructValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestRuntimeExportDictionaryEntryValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        access(all) fun main(): [DictionaryEntry<String, Int>] {
+            return {"a": 1}.toEntries()
+        }
+    `
+
+	entryType := &cadence.StructType{
+		QualifiedIdentifier: "DictionaryEntry<String,Int>",
+		Fields: []cadence.Field{
+			{
+				Identifier: "key",
+				Type:       cadence.StringType,
+			},
+			{
+				Identifier: "value",
+				Type:       cadence.IntType,
+			},
+		},
+	}
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewArray([]cadence.Value{
+		cadence.NewStruct([]cadence.Value{
+			cadence.String("a"),
+			cadence.NewInt(1),
+		}).WithType(entryType),
+	}).WithType(&cadence.VariableSizedArrayType{
+		ElementType: entryType,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestRuntimeImportExportDictionaryEntryType(t *testing.T) {
+
+	t.Parallel()
+
+	exported, err := executeTestScript(t,
+		`
+          access(all) fun main(): Type {
+              return Type<DictionaryEntry<String, [Int]>>()
+          }
+        `,
+		nil,
+	)
+	require.NoError(t, err)
+
+	require.IsType(t, cadence.TypeValue{}, exported)
+	exportedType := exported.(cadence.TypeValue).StaticType
+	require.IsType(t, &cadence.StructType{}, exportedType)
+	assert.Equal(t,
+		"DictionaryEntry<String,[Int]>",
+		exportedType.(*cadence.StructType).QualifiedIdentifier,
+	)
+
+	staticType := ImportType(nil, exportedType)
+	assert.Equal(t,
+		interpreter.TypeID("DictionaryEntry<String,[Int]>"),
+		staticType.ID(),
+	)
+
+	_, err = executeTestScript(t,
+		`
+          access(all) fun main(type: Type) {
+              assert(type == Type<DictionaryEntry<String, [Int]>>())
+          }
+        `,
+		exported,
+	)
+	require.NoError(t, err)
+}
+
 func TestRuntimeExportResourceValue(t *testing.T) {
 
 	t.Parallel()
@@ -2763,6 +3026,15 @@ func TestRuntimeArgumentPassing(t *testing.T) {
 				ElementType: cadence.UInt128Type,
 			}),
 		},
+		{
+			label:         "Set",
+			typeSignature: "Set<String>",
+			exportedValue: cadence.NewSet([]cadence.Value{
+				cadence.String("foo"),
+			}).WithType(&cadence.SetType{
+				ElementType: cadence.StringType,
+			}),
+		},
 		{
 			label:         "Int",
 			typeSignature: "Int",
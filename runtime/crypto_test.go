@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
@@ -816,3 +817,153 @@ func TestRuntimeTraversingMerkleProof(t *testing.T) {
 		logMessages,
 	)
 }
+
+func TestRuntimeCryptoMerkleProof(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	script := []byte(`
+      import Crypto
+
+      access(all)
+      fun main(
+          leaves: [[UInt8]],
+          trieRoot: [UInt8],
+          trieNode: [UInt8]
+      ): [AnyStruct] {
+          let alg = HashAlgorithm.KECCAK_256
+
+          let l0 = alg.hash(leaves[0])
+          let l1 = alg.hash(leaves[1])
+          let l2 = alg.hash(leaves[2])
+          let l3 = alg.hash(leaves[3])
+
+          let n01 = alg.hash(l0.concat(l1))
+          let n23 = alg.hash(l2.concat(l3))
+          let root = alg.hash(n01.concat(n23))
+
+          return [
+              Crypto.verifyPositionalMerkleProof(
+                  proof: [l3, n01],
+                  root: root,
+                  leaf: l2,
+                  index: 2,
+                  algorithm: alg
+              ),
+              Crypto.verifyPositionalMerkleProof(
+                  proof: [l3, n01],
+                  root: root,
+                  leaf: l2,
+                  index: 3,
+                  algorithm: alg
+              ),
+              Crypto.verifyMerkleProof(
+                  proof: [l1, n23],
+                  root: root,
+                  leaf: l0,
+                  algorithm: alg
+              ),
+              Crypto.verifyEthereumTrieProof(
+                  proof: [trieNode],
+                  root: trieRoot,
+                  key: [0xab]
+              ),
+              Crypto.verifyEthereumTrieProof(
+                  proof: [trieNode],
+                  root: trieRoot,
+                  key: [0xac]
+              )
+          ]
+      }
+    `)
+
+	keccak256 := func(data []byte) []byte {
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(data)
+		return hasher.Sum(nil)
+	}
+
+	// Choose leaves for which the positional tree is also a valid sorted tree,
+	// i.e. the left node of each pair is smaller than the right one
+	isSorted := func(leaves [][]byte) bool {
+		hashes := make([][]byte, len(leaves))
+		for i, leaf := range leaves {
+			hashes[i] = keccak256(leaf)
+		}
+		n01 := keccak256(append(append([]byte{}, hashes[0]...), hashes[1]...))
+		n23 := keccak256(append(append([]byte{}, hashes[2]...), hashes[3]...))
+		return string(hashes[0]) < string(hashes[1]) &&
+			string(n01) < string(n23)
+	}
+
+	var leaves [][]byte
+	for i := 0; leaves == nil; i++ {
+		candidate := [][]byte{{byte(i)}, {byte(i + 1)}, {byte(i + 2)}, {byte(i + 3)}}
+		if isSorted(candidate) {
+			leaves = candidate
+		}
+	}
+
+	leavesValue := make([]cadence.Value, len(leaves))
+	for i, leaf := range leaves {
+		leavesValue[i] = getCadenceValueArrayFromHexStr(t, hex.EncodeToString(leaf))
+	}
+
+	// Leaf node: ["0x20ab" (hex-prefixed even leaf path), "value"]
+	trieNode := []byte{0xc9, 0x82, 0x20, 0xab, 0x85, 'v', 'a', 'l', 'u', 'e'}
+	trieRoot := keccak256(trieNode)
+
+	var hashCount uint
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnHash: func(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error) {
+			require.Equal(t, HashAlgorithmKECCAK_256, hashAlgorithm)
+			return keccak256(data), nil
+		},
+		OnMeterComputation: func(compKind common.ComputationKind, intensity uint) error {
+			if compKind == common.ComputationKindSTDLIBCryptoMerkleProofHash {
+				hashCount += intensity
+			}
+			return nil
+		},
+		OnDecodeArgument: func(b []byte, t cadence.Type) (cadence.Value, error) {
+			return json.Decode(nil, b)
+		},
+	}
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+			Arguments: encodeArgs([]cadence.Value{
+				cadence.NewArray(leavesValue),
+				getCadenceValueArrayFromHexStr(t, hex.EncodeToString(trieRoot)),
+				getCadenceValueArrayFromHexStr(t, hex.EncodeToString(trieNode)),
+			}),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewBool(true),
+			cadence.NewBool(false),
+			cadence.NewBool(true),
+			cadence.NewOptional(
+				getCadenceValueArrayFromHexStr(t, hex.EncodeToString([]byte("value"))).(cadence.Array).
+					WithType(cadence.NewVariableSizedArrayType(cadence.UInt8Type)),
+			),
+			cadence.NewOptional(nil),
+		}).WithType(cadence.NewVariableSizedArrayType(cadence.AnyStructType)),
+		result,
+	)
+
+	// Two hashes for each binary proof, one for each trie proof
+	assert.Equal(t, uint(8), hashCount)
+}
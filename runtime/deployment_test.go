@@ -235,7 +235,7 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 			},
 			check: expectFailure(
 				"Execution failed:\n"+
-					"error: invalid argument at index 0: expected type `Int`, got `Bool`\n"+
+					"error[E7003]: invalid argument at index 0: expected type `Int`, got `Bool`\n"+
 					" --> 0000000000000000000000000000000000000000000000000000000000000000:5:22\n"+
 					"  |\n"+
 					"5 |                       signer.contracts.add(name: \"Test\", code: \"0a202020202020202020202020202061636365737328616c6c2920636f6e74726163742054657374207b0a202020202020202020202020202020202020696e6974285f20783a20496e7429207b7d0a20202020202020202020202020207d0a202020202020202020202020\".decodeHex(), true)\n"+
@@ -255,7 +255,7 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 			},
 			check: expectFailure(
 				"Execution failed:\n"+
-					"error: invalid argument count, too many arguments: expected 0, got 1\n"+
+					"error[E3001]: invalid argument count, too many arguments: expected 0, got 1\n"+
 					" --> 0000000000000000000000000000000000000000000000000000000000000000:5:22\n"+
 					"  |\n"+
 					"5 |                       signer.contracts.add(name: \"Test\", code: \"0a202020202020202020202020202061636365737328616c6c2920636f6e74726163742054657374207b7d0a202020202020202020202020\".decodeHex(), 1)\n"+
@@ -275,19 +275,19 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 			arguments: []argument{},
 			check: expectFailure(
 				"Execution failed:\n"+
-					"error: cannot deploy invalid contract\n"+
+					"error[E7002]: cannot deploy invalid contract\n"+
 					" --> 0000000000000000000000000000000000000000000000000000000000000000:5:22\n"+
 					"  |\n"+
 					"5 |                       signer.contracts.add(name: \"Test\", code: \"0a202020202020202020202020202061636365737328616c6c2920636f6e74726163742054657374207b7d0a0a202020202020202020202020202066756e2074657374436173652829207b7d0a202020202020202020202020\".decodeHex())\n"+
 					"  |                       ^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^\n"+
 					"\n"+
-					"error: function declarations are not valid at the top-level\n"+
+					"error[E2121]: function declarations are not valid at the top-level\n"+
 					" --> 2a00000000000000.Test:4:18\n"+
 					"  |\n"+
 					"4 |               fun testCase() {}\n"+
 					"  |                   ^^^^^^^^\n"+
 					"\n"+
-					"error: missing access modifier for function\n"+
+					"error[E2021]: missing access modifier for function\n"+
 					" --> 2a00000000000000.Test:4:14\n"+
 					"  |\n"+
 					"4 |               fun testCase() {}\n"+
@@ -305,13 +305,13 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 			arguments: []argument{},
 			check: expectFailure(
 				"Execution failed:\n"+
-					"error: cannot deploy invalid contract\n"+
+					"error[E7002]: cannot deploy invalid contract\n"+
 					" --> 0000000000000000000000000000000000000000000000000000000000000000:5:22\n"+
 					"  |\n"+
 					"5 |                       signer.contracts.add(name: \"Test\", code: \"0a2020202020202020202020202020580a202020202020202020202020\".decodeHex())\n"+
 					"  |                       ^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^\n"+
 					"\n"+
-					"error: unexpected token: identifier\n"+
+					"error[E1001]: unexpected token: identifier\n"+
 					" --> 2a00000000000000.Test:2:14\n"+
 					"  |\n"+
 					"2 |               X\n"+
@@ -331,13 +331,13 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 			arguments: []argument{},
 			check: expectFailure(
 				"Execution failed:\n"+
-					"error: cannot deploy invalid contract\n"+
+					"error[E7002]: cannot deploy invalid contract\n"+
 					" --> 0000000000000000000000000000000000000000000000000000000000000000:5:22\n"+
 					"  |\n"+
 					"5 |                       signer.contracts.add(name: \"Test\", code: \"0a202020202020202020202020202061636365737328616c6c2920636f6e74726163742054657374207b0a20202020202020202020202020202020202061636365737328616c6c292066756e20746573742829207b2058207d0a20202020202020202020202020207d0a202020202020202020202020\".decodeHex())\n"+
 					"  |                       ^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^\n"+
 					"\n"+
-					"error: cannot find variable in this scope: `X`\n"+
+					"error[E2004]: cannot find variable in this scope: `X`\n"+
 					" --> 2a00000000000000.Test:3:43\n"+
 					"  |\n"+
 					"3 |                   access(all) fun test() { X }\n"+
@@ -19,12 +19,16 @@
 package runtime
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/activations"
 
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
@@ -56,6 +60,10 @@ type Environment interface {
 		storage *Storage,
 		coverageReport *CoverageReport,
 	)
+	// ConfigureExecution configures the options of the execution,
+	// e.g. its cancellation.
+	// The options are reset by Configure, so it must be called after it
+	ConfigureExecution(options ExecutionOptions)
 	ParseAndCheckProgram(
 		code []byte,
 		location common.Location,
@@ -77,6 +85,15 @@ type Environment interface {
 	NewAccountValue(address interpreter.AddressValue) interpreter.Value
 }
 
+// ExecutionOptions are the options of an execution,
+// see Environment.ConfigureExecution
+type ExecutionOptions struct {
+	// Context, if set, aborts the execution when it is done
+	Context context.Context
+	// Deadline, if set, aborts the execution when it is exceeded
+	Deadline time.Time
+}
+
 // interpreterEnvironmentReconfigured is the portion of interpreterEnvironment
 // that gets reconfigured by interpreterEnvironment.Configure
 type interpreterEnvironmentReconfigured struct {
@@ -123,10 +140,17 @@ type interpreterEnvironment struct {
 	CheckerConfig                         *sema.Config
 	deployedContractConstructorInvocation *stdlib.DeployedContractConstructorInvocation
 	stackDepthLimiter                     *stackDepthLimiter
+	executionCanceler                     *executionCanceler
+	spanTracer                            *spanTracingInterface
 	checkedImports                        importResolutionResults
 	compositeValueFunctionsHandlers       stdlib.CompositeValueFunctionsHandlers
 	config                                Config
 	deployedContracts                     map[Location]struct{}
+	// importHashesChecked are the locations of the programs
+	// whose pinned import hashes were checked in the current execution
+	importHashesChecked map[common.Location]struct{}
+	// importedCodeHashes are the hashes of the imported code of the current execution
+	importedCodeHashes map[common.Location][]byte
 }
 
 var _ Environment = &interpreterEnvironment{}
@@ -161,6 +185,9 @@ func newInterpreterEnvironment(config Config) *interpreterEnvironment {
 	env.InterpreterConfig = env.newInterpreterConfig()
 	env.CheckerConfig = env.newCheckerConfig()
 	env.compositeValueFunctionsHandlers = stdlib.DefaultStandardLibraryCompositeValueFunctionHandlers(env)
+	for _, valueDeclaration := range stdlib.CryptoContractNativeValues(env) {
+		env.DeclareValue(valueDeclaration, stdlib.CryptoCheckerLocation)
+	}
 	return env
 }
 
@@ -174,6 +201,7 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 		ContractValueHandler:           e.newContractValueHandler(),
 		ImportLocationHandler:          e.newImportLocationHandler(),
 		AccountHandler:                 e.NewAccountValue,
+		OnStartTrace:                   e.newOnStartTraceHandler(),
 		OnRecordTrace:                  e.newOnRecordTraceHandler(),
 		OnResourceOwnerChange:          e.newResourceOwnerChangedHandler(),
 		CompositeTypeHandler:           e.newCompositeTypeHandler(),
@@ -186,7 +214,6 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 		// see interpreterEnvironment.CommitStorage
 		AtreeStorageValidationEnabled: false,
 		Debugger:                      e.config.Debugger,
-		OnStatement:                   e.newOnStatementHandler(),
 		OnMeterComputation:            e.newOnMeterComputation(),
 		OnFunctionInvocation:          e.newOnFunctionInvocationHandler(),
 		OnInvokedFunctionReturn:       e.newOnInvokedFunctionReturnHandler(),
@@ -206,6 +233,7 @@ func (e *interpreterEnvironment) newCheckerConfig() *sema.Config {
 		ImportHandler:                    e.resolveImport,
 		CheckHandler:                     e.newCheckHandler(),
 		AttachmentsEnabled:               e.config.AttachmentsEnabled,
+		TypeParametersEnabled:            e.config.TypeParametersEnabled,
 	}
 }
 
@@ -237,6 +265,18 @@ func (e *interpreterEnvironment) Configure(
 	e.InterpreterConfig.Storage = storage
 	e.coverageReport = coverageReport
 	e.stackDepthLimiter.depth = 0
+	e.resetImportHashes()
+	// Tracing is enabled if configured,
+	// or if the execution is traced as a tree of spans
+	e.spanTracer = spanTracerOf(runtimeInterface)
+	e.InterpreterConfig.TracingEnabled = e.config.TracingEnabled || e.spanTracer != nil
+	e.ConfigureExecution(ExecutionOptions{})
+}
+
+func (e *interpreterEnvironment) ConfigureExecution(options ExecutionOptions) {
+	e.executionCanceler = newExecutionCanceler(options.Context, options.Deadline)
+	e.InterpreterConfig.OnStatement = e.newOnStatementHandler()
+	e.InterpreterConfig.OnLoopIteration = e.newOnLoopIterationHandler()
 }
 
 func (e *interpreterEnvironment) DeclareValue(valueDeclaration stdlib.StandardLibraryValue, location common.Location) {
@@ -399,10 +439,12 @@ func (e *interpreterEnvironment) RevokeAccountKey(address common.Address, index
 }
 
 func (e *interpreterEnvironment) UpdateAccountContractCode(location common.AddressLocation, code []byte) error {
+	e.resetImportHashes()
 	return e.runtimeInterface.UpdateAccountContractCode(location, code)
 }
 
 func (e *interpreterEnvironment) RemoveAccountContractCode(location common.AddressLocation) error {
+	e.resetImportHashes()
 	return e.runtimeInterface.RemoveAccountContractCode(location)
 }
 
@@ -461,15 +503,20 @@ func (e *interpreterEnvironment) ParseAndCheckProgram(
 			// So mark it also as 'already seen'.
 			location: true,
 		},
+		// The code is provided by the user, e.g. the code of a contract deployment,
+		// so it must be source code, which is parsed and validated by the parser.
+		// Only code provided by the host may be an encoded program
+		false,
 	)
 }
 
 // parseAndCheckProgram parses and checks the given program.
-// If storeProgram is true, it calls Interface.SetProgram.
+// If allowEncoded is true, the code may also be an encoded program (see ast.EncodeProgram).
 func (e *interpreterEnvironment) parseAndCheckProgram(
 	code []byte,
 	location common.Location,
 	checkedImports importResolutionResults,
+	allowEncoded bool,
 ) (
 	program *ast.Program,
 	elaboration *sema.Elaboration,
@@ -484,11 +531,27 @@ func (e *interpreterEnvironment) parseAndCheckProgram(
 
 	// Parse
 
+	if !allowEncoded && ast.IsEncodedProgram(code) {
+		return nil, nil, wrapParsingCheckingError(
+			&EncodedProgramNotAllowedError{
+				Location: location,
+			},
+		)
+	}
+
 	reportMetric(
 		func() {
-			program, err = parser.ParseProgram(e, code, parser.Config{})
+			program, err = parser.ParseOrDecodeProgram(
+				e,
+				code,
+				parser.Config{
+					TypeParametersEnabled: e.config.TypeParametersEnabled,
+				},
+			)
 		},
 		e.runtimeInterface,
+		traceSpanNameParse,
+		location,
 		func(metrics Metrics, duration time.Duration) {
 			metrics.ProgramParsed(location, duration)
 		},
@@ -507,6 +570,118 @@ func (e *interpreterEnvironment) parseAndCheckProgram(
 	return program, elaboration, nil
 }
 
+// checkImportHashes ensures that the code of each imported program
+// which is pinned by a hash in an import declaration has the expected hash,
+// see importedCodeHash.
+//
+// The imports of a program are only checked once per execution.
+func (e *interpreterEnvironment) checkImportHashes(location common.Location, program *ast.Program) error {
+	if _, ok := e.importHashesChecked[location]; ok {
+		return nil
+	}
+
+	for _, declaration := range program.ImportDeclarations() {
+		expectedHash := declaration.Hash
+		if expectedHash == nil {
+			continue
+		}
+
+		resolvedLocations, err := e.CheckerConfig.LocationHandler(
+			declaration.Identifiers,
+			declaration.Location,
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(resolvedLocations) != 1 {
+			return &AmbiguousImportHashError{
+				Location: declaration.Location,
+				Count:    len(resolvedLocations),
+				Range:    declaration.Range,
+			}
+		}
+
+		importedLocation := resolvedLocations[0].Location
+
+		actualHash, err := e.importedCodeHash(importedLocation)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(expectedHash, actualHash) {
+			return &ImportHashMismatchError{
+				Location:     importedLocation,
+				ExpectedHash: expectedHash,
+				ActualHash:   actualHash,
+				Range:        declaration.Range,
+			}
+		}
+	}
+
+	if e.importHashesChecked == nil {
+		e.importHashesChecked = map[common.Location]struct{}{}
+	}
+	e.importHashesChecked[location] = struct{}{}
+
+	return nil
+}
+
+// importedCodeHash returns the hash of the code of the given imported location,
+// which is the SHA3-256 hash of the source code, i.e. the same as the code hash
+// in the account contract events.
+//
+// The hash does not depend on the representation of the code provided by the host:
+// if the host provides an encoded program, the source hash stored in the encoding is used
+// (see ast.EncodeProgram).
+//
+// The hashes are cached for the execution.
+func (e *interpreterEnvironment) importedCodeHash(location common.Location) ([]byte, error) {
+	if hash, ok := e.importedCodeHashes[location]; ok {
+		return hash, nil
+	}
+
+	code, err := e.getCode(location)
+	if err != nil {
+		return nil, err
+	}
+
+	var hash []byte
+	if ast.IsEncodedProgram(code) {
+		hash, err = ast.DecodeProgramSourceHash(code)
+		if err != nil {
+			return nil, err
+		}
+		if hash == nil {
+			// The host provided the encoding without the source hash,
+			// so the hash is not available
+			return nil, errors.NewExternalError(
+				fmt.Errorf(
+					"encoded program of `%s` has no source hash, which is required for pinned imports",
+					location,
+				),
+			)
+		}
+	} else {
+		sourceHash := sha3.Sum256(code)
+		hash = sourceHash[:]
+	}
+
+	if e.importedCodeHashes == nil {
+		e.importedCodeHashes = map[common.Location][]byte{}
+	}
+	e.importedCodeHashes[location] = hash
+
+	return hash, nil
+}
+
+// resetImportHashes discards the cached import hashes,
+// e.g. when the code of a contract is updated
+func (e *interpreterEnvironment) resetImportHashes() {
+	e.importHashesChecked = nil
+	e.importedCodeHashes = nil
+}
+
 func (e *interpreterEnvironment) check(
 	location common.Location,
 	program *ast.Program,
@@ -555,6 +730,8 @@ func (e *interpreterEnvironment) newCheckHandler() sema.CheckHandlerFunc {
 		reportMetric(
 			check,
 			e.runtimeInterface,
+			traceSpanNameCheck,
+			checker.Location,
 			func(metrics Metrics, duration time.Duration) {
 				metrics.ProgramChecked(checker.Location, duration)
 			},
@@ -620,16 +797,20 @@ func (e *interpreterEnvironment) GetProgram(
 		},
 		storeProgram,
 		checkedImports,
+		// The code is provided by the host
+		true,
 	)
 }
 
 // getProgram returns the existing program at the given location, if available.
 // If it is not available, it loads the code, and then parses and checks it.
+// If allowEncoded is true, the code may also be an encoded program.
 func (e *interpreterEnvironment) getProgram(
 	location Location,
 	getCode func() ([]byte, error),
 	getAndSetProgram bool,
 	checkedImports importResolutionResults,
+	allowEncoded bool,
 ) (
 	program *interpreter.Program,
 	err error,
@@ -640,12 +821,17 @@ func (e *interpreterEnvironment) getProgram(
 			return nil, err
 		}
 
-		e.codesAndPrograms.setCode(location, code)
+		// The code of encoded programs is not source code,
+		// so it cannot be used for error messages
+		if !ast.IsEncodedProgram(code) {
+			e.codesAndPrograms.setCode(location, code)
+		}
 
 		parsedProgram, elaboration, err := e.parseAndCheckProgram(
 			code,
 			location,
 			checkedImports,
+			allowEncoded,
 		)
 		if parsedProgram != nil {
 			e.codesAndPrograms.setProgram(location, parsedProgram)
@@ -661,30 +847,48 @@ func (e *interpreterEnvironment) getProgram(
 	}
 
 	if !getAndSetProgram {
-		return load()
-	}
+		program, err = load()
+	} else {
+		errors.WrapPanic(func() {
+			program, err = e.runtimeInterface.GetOrLoadProgram(location, func() (program *interpreter.Program, err error) {
+				// Loading is done by Cadence.
+				// If it panics with a user error, e.g. when parsing fails due to a memory metering error,
+				// then do not treat it as an external error (the load callback is called by the embedder)
+				panicErr := UserPanicToError(func() {
+					program, err = load()
+				})
+				if panicErr != nil {
+					return nil, panicErr
+				}
+
+				if err != nil {
+					err = interpreter.WrappedExternalError(err)
+				}
 
-	errors.WrapPanic(func() {
-		program, err = e.runtimeInterface.GetOrLoadProgram(location, func() (program *interpreter.Program, err error) {
-			// Loading is done by Cadence.
-			// If it panics with a user error, e.g. when parsing fails due to a memory metering error,
-			// then do not treat it as an external error (the load callback is called by the embedder)
-			panicErr := UserPanicToError(func() {
-				program, err = load()
+				return
 			})
-			if panicErr != nil {
-				return nil, panicErr
-			}
+		})
+	}
+	if err != nil || program == nil || program.Program == nil {
+		return
+	}
 
-			if err != nil {
-				err = interpreter.WrappedExternalError(err)
-			}
+	// Check pinned import hashes.
+	// The program might not have been loaded, but e.g. have been cached by the host,
+	// so the hashes are checked whenever the program is requested, not just when it is checked
 
-			return
-		})
-	})
+	err = e.checkImportHashes(location, program.Program)
+	if err != nil {
+		if !errors.IsUserError(err) {
+			return nil, err
+		}
+		return nil, &ParsingCheckingError{
+			Err:      err,
+			Location: location,
+		}
+	}
 
-	return
+	return program, nil
 }
 
 func (e *interpreterEnvironment) getCode(location common.Location) (code []byte, err error) {
@@ -739,11 +943,23 @@ func (e *interpreterEnvironment) newInterpreter(
 }
 
 func (e *interpreterEnvironment) newOnStatementHandler() interpreter.OnStatementFunc {
+	executionCanceler := e.executionCanceler
+
 	if e.config.CoverageReport == nil {
-		return nil
+		if executionCanceler == nil {
+			return nil
+		}
+
+		return func(_ *interpreter.Interpreter, _ ast.Statement) {
+			executionCanceler.check()
+		}
 	}
 
 	return func(inter *interpreter.Interpreter, statement ast.Statement) {
+		if executionCanceler != nil {
+			executionCanceler.check()
+		}
+
 		location := inter.Location
 		if !e.coverageReport.IsLocationInspected(location) {
 			program := inter.Program.Program
@@ -755,6 +971,15 @@ func (e *interpreterEnvironment) newOnStatementHandler() interpreter.OnStatement
 	}
 }
 
+func (e *interpreterEnvironment) newOnStartTraceHandler() interpreter.OnStartTraceFunc {
+	return func(inter *interpreter.Interpreter) {
+		if e.spanTracer == nil {
+			return
+		}
+		e.spanTracer.startTrace(inter.Location)
+	}
+}
+
 func (e *interpreterEnvironment) newOnRecordTraceHandler() interpreter.OnRecordTraceFunc {
 	return func(
 		interpreter *interpreter.Interpreter,
@@ -1055,8 +1280,22 @@ func (e *interpreterEnvironment) loadContract(
 	}
 }
 
+func (e *interpreterEnvironment) newOnLoopIterationHandler() interpreter.OnLoopIterationFunc {
+	executionCanceler := e.executionCanceler
+	if executionCanceler == nil {
+		return nil
+	}
+
+	return func(_ *interpreter.Interpreter, _ int) {
+		executionCanceler.check()
+	}
+}
+
 func (e *interpreterEnvironment) newOnFunctionInvocationHandler() func(_ *interpreter.Interpreter) {
 	return func(_ *interpreter.Interpreter) {
+		if e.executionCanceler != nil {
+			e.executionCanceler.check()
+		}
 		e.stackDepthLimiter.OnFunctionInvocation()
 	}
 }
@@ -1136,6 +1375,8 @@ func (e *interpreterEnvironment) Interpret(
 			result, err = f(inter)
 		},
 		e.runtimeInterface,
+		traceSpanNameInterpret,
+		location,
 		func(metrics Metrics, duration time.Duration) {
 			metrics.ProgramInterpreted(location, duration)
 		},
@@ -27,6 +27,7 @@ import (
 
 	"github.com/onflow/cadence"
 	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
@@ -63,7 +64,7 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
-				"error: unexpected token: identifier\n"+
+				"error[E1001]: unexpected token: identifier\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:1:0\n"+
 				"  |\n"+
 				"1 | X\n"+
@@ -96,7 +97,7 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
-				"error: missing access modifier for function\n"+
+				"error[E2021]: missing access modifier for function\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:1:0\n"+
 				"  |\n"+
 				"1 | fun test() {}\n"+
@@ -136,7 +137,7 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
-				"error: overflow\n"+
+				"error[E3009]: overflow\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:6:16\n"+
 				"  |\n"+
 				"6 |                 a + b\n"+
@@ -174,7 +175,7 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
-				"error: unexpectedly found nil while forcing an Optional value\n"+
+				"error[E3014]: unexpectedly found nil while forcing an Optional value\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:4:12\n"+
 				"  |\n"+
 				"4 | 				let y = x!\n"+
@@ -222,19 +223,20 @@ func TestRuntimeError(t *testing.T) {
 
 		require.EqualError(t, err,
 			"Execution failed:\n"+
+				"Traceback (most recent call last):\n"+
 				"  --> 0100000000000000000000000000000000000000000000000000000000000000:15:12\n"+
 				"   |\n"+
 				"15 | 				destroy createResource()\n"+
-				"   | 				        ^^^^^^^^^^^^^^^^\n"+
+				"   | 				        ^^^^^^^^^^^^^^^^ call to `createResource`\n"+
 				"\n"+
 				"  --> 0100000000000000000000000000000000000000000000000000000000000000:9:21\n"+
 				"   |\n"+
 				" 9 | 				return <- create Resource(\n"+
 				"10 | 					s: \"argument\"\n"+
 				"11 | 				)\n"+
-				"   | 				^^^^^^^^^^^^^^^^^^^^^^^^^^^\n"+
+				"   | 				^^^^^^^^^^^^^^^^^^^^^^^^^^^ call to `Resource`\n"+
 				"\n"+
-				"error: panic: 42\n"+
+				"error[E3046]: panic: 42\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:4:5\n"+
 				"  |\n"+
 				"4 | 					panic(\"42\")\n"+
@@ -277,7 +279,7 @@ func TestRuntimeError(t *testing.T) {
 		require.EqualError(
 			t,
 			err,
-			"Execution failed:\nerror: unexpected token: identifier\n"+
+			"Execution failed:\nerror[E1001]: unexpected token: identifier\n"+
 				" --> imported:1:0\n"+
 				"  |\n"+
 				"1 | X\n"+
@@ -321,7 +323,7 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
-				"error: missing access modifier for function\n"+
+				"error[E2021]: missing access modifier for function\n"+
 				" --> imported:1:0\n"+
 				"  |\n"+
 				"1 | fun test() {}\n"+
@@ -378,18 +380,35 @@ func TestRuntimeError(t *testing.T) {
 			t,
 			err,
 			"Execution failed:\n"+
+				"Traceback (most recent call last):\n"+
 				" --> 0100000000000000000000000000000000000000000000000000000000000000:5:16\n"+
 				"  |\n"+
 				"5 |                 add()\n"+
-				"  |                 ^^^^^\n"+
+				"  |                 ^^^^^ call to `add`\n"+
 				"\n"+
-				"error: overflow\n"+
+				"error[E3009]: overflow\n"+
 				" --> imported:6:16\n"+
 				"  |\n"+
 				"6 |                 a + b\n"+
 				"  |                 ^^^^^\n"+
 				"",
 		)
+
+		var runtimeErr Error
+		require.ErrorAs(t, err, &runtimeErr)
+		require.Equal(t,
+			[]interpreter.StackFrame{
+				{
+					FunctionName: "add",
+					Location:     location,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 94, Line: 5, Column: 16},
+						EndPos:   ast.Position{Offset: 98, Line: 5, Column: 20},
+					},
+				},
+			},
+			runtimeErr.StackTrace,
+		)
 	})
 
 	t.Run("nested errors", func(t *testing.T) {
@@ -457,25 +476,25 @@ func TestRuntimeError(t *testing.T) {
 		)
 		require.EqualError(t, err,
 			"Execution failed:\n"+
-				"error: function declarations are not valid at the top-level\n"+
+				"error[E2121]: function declarations are not valid at the top-level\n"+
 				" --> 0000000000000002.B:3:30\n"+
 				"  |\n"+
 				"3 |               access(all) fun bar() {\n"+
 				"  |                               ^^^\n"+
 				"\n"+
-				"error: cannot find variable in this scope: `X`\n"+
+				"error[E2004]: cannot find variable in this scope: `X`\n"+
 				" --> 0000000000000002.B:5:18\n"+
 				"  |\n"+
 				"5 |                   X\n"+
 				"  |                   ^ not found in this scope\n"+
 				"\n"+
-				"error: function declarations are not valid at the top-level\n"+
+				"error[E2121]: function declarations are not valid at the top-level\n"+
 				" --> 0000000000000001.A:8:30\n"+
 				"  |\n"+
 				"8 |               access(all) fun foo() {\n"+
 				"  |                               ^^^\n"+
 				"\n"+
-				"error: cannot find variable in this scope: `Y`\n"+
+				"error[E2004]: cannot find variable in this scope: `Y`\n"+
 				"  --> 0000000000000001.A:10:18\n"+
 				"   |\n"+
 				"10 |                   Y\n"+
@@ -0,0 +1,100 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Error codes of the runtime errors.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Checking
+
+func (*ParsingCheckingError) ErrorCode() errors.ErrorCode {
+	return 2176
+}
+
+func (*ImportHashMismatchError) ErrorCode() errors.ErrorCode {
+	return 2177
+}
+
+func (*AmbiguousImportHashError) ErrorCode() errors.ErrorCode {
+	return 2178
+}
+
+func (*EncodedProgramNotAllowedError) ErrorCode() errors.ErrorCode {
+	return 2179
+}
+
+// Execution
+
+func (InvalidTransactionCountError) ErrorCode() errors.ErrorCode {
+	return 3052
+}
+
+// Metering
+
+func (CallStackLimitExceededError) ErrorCode() errors.ErrorCode {
+	return 6002
+}
+
+// Argument
+
+func (InvalidEntryPointParameterCountError) ErrorCode() errors.ErrorCode {
+	return 9001
+}
+
+func (InvalidTransactionAuthorizerCountError) ErrorCode() errors.ErrorCode {
+	return 9002
+}
+
+func (*InvalidEntryPointArgumentError) ErrorCode() errors.ErrorCode {
+	return 9003
+}
+
+func (*MalformedValueError) ErrorCode() errors.ErrorCode {
+	return 9004
+}
+
+func (*InvalidValueTypeError) ErrorCode() errors.ErrorCode {
+	return 9005
+}
+
+func (*InvalidScriptReturnTypeError) ErrorCode() errors.ErrorCode {
+	return 9006
+}
+
+func (*ValueNotExportableError) ErrorCode() errors.ErrorCode {
+	return 9007
+}
+
+func (*ScriptParameterTypeNotStorableError) ErrorCode() errors.ErrorCode {
+	return 9008
+}
+
+func (*ScriptParameterTypeNotImportableError) ErrorCode() errors.ErrorCode {
+	return 9009
+}
+
+func (*ArgumentNotImportableError) ErrorCode() errors.ErrorCode {
+	return 9010
+}
@@ -19,6 +19,8 @@
 package runtime
 
 import (
+	"encoding/hex"
+	goerrors "errors"
 	"fmt"
 	"strings"
 
@@ -35,14 +37,24 @@ type Error struct {
 	Location Location
 	Codes    map[Location][]byte
 	Programs map[Location]*ast.Program
+	// StackTrace is the Cadence call stack at the time the error occurred,
+	// from the outermost to the innermost invocation, if available
+	StackTrace []interpreter.StackFrame
 }
 
 func newError(err error, location Location, codesAndPrograms CodesAndPrograms) Error {
+	var stackTrace []interpreter.StackFrame
+	var interpreterErr interpreter.Error
+	if goerrors.As(err, &interpreterErr) {
+		stackTrace = interpreterErr.StackTrace
+	}
+
 	return Error{
-		Err:      err,
-		Location: location,
-		Codes:    codesAndPrograms.codes,
-		Programs: codesAndPrograms.programs,
+		Err:        err,
+		Location:   location,
+		Codes:      codesAndPrograms.codes,
+		Programs:   codesAndPrograms.programs,
+		StackTrace: stackTrace,
 	}
 }
 
@@ -78,6 +90,26 @@ func (e CallStackLimitExceededError) Error() string {
 	)
 }
 
+// ExecutionCanceledError is reported when the execution was aborted,
+// because the context of the execution was canceled or the deadline was exceeded.
+// The cause is available through Unwrap.
+//
+// Cancellation depends on the host, e.g. on the wall-clock time,
+// so the error is not a user error, but is reported as an external error:
+// the failure is not a deterministic result of the executed program.
+
+type ExecutionCanceledError struct {
+	Err error
+}
+
+func (e ExecutionCanceledError) Error() string {
+	return fmt.Sprintf("execution canceled: %s", e.Err)
+}
+
+func (e ExecutionCanceledError) Unwrap() error {
+	return e.Err
+}
+
 // InvalidTransactionCountError
 
 type InvalidTransactionCountError struct {
@@ -312,3 +344,63 @@ func (e *ParsingCheckingError) Unwrap() error {
 func (e *ParsingCheckingError) ImportLocation() Location {
 	return e.Location
 }
+
+// ImportHashMismatchError is reported when the code of an imported program
+// does not have the hash that the import declaration pins
+type ImportHashMismatchError struct {
+	Location     Location
+	ExpectedHash []byte
+	ActualHash   []byte
+	ast.Range
+}
+
+var _ errors.UserError = &ImportHashMismatchError{}
+
+func (*ImportHashMismatchError) IsUserError() {}
+
+func (e *ImportHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"hash of imported program `%s` does not match: expected 0x%s, got 0x%s",
+		e.Location,
+		hex.EncodeToString(e.ExpectedHash),
+		hex.EncodeToString(e.ActualHash),
+	)
+}
+
+// EncodedProgramNotAllowedError is reported when an encoded program is given as code
+// which must be source code, e.g. the code of a contract deployment.
+// Only code provided by the host may be an encoded program
+type EncodedProgramNotAllowedError struct {
+	Location Location
+}
+
+var _ errors.UserError = &EncodedProgramNotAllowedError{}
+
+func (*EncodedProgramNotAllowedError) IsUserError() {}
+
+func (e *EncodedProgramNotAllowedError) Error() string {
+	return fmt.Sprintf(
+		"cannot use encoded program as code of `%s`: expected source code",
+		e.Location,
+	)
+}
+
+// AmbiguousImportHashError is reported when an import declaration pins a hash,
+// but does not resolve to exactly one imported program
+type AmbiguousImportHashError struct {
+	Location Location
+	Count    int
+	ast.Range
+}
+
+var _ errors.UserError = &AmbiguousImportHashError{}
+
+func (*AmbiguousImportHashError) IsUserError() {}
+
+func (e *AmbiguousImportHashError) Error() string {
+	return fmt.Sprintf(
+		"cannot pin hash of import of `%s`: expected exactly one imported program, got %d",
+		e.Location,
+		e.Count,
+	)
+}
@@ -0,0 +1,88 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errors
+
+import (
+	"fmt"
+
+	"golang.org/x/xerrors"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=ErrorCategory -trimprefix=ErrorCategory
+
+// ErrorCategory is the category of an error, e.g. parsing or checking.
+//
+// NOTE: The values are stable and part of the public API:
+// Never reorder or remove categories, only append new ones.
+type ErrorCategory uint8
+
+const (
+	ErrorCategoryUnknown ErrorCategory = iota
+	ErrorCategoryParsing
+	ErrorCategoryChecking
+	ErrorCategoryExecution
+	ErrorCategoryStorage
+	ErrorCategoryCapability
+	ErrorCategoryMetering
+	ErrorCategoryAccount
+	ErrorCategoryContractUpdate
+	ErrorCategoryArgument
+)
+
+// ErrorCode is a stable, machine-readable code for an error.
+//
+// The category of the error is encoded in the thousands of the code,
+// e.g. code 2001 is in the checking category.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+type ErrorCode uint16
+
+const errorCodeCategorySize = 1000
+
+func (c ErrorCode) Category() ErrorCategory {
+	return ErrorCategory(c / errorCodeCategorySize)
+}
+
+func (c ErrorCode) String() string {
+	return fmt.Sprintf("E%04d", uint16(c))
+}
+
+// HasErrorCode is an interface for errors that provide a stable error code.
+// All user errors provide an error code, except for pure wrappers
+// which only add information (e.g. a position) to another error
+type HasErrorCode interface {
+	ErrorCode() ErrorCode
+}
+
+// GetErrorCode returns the error code of the given error.
+// If the error does not provide an error code, the wrapped errors are searched.
+func GetErrorCode(err error) (ErrorCode, bool) {
+	for err != nil {
+		if hasErrorCode, ok := err.(HasErrorCode); ok {
+			return hasErrorCode.ErrorCode(), true
+		}
+		wrapper, ok := err.(xerrors.Wrapper)
+		if !ok {
+			break
+		}
+		err = wrapper.Unwrap()
+	}
+	return 0, false
+}
@@ -0,0 +1,36 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errors
+
+// Error codes of the user errors of the errors package.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Execution
+
+func (DefaultUserError) ErrorCode() ErrorCode {
+	return 3001
+}
+
+// Metering
+
+func (MemoryError) ErrorCode() ErrorCode {
+	return 6001
+}
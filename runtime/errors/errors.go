@@ -103,6 +103,11 @@ type ParentError interface {
 	ChildErrors() []error
 }
 
+// StackTraceFrame is an interface for errors that are a frame of a stack trace
+type StackTraceFrame interface {
+	IsStackTraceFrame()
+}
+
 // HasPrefix is an interface for errors that provide a custom prefix
 type HasPrefix interface {
 	Prefix() string
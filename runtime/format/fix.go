@@ -20,6 +20,7 @@ package format
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -53,3 +54,28 @@ func UFix64(v uint64) string {
 		PadLeft(strconv.Itoa(int(fraction)), '0', fixedpoint.Fix64Scale),
 	)
 }
+
+// Fix128 formats the given raw Fix128 value
+func Fix128(v *big.Int) string {
+	return bigFixedPoint(v)
+}
+
+// UFix128 formats the given raw UFix128 value
+func UFix128(v *big.Int) string {
+	return bigFixedPoint(v)
+}
+
+func bigFixedPoint(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, fixedpoint.Fix128FactorBig, new(big.Int))
+	var builder strings.Builder
+	if fraction.Sign() < 0 {
+		fraction.Neg(fraction)
+		if integer.Sign() == 0 {
+			builder.WriteByte('-')
+		}
+	}
+	builder.WriteString(integer.String())
+	builder.WriteByte('.')
+	builder.WriteString(PadLeft(fraction.String(), '0', fixedpoint.Fix128Scale))
+	return builder.String()
+}
@@ -19,16 +19,22 @@
 package runtime_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
 	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
 	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
@@ -406,3 +412,387 @@ func TestRuntimeCheckCyclicImportToSelfDuringDeploy(t *testing.T) {
 	errs := checker.RequireCheckerErrors(t, checkerErr, 1)
 	require.IsType(t, &sema.CyclicImportsError{}, errs[0])
 }
+
+func TestRuntimeImportHashPinning(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	fooCode := []byte(`
+      access(all) contract Foo {}
+    `)
+
+	fooHash := sha3.Sum256(fooCode)
+
+	barCode := []byte(`
+      access(all) contract Bar {}
+    `)
+
+	fooLocation := common.AddressLocation{
+		Address: address,
+		Name:    "Foo",
+	}
+
+	barLocation := common.AddressLocation{
+		Address: address,
+		Name:    "Bar",
+	}
+
+	executeScript := func(script string) (cadence.Value, error) {
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				switch location {
+				case fooLocation:
+					return fooCode, nil
+				case barLocation:
+					return barCode, nil
+				default:
+					return nil, nil
+				}
+			},
+			OnResolveLocation: MultipleIdentifierLocationResolver,
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(fmt.Sprintf(
+			`
+              import Foo from 0x1 hash 0x%s
+
+              access(all) fun main(): String {
+                  return Type<Foo>().identifier
+              }
+            `,
+			hex.EncodeToString(fooHash[:]),
+		))
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.String("A.0000000000000001.Foo"), result)
+	})
+
+	t.Run("mismatched hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		barHash := sha3.Sum256(barCode)
+
+		_, err := executeScript(fmt.Sprintf(
+			`
+              import Foo from 0x1 hash 0x%s
+
+              access(all) fun main(): String {
+                  return Type<Foo>().identifier
+              }
+            `,
+			hex.EncodeToString(barHash[:]),
+		))
+		RequireError(t, err)
+
+		var mismatchErr *ImportHashMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+
+		require.Equal(t, Location(fooLocation), mismatchErr.Location)
+		require.Equal(t, barHash[:], mismatchErr.ExpectedHash)
+		require.Equal(t, fooHash[:], mismatchErr.ActualHash)
+	})
+
+	t.Run("multiple imported programs", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := executeScript(fmt.Sprintf(
+			`
+              import Foo, Bar from 0x1 hash 0x%s
+
+              access(all) fun main() {}
+            `,
+			hex.EncodeToString(fooHash[:]),
+		))
+		RequireError(t, err)
+
+		var ambiguousErr *AmbiguousImportHashError
+		require.ErrorAs(t, err, &ambiguousErr)
+
+		require.Equal(t, 2, ambiguousErr.Count)
+	})
+
+	t.Run("program cached by host", func(t *testing.T) {
+
+		t.Parallel()
+
+		bazLocation := common.AddressLocation{
+			Address: address,
+			Name:    "Baz",
+		}
+
+		bazCode := []byte(fmt.Sprintf(
+			`
+              import Foo from 0x1 hash 0x%s
+
+              access(all) contract Baz {
+                  access(all) let foo: Type
+
+                  init() {
+                      self.foo = Type<Foo>()
+                  }
+              }
+            `,
+			hex.EncodeToString(fooHash[:]),
+		))
+
+		codes := map[common.AddressLocation][]byte{
+			fooLocation: fooCode,
+			bazLocation: bazCode,
+		}
+
+		programs := map[Location]*interpreter.Program{}
+
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				return codes[location], nil
+			},
+			OnResolveLocation: MultipleIdentifierLocationResolver,
+			OnGetAndSetProgram: func(
+				location Location,
+				load func() (*interpreter.Program, error),
+			) (*interpreter.Program, error) {
+				program, ok := programs[location]
+				if ok {
+					return program, nil
+				}
+
+				program, err := load()
+				if err != nil {
+					return nil, err
+				}
+
+				programs[location] = program
+				return program, nil
+			},
+		}
+
+		executeScript := func() (cadence.Value, error) {
+			return runtime.ExecuteScript(
+				Script{
+					Source: []byte(`
+                      import Baz from 0x1
+
+                      access(all) fun main(): String {
+                          return Type<Baz>().identifier
+                      }
+                    `),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{},
+				},
+			)
+		}
+
+		result, err := executeScript()
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.String("A.0000000000000001.Baz"), result)
+
+		// Change the code of Foo.
+		// The program of Baz is cached by the host, so it is not checked again,
+		// but its pinned import must still be checked
+
+		changedFooCode := []byte(`
+          access(all) contract Foo {
+              access(all) fun changed() {}
+          }
+        `)
+		codes[fooLocation] = changedFooCode
+
+		_, err = executeScript()
+		RequireError(t, err)
+
+		var mismatchErr *ImportHashMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+
+		changedFooHash := sha3.Sum256(changedFooCode)
+
+		require.Equal(t, Location(fooLocation), mismatchErr.Location)
+		require.Equal(t, fooHash[:], mismatchErr.ExpectedHash)
+		require.Equal(t, changedFooHash[:], mismatchErr.ActualHash)
+	})
+
+	// executeScriptWithEncodedFoo executes the given script
+	// with a host which provides the code of Foo as an encoded program
+	executeScriptWithEncodedFoo := func(t *testing.T, sourceHash []byte, script string) (cadence.Value, error) {
+		program, err := parser.ParseProgram(nil, fooCode, parser.Config{})
+		require.NoError(t, err)
+
+		encodedFooCode, err := ast.EncodeProgram(program, sourceHash)
+		require.NoError(t, err)
+
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				if location == fooLocation {
+					return encodedFooCode, nil
+				}
+				return nil, nil
+			},
+			OnResolveLocation: MultipleIdentifierLocationResolver,
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("encoded program", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The hash is defined over the source code,
+		// so the same pinned import is valid if the host provides an encoded program
+
+		result, err := executeScriptWithEncodedFoo(
+			t,
+			fooHash[:],
+			fmt.Sprintf(
+				`
+                  import Foo from 0x1 hash 0x%s
+
+                  access(all) fun main(): String {
+                      return Type<Foo>().identifier
+                  }
+                `,
+				hex.EncodeToString(fooHash[:]),
+			),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.String("A.0000000000000001.Foo"), result)
+	})
+
+	t.Run("encoded program without source hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := executeScriptWithEncodedFoo(
+			t,
+			nil,
+			fmt.Sprintf(
+				`
+                  import Foo from 0x1 hash 0x%s
+
+                  access(all) fun main() {}
+                `,
+				hex.EncodeToString(fooHash[:]),
+			),
+		)
+		RequireError(t, err)
+
+		_, ok := errors.GetExternalError(err)
+		require.True(t, ok)
+		require.False(t, errors.IsUserError(err))
+		require.ErrorContains(t, err, "has no source hash")
+	})
+
+	t.Run("hashes checked once per execution", func(t *testing.T) {
+
+		t.Parallel()
+
+		bazLocation := common.AddressLocation{
+			Address: address,
+			Name:    "Baz",
+		}
+
+		bazCode := []byte(fmt.Sprintf(
+			`
+              import Foo from 0x1 hash 0x%s
+
+              access(all) contract Baz {
+                  access(all) struct S {
+                      access(all) let foo: Type
+
+                      init() {
+                          self.foo = Type<Foo>()
+                      }
+                  }
+              }
+            `,
+			hex.EncodeToString(fooHash[:]),
+		))
+
+		var fooCodeReads int
+
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				switch location {
+				case fooLocation:
+					fooCodeReads++
+					return fooCode, nil
+				case bazLocation:
+					return bazCode, nil
+				default:
+					return nil, nil
+				}
+			},
+			OnResolveLocation: MultipleIdentifierLocationResolver,
+		}
+
+		// The program of Baz is requested multiple times,
+		// e.g. when checking and when interpreting the script
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(fmt.Sprintf(
+					`
+                      import Foo from 0x1 hash 0x%[1]s
+                      import Baz from 0x1
+
+                      access(all) fun main(): Bool {
+                          return Type<Baz.S>() != Type<Foo>()
+                      }
+                    `,
+					hex.EncodeToString(fooHash[:]),
+				)),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		// The code of Foo is read once for loading its program,
+		// and once for checking its hash
+		require.Equal(t, 2, fooCodeReads)
+	})
+}
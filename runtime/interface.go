@@ -81,6 +81,9 @@ type Interface interface {
 	// UpdateAccountContractCode updates the code associated with an account contract.
 	UpdateAccountContractCode(location common.AddressLocation, code []byte) (err error)
 	// GetAccountContractCode returns the code associated with an account contract.
+	// The code may be source code, or an encoded program (see ast.EncodeProgram).
+	// An encoded program should include the hash of its source code,
+	// which is required to check imports which pin the hash of the contract.
 	GetAccountContractCode(location common.AddressLocation) (code []byte, err error)
 	// RemoveAccountContractCode removes the code associated with an account contract.
 	RemoveAccountContractCode(location common.AddressLocation) (err error)
@@ -164,3 +167,37 @@ type Metrics interface {
 	ProgramChecked(location Location, duration time.Duration)
 	ProgramInterpreted(location Location, duration time.Duration)
 }
+
+// wrappedInterface is the base of Interfaces which wrap another Interface.
+// The metrics functions are not part of Interface, so they are forwarded explicitly,
+// and wrapping an Interface does not disable metrics reporting.
+// Likewise, the span tracer of the wrapped Interface is forwarded,
+// so wrapping an Interface does not disable span tracing.
+type wrappedInterface struct {
+	Interface
+}
+
+var _ Metrics = wrappedInterface{}
+var _ spanTracerProvider = wrappedInterface{}
+
+func (i wrappedInterface) spanTracer() *spanTracingInterface {
+	return spanTracerOf(i.Interface)
+}
+
+func (i wrappedInterface) ProgramParsed(location Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramParsed(location, duration)
+	}
+}
+
+func (i wrappedInterface) ProgramChecked(location Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramChecked(location, duration)
+	}
+}
+
+func (i wrappedInterface) ProgramInterpreted(location Location, duration time.Duration) {
+	if metrics, ok := i.Interface.(Metrics); ok {
+		metrics.ProgramInterpreted(location, duration)
+	}
+}
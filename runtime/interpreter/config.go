@@ -29,6 +29,8 @@ type Config struct {
 	ImportLocationHandler ImportLocationHandlerFunc
 	// OnInvokedFunctionReturn is triggered when an invoked function returned
 	OnInvokedFunctionReturn OnInvokedFunctionReturnFunc
+	// OnStartTrace is triggered when a traced operation starts
+	OnStartTrace OnStartTraceFunc
 	// OnRecordTrace is triggered when a trace is recorded
 	OnRecordTrace OnRecordTraceFunc
 	// OnResourceOwnerChange is triggered when the owner of a resource changes
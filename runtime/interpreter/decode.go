@@ -304,6 +304,12 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
+		case CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
 		// Storage
 
 		case CBORTagPathValue:
@@ -814,6 +820,51 @@ func (d StorableDecoder) decodeUFix64() (UFix64Value, error) {
 	return NewUnmeteredUFix64Value(value), nil
 }
 
+func (d StorableDecoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Fix128TypeMinBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredFix128Value(bigInt), nil
+}
+
+func (d StorableDecoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredUFix128Value(bigInt), nil
+}
+
 func (d StorableDecoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -1399,6 +1450,12 @@ func NewTypeDecoder(
 	}
 }
 
+// StaticTypeFromBytes decodes a static type encoded with StaticTypeToBytes
+func StaticTypeFromBytes(data []byte) (StaticType, error) {
+	dec := CBORDecMode.NewByteStreamDecoder(data)
+	return NewTypeDecoder(dec, nil).DecodeStaticType()
+}
+
 func (d TypeDecoder) DecodeStaticType() (StaticType, error) {
 	number, err := d.decoder.DecodeTagNumber()
 	if err != nil {
@@ -1445,6 +1502,9 @@ func (d TypeDecoder) DecodeStaticType() (StaticType, error) {
 	case CBORTagInclusiveRangeStaticType:
 		return d.decodeInclusiveRangeStaticType()
 
+	case CBORTagSetStaticType:
+		return d.decodeSetStaticType()
+
 	default:
 		return nil, errors.NewUnexpectedError("invalid static type encoding tag: %d", number)
 	}
@@ -1491,7 +1551,7 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	if size != expectedLength && size != encodedInstantiatedCompositeStaticTypeLength {
 		return nil, errors.NewUnexpectedError(
 			"invalid composite static type encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -1517,7 +1577,44 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
+	if size == expectedLength {
+		return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
+	}
+
+	// Decode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	typeArguments, err := d.decodeStaticTypeArguments()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid composite static type type arguments encoding: %w", err)
+	}
+
+	return NewInstantiatedCompositeStaticType(
+		d.memoryGauge,
+		location,
+		qualifiedIdentifier,
+		typeArguments,
+	), nil
+}
+
+func (d TypeDecoder) decodeStaticTypeArguments() ([]StaticType, error) {
+	count, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, errors.NewUnexpectedError("expected at least one type argument")
+	}
+
+	typeArguments := make([]StaticType, 0, count)
+	for i := uint64(0); i < count; i++ {
+		typeArgument, err := d.DecodeStaticType()
+		if err != nil {
+			return nil, err
+		}
+		typeArguments = append(typeArguments, typeArgument)
+	}
+
+	return typeArguments, nil
 }
 
 func (d TypeDecoder) decodeInterfaceStaticType() (*InterfaceStaticType, error) {
@@ -1998,7 +2095,9 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		return nil, err
 	}
 
-	if length != encodedCompositeTypeInfoLength {
+	if length != encodedCompositeTypeInfoLength &&
+		length != encodedInstantiatedCompositeTypeInfoLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite type info: expected %d elements, got %d",
 			encodedCompositeTypeInfoLength, length,
@@ -2027,11 +2126,26 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		)
 	}
 
-	return NewCompositeTypeInfo(
+	if length == encodedCompositeTypeInfoLength {
+		return NewCompositeTypeInfo(
+			d.memoryGauge,
+			location,
+			qualifiedIdentifier,
+			common.CompositeKind(kind),
+		), nil
+	}
+
+	typeArguments, err := d.decodeStaticTypeArguments()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid composite type info type arguments: %w", err)
+	}
+
+	return NewInstantiatedCompositeTypeInfo(
 		d.memoryGauge,
 		location,
 		qualifiedIdentifier,
 		common.CompositeKind(kind),
+		typeArguments,
 	), nil
 }
 
@@ -2046,6 +2160,17 @@ func (d TypeDecoder) decodeInclusiveRangeStaticType() (StaticType, error) {
 	return NewInclusiveRangeStaticType(d.memoryGauge, elementType), nil
 }
 
+func (d TypeDecoder) decodeSetStaticType() (StaticType, error) {
+	elementType, err := d.DecodeStaticType()
+	if err != nil {
+		return nil, errors.NewUnexpectedError(
+			"invalid set static type encoding: %w",
+			err,
+		)
+	}
+	return NewSetStaticType(d.memoryGauge, elementType), nil
+}
+
 func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge) (atree.TypeInfo, error) {
 	d := NewTypeDecoder(decoder, memoryGauge)
 
@@ -2068,6 +2193,8 @@ func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge)
 			return d.decodeVariableSizedStaticType()
 		case CBORTagDictionaryStaticType:
 			return d.decodeDictionaryStaticType()
+		case CBORTagSetStaticType:
+			return d.decodeSetStaticType()
 		case CBORTagCompositeValue:
 			return d.decodeCompositeTypeInfo()
 		default:
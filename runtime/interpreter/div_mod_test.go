@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -3412,6 +3413,11 @@ func TestNegativeMod(t *testing.T) {
 				NewUnmeteredFix64ValueWithInteger(5, EmptyLocationRange),
 				NewUnmeteredFix64ValueWithInteger(-1, EmptyLocationRange),
 			},
+			"Fix128": {
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(-1), EmptyLocationRange),
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(5), EmptyLocationRange),
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(-1), EmptyLocationRange),
+			},
 		}
 
 		for _, integerType := range sema.AllSignedFixedPointTypes {
@@ -168,7 +168,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	CBORTagFix64Value
-	_ // future: Fix128
+	CBORTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -178,7 +178,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	CBORTagUFix64Value
-	_ // future: UFix128
+	CBORTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -225,6 +225,7 @@ const (
 	CBORTagEntitlementMapStaticAuthorization
 	CBORTagEntitlementSetStaticAuthorization
 	CBORTagInclusiveRangeStaticType
+	CBORTagSetStaticType
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -688,6 +689,40 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Fix128Value as
+//
+//	cbor.Tag{
+//			Number:  CBORTagFix128Value,
+//			Content: *big.Int(v.BigInt),
+//	}
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes UFix128Value as
+//
+//	cbor.Tag{
+//			Number:  CBORTagUFix128Value,
+//			Content: *big.Int(v.BigInt),
+//	}
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes SomeStorable as
 //
 //	cbor.Tag{
@@ -1166,12 +1201,18 @@ func (t *OptionalStaticType) Encode(e *cbor.StreamEncoder) error {
 const (
 	// encodedCompositeStaticTypeLocationFieldKey            uint64 = 0
 	// encodedCompositeStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+	// encodedCompositeStaticTypeTypeArgumentsFieldKey       uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCompositeStaticTypeLength MUST be updated when new element is added.
 	// It is used to verify encoded composite static type length during decoding.
 	encodedCompositeStaticTypeLength = 2
+
+	// encodedInstantiatedCompositeStaticTypeLength is the length
+	// of the encoding of an instantiation of a generic composite type,
+	// which additionally contains the type arguments
+	encodedInstantiatedCompositeStaticTypeLength = 3
 )
 
 // Encode encodes CompositeStaticType as
@@ -1181,15 +1222,23 @@ const (
 //				Content: cborArray{
 //					encodedCompositeStaticTypeLocationFieldKey:            Location(v.Location),
 //					encodedCompositeStaticTypeQualifiedIdentifierFieldKey: string(v.QualifiedIdentifier),
+//					encodedCompositeStaticTypeTypeArgumentsFieldKey:       []StaticType(v.TypeArguments),
 //			},
 //	}
+//
+// The type arguments are only encoded for instantiations of generic composite types.
 func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
+	var arrayHead byte = 0x80 | encodedCompositeStaticTypeLength
+	if len(t.TypeArguments) > 0 {
+		arrayHead = 0x80 | encodedInstantiatedCompositeStaticTypeLength
+	}
+
 	// Encode tag number and array head
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeStaticType,
-		// array, 2 items follow
-		0x82,
+		// array, 2 or 3 items follow
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1202,7 +1251,33 @@ func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
 	}
 
 	// Encode qualified identifier at array index encodedCompositeStaticTypeQualifiedIdentifierFieldKey
-	return e.EncodeString(t.QualifiedIdentifier)
+	err = e.EncodeString(t.QualifiedIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if len(t.TypeArguments) == 0 {
+		return nil
+	}
+
+	// Encode type arguments (as array) at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	return encodeStaticTypeArguments(e, t.TypeArguments)
+}
+
+func encodeStaticTypeArguments(e *cbor.StreamEncoder, typeArguments []StaticType) error {
+	err := e.EncodeArrayHead(uint64(len(typeArguments)))
+	if err != nil {
+		return err
+	}
+
+	for _, typeArgument := range typeArguments {
+		err = typeArgument.Encode(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1467,6 +1542,25 @@ func (t InclusiveRangeStaticType) Encode(e *cbor.StreamEncoder) error {
 	return t.ElementType.Encode(e)
 }
 
+// Encode encodes SetStaticType as
+//
+//	cbor.Tag{
+//			Number: CBORTagSetStaticType,
+//			Content: StaticType(v.ElementType),
+//	}
+func (t *SetStaticType) Encode(e *cbor.StreamEncoder) error {
+	// Encode tag number
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagSetStaticType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.ElementType.Encode(e)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedIntersectionStaticTypeLegacyTypeFieldKey  uint64 = 0
@@ -1561,6 +1655,7 @@ type compositeTypeInfo struct {
 	location            common.Location
 	qualifiedIdentifier string
 	kind                common.CompositeKind
+	typeArguments       []StaticType
 }
 
 func NewCompositeTypeInfo(
@@ -1578,16 +1673,43 @@ func NewCompositeTypeInfo(
 	}
 }
 
+func NewInstantiatedCompositeTypeInfo(
+	memoryGauge common.MemoryGauge,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	typeArguments []StaticType,
+) compositeTypeInfo {
+	info := NewCompositeTypeInfo(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+		kind,
+	)
+	info.typeArguments = typeArguments
+	return info
+}
+
 var _ atree.TypeInfo = compositeTypeInfo{}
 
 const encodedCompositeTypeInfoLength = 3
 
+// encodedInstantiatedCompositeTypeInfoLength is the length of the encoding
+// of the type info of a value of an instantiation of a generic composite type,
+// which additionally contains the type arguments
+const encodedInstantiatedCompositeTypeInfoLength = 4
+
 func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
+	var arrayHead byte = 0x80 | encodedCompositeTypeInfoLength
+	if len(c.typeArguments) > 0 {
+		arrayHead = 0x80 | encodedInstantiatedCompositeTypeInfoLength
+	}
+
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeValue,
-		// array, 3 items follow
-		0x83,
+		// array, 3 or 4 items follow
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1608,15 +1730,34 @@ func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
 		return err
 	}
 
+	if len(c.typeArguments) > 0 {
+		err = encodeStaticTypeArguments(e, c.typeArguments)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (c compositeTypeInfo) Equal(o atree.TypeInfo) bool {
 	other, ok := o.(compositeTypeInfo)
-	return ok &&
-		c.location == other.location &&
-		c.qualifiedIdentifier == other.qualifiedIdentifier &&
-		c.kind == other.kind
+	if !ok ||
+		c.location != other.location ||
+		c.qualifiedIdentifier != other.qualifiedIdentifier ||
+		c.kind != other.kind ||
+		len(c.typeArguments) != len(other.typeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range c.typeArguments {
+		if !typeArgument.Equal(other.typeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // EmptyTypeInfo
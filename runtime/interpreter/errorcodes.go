@@ -0,0 +1,226 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Error codes of the interpreter errors.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Execution
+
+func (NotDeclaredError) ErrorCode() errors.ErrorCode {
+	return 3002
+}
+
+func (NotInvokableError) ErrorCode() errors.ErrorCode {
+	return 3003
+}
+
+func (ArgumentCountError) ErrorCode() errors.ErrorCode {
+	return 3004
+}
+
+func (TransactionNotDeclaredError) ErrorCode() errors.ErrorCode {
+	return 3005
+}
+
+func (ConditionError) ErrorCode() errors.ErrorCode {
+	return 3006
+}
+
+func (RedeclarationError) ErrorCode() errors.ErrorCode {
+	return 3007
+}
+
+func (DereferenceError) ErrorCode() errors.ErrorCode {
+	return 3008
+}
+
+func (OverflowError) ErrorCode() errors.ErrorCode {
+	return 3009
+}
+
+func (UnderflowError) ErrorCode() errors.ErrorCode {
+	return 3010
+}
+
+func (DivisionByZeroError) ErrorCode() errors.ErrorCode {
+	return 3011
+}
+
+func (DestroyedResourceError) ErrorCode() errors.ErrorCode {
+	return 3012
+}
+
+func (ForceAssignmentToNonNilResourceError) ErrorCode() errors.ErrorCode {
+	return 3013
+}
+
+func (ForceNilError) ErrorCode() errors.ErrorCode {
+	return 3014
+}
+
+func (ForceCastTypeMismatchError) ErrorCode() errors.ErrorCode {
+	return 3015
+}
+
+func (TypeMismatchError) ErrorCode() errors.ErrorCode {
+	return 3016
+}
+
+func (ArrayIndexOutOfBoundsError) ErrorCode() errors.ErrorCode {
+	return 3017
+}
+
+func (ArraySliceIndicesError) ErrorCode() errors.ErrorCode {
+	return 3018
+}
+
+func (InvalidSliceIndexError) ErrorCode() errors.ErrorCode {
+	return 3019
+}
+
+func (StringIndexOutOfBoundsError) ErrorCode() errors.ErrorCode {
+	return 3020
+}
+
+func (StringSliceIndicesError) ErrorCode() errors.ErrorCode {
+	return 3021
+}
+
+func (EventEmissionUnavailableError) ErrorCode() errors.ErrorCode {
+	return 3022
+}
+
+func (UUIDUnavailableError) ErrorCode() errors.ErrorCode {
+	return 3023
+}
+
+func (TypeLoadingError) ErrorCode() errors.ErrorCode {
+	return 3024
+}
+
+func (UseBeforeInitializationError) ErrorCode() errors.ErrorCode {
+	return 3025
+}
+
+func (InvocationArgumentTypeError) ErrorCode() errors.ErrorCode {
+	return 3026
+}
+
+func (ContainerMutationError) ErrorCode() errors.ErrorCode {
+	return 3027
+}
+
+func (InterfaceMissingLocationError) ErrorCode() errors.ErrorCode {
+	return 3028
+}
+
+func (InvalidOperandsError) ErrorCode() errors.ErrorCode {
+	return 3029
+}
+
+func (InvalidPublicKeyError) ErrorCode() errors.ErrorCode {
+	return 3030
+}
+
+func (NonTransferableValueError) ErrorCode() errors.ErrorCode {
+	return 3031
+}
+
+func (DuplicateKeyInResourceDictionaryError) ErrorCode() errors.ErrorCode {
+	return 3032
+}
+
+func (ContainerMutatedDuringIterationError) ErrorCode() errors.ErrorCode {
+	return 3033
+}
+
+func (InvalidHexByteError) ErrorCode() errors.ErrorCode {
+	return 3034
+}
+
+func (InvalidHexLengthError) ErrorCode() errors.ErrorCode {
+	return 3035
+}
+
+func (InvalidatedResourceReferenceError) ErrorCode() errors.ErrorCode {
+	return 3036
+}
+
+func (DuplicateAttachmentError) ErrorCode() errors.ErrorCode {
+	return 3037
+}
+
+func (AttachmentIterationMutationError) ErrorCode() errors.ErrorCode {
+	return 3038
+}
+
+func (RecursiveTransferError) ErrorCode() errors.ErrorCode {
+	return 3039
+}
+
+func (NestedReferenceError) ErrorCode() errors.ErrorCode {
+	return 3040
+}
+
+func (InclusiveRangeConstructionError) ErrorCode() errors.ErrorCode {
+	return 3041
+}
+
+// Storage
+
+func (InvalidPathDomainError) ErrorCode() errors.ErrorCode {
+	return 4001
+}
+
+func (OverwriteError) ErrorCode() errors.ErrorCode {
+	return 4002
+}
+
+func (NonStorableValueError) ErrorCode() errors.ErrorCode {
+	return 4003
+}
+
+func (NonStorableStaticTypeError) ErrorCode() errors.ErrorCode {
+	return 4004
+}
+
+func (StorageMutatedDuringIterationError) ErrorCode() errors.ErrorCode {
+	return 4005
+}
+
+func (InvalidStorageQueryLimitError) ErrorCode() errors.ErrorCode {
+	return 4006
+}
+
+// Capability
+
+func (CapabilityAddressPublishingError) ErrorCode() errors.ErrorCode {
+	return 5001
+}
+
+func (InvalidCapabilityIssueTypeError) ErrorCode() errors.ErrorCode {
+	return 5002
+}
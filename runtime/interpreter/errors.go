@@ -54,7 +54,7 @@ func (e *unsupportedOperation) Error() string {
 type Error struct {
 	Err        error
 	Location   common.Location
-	StackTrace []Invocation
+	StackTrace []StackFrame
 }
 
 func (e Error) Unwrap() error {
@@ -75,16 +75,11 @@ func (e Error) Error() string {
 func (e Error) ChildErrors() []error {
 	errs := make([]error, 0, 1+len(e.StackTrace))
 
-	for _, invocation := range e.StackTrace {
-		locationRange := invocation.LocationRange
-		if locationRange.Location == nil {
-			continue
-		}
-
+	for _, frame := range e.StackTrace {
 		errs = append(
 			errs,
 			StackTraceError{
-				LocationRange: locationRange,
+				StackFrame: frame,
 			},
 		)
 	}
@@ -96,10 +91,16 @@ func (e Error) ImportLocation() common.Location {
 	return e.Location
 }
 
+// StackTraceError is a frame of the stack trace of an Error
 type StackTraceError struct {
-	LocationRange
+	StackFrame
 }
 
+var _ errors.StackTraceFrame = StackTraceError{}
+var _ errors.SecondaryError = StackTraceError{}
+
+func (StackTraceError) IsStackTraceFrame() {}
+
 func (e StackTraceError) Error() string {
 	return ""
 }
@@ -108,20 +109,25 @@ func (e StackTraceError) Prefix() string {
 	return ""
 }
 
+func (e StackTraceError) SecondaryError() string {
+	if e.FunctionName == "" {
+		return ""
+	}
+	return fmt.Sprintf("call to `%s`", e.FunctionName)
+}
+
 func (e StackTraceError) ImportLocation() common.Location {
 	return e.Location
 }
 
-// PositionedError wraps an unpositioned error with position info
+// PositionedError wraps an unpositioned error with position info.
+// It is a pure wrapper: whether it is a user, internal, or external error
+// is determined by the wrapped error
 type PositionedError struct {
 	Err error
 	ast.Range
 }
 
-var _ errors.UserError = PositionedError{}
-
-func (PositionedError) IsUserError() {}
-
 func (e PositionedError) Unwrap() error {
 	return e.Err
 }
@@ -858,6 +864,23 @@ func (StorageMutatedDuringIterationError) Error() string {
 	return "storage iteration continued after modifying storage"
 }
 
+// InvalidStorageQueryLimitError
+type InvalidStorageQueryLimitError struct {
+	LocationRange
+	Limit int
+}
+
+var _ errors.UserError = InvalidStorageQueryLimitError{}
+
+func (InvalidStorageQueryLimitError) IsUserError() {}
+
+func (e InvalidStorageQueryLimitError) Error() string {
+	return fmt.Sprintf(
+		"invalid storage query limit: expected positive limit, got %d",
+		e.Limit,
+	)
+}
+
 // ContainerMutatedDuringIterationError
 type ContainerMutatedDuringIterationError struct {
 	LocationRange
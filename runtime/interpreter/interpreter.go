@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"container/heap"
 	"encoding/binary"
 	goErrors "errors"
 	"fmt"
@@ -81,6 +82,10 @@ type OnFunctionInvocationFunc func(inter *Interpreter)
 // OnInvokedFunctionReturnFunc is a function that is triggered when an invoked function returned.
 type OnInvokedFunctionReturnFunc func(inter *Interpreter)
 
+// OnStartTraceFunc is a function that is triggered when a traced operation starts.
+// The trace of the operation is recorded when the operation ends, see OnRecordTraceFunc.
+type OnStartTraceFunc func(inter *Interpreter)
+
 // OnRecordTraceFunc is a function that records a trace.
 type OnRecordTraceFunc func(
 	inter *Interpreter,
@@ -531,7 +536,7 @@ func (interpreter *Interpreter) RecoverErrors(onError func(error)) {
 		}
 
 		interpreterErr := err.(Error)
-		interpreterErr.StackTrace = interpreter.CallStack()
+		interpreterErr.StackTrace = interpreter.SharedState.callStack.Snapshot()
 
 		onError(interpreterErr)
 	}
@@ -1342,11 +1347,31 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 					)
 				}
 
-				value := NewCompositeValue(
+				// Instances of generic composite types
+				// record the inferred or explicit type arguments in their static type
+
+				var typeArguments []StaticType
+				typeParameters := compositeType.TypeParameters()
+				if len(typeParameters) > 0 {
+					typeArguments = make([]StaticType, 0, len(typeParameters))
+					for _, typeParameter := range typeParameters {
+						typeArgument, ok := invocation.TypeParameterTypes.Get(typeParameter)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+						typeArguments = append(
+							typeArguments,
+							ConvertSemaToStaticType(interpreter, typeArgument),
+						)
+					}
+				}
+
+				value := NewInstantiatedCompositeValue(
 					interpreter,
 					locationRange,
 					location,
 					qualifiedIdentifier,
+					typeArguments,
 					declaration.Kind(),
 					fields,
 					address,
@@ -1765,6 +1790,42 @@ func (interpreter *Interpreter) substituteMappedEntitlements(ty sema.Type) sema.
 	})
 }
 
+// substituteTypeArguments replaces the generic types of the type parameters
+// of the currently interpreted generic functions and composite types in the given type
+// with their type arguments
+func (interpreter *Interpreter) substituteTypeArguments(ty sema.Type) sema.Type {
+	if ty == nil {
+		return nil
+	}
+
+	return sema.SubstituteTypeParameters(
+		interpreter,
+		ty,
+		interpreter.SharedState.currentTypeArguments,
+	)
+}
+
+// substituteTypeParameterTypes substitutes the type arguments of an invocation,
+// which may refer to the type parameters of the currently interpreted generic functions
+// and composite types, see substituteTypeArguments
+func (interpreter *Interpreter) substituteTypeParameterTypes(
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+	currentTypeArguments := interpreter.SharedState.currentTypeArguments
+	if typeParameterTypes == nil ||
+		currentTypeArguments == nil ||
+		currentTypeArguments.Len() == 0 {
+
+		return typeParameterTypes
+	}
+
+	result := &sema.TypeParameterTypeOrderedMap{}
+	typeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		result.Set(typeParameter, interpreter.substituteTypeArguments(ty))
+	})
+	return result
+}
+
 func (interpreter *Interpreter) ValueIsSubtypeOfSemaType(value Value, targetType sema.Type) bool {
 	return interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), targetType)
 }
@@ -1784,6 +1845,8 @@ func (interpreter *Interpreter) transferAndConvert(
 		nil,
 	)
 
+	valueType = interpreter.substituteTypeArguments(valueType)
+	targetType = interpreter.substituteTypeArguments(targetType)
 	targetType = interpreter.substituteMappedEntitlements(targetType)
 
 	result := interpreter.ConvertAndBox(
@@ -1817,6 +1880,9 @@ func (interpreter *Interpreter) ConvertAndBox(
 	value Value,
 	valueType, targetType sema.Type,
 ) Value {
+	valueType = interpreter.substituteTypeArguments(valueType)
+	targetType = interpreter.substituteTypeArguments(targetType)
+
 	value = interpreter.convert(value, valueType, targetType, locationRange)
 	return interpreter.BoxOptional(locationRange, value, targetType)
 }
@@ -2039,6 +2105,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(interpreter, value, locationRange)
 		}
+
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(interpreter, value, locationRange)
+		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(interpreter, value, locationRange)
+		}
 	}
 
 	switch unwrappedTargetType := unwrappedTargetType.(type) {
@@ -2791,6 +2867,26 @@ var fromStringFunctionValues = func() map[string]fromStringFunctionValue {
 			val := NewUFix64Value(inter, n.Uint64)
 			return NewSomeValueNonCopying(inter, val)
 		}),
+		newFromStringFunction(sema.Fix128Type, func(inter *Interpreter, input string) OptionalValue {
+			n, err := fixedpoint.ParseFix128(input)
+			if err != nil {
+				return NilOptionalValue
+			}
+			val := NewFix128Value(inter, func() *big.Int {
+				return n
+			})
+			return NewSomeValueNonCopying(inter, val)
+		}),
+		newFromStringFunction(sema.UFix128Type, func(inter *Interpreter, input string) OptionalValue {
+			n, err := fixedpoint.ParseUFix128(input)
+			if err != nil {
+				return NilOptionalValue
+			}
+			val := NewUFix128Value(inter, func() *big.Int {
+				return n
+			})
+			return NewSomeValueNonCopying(inter, val)
+		}),
 	}
 
 	values := make(map[string]fromStringFunctionValue, len(declarations))
@@ -3015,6 +3111,16 @@ var fromBigEndianBytesFunctionValues = func() map[string]fromBigEndianBytesFunct
 				return val
 			})
 		}),
+		newFromBigEndianBytesFunction(sema.Fix128Type, 16, func(i *Interpreter, b []byte) Value {
+			return NewFix128Value(i, func() *big.Int {
+				return BigEndianBytesToSignedBigInt(b)
+			})
+		}),
+		newFromBigEndianBytesFunction(sema.UFix128Type, 16, func(i *Interpreter, b []byte) Value {
+			return NewUFix128Value(i, func() *big.Int {
+				return BigEndianBytesToUnsignedBigInt(b)
+			})
+		}),
 	}
 
 	values := make(map[string]fromBigEndianBytesFunctionValue, len(declarations))
@@ -3235,6 +3341,24 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 		min: NewUnmeteredUFix64Value(0),
 		max: NewUnmeteredUFix64Value(math.MaxUint64),
 	},
+	{
+		name:         sema.Fix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Fix128Type),
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertFix128(interpreter, value, locationRange)
+		},
+		min: NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+		max: NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+	},
+	{
+		name:         sema.UFix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.UFix128Type),
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertUFix128(interpreter, value, locationRange)
+		},
+		min: NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+		max: NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+	},
 	{
 		name:         sema.AddressTypeName,
 		functionType: sema.AddressConversionFunctionType,
@@ -3374,6 +3498,15 @@ func init() {
 			dictionaryTypeFunction,
 		))
 
+	defineBaseValue(
+		BaseActivation,
+		sema.SetTypeFunctionName,
+		NewUnmeteredHostFunctionValue(
+			sema.SetTypeFunctionType,
+			setTypeFunction,
+		),
+	)
+
 	defineBaseValue(
 		BaseActivation,
 		sema.CompositeTypeFunctionName,
@@ -3447,6 +3580,35 @@ func dictionaryTypeFunction(invocation Invocation) Value {
 	)
 }
 
+func setTypeFunction(invocation Invocation) Value {
+	elementTypeValue, ok := invocation.Arguments[0].(TypeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	elementType := elementTypeValue.Type
+
+	// if the given type is not a valid set element, it wouldn't make sense to create this type
+	if elementType == nil ||
+		!sema.IsSubType(
+			invocation.Interpreter.MustConvertStaticToSemaType(elementType),
+			sema.HashableStructType,
+		) {
+		return Nil
+	}
+
+	return NewSomeValueNonCopying(
+		invocation.Interpreter,
+		NewTypeValue(
+			invocation.Interpreter,
+			NewSetStaticType(
+				invocation.Interpreter,
+				elementType,
+			),
+		),
+	)
+}
+
 func referenceTypeFunction(invocation Invocation) Value {
 	entitlementValues, ok := invocation.Arguments[0].(*ArrayValue)
 	if !ok {
@@ -4111,6 +4273,228 @@ func (interpreter *Interpreter) newStorageIterationFunction(
 	)
 }
 
+func (interpreter *Interpreter) newStorageQueryFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		interpreter,
+		sema.Account_StorageTypeQueryFunctionType,
+		func(invocation Invocation) Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			typeValue, ok := invocation.Arguments[0].(TypeValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			subtypes, ok := invocation.Arguments[1].(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			var after string
+			switch afterValue := invocation.Arguments[2].(type) {
+			case NilValue:
+				// NO-OP
+			case *SomeValue:
+				pathValue, ok := afterValue.InnerValue(inter, locationRange).(PathValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				after = pathValue.Identifier
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			limitValue, ok := invocation.Arguments[3].(IntValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			limit := limitValue.ToInt(locationRange)
+			if limit <= 0 {
+				panic(InvalidStorageQueryLimitError{
+					Limit:         limit,
+					LocationRange: locationRange,
+				})
+			}
+
+			identifiers, more := inter.queryStorage(
+				address,
+				typeValue.Type,
+				bool(subtypes),
+				after,
+				limit,
+			)
+
+			paths := make([]Value, len(identifiers))
+			for i, identifier := range identifiers {
+				paths[i] = NewPathValue(inter, common.PathDomainStorage, identifier)
+			}
+
+			pathsValue := NewArrayValue(
+				inter,
+				locationRange,
+				NewVariableSizedStaticType(inter, PrimitiveStaticTypeStoragePath),
+				common.ZeroAddress,
+				paths...,
+			)
+
+			cursorValue := NilOptionalValue
+			if more {
+				cursorValue = NewSomeValueNonCopying(inter, paths[len(paths)-1])
+			}
+
+			return NewAccountStorageQueryResultValue(inter, pathsValue, cursorValue)
+		},
+	)
+}
+
+// queryStorage returns the identifiers of the paths in the storage domain of the given account
+// which store a value of the given type (or a subtype, if requested),
+// and which are ordered after the given identifier, if any.
+//
+// At most limit identifiers are returned, in ascending order.
+// The returned boolean indicates if there are further matching identifiers.
+//
+// Only the keys of the storage map are iterated over,
+// and values are only read for identifiers which may be part of the result.
+//
+// The storage map is ordered by the hashes of the keys, not by the identifiers,
+// so iteration cannot start at the given identifier:
+// Each query visits all keys of the storage map, so paging through all results
+// visits O(N^2 / limit) keys, for N stored values.
+// Computation is therefore reported for each visited key and for each read value.
+func (interpreter *Interpreter) queryStorage(
+	address common.Address,
+	queryType StaticType,
+	subtypes bool,
+	after string,
+	limit int,
+) (
+	identifiers []string,
+	more bool,
+) {
+	config := interpreter.SharedState.Config
+
+	storageMap := config.Storage.GetStorageMap(address, common.PathDomainStorage.Identifier(), false)
+	if storageMap == nil || queryType == nil {
+		return nil, false
+	}
+
+	var querySemaType sema.Type
+	if subtypes {
+		querySemaType = interpreter.MustConvertStaticToSemaType(queryType)
+	}
+
+	matches := func(staticType StaticType) bool {
+		if staticType.Equal(queryType) {
+			return true
+		}
+		if !subtypes {
+			return false
+		}
+
+		// Values with types which can no longer be loaded (e.g. broken contracts)
+		// never match, like they are skipped during storage iteration
+		semaType, err := interpreter.ConvertStaticToSemaType(staticType)
+		if err != nil {
+			return false
+		}
+
+		return sema.IsSubType(semaType, querySemaType)
+	}
+
+	// The result is a max-heap of the smallest matching identifiers,
+	// so the largest identifier can be replaced when a smaller match is found
+
+	result := &storageQueryResultHeap{}
+
+	storageIterator := storageMap.Iterator(interpreter)
+
+	for key := storageIterator.NextKey(); key != nil; key = storageIterator.NextKey() {
+
+		interpreter.ReportComputation(common.ComputationKindStorageQueryKey, 1)
+
+		identifier := string(key.(StringAtreeValue))
+
+		if after != "" && identifier <= after {
+			continue
+		}
+
+		full := result.Len() >= limit
+
+		// If the result is full and further matches are already known to exist,
+		// only identifiers which are smaller than the largest result identifier are relevant
+
+		if full && more && identifier > result.Max() {
+			continue
+		}
+
+		interpreter.ReportComputation(common.ComputationKindStorageQueryValueRead, 1)
+
+		value := storageMap.ReadValue(interpreter, StringStorageMapKey(identifier))
+		if value == nil || !matches(value.StaticType(interpreter)) {
+			continue
+		}
+
+		if !full {
+			heap.Push(result, identifier)
+			continue
+		}
+
+		more = true
+
+		if identifier < result.Max() {
+			result.identifiers[0] = identifier
+			heap.Fix(result, 0)
+		}
+	}
+
+	identifiers = result.identifiers
+	sort.Strings(identifiers)
+
+	return identifiers, more
+}
+
+// storageQueryResultHeap is a max-heap of storage path identifiers
+type storageQueryResultHeap struct {
+	identifiers []string
+}
+
+var _ heap.Interface = &storageQueryResultHeap{}
+
+func (h *storageQueryResultHeap) Len() int {
+	return len(h.identifiers)
+}
+
+func (h *storageQueryResultHeap) Less(i, j int) bool {
+	return h.identifiers[i] > h.identifiers[j]
+}
+
+func (h *storageQueryResultHeap) Swap(i, j int) {
+	h.identifiers[i], h.identifiers[j] = h.identifiers[j], h.identifiers[i]
+}
+
+func (h *storageQueryResultHeap) Push(identifier any) {
+	h.identifiers = append(h.identifiers, identifier.(string))
+}
+
+func (h *storageQueryResultHeap) Pop() any {
+	lastIndex := len(h.identifiers) - 1
+	identifier := h.identifiers[lastIndex]
+	h.identifiers = h.identifiers[:lastIndex]
+	return identifier
+}
+
+// Max returns the largest identifier of the heap
+func (h *storageQueryResultHeap) Max() string {
+	return h.identifiers[0]
+}
+
 func (interpreter *Interpreter) checkValue(
 	value Value,
 	staticType StaticType,
@@ -4906,6 +5290,12 @@ func (interpreter *Interpreter) getMemberWithAuthMapping(
 	return interpreter.mapMemberValueAuthorization(self, memberAccess, result, memberAccessInfo.ResultingType, locationRange)
 }
 
+// GetMember gets the member value by the given identifier from the given Value depending on its type.
+// May return nil if the member does not exist.
+func (interpreter *Interpreter) GetMember(self Value, locationRange LocationRange, identifier string) Value {
+	return interpreter.getMember(self, locationRange, identifier)
+}
+
 // getMember gets the member value by the given identifier from the given Value depending on its type.
 // May return nil if the member does not exist.
 func (interpreter *Interpreter) getMember(self Value, locationRange LocationRange, identifier string) Value {
@@ -5048,6 +5438,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 			return info.Equal(other.(StaticType))
 		case *DictionaryStaticType:
 			return info.Equal(other.(StaticType))
+		case *SetStaticType:
+			return info.Equal(other.(StaticType))
 		case compositeTypeInfo:
 			return info.Equal(other)
 		case EmptyTypeInfo:
@@ -20,6 +20,7 @@ package interpreter
 
 import (
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/onflow/atree"
@@ -309,7 +310,7 @@ func (interpreter *Interpreter) checkMemberAccess(
 	locationRange LocationRange,
 ) {
 	memberInfo, _ := interpreter.Program.Elaboration.MemberExpressionMemberAccessInfo(memberExpression)
-	expectedType := memberInfo.AccessedType
+	expectedType := interpreter.substituteTypeArguments(memberInfo.AccessedType)
 
 	switch expectedType := expectedType.(type) {
 	case *sema.TransactionType:
@@ -853,23 +854,27 @@ func (interpreter *Interpreter) NewIntegerValueFromBigInt(value *big.Int, intege
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) Value {
-	// TODO: adjust once/if we support more fixed point types
-
 	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression(expression)
 
-	value := fixedpoint.ConvertToFixedPointBigInt(
-		expression.Negative,
-		expression.UnsignedInteger,
-		expression.Fractional,
-		expression.Scale,
-		sema.Fix64Scale,
-	)
 	switch fixedPointSubType {
 	case sema.Fix64Type, sema.SignedFixedPointType:
+		value := fixedPointLiteralBigInt(expression, sema.Fix64Scale)
 		return NewFix64Value(interpreter, value.Int64)
 	case sema.UFix64Type:
+		value := fixedPointLiteralBigInt(expression, sema.Fix64Scale)
 		return NewUFix64Value(interpreter, value.Uint64)
+	case sema.Fix128Type:
+		value := fixedPointLiteralBigInt(expression, sema.Fix128Scale)
+		return NewFix128Value(interpreter, func() *big.Int {
+			return value
+		})
+	case sema.UFix128Type:
+		value := fixedPointLiteralBigInt(expression, sema.Fix128Scale)
+		return NewUFix128Value(interpreter, func() *big.Int {
+			return value
+		})
 	case sema.FixedPointType:
+		value := fixedPointLiteralBigInt(expression, sema.Fix64Scale)
 		if expression.Negative {
 			return NewFix64Value(interpreter, value.Int64)
 		} else {
@@ -880,6 +885,16 @@ func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedP
 	}
 }
 
+func fixedPointLiteralBigInt(expression *ast.FixedPointExpression, targetScale uint) *big.Int {
+	return fixedpoint.ConvertToFixedPointBigInt(
+		expression.Negative,
+		expression.UnsignedInteger,
+		expression.Fractional,
+		expression.Scale,
+		targetScale,
+	)
+}
+
 func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpression) Value {
 	stringType := interpreter.Program.Elaboration.StringExpressionType(expression)
 
@@ -892,12 +907,74 @@ func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpr
 	return NewUnmeteredStringValue(expression.Value)
 }
 
+func (interpreter *Interpreter) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) Value {
+
+	values := interpreter.visitExpressionsNonCopying(expression.Expressions)
+
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: expression,
+	}
+
+	length := 0
+	for _, value := range expression.Values {
+		length = safeAdd(length, len(value), locationRange)
+	}
+
+	interpolatedStrings := make([]string, len(values))
+	for i, value := range values {
+		interpolatedString := interpreter.stringTemplateValueString(value)
+		interpolatedStrings[i] = interpolatedString
+		length = safeAdd(length, len(interpolatedString), locationRange)
+	}
+
+	// NOTE: the result is metered like a concatenation of all parts
+
+	memoryUsage := common.NewStringMemoryUsage(length)
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			var builder strings.Builder
+			builder.Grow(length)
+
+			for i, value := range expression.Values {
+				builder.WriteString(value)
+				if i < len(interpolatedStrings) {
+					builder.WriteString(interpolatedStrings[i])
+				}
+			}
+
+			return builder.String()
+		},
+	)
+}
+
+// stringTemplateValueString returns the string representation
+// of a value interpolated in a string template,
+// i.e. the result of `toString` for values other than strings and characters
+func (interpreter *Interpreter) stringTemplateValueString(value Value) string {
+	switch value := value.(type) {
+	case *StringValue:
+		return value.Str
+
+	case CharacterValue:
+		return value.Str
+
+	case NumberValue, AddressValue, PathValue:
+		return value.MeteredString(interpreter, SeenReferences{})
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) Value {
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
 	arrayExpressionTypes := interpreter.Program.Elaboration.ArrayExpressionTypes(expression)
 	argumentTypes := arrayExpressionTypes.ArgumentTypes
-	arrayType := arrayExpressionTypes.ArrayType
+	arrayType := interpreter.substituteTypeArguments(arrayExpressionTypes.ArrayType).(sema.ArrayType)
 	elementType := arrayType.ElementType(false)
 
 	var copies []Value
@@ -938,7 +1015,7 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 
 	dictionaryExpressionTypes := interpreter.Program.Elaboration.DictionaryExpressionTypes(expression)
 	entryTypes := dictionaryExpressionTypes.EntryTypes
-	dictionaryType := dictionaryExpressionTypes.DictionaryType
+	dictionaryType := interpreter.substituteTypeArguments(dictionaryExpressionTypes.DictionaryType).(*sema.DictionaryType)
 
 	var keyValuePairs []Value
 
@@ -1065,11 +1142,12 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 
 	// tracing
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 		invokedExpression := invocationExpression.InvokedExpression.String()
 		defer func() {
 			interpreter.reportFunctionTrace(
 				invokedExpression,
+				invocationExpression.StartPosition(),
 				time.Since(startTime),
 			)
 		}()
@@ -1131,7 +1209,7 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 
 	invocationExpressionTypes := elaboration.InvocationExpressionTypes(invocationExpression)
 
-	typeParameterTypes := invocationExpressionTypes.TypeArguments
+	typeParameterTypes := interpreter.substituteTypeParameterTypes(invocationExpressionTypes.TypeArguments)
 	argumentTypes := invocationExpressionTypes.ArgumentTypes
 	parameterTypes := invocationExpressionTypes.TypeParameterTypes
 
@@ -1248,7 +1326,11 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 		HasPosition: expression.Expression,
 	}
 
-	expectedType := interpreter.substituteMappedEntitlements(interpreter.Program.Elaboration.CastingExpressionTypes(expression).TargetType)
+	expectedType := interpreter.substituteMappedEntitlements(
+		interpreter.substituteTypeArguments(
+			interpreter.Program.Elaboration.CastingExpressionTypes(expression).TargetType,
+		),
+	)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
@@ -1331,7 +1413,9 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) Value {
 
-	borrowType := interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression)
+	borrowType := interpreter.substituteTypeArguments(
+		interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression),
+	)
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
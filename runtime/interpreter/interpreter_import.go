@@ -42,7 +42,7 @@ func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.Res
 
 	// tracing
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 		defer func() {
 			interpreter.reportImportTrace(
 				resolvedLocation.Location.String(),
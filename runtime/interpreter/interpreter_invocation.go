@@ -153,9 +153,68 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 		}()
 	}
 
+	oldTypeArguments := interpreter.SharedState.currentTypeArguments
+	interpreter.SharedState.currentTypeArguments = interpreter.invocationTypeArguments(function, invocation)
+	defer func() {
+		interpreter.SharedState.currentTypeArguments = oldTypeArguments
+	}()
+
 	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments, invocation.LocationRange)
 }
 
+// invocationTypeArguments returns the type arguments for the type parameters
+// which may occur in the body of the given interpreted function:
+// The type arguments of the enclosing generic functions at the time the function was created,
+// the type arguments of the invocation, and the type arguments of the generic composite type of `self`
+func (interpreter *Interpreter) invocationTypeArguments(
+	function *InterpretedFunctionValue,
+	invocation Invocation,
+) *sema.TypeParameterTypeOrderedMap {
+
+	var selfTypeParameters []*sema.TypeParameter
+	var selfTypeArguments []StaticType
+	if invocation.Self != nil {
+		if compositeValue, ok := (*invocation.Self).(*CompositeValue); ok {
+			selfTypeArguments = compositeValue.TypeArguments()
+			if len(selfTypeArguments) > 0 {
+				compositeType := interpreter.MustSemaTypeOfValue(compositeValue).(*sema.CompositeType)
+				selfTypeParameters = compositeType.TypeParameters()
+			}
+		}
+	}
+
+	invocationTypeParameterTypes := invocation.TypeParameterTypes
+
+	if len(selfTypeArguments) == 0 &&
+		(invocationTypeParameterTypes == nil || invocationTypeParameterTypes.Len() == 0) {
+
+		return function.typeArguments
+	}
+
+	typeArguments := &sema.TypeParameterTypeOrderedMap{}
+
+	if function.typeArguments != nil {
+		function.typeArguments.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+			typeArguments.Set(typeParameter, ty)
+		})
+	}
+
+	for i, typeParameter := range selfTypeParameters {
+		typeArguments.Set(
+			typeParameter,
+			interpreter.MustConvertStaticToSemaType(selfTypeArguments[i]),
+		)
+	}
+
+	if invocationTypeParameterTypes != nil {
+		invocationTypeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+			typeArguments.Set(typeParameter, ty)
+		})
+	}
+
+	return typeArguments
+}
+
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
 func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	function *InterpretedFunctionValue,
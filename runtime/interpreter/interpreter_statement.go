@@ -178,6 +178,37 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 	return nil
 }
 
+func (interpreter *Interpreter) VisitGuardStatement(statement *ast.GuardStatement) StatementResult {
+
+	declaration := statement.Test
+
+	value := interpreter.visitVariableDeclaration(declaration, true)
+
+	if someValue, ok := value.(*SomeValue); ok {
+		locationRange := LocationRange{
+			Location:    interpreter.Location,
+			HasPosition: declaration.Value,
+		}
+
+		innerValue := someValue.InnerValue(interpreter, locationRange)
+
+		// NOTE: the variable is declared in the current activation,
+		// so it remains available after the statement
+
+		interpreter.declareVariable(
+			declaration.Identifier.Identifier,
+			innerValue,
+		)
+
+		return nil
+	}
+
+	// The checker ensures that the else-block does not fall through,
+	// i.e. the result is a return, break, or continue
+
+	return interpreter.visitBlock(statement.Else)
+}
+
 func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) StatementResult {
 
 	testValue, ok := interpreter.evalExpression(switchStatement.Expression).(EquatableValue)
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/onflow/cadence/runtime/ast"
 )
 
 const (
@@ -47,9 +49,32 @@ const (
 	tracingRemoveMemberPrefix = "removeMember."
 )
 
-func (interpreter *Interpreter) reportFunctionTrace(functionName string, duration time.Duration) {
+// startTrace reports that a traced operation starts and returns its start time.
+// Every started trace must be ended by recording it, even if the operation fails
+func (interpreter *Interpreter) startTrace() time.Time {
 	config := interpreter.SharedState.Config
-	config.OnRecordTrace(interpreter, tracingFunctionPrefix+functionName, duration, nil)
+	onStartTrace := config.OnStartTrace
+	if onStartTrace != nil {
+		onStartTrace(interpreter)
+	}
+	return time.Now()
+}
+
+func (interpreter *Interpreter) reportFunctionTrace(
+	functionName string,
+	position ast.Position,
+	duration time.Duration,
+) {
+	config := interpreter.SharedState.Config
+	config.OnRecordTrace(
+		interpreter,
+		tracingFunctionPrefix+functionName,
+		duration,
+		[]attribute.KeyValue{
+			attribute.Int("line", position.Line),
+			attribute.Int("column", position.Column),
+		},
+	)
 }
 
 func (interpreter *Interpreter) reportImportTrace(importPath string, duration time.Duration) {
@@ -166,4 +166,80 @@ func TestInterpreterTracing(t *testing.T) {
 		require.Equal(t, traceOps[6], "composite.transfer")
 		require.Equal(t, traceOps[7], "array.construct")
 	})
+
+	t.Run("started traces", func(t *testing.T) {
+
+		var traceOps []string
+		started := 0
+		open := 0
+
+		storage := newUnmeteredInMemoryStorage()
+		inter, err := interpreter.NewInterpreter(
+			nil,
+			utils.TestLocation,
+			&interpreter.Config{
+				OnStartTrace: func(_ *interpreter.Interpreter) {
+					started++
+					open++
+				},
+				OnRecordTrace: func(
+					_ *interpreter.Interpreter,
+					operationName string,
+					_ time.Duration,
+					_ []attribute.KeyValue,
+				) {
+					// Every recorded trace was started
+					require.Positive(t, open)
+					open--
+					traceOps = append(traceOps, operationName)
+				},
+				Storage:        storage,
+				TracingEnabled: true,
+			},
+		)
+		require.NoError(t, err)
+
+		owner := common.Address{0x1}
+
+		value := newTestCompositeValue(inter, owner)
+		require.Equal(t, 1, started)
+		require.Equal(t, 0, open)
+
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeAnyStruct,
+			},
+			common.ZeroAddress,
+			value,
+		)
+		require.Equal(t, 3, started)
+		require.Equal(t, 0, open)
+		require.Equal(t,
+			[]string{
+				"composite.construct",
+				"composite.transfer",
+				"array.construct",
+			},
+			traceOps,
+		)
+
+		// The trace of a failed construction is recorded, too
+
+		require.Panics(t, func() {
+			interpreter.NewDictionaryValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.DictionaryStaticType{
+					KeyType:   interpreter.PrimitiveStaticTypeString,
+					ValueType: interpreter.PrimitiveStaticTypeInt,
+				},
+				interpreter.NewUnmeteredStringValue("test"),
+			)
+		})
+		require.Equal(t, 4, started)
+		require.Equal(t, 0, open)
+		require.Equal(t, "dictionary.construct", traceOps[3])
+	})
 }
@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -73,3 +74,58 @@ func (i *CallStack) Pop() {
 	i.Invocations[depth-1] = Invocation{}
 	i.Invocations = i.Invocations[:depth-1]
 }
+
+// Snapshot returns the stack frames of the invocations in the call stack,
+// from the outermost to the innermost invocation.
+// Invocations without location information are skipped.
+func (i *CallStack) Snapshot() []StackFrame {
+	var frames []StackFrame
+
+	for _, invocation := range i.Invocations {
+		locationRange := invocation.LocationRange
+		if locationRange.Location == nil || locationRange.HasPosition == nil {
+			continue
+		}
+
+		frames = append(
+			frames,
+			StackFrame{
+				FunctionName: invokedFunctionName(locationRange.HasPosition),
+				Location:     locationRange.Location,
+				Range:        ast.NewUnmeteredRangeFromPositioned(locationRange.HasPosition),
+			},
+		)
+	}
+
+	return frames
+}
+
+// StackFrame is a snapshot of an invocation in the call stack.
+type StackFrame struct {
+	// FunctionName is the name of the invoked function, if known
+	FunctionName string
+	// Location and Range are the location and source range of the invocation
+	Location common.Location
+	ast.Range
+}
+
+func invokedFunctionName(position ast.HasPosition) string {
+	invocationExpression, ok := position.(*ast.InvocationExpression)
+	if !ok {
+		return ""
+	}
+
+	switch invokedExpression := invocationExpression.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		return invokedExpression.Identifier.Identifier
+
+	case *ast.MemberExpression:
+		member := invokedExpression.Identifier.Identifier
+		if receiver, ok := invokedExpression.Expression.(*ast.IdentifierExpression); ok {
+			return receiver.Identifier.Identifier + "." + member
+		}
+		return member
+	}
+
+	return ""
+}
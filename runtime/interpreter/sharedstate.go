@@ -46,6 +46,9 @@ type SharedState struct {
 	containerValueIteration                     map[atree.StorageID]struct{}
 	destroyedResources                          map[atree.StorageID]struct{}
 	currentEntitlementMappedValue               Authorization
+	// currentTypeArguments are the type arguments for the type parameters
+	// of the currently interpreted generic functions and composite types
+	currentTypeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewSharedState(config *Config) *SharedState {
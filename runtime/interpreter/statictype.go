@@ -60,6 +60,8 @@ type CompositeStaticType struct {
 	Location            common.Location
 	QualifiedIdentifier string
 	TypeID              TypeID
+	// TypeArguments are the type arguments of an instantiation of a generic composite type
+	TypeArguments []StaticType
 }
 
 var _ StaticType = &CompositeStaticType{}
@@ -102,6 +104,54 @@ func NewCompositeStaticTypeComputeTypeID(
 	)
 }
 
+// NewInstantiatedCompositeStaticType returns the static type
+// of an instantiation of a generic composite type with the given type arguments.
+// The type ID of the instantiation consists of the type ID of the generic composite type
+// and the type IDs of the type arguments, e.g. `A.0000000000000001.Box<Int>`
+func NewInstantiatedCompositeStaticType(
+	memoryGauge common.MemoryGauge,
+	location common.Location,
+	qualifiedIdentifier string,
+	typeArguments []StaticType,
+) *CompositeStaticType {
+	if len(typeArguments) == 0 {
+		return NewCompositeStaticTypeComputeTypeID(
+			memoryGauge,
+			location,
+			qualifiedIdentifier,
+		)
+	}
+
+	baseTypeID := common.NewTypeIDFromQualifiedName(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+	)
+
+	var builder strings.Builder
+	builder.WriteString(string(baseTypeID))
+	builder.WriteByte('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(string(typeArgument.ID()))
+	}
+	builder.WriteByte('>')
+
+	typeID := TypeID(builder.String())
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(typeID)))
+
+	staticType := NewCompositeStaticType(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+		typeID,
+	)
+	staticType.TypeArguments = typeArguments
+	return staticType
+}
+
 func (*CompositeStaticType) isStaticType() {}
 
 func (*CompositeStaticType) elementSize() uint {
@@ -469,6 +519,61 @@ func (t *DictionaryStaticType) IsDeprecated() bool {
 		t.ValueType.IsDeprecated()
 }
 
+// SetStaticType
+
+type SetStaticType struct {
+	ElementType StaticType
+}
+
+var _ StaticType = &SetStaticType{}
+var _ atree.TypeInfo = &SetStaticType{}
+
+func NewSetStaticType(
+	memoryGauge common.MemoryGauge,
+	elementType StaticType,
+) *SetStaticType {
+	common.UseMemory(memoryGauge, common.SetStaticTypeMemoryUsage)
+
+	return &SetStaticType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetStaticType) isStaticType() {}
+
+func (*SetStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t *SetStaticType) String() string {
+	return t.MeteredString(nil)
+}
+
+func (t *SetStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.SetStaticTypeStringMemoryUsage)
+
+	elementStr := t.ElementType.MeteredString(memoryGauge)
+
+	return fmt.Sprintf("Set<%s>", elementStr)
+}
+
+func (t *SetStaticType) Equal(other StaticType) bool {
+	otherSetType, ok := other.(*SetStaticType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherSetType.ElementType)
+}
+
+func (t *SetStaticType) ID() TypeID {
+	return sema.SetTypeID(string(t.ElementType.ID()))
+}
+
+func (t *SetStaticType) IsDeprecated() bool {
+	return t.ElementType.IsDeprecated()
+}
+
 // OptionalStaticType
 
 type OptionalStaticType struct {
@@ -993,6 +1098,9 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 		memberType := ConvertSemaToStaticType(memoryGauge, t.MemberType)
 		return NewInclusiveRangeStaticType(memoryGauge, memberType)
 
+	case *sema.SetType:
+		return ConvertSemaSetTypeToStaticSetType(memoryGauge, t)
+
 	case *sema.FunctionType:
 		return NewFunctionStaticType(memoryGauge, t)
 	}
@@ -1032,6 +1140,16 @@ func ConvertSemaDictionaryTypeToStaticDictionaryType(
 	)
 }
 
+func ConvertSemaSetTypeToStaticSetType(
+	memoryGauge common.MemoryGauge,
+	t *sema.SetType,
+) *SetStaticType {
+	return NewSetStaticType(
+		memoryGauge,
+		ConvertSemaToStaticType(memoryGauge, t.ElementType),
+	)
+}
+
 func ConvertSemaAccessToStaticAuthorization(
 	memoryGauge common.MemoryGauge,
 	access sema.Access,
@@ -1083,6 +1201,24 @@ func ConvertSemaCompositeTypeToStaticCompositeType(
 	memoryGauge common.MemoryGauge,
 	t *sema.CompositeType,
 ) *CompositeStaticType {
+	semaTypeArguments := t.TypeArguments()
+	if len(semaTypeArguments) > 0 && t.BaseType() != nil {
+		typeArguments := make([]StaticType, 0, len(semaTypeArguments))
+		for _, typeArgument := range semaTypeArguments {
+			typeArguments = append(
+				typeArguments,
+				ConvertSemaToStaticType(memoryGauge, typeArgument),
+			)
+		}
+
+		return NewInstantiatedCompositeStaticType(
+			memoryGauge,
+			t.Location,
+			t.QualifiedIdentifier(),
+			typeArguments,
+		)
+	}
+
 	return NewCompositeStaticType(
 		memoryGauge,
 		t.Location,
@@ -1148,7 +1284,51 @@ func ConvertStaticToSemaType(
 ) (_ sema.Type, err error) {
 	switch t := typ.(type) {
 	case *CompositeStaticType:
-		return getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		if len(t.TypeArguments) == 0 {
+			return getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		}
+
+		// The generic composite type is looked up by its type ID,
+		// and then instantiated with the converted type arguments
+
+		baseTypeID := common.NewTypeIDFromQualifiedName(
+			memoryGauge,
+			t.Location,
+			t.QualifiedIdentifier,
+		)
+
+		compositeType, err := getComposite(t.Location, t.QualifiedIdentifier, baseTypeID)
+		if err != nil {
+			return nil, err
+		}
+
+		typeParameterCount := len(compositeType.TypeParameters())
+		if typeParameterCount != len(t.TypeArguments) {
+			return nil, errors.NewUnexpectedError(
+				"invalid type arguments for %s: expected %d, got %d",
+				t.QualifiedIdentifier,
+				typeParameterCount,
+				len(t.TypeArguments),
+			)
+		}
+
+		typeArguments := make([]sema.Type, 0, len(t.TypeArguments))
+		for _, typeArgument := range t.TypeArguments {
+			convertedTypeArgument, err := ConvertStaticToSemaType(
+				memoryGauge,
+				typeArgument,
+				getInterface,
+				getComposite,
+				getEntitlement,
+				getEntitlementMapType,
+			)
+			if err != nil {
+				return nil, err
+			}
+			typeArguments = append(typeArguments, convertedTypeArgument)
+		}
+
+		return compositeType.Instantiate(memoryGauge, typeArguments, nil, nil), nil
 
 	case *InterfaceStaticType:
 		return getInterface(t.Location, t.QualifiedIdentifier, t.TypeID)
@@ -1235,6 +1415,24 @@ func ConvertStaticToSemaType(
 			elementType,
 		), nil
 
+	case *SetStaticType:
+		elementType, err := ConvertStaticToSemaType(
+			memoryGauge,
+			t.ElementType,
+			getInterface,
+			getComposite,
+			getEntitlement,
+			getEntitlementMapType,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return sema.NewSetType(
+			memoryGauge,
+			elementType,
+		), nil
+
 	case *OptionalStaticType:
 		ty, err := ConvertStaticToSemaType(
 			memoryGauge,
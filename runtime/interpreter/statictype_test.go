@@ -738,6 +738,57 @@ func TestInclusiveRangeStaticType_Equal(t *testing.T) {
 	})
 }
 
+func TestSetStaticType_Equal(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("equal", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.True(t,
+			(&SetStaticType{
+				ElementType: PrimitiveStaticTypeString,
+			}).Equal(
+				&SetStaticType{
+					ElementType: PrimitiveStaticTypeString,
+				},
+			),
+		)
+	})
+
+	t.Run("different element types", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.False(t,
+			(&SetStaticType{
+				ElementType: PrimitiveStaticTypeInt,
+			}).Equal(
+				&SetStaticType{
+					ElementType: PrimitiveStaticTypeString,
+				},
+			),
+		)
+	})
+
+	t.Run("different kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.False(t,
+			(&SetStaticType{
+				ElementType: PrimitiveStaticTypeInt,
+			}).Equal(
+				&DictionaryStaticType{
+					KeyType:   PrimitiveStaticTypeInt,
+					ValueType: PrimitiveStaticTypeBool,
+				},
+			),
+		)
+	})
+}
+
 func TestIntersectionStaticType_Equal(t *testing.T) {
 
 	t.Parallel()
@@ -1203,6 +1254,18 @@ func TestStaticTypeConversion(t *testing.T) {
 			staticType: PrimitiveStaticTypeUFix64,
 		},
 
+		{
+			name:       "Fix128",
+			semaType:   sema.Fix128Type,
+			staticType: PrimitiveStaticTypeFix128,
+		},
+
+		{
+			name:       "UFix128",
+			semaType:   sema.UFix128Type,
+			staticType: PrimitiveStaticTypeUFix128,
+		},
+
 		{
 			name:       "Path",
 			semaType:   sema.PathType,
@@ -1611,6 +1674,15 @@ func TestStaticTypeConversion(t *testing.T) {
 				ElementType: PrimitiveStaticTypeInt,
 			},
 		},
+		{
+			name: "Set",
+			semaType: &sema.SetType{
+				ElementType: sema.StringType,
+			},
+			staticType: &SetStaticType{
+				ElementType: PrimitiveStaticTypeString,
+			},
+		},
 		// Deprecated primitive static types, only exist for migration purposes
 		{
 			name:           "AuthAccount",
@@ -2407,6 +2479,14 @@ func TestStaticType_IsDeprecated(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Set",
+			genTy: func(innerType PrimitiveStaticType) StaticType {
+				return &SetStaticType{
+					ElementType: innerType,
+				}
+			},
+		},
 	}
 
 	test := func(test testCase) {
@@ -492,6 +492,63 @@ func TestDictionaryStorage(t *testing.T) {
 	})
 }
 
+func TestSetStorage(t *testing.T) {
+
+	t.Parallel()
+
+	storage := newUnmeteredInMemoryStorage()
+
+	inter, err := NewInterpreter(
+		nil,
+		common.AddressLocation{},
+		&Config{Storage: storage},
+	)
+	require.NoError(t, err)
+
+	setType := &SetStaticType{
+		ElementType: PrimitiveStaticTypeString,
+	}
+
+	value := NewSetValue(
+		inter,
+		EmptyLocationRange,
+		setType,
+		NewUnmeteredStringValue("a"),
+	)
+
+	require.NotEqual(t, atree.StorageIDUndefined, value.StorageID())
+
+	require.Equal(t, 1, storage.BasicSlabStorage.Count())
+
+	inserted := value.Insert(inter, EmptyLocationRange, NewUnmeteredStringValue("b"))
+	require.Equal(t, TrueValue, inserted)
+
+	inserted = value.Insert(inter, EmptyLocationRange, NewUnmeteredStringValue("a"))
+	require.Equal(t, FalseValue, inserted)
+
+	require.Equal(t, 1, storage.BasicSlabStorage.Count())
+
+	retrievedStorable, ok, err := storage.BasicSlabStorage.Retrieve(value.StorageID())
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	storedValue := StoredValue(inter, retrievedStorable, storage)
+
+	require.IsType(t, storedValue, &SetValue{})
+	storedSet := storedValue.(*SetValue)
+
+	assert.Equal(t, setType, storedSet.Type)
+	assert.Equal(t, 2, storedSet.Count())
+	assert.Equal(t,
+		TrueValue,
+		storedSet.Contains(inter, EmptyLocationRange, NewUnmeteredStringValue("b")),
+	)
+	assert.Equal(t,
+		FalseValue,
+		storedSet.Contains(inter, EmptyLocationRange, NewUnmeteredStringValue("c")),
+	)
+}
+
 func TestInterpretStorageOverwriteAndRemove(t *testing.T) {
 
 	t.Parallel()
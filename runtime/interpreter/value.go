@@ -1399,6 +1399,127 @@ func (v *StringValue) GetMember(interpreter *Interpreter, locationRange Location
 				return v.ReplaceAll(invocation.Interpreter, invocation.LocationRange, of.Str, with.Str)
 			},
 		)
+
+	case sema.StringTypeToUpperFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeToUpperFunctionType,
+			func(invocation Invocation) Value {
+				return v.ToUpper(invocation.Interpreter)
+			},
+		)
+
+	case sema.StringTypeContainsFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeContainsFunctionType,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Contains(invocation.Interpreter, other)
+			},
+		)
+
+	case sema.StringTypeIndexFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeIndexFunctionType,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.IndexOf(invocation.Interpreter, other)
+			},
+		)
+
+	case sema.StringTypeCountFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeCountFunctionType,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Count(invocation.Interpreter, other)
+			},
+		)
+
+	case sema.StringTypeStartsWithFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeStartsWithFunctionType,
+			func(invocation Invocation) Value {
+				prefix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.StartsWith(invocation.Interpreter, prefix)
+			},
+		)
+
+	case sema.StringTypeEndsWithFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeEndsWithFunctionType,
+			func(invocation Invocation) Value {
+				suffix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.EndsWith(invocation.Interpreter, suffix)
+			},
+		)
+
+	case sema.StringTypeTrimFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.StringTypeTrimFunctionType,
+			func(invocation Invocation) Value {
+				return v.Trim(invocation.Interpreter)
+			},
+		)
+
+	case sema.StringTypePadStartFunctionName,
+		sema.StringTypePadEndFunctionName:
+
+		functionType := sema.StringTypePadStartFunctionType
+		atStart := name == sema.StringTypePadStartFunctionName
+		if !atStart {
+			functionType = sema.StringTypePadEndFunctionType
+		}
+
+		return NewHostFunctionValue(
+			interpreter,
+			functionType,
+			func(invocation Invocation) Value {
+				toLength, ok := invocation.Arguments[0].(IntValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				with, ok := invocation.Arguments[1].(CharacterValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Pad(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					toLength,
+					with,
+					atStart,
+				)
+			},
+		)
 	}
 
 	return nil
@@ -1427,7 +1548,9 @@ func (v *StringValue) Length() int {
 	return v.length
 }
 
-func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
+// caseMappingLengthEstimate over-estimates the length of the string
+// after mapping its letters to a different case.
+func (v *StringValue) caseMappingLengthEstimate() int {
 
 	// Over-estimate resulting string length,
 	// as an uppercase character may be converted to several lower-case characters, e.g İ => [i, ̇]
@@ -1442,7 +1565,12 @@ func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
 		}
 	}
 
-	memoryUsage := common.NewStringMemoryUsage(lengthEstimate)
+	return lengthEstimate
+}
+
+func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
+
+	memoryUsage := common.NewStringMemoryUsage(v.caseMappingLengthEstimate())
 
 	return NewStringValue(
 		interpreter,
@@ -1453,6 +1581,237 @@ func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
 	)
 }
 
+func (v *StringValue) ToUpper(interpreter *Interpreter) *StringValue {
+
+	memoryUsage := common.NewStringMemoryUsage(v.caseMappingLengthEstimate())
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			return strings.ToUpper(v.Str)
+		},
+	)
+}
+
+// isGraphemeBoundary returns true if the given byte offset of the string
+// is a grapheme cluster boundary.
+// The search for the boundary starts at the given byte offset start,
+// which must be a grapheme cluster boundary itself.
+func (v *StringValue) isGraphemeBoundary(interpreter *Interpreter, start int, offset int) bool {
+	position := start
+	rest := v.Str[start:]
+	state := -1
+
+	for position < offset {
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		position += len(cluster)
+	}
+
+	return position == offset
+}
+
+// search calls the given function for each non-overlapping occurrence of the given string,
+// with the character index of the start of the occurrence,
+// until the function returns false.
+//
+// Occurrences must start and end on grapheme cluster boundaries.
+// An empty string occurs at every grapheme cluster boundary,
+// including the start and the end of the string.
+func (v *StringValue) search(
+	interpreter *Interpreter,
+	other string,
+	f func(index int) (resume bool),
+) {
+	v.prepareGraphemes()
+
+	index := 0
+	searchStart := 0
+
+	for {
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		hasNext := v.graphemes.Next()
+
+		start := len(v.Str)
+		if hasNext {
+			start, _ = v.graphemes.Positions()
+		}
+
+		if start >= searchStart && strings.HasPrefix(v.Str[start:], other) {
+			end := start + len(other)
+			if v.isGraphemeBoundary(interpreter, start, end) {
+				if !f(index) {
+					return
+				}
+				searchStart = end
+			}
+		}
+
+		if !hasNext {
+			return
+		}
+
+		index++
+	}
+}
+
+func (v *StringValue) Contains(interpreter *Interpreter, other *StringValue) BoolValue {
+	var found bool
+	v.search(
+		interpreter,
+		other.Str,
+		func(_ int) bool {
+			found = true
+			return false
+		},
+	)
+	return AsBoolValue(found)
+}
+
+func (v *StringValue) IndexOf(interpreter *Interpreter, other *StringValue) OptionalValue {
+	result := -1
+	v.search(
+		interpreter,
+		other.Str,
+		func(index int) bool {
+			result = index
+			return false
+		},
+	)
+
+	if result < 0 {
+		return NilOptionalValue
+	}
+
+	return NewSomeValueNonCopying(
+		interpreter,
+		NewIntValueFromInt64(interpreter, int64(result)),
+	)
+}
+
+func (v *StringValue) Count(interpreter *Interpreter, other *StringValue) IntValue {
+	var count int64
+	v.search(
+		interpreter,
+		other.Str,
+		func(_ int) bool {
+			count++
+			return true
+		},
+	)
+	return NewIntValueFromInt64(interpreter, count)
+}
+
+func (v *StringValue) StartsWith(interpreter *Interpreter, prefix *StringValue) BoolValue {
+	return AsBoolValue(
+		strings.HasPrefix(v.Str, prefix.Str) &&
+			v.isGraphemeBoundary(interpreter, 0, len(prefix.Str)),
+	)
+}
+
+func (v *StringValue) EndsWith(interpreter *Interpreter, suffix *StringValue) BoolValue {
+	return AsBoolValue(
+		strings.HasSuffix(v.Str, suffix.Str) &&
+			v.isGraphemeBoundary(interpreter, 0, len(v.Str)-len(suffix.Str)),
+	)
+}
+
+// isWhitespaceGrapheme returns true if all code points of the given grapheme cluster are whitespace
+func isWhitespaceGrapheme(cluster string) bool {
+	for _, r := range cluster {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *StringValue) Trim(interpreter *Interpreter) *StringValue {
+	v.prepareGraphemes()
+
+	start := -1
+	end := 0
+
+	for v.graphemes.Next() {
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		if isWhitespaceGrapheme(v.graphemes.Str()) {
+			continue
+		}
+
+		clusterStart, clusterEnd := v.graphemes.Positions()
+		if start < 0 {
+			start = clusterStart
+		}
+		end = clusterEnd
+	}
+
+	if start < 0 {
+		return EmptyString
+	}
+
+	if start == 0 && end == len(v.Str) {
+		return v
+	}
+
+	return NewStringValue(
+		interpreter,
+		common.NewStringMemoryUsage(end-start),
+		func() string {
+			return v.Str[start:end]
+		},
+	)
+}
+
+// Pad returns the string padded with the given character to the given length,
+// either at the start or at the end of the string
+func (v *StringValue) Pad(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	toLength IntValue,
+	with CharacterValue,
+	atStart bool,
+) *StringValue {
+	count := toLength.ToInt(locationRange) - v.Length()
+	if count <= 0 {
+		return v
+	}
+
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(count))
+
+	paddingLength := safeMul(count, len(with.Str), locationRange)
+	newLength := safeAdd(len(v.Str), paddingLength, locationRange)
+
+	memoryUsage := common.NewStringMemoryUsage(newLength)
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			var sb strings.Builder
+			sb.Grow(newLength)
+
+			if !atStart {
+				sb.WriteString(v.Str)
+			}
+
+			for i := 0; i < count; i++ {
+				sb.WriteString(with.Str)
+			}
+
+			if atStart {
+				sb.WriteString(v.Str)
+			}
+
+			return sb.String()
+		},
+	)
+}
+
 func (v *StringValue) Split(inter *Interpreter, locationRange LocationRange, separator string) Value {
 	split := strings.Split(v.Str, separator)
 
@@ -1735,18 +2094,19 @@ func NewArrayValueWithIterator(
 	var v *ArrayValue
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		defer func() {
 			// NOTE: in defer, as v is only initialized at the end of the function,
-			// if there was no error during construction
-			if v == nil {
-				return
+			// if there was no error during construction.
+			// The trace is reported even if the construction failed,
+			// as every started trace must be ended
+			typeInfo := arrayType.String()
+			count := 0
+			if v != nil {
+				count = v.Count()
 			}
 
-			typeInfo := v.Type.String()
-			count := v.Count()
-
 			interpreter.reportArrayValueConstructTrace(
 				typeInfo,
 				count,
@@ -1918,7 +2278,7 @@ func (v *ArrayValue) Destroy(interpreter *Interpreter, locationRange LocationRan
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -2591,54 +2951,186 @@ func (v *ArrayValue) GetMember(interpreter *Interpreter, locationRange LocationR
 			},
 		)
 
-	case sema.ArrayTypeToVariableSizedFunctionName:
+	case sema.ArrayTypeLastIndexFunctionName:
 		return NewHostFunctionValue(
 			interpreter,
-			sema.ArrayToVariableSizedFunctionType(
+			sema.ArrayLastIndexFunctionType(
 				v.SemaType(interpreter).ElementType(false),
 			),
 			func(invocation Invocation) Value {
-				interpreter := invocation.Interpreter
-
-				return v.ToVariableSized(
-					interpreter,
+				return v.LastIndex(
+					invocation.Interpreter,
 					invocation.LocationRange,
+					invocation.Arguments[0],
 				)
 			},
 		)
 
-	case sema.ArrayTypeToConstantSizedFunctionName:
+	case sema.ArrayTypeIndexOfFunctionName:
 		return NewHostFunctionValue(
 			interpreter,
-			sema.ArrayToConstantSizedFunctionType(
+			sema.ArrayIndexOfFunctionType(
 				v.SemaType(interpreter).ElementType(false),
 			),
 			func(invocation Invocation) Value {
 				interpreter := invocation.Interpreter
 
-				typeParameterPair := invocation.TypeParameterTypes.Oldest()
-				if typeParameterPair == nil {
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
 					panic(errors.NewUnreachableError())
 				}
 
-				ty := typeParameterPair.Value
+				return v.IndexOf(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+			},
+		)
 
-				constantSizedArrayType, ok := ty.(*sema.ConstantSizedType)
+	case sema.ArrayTypeAllSatisfyFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArrayAllSatisfyFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
 
-				return v.ToConstantSized(
+				return v.AllSatisfy(
 					interpreter,
 					invocation.LocationRange,
-					constantSizedArrayType.Size,
+					funcArgument,
 				)
 			},
 		)
-	}
 
-	return nil
-}
+	case sema.ArrayTypeAnySatisfyFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArrayAnySatisfyFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.AnySatisfy(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+			},
+		)
+
+	case sema.ArrayTypeSortFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArraySortFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Sort(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+			},
+		)
+
+	case sema.ArrayTypeReduceFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArrayReduceFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[1].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Reduce(
+					interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+					typeParameterPair.Value,
+					funcArgument,
+				)
+			},
+		)
+
+	case sema.ArrayTypeToVariableSizedFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArrayToVariableSizedFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				return v.ToVariableSized(
+					interpreter,
+					invocation.LocationRange,
+				)
+			},
+		)
+
+	case sema.ArrayTypeToConstantSizedFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.ArrayToConstantSizedFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				ty := typeParameterPair.Value
+
+				constantSizedArrayType, ok := ty.(*sema.ConstantSizedType)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.ToConstantSized(
+					interpreter,
+					invocation.LocationRange,
+					constantSizedArrayType.Size,
+				)
+			},
+		)
+	}
+
+	return nil
+}
 
 func (v *ArrayValue) RemoveMember(interpreter *Interpreter, locationRange LocationRange, _ string) Value {
 	// Arrays have no removable members (fields / functions)
@@ -2664,7 +3156,7 @@ func (v *ArrayValue) ConformsToStaticType(
 	count := v.Count()
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 
@@ -2779,7 +3271,7 @@ func (v *ArrayValue) Transfer(
 	)
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -2939,7 +3431,7 @@ func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -3275,55 +3767,183 @@ func (v *ArrayValue) Map(
 	)
 }
 
-func (v *ArrayValue) ForEach(
+func (v *ArrayValue) LastIndex(
 	interpreter *Interpreter,
-	_ sema.Type,
-	function func(value Value) (resume bool),
-	_ LocationRange,
-) {
-	v.Iterate(interpreter, function)
+	locationRange LocationRange,
+	needleValue Value,
+) OptionalValue {
+
+	needleEquatable, ok := needleValue.(EquatableValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	for index := v.Count() - 1; index >= 0; index-- {
+
+		// Meter computation for iterating the array.
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		element := v.Get(interpreter, locationRange, index)
+		if needleEquatable.Equal(interpreter, locationRange, element) {
+			value := NewIntValueFromInt64(interpreter, int64(index))
+			return NewSomeValueNonCopying(interpreter, value)
+		}
+	}
+
+	return NilOptionalValue
 }
 
-func (v *ArrayValue) ToVariableSized(
+// firstSatisfying returns the index of the first element of the array
+// for which the given predicate function returns the given expected result,
+// or -1 if there is no such element.
+func (v *ArrayValue) firstSatisfying(
 	interpreter *Interpreter,
 	locationRange LocationRange,
-) Value {
-	var returnArrayStaticType ArrayStaticType
-	switch v.Type.(type) {
-	case *ConstantSizedStaticType:
-		returnArrayStaticType = NewVariableSizedStaticType(
+	predicate FunctionValue,
+	expected BoolValue,
+) int {
+
+	elementTypeSlice := []sema.Type{v.semaType.ElementType(false)}
+
+	result := -1
+	var index int
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+
+		// Meter computation for iterating the array.
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		invocation := NewInvocation(
 			interpreter,
-			v.Type.ElementType(),
+			nil,
+			nil,
+			nil,
+			[]Value{element},
+			elementTypeSlice,
+			nil,
+			locationRange,
 		)
-	default:
-		panic(errors.NewUnreachableError())
+
+		satisfied, ok := predicate.invoke(invocation).(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if satisfied == expected {
+			result = index
+			// stop iteration
+			return false
+		}
+
+		index++
+		// continue iteration
+		return true
+	})
+
+	return result
+}
+
+func (v *ArrayValue) IndexOf(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) OptionalValue {
+	index := v.firstSatisfying(interpreter, locationRange, predicate, TrueValue)
+	if index < 0 {
+		return NilOptionalValue
 	}
 
-	iterator, err := v.array.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	value := NewIntValueFromInt64(interpreter, int64(index))
+	return NewSomeValueNonCopying(interpreter, value)
+}
+
+func (v *ArrayValue) AllSatisfy(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) BoolValue {
+	index := v.firstSatisfying(interpreter, locationRange, predicate, FalseValue)
+	return AsBoolValue(index < 0)
+}
+
+func (v *ArrayValue) AnySatisfy(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) BoolValue {
+	index := v.firstSatisfying(interpreter, locationRange, predicate, TrueValue)
+	return AsBoolValue(index >= 0)
+}
+
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	isLess FunctionValue,
+) Value {
+
+	count := v.Count()
+
+	elementType := v.semaType.ElementType(false)
+	elementTypeSlice := []sema.Type{elementType, elementType}
+
+	less := func(a, b Value) bool {
+
+		// Meter computation for each comparison.
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		invocation := NewInvocation(
+			interpreter,
+			nil,
+			nil,
+			nil,
+			[]Value{a, b},
+			elementTypeSlice,
+			nil,
+			locationRange,
+		)
+
+		result, ok := isLess.invoke(invocation).(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		return bool(result)
 	}
 
+	common.UseMemory(interpreter, common.NewValueSliceMemoryUsage(count))
+	values := make([]Value, 0, count)
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+
+		// Meter computation for iterating the array.
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		values = append(values, element)
+
+		// continue iteration
+		return true
+	})
+
+	common.UseMemory(interpreter, common.NewValueSliceMemoryUsage(count))
+	buffer := make([]Value, count)
+
+	// The sort algorithm is implemented here instead of using the Go standard library,
+	// so that the number of comparisons, and therefore the metered computation,
+	// is deterministic and does not depend on the Go version.
+	mergeSortValues(values, buffer, less)
+
+	index := 0
+
 	return NewArrayValueWithIterator(
 		interpreter,
-		returnArrayStaticType,
+		v.Type,
 		common.ZeroAddress,
-		uint64(v.Count()),
+		uint64(count),
 		func() Value {
-
-			// Meter computation for iterating the array.
-			interpreter.ReportComputation(common.ComputationKindLoop, 1)
-
-			atreeValue, err := iterator.Next()
-			if err != nil {
-				panic(errors.NewExternalError(err))
-			}
-
-			if atreeValue == nil {
+			if index >= count {
 				return nil
 			}
 
-			value := MustConvertStoredValue(interpreter, atreeValue)
+			value := values[index]
+			index++
 
 			return value.Transfer(
 				interpreter,
@@ -3337,10 +3957,159 @@ func (v *ArrayValue) ToVariableSized(
 	)
 }
 
-func (v *ArrayValue) ToConstantSized(
+// mergeSortValues sorts the given values stably, using the given less function.
+// The given buffer must have the same length as the values.
+func mergeSortValues(values []Value, buffer []Value, less func(a, b Value) bool) {
+	count := len(values)
+	if count < 2 {
+		return
+	}
+
+	middle := count / 2
+	mergeSortValues(values[:middle], buffer[:middle], less)
+	mergeSortValues(values[middle:], buffer[middle:], less)
+
+	// The halves are already in order
+	if !less(values[middle], values[middle-1]) {
+		return
+	}
+
+	copy(buffer, values)
+
+	left, right := 0, middle
+	for index := range values {
+		// Only take from the right half if its element is strictly less,
+		// so equal elements retain their original order
+		if left < middle &&
+			(right >= count || !less(buffer[right], buffer[left])) {
+
+			values[index] = buffer[left]
+			left++
+		} else {
+			values[index] = buffer[right]
+			right++
+		}
+	}
+}
+
+func (v *ArrayValue) Reduce(
 	interpreter *Interpreter,
 	locationRange LocationRange,
-	expectedConstantSizedArraySize int64,
+	initial Value,
+	resultType sema.Type,
+	combine FunctionValue,
+) Value {
+
+	argumentTypes := []sema.Type{resultType, v.semaType.ElementType(false)}
+
+	iterator, err := v.array.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	result := initial
+
+	// The combine function may be impure, so the array must not be mutated during the iteration
+	interpreter.withMutationPrevention(v.StorageID(), func() {
+		for {
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			atreeValue, err := iterator.Next()
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+
+			if atreeValue == nil {
+				return
+			}
+
+			value := MustConvertStoredValue(interpreter, atreeValue)
+
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				nil,
+				nil,
+				[]Value{result, value},
+				argumentTypes,
+				nil,
+				locationRange,
+			)
+
+			result = combine.invoke(invocation)
+		}
+	})
+
+	return result
+}
+
+func (v *ArrayValue) ForEach(
+	interpreter *Interpreter,
+	_ sema.Type,
+	function func(value Value) (resume bool),
+	_ LocationRange,
+) {
+	v.Iterate(interpreter, function)
+}
+
+func (v *ArrayValue) ToVariableSized(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+) Value {
+	var returnArrayStaticType ArrayStaticType
+	switch v.Type.(type) {
+	case *ConstantSizedStaticType:
+		returnArrayStaticType = NewVariableSizedStaticType(
+			interpreter,
+			v.Type.ElementType(),
+		)
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	iterator, err := v.array.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		returnArrayStaticType,
+		common.ZeroAddress,
+		uint64(v.Count()),
+		func() Value {
+
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			atreeValue, err := iterator.Next()
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+
+			if atreeValue == nil {
+				return nil
+			}
+
+			value := MustConvertStoredValue(interpreter, atreeValue)
+
+			return value.Transfer(
+				interpreter,
+				locationRange,
+				atree.Address{},
+				false,
+				nil,
+				nil,
+			)
+		},
+	)
+}
+
+func (v *ArrayValue) ToConstantSized(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	expectedConstantSizedArraySize int64,
 ) Value {
 	if int64(v.Count()) != expectedConstantSizedArraySize {
 		return NilOptionalValue
@@ -15802,6 +16571,40 @@ func ConvertFix64(memoryGauge common.MemoryGauge, value Value, locationRange Loc
 			},
 		)
 
+	case Fix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				// Truncate the fractional digits which are not representable in Fix64
+				v := new(big.Int).Quo(value.BigInt, fix128PerFix64Factor)
+				if v.Cmp(minInt64Big) < 0 {
+					panic(UnderflowError{
+						LocationRange: locationRange,
+					})
+				} else if v.Cmp(maxInt64Big) > 0 {
+					panic(OverflowError{
+						LocationRange: locationRange,
+					})
+				}
+				return v.Int64()
+			},
+		)
+
+	case UFix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				// Truncate the fractional digits which are not representable in Fix64
+				v := new(big.Int).Quo(value.BigInt, fix128PerFix64Factor)
+				if !v.IsInt64() {
+					panic(OverflowError{
+						LocationRange: locationRange,
+					})
+				}
+				return v.Int64()
+			},
+		)
+
 	case BigNumberValue:
 		converter := func() int64 {
 			v := value.ToBigInt(memoryGauge)
@@ -16325,6 +17128,41 @@ func ConvertUFix64(memoryGauge common.MemoryGauge, value Value, locationRange Lo
 			},
 		)
 
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				// Truncate the fractional digits which are not representable in UFix64
+				v := new(big.Int).Quo(value.BigInt, fix128PerFix64Factor)
+				if !v.IsUint64() {
+					panic(OverflowError{
+						LocationRange: locationRange,
+					})
+				}
+				return v.Uint64()
+			},
+		)
+
+	case UFix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				// Truncate the fractional digits which are not representable in UFix64
+				v := new(big.Int).Quo(value.BigInt, fix128PerFix64Factor)
+				if !v.IsUint64() {
+					panic(OverflowError{
+						LocationRange: locationRange,
+					})
+				}
+				return v.Uint64()
+			},
+		)
+
 	case BigNumberValue:
 		converter := func() uint64 {
 			v := value.ToBigInt(memoryGauge)
@@ -16457,67 +17295,1228 @@ func (UFix64Value) Scale() int {
 	return sema.Fix64Scale
 }
 
-// CompositeValue
+// Fix128Value
 
-type FunctionOrderedMap = orderedmap.OrderedMap[string, FunctionValue]
+type Fix128Value struct {
+	BigInt *big.Int
+}
 
-type CompositeValue struct {
-	Location common.Location
+var Fix128MemoryUsage = common.NewBigIntMemoryUsage(16)
 
-	// note that the staticType is not guaranteed to be a CompositeStaticType as there can be types
-	// which are non-composite but their values are treated as CompositeValue.
-	// For e.g. InclusiveRangeValue
-	staticType StaticType
+// fix128PerFix64Factor is the factor between the raw values of Fix128 and Fix64,
+// i.e. 10^(Fix128Scale - Fix64Scale)
+var fix128PerFix64Factor = new(big.Int).Quo(sema.Fix128FactorBig, sema.Fix64FactorBig)
 
-	Stringer        func(gauge common.MemoryGauge, value *CompositeValue, seenReferences SeenReferences) string
-	injectedFields  map[string]Value
-	computedFields  map[string]ComputedField
-	NestedVariables map[string]*Variable
-	Functions       *FunctionOrderedMap
-	dictionary      *atree.OrderedMap
-	typeID          TypeID
+func NewFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int, locationRange LocationRange) Fix128Value {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewUnmeteredFix128ValueWithInteger(constructor(), locationRange)
+}
 
-	// attachments also have a reference to their base value. This field is set in three cases:
-	// 1) when an attachment `A` is accessed off `v` using `v[A]`, this is set to `&v`
-	// 2) When a resource `r`'s destructor is invoked, all of `r`'s attachments' destructors will also run, and
-	//    have their `base` fields set to `&r`
-	// 3) When a value is transferred, this field is copied between its attachments
-	base                *CompositeValue
-	QualifiedIdentifier string
-	Kind                common.CompositeKind
-	isDestroyed         bool
+func NewUnmeteredFix128ValueWithInteger(integer *big.Int, locationRange LocationRange) Fix128Value {
+
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return NewUnmeteredFix128Value(new(big.Int).Mul(integer, sema.Fix128FactorBig))
 }
 
-type ComputedField func(*Interpreter, LocationRange, *CompositeValue) Value
+func NewFix128Value(gauge common.MemoryGauge, valueGetter func() *big.Int) Fix128Value {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewUnmeteredFix128Value(valueGetter())
+}
 
-type CompositeField struct {
-	Value Value
-	Name  string
+func NewUnmeteredFix128Value(value *big.Int) Fix128Value {
+	return Fix128Value{
+		BigInt: value,
+	}
 }
 
-const unrepresentableNamePrefix = "$"
-const resourceDefaultDestroyEventPrefix = ast.ResourceDestructionDefaultEventName + unrepresentableNamePrefix
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ FixedPointValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ ComparableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
 
-var _ TypeIndexableValue = &CompositeValue{}
-var _ IterableValue = &CompositeValue{}
+func (Fix128Value) isValue() {}
 
-func NewCompositeField(memoryGauge common.MemoryGauge, name string, value Value) CompositeField {
-	common.UseMemory(memoryGauge, common.CompositeFieldMemoryUsage)
-	return NewUnmeteredCompositeField(name, value)
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
 }
 
-func NewUnmeteredCompositeField(name string, value Value) CompositeField {
-	return CompositeField{
-		Name:  name,
-		Value: value,
+func (Fix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (Fix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeFix128)
+}
+
+func (Fix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v Fix128Value) ToInt(_ LocationRange) int {
+	// The integer part of a Fix128 always fits into an int64
+	return int(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Int64())
+}
+
+func (v Fix128Value) Negate(interpreter *Interpreter, locationRange LocationRange) NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		return new(big.Int).Neg(v.BigInt)
 	}
+
+	return NewFix128Value(interpreter, valueGetter)
 }
 
-// Create a CompositeValue with the provided StaticType.
-// Useful when we wish to utilize CompositeValue as the value
-// for a type which isn't CompositeType.
-// For e.g. InclusiveRangeType
-func NewCompositeValueWithStaticType(
+func (v Fix128Value) Plus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationPlus,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// Given that this value is backed by an arbitrary size integer,
+		// we can just add and check the range of the result.
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		checkFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return saturateFix128(res)
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Minus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMinus,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		checkFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return saturateFix128(res)
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mul(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMul,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		checkFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return saturateFix128(res)
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Div(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationDiv,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{
+				LocationRange: locationRange,
+			})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		checkFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{
+				LocationRange: locationRange,
+			})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		return saturateFix128(res)
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mod(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o, locationRange).(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	truncatedQuotient := NewFix128Value(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o, locationRange),
+		locationRange,
+	)
+}
+
+func (v Fix128Value) Less(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp == -1)
+}
+
+func (v Fix128Value) LessEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp <= 0)
+}
+
+func (v Fix128Value) Greater(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp == 1)
+}
+
+func (v Fix128Value) GreaterEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp >= 0)
+}
+
+func (v Fix128Value) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherFix128.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func checkFix128Range(value *big.Int, locationRange LocationRange) {
+	if value.Cmp(sema.Fix128TypeMinBig) < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	} else if value.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+}
+
+func saturateFix128(value *big.Int) *big.Int {
+	if value.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return sema.Fix128TypeMinBig
+	} else if value.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return sema.Fix128TypeMaxBig
+	}
+	return value
+}
+
+func ConvertFix128(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return value.BigInt
+			},
+		)
+
+	case Fix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				res := big.NewInt(int64(value))
+				return res.Mul(res, fix128PerFix64Factor)
+			},
+		)
+
+	case UFix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				res := new(big.Int).SetUint64(uint64(value))
+				return res.Mul(res, fix128PerFix64Factor)
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+			locationRange,
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt(locationRange)))
+			},
+			locationRange,
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to Fix128: %s", value))
+	}
+}
+
+func (v Fix128Value) GetMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Fix128Type, locationRange)
+}
+
+func (Fix128Value) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToSizedBigEndianBytes(v.BigInt, sema.Fix128TypeSize)
+}
+
+func (v Fix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (Fix128Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix128Value) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.StorageID]struct{},
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredFix128Value(v.BigInt)
+}
+
+func (Fix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v Fix128Value) IntegerPart() NumberValue {
+	return Int64Value(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Int64())
+}
+
+func (Fix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+// UFix128Value
+
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+var UFix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewUFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int, locationRange LocationRange) UFix128Value {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUnmeteredUFix128ValueWithInteger(constructor(), locationRange)
+}
+
+func NewUnmeteredUFix128ValueWithInteger(integer *big.Int, locationRange LocationRange) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return NewUnmeteredUFix128Value(new(big.Int).Mul(integer, sema.Fix128FactorBig))
+}
+
+func NewUFix128Value(gauge common.MemoryGauge, valueGetter func() *big.Int) UFix128Value {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUnmeteredUFix128Value(valueGetter())
+}
+
+func NewUnmeteredUFix128Value(value *big.Int) UFix128Value {
+	return UFix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ FixedPointValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ ComparableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
+
+func (UFix128Value) isValue() {}
+
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
+}
+
+func (UFix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (UFix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeUFix128)
+}
+
+func (UFix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
+}
+
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v UFix128Value) ToInt(_ LocationRange) int {
+	// The integer part of a UFix128 always fits into an int64
+	return int(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Int64())
+}
+
+func (v UFix128Value) Negate(*Interpreter, LocationRange) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) Plus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationPlus,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		checkUFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		return saturateUFix128(res)
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Minus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMinus,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		checkUFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		return saturateUFix128(res)
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Mul(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMul,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		checkUFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+		return saturateUFix128(res)
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Div(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationDiv,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{
+				LocationRange: locationRange,
+			})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		checkUFix128Range(res, locationRange)
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{
+				LocationRange: locationRange,
+			})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+		return saturateUFix128(res)
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Mod(interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o, locationRange).(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	truncatedQuotient := NewUFix128Value(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o, locationRange),
+		locationRange,
+	)
+}
+
+func (v UFix128Value) Less(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp == -1)
+}
+
+func (v UFix128Value) LessEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp <= 0)
+}
+
+func (v UFix128Value) Greater(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp == 1)
+}
+
+func (v UFix128Value) GreaterEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return AsBoolValue(cmp >= 0)
+}
+
+func (v UFix128Value) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherUFix128.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func checkUFix128Range(value *big.Int, locationRange LocationRange) {
+	if value.Sign() < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	} else if value.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+}
+
+func saturateUFix128(value *big.Int) *big.Int {
+	if value.Sign() < 0 {
+		return sema.UFix128TypeMinBig
+	} else if value.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return sema.UFix128TypeMaxBig
+	}
+	return value
+}
+
+func ConvertUFix128(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) UFix128Value {
+	switch value := value.(type) {
+	case UFix128Value:
+		return value
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return value.BigInt
+			},
+		)
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				res := big.NewInt(int64(value))
+				return res.Mul(res, fix128PerFix64Factor)
+			},
+		)
+
+	case UFix64Value:
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				res := new(big.Int).SetUint64(uint64(value))
+				return res.Mul(res, fix128PerFix64Factor)
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+			locationRange,
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt(locationRange)))
+			},
+			locationRange,
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
+	}
+}
+
+func (v UFix128Value) GetMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.UFix128Type, locationRange)
+}
+
+func (UFix128Value) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToSizedBigEndianBytes(v.BigInt, sema.UFix128TypeSize)
+}
+
+func (v UFix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (UFix128Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix128Value) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.StorageID]struct{},
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredUFix128Value(v.BigInt)
+}
+
+func (UFix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v UFix128Value) IntegerPart() NumberValue {
+	return UInt64Value(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Uint64())
+}
+
+func (UFix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+// CompositeValue
+
+type FunctionOrderedMap = orderedmap.OrderedMap[string, FunctionValue]
+
+type CompositeValue struct {
+	Location common.Location
+
+	// note that the staticType is not guaranteed to be a CompositeStaticType as there can be types
+	// which are non-composite but their values are treated as CompositeValue.
+	// For e.g. InclusiveRangeValue
+	staticType StaticType
+
+	Stringer        func(gauge common.MemoryGauge, value *CompositeValue, seenReferences SeenReferences) string
+	injectedFields  map[string]Value
+	computedFields  map[string]ComputedField
+	NestedVariables map[string]*Variable
+	Functions       *FunctionOrderedMap
+	dictionary      *atree.OrderedMap
+	typeID          TypeID
+
+	// attachments also have a reference to their base value. This field is set in three cases:
+	// 1) when an attachment `A` is accessed off `v` using `v[A]`, this is set to `&v`
+	// 2) When a resource `r`'s destructor is invoked, all of `r`'s attachments' destructors will also run, and
+	//    have their `base` fields set to `&r`
+	// 3) When a value is transferred, this field is copied between its attachments
+	base                *CompositeValue
+	QualifiedIdentifier string
+	Kind                common.CompositeKind
+	isDestroyed         bool
+}
+
+type ComputedField func(*Interpreter, LocationRange, *CompositeValue) Value
+
+type CompositeField struct {
+	Value Value
+	Name  string
+}
+
+const unrepresentableNamePrefix = "$"
+const resourceDefaultDestroyEventPrefix = ast.ResourceDestructionDefaultEventName + unrepresentableNamePrefix
+
+var _ TypeIndexableValue = &CompositeValue{}
+var _ IterableValue = &CompositeValue{}
+
+func NewCompositeField(memoryGauge common.MemoryGauge, name string, value Value) CompositeField {
+	common.UseMemory(memoryGauge, common.CompositeFieldMemoryUsage)
+	return NewUnmeteredCompositeField(name, value)
+}
+
+func NewUnmeteredCompositeField(name string, value Value) CompositeField {
+	return CompositeField{
+		Name:  name,
+		Value: value,
+	}
+}
+
+// Create a CompositeValue with the provided StaticType.
+// Useful when we wish to utilize CompositeValue as the value
+// for a type which isn't CompositeType.
+// For e.g. InclusiveRangeType
+func NewCompositeValueWithStaticType(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	fields []CompositeField,
+	address common.Address,
+	staticType StaticType,
+) *CompositeValue {
+	value := NewCompositeValue(
+		interpreter,
+		locationRange,
+		location,
+		qualifiedIdentifier,
+		kind,
+		fields,
+		address,
+	)
+	value.staticType = staticType
+	return value
+}
+
+func NewCompositeValue(
 	interpreter *Interpreter,
 	locationRange LocationRange,
 	location common.Location,
@@ -16525,26 +18524,28 @@ func NewCompositeValueWithStaticType(
 	kind common.CompositeKind,
 	fields []CompositeField,
 	address common.Address,
-	staticType StaticType,
 ) *CompositeValue {
-	value := NewCompositeValue(
+	return NewInstantiatedCompositeValue(
 		interpreter,
 		locationRange,
 		location,
 		qualifiedIdentifier,
+		nil,
 		kind,
 		fields,
 		address,
 	)
-	value.staticType = staticType
-	return value
 }
 
-func NewCompositeValue(
+// NewInstantiatedCompositeValue creates a new composite value
+// of an instantiation of a generic composite type with the given type arguments.
+// If no type arguments are given, the composite type is not generic.
+func NewInstantiatedCompositeValue(
 	interpreter *Interpreter,
 	locationRange LocationRange,
 	location common.Location,
 	qualifiedIdentifier string,
+	typeArguments []StaticType,
 	kind common.CompositeKind,
 	fields []CompositeField,
 	address common.Address,
@@ -16557,23 +18558,20 @@ func NewCompositeValue(
 	var v *CompositeValue
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		defer func() {
 			// NOTE: in defer, as v is only initialized at the end of the function
-			// if there was no error during construction
-			if v == nil {
-				return
-			}
-
-			owner := v.GetOwner().String()
-			typeID := string(v.TypeID())
-			kind := v.Kind.String()
+			// if there was no error during construction.
+			// The trace is reported even if the construction failed,
+			// as every started trace must be ended
+			owner := address.String()
+			typeID := string(common.NewTypeIDFromQualifiedName(nil, location, qualifiedIdentifier))
 
 			interpreter.reportCompositeValueConstructTrace(
 				owner,
 				typeID,
-				kind,
+				kind.String(),
 				time.Since(startTime),
 			)
 		}()
@@ -16584,11 +18582,12 @@ func NewCompositeValue(
 			config.Storage,
 			atree.Address(address),
 			atree.NewDefaultDigesterBuilder(),
-			NewCompositeTypeInfo(
+			NewInstantiatedCompositeTypeInfo(
 				interpreter,
 				location,
 				qualifiedIdentifier,
 				kind,
+				typeArguments,
 			),
 		)
 		if err != nil {
@@ -16597,11 +18596,12 @@ func NewCompositeValue(
 		return dictionary
 	}
 
-	typeInfo := NewCompositeTypeInfo(
+	typeInfo := NewInstantiatedCompositeTypeInfo(
 		interpreter,
 		location,
 		qualifiedIdentifier,
 		kind,
+		typeArguments,
 	)
 
 	v = newCompositeValueFromConstructor(interpreter, uint64(len(fields)), typeInfo, constructor)
@@ -16646,11 +18646,22 @@ func newCompositeValueFromAtreeMap(
 
 	common.UseMemory(gauge, common.CompositeValueBaseMemoryUsage)
 
+	var staticType StaticType
+	if len(typeInfo.typeArguments) > 0 {
+		staticType = NewInstantiatedCompositeStaticType(
+			gauge,
+			typeInfo.location,
+			typeInfo.qualifiedIdentifier,
+			typeInfo.typeArguments,
+		)
+	}
+
 	return &CompositeValue{
 		dictionary:          atreeOrderedMap,
 		Location:            typeInfo.location,
 		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
 		Kind:                typeInfo.kind,
+		staticType:          staticType,
 	}
 }
 
@@ -16757,7 +18768,7 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, locationRange Locatio
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -16853,7 +18864,7 @@ func (v *CompositeValue) GetMember(interpreter *Interpreter, locationRange Locat
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -17044,7 +19055,7 @@ func (v *CompositeValue) RemoveMember(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -17109,7 +19120,7 @@ func (v *CompositeValue) SetMember(
 	interpreter.enforceNotResourceDestruction(v.StorageID(), locationRange)
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -17327,6 +19338,16 @@ func (v *CompositeValue) HashInput(interpreter *Interpreter, locationRange Locat
 	panic(errors.NewUnreachableError())
 }
 
+// TypeArguments returns the type arguments of the composite value,
+// if the value is an instance of a generic composite type
+func (v *CompositeValue) TypeArguments() []StaticType {
+	compositeStaticType, ok := v.staticType.(*CompositeStaticType)
+	if !ok {
+		return nil
+	}
+	return compositeStaticType.TypeArguments
+}
+
 func (v *CompositeValue) TypeID() TypeID {
 	if v.typeID == "" {
 		v.typeID = common.NewTypeIDFromQualifiedName(nil, v.Location, v.QualifiedIdentifier)
@@ -17342,7 +19363,7 @@ func (v *CompositeValue) ConformsToStaticType(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -17547,7 +19568,7 @@ func (v *CompositeValue) Transfer(
 	interpreter.ReportComputation(common.ComputationKindTransferCompositeValue, 1)
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -17678,11 +19699,12 @@ func (v *CompositeValue) Transfer(
 		v.dictionary = nil
 	}
 
-	info := NewCompositeTypeInfo(
+	info := NewInstantiatedCompositeTypeInfo(
 		interpreter,
 		v.Location,
 		v.QualifiedIdentifier,
 		v.Kind,
+		v.TypeArguments(),
 	)
 
 	res := newCompositeValueFromAtreeMap(
@@ -17788,7 +19810,7 @@ func (v *CompositeValue) DeepRemove(interpreter *Interpreter) {
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		owner := v.GetOwner().String()
 		typeID := string(v.TypeID())
@@ -18289,18 +20311,19 @@ func NewDictionaryValueWithAddress(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		defer func() {
 			// NOTE: in defer, as v is only initialized at the end of the function
-			// if there was no error during construction
-			if v == nil {
-				return
+			// if there was no error during construction.
+			// The trace is reported even if the construction failed,
+			// as every started trace must be ended
+			typeInfo := dictionaryType.String()
+			count := 0
+			if v != nil {
+				count = v.Count()
 			}
 
-			typeInfo := v.Type.String()
-			count := v.Count()
-
 			interpreter.reportDictionaryValueConstructTrace(
 				typeInfo,
 				count,
@@ -18382,18 +20405,19 @@ func newDictionaryValueWithIterator(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		defer func() {
 			// NOTE: in defer, as v is only initialized at the end of the function
-			// if there was no error during construction
-			if v == nil {
-				return
+			// if there was no error during construction.
+			// The trace is reported even if the construction failed,
+			// as every started trace must be ended
+			typeInfo := staticType.String()
+			count := 0
+			if v != nil {
+				count = v.Count()
 			}
 
-			typeInfo := v.Type.String()
-			count := v.Count()
-
 			interpreter.reportDictionaryValueConstructTrace(
 				typeInfo,
 				count,
@@ -18643,7 +20667,7 @@ func (v *DictionaryValue) Destroy(interpreter *Interpreter, locationRange Locati
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -18726,6 +20750,251 @@ func (v *DictionaryValue) ForEachKey(
 	}
 }
 
+func (v *DictionaryValue) ForEach(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) {
+	dictionaryType := v.SemaType(interpreter)
+	argumentTypes := []sema.Type{dictionaryType.KeyType, dictionaryType.ValueType}
+
+	// The procedure may be impure, so the dictionary must not be mutated during the iteration
+	interpreter.withMutationPrevention(v.StorageID(), func() {
+		v.Iterate(interpreter, func(key, value Value) (resume bool) {
+
+			// Meter computation for iterating the dictionary.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				nil,
+				nil,
+				[]Value{key, value},
+				argumentTypes,
+				nil,
+				locationRange,
+			)
+
+			shouldContinue, ok := procedure.invoke(invocation).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(shouldContinue)
+		})
+	})
+}
+
+func (v *DictionaryValue) Filter(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) Value {
+	dictionaryType := v.SemaType(interpreter)
+	argumentTypes := []sema.Type{dictionaryType.KeyType, dictionaryType.ValueType}
+
+	iterator := v.Iterator()
+
+	// The filtered entries are a subset of the entries of this dictionary,
+	// in the same order, so the dictionary can be constructed using the same seed.
+	//
+	// The dictionary is constructed while iterating over this dictionary,
+	// so this dictionary must not be mutated during the construction
+
+	var result Value
+	interpreter.withMutationPrevention(v.StorageID(), func() {
+		result = newDictionaryValueWithIterator(
+			interpreter,
+			locationRange,
+			v.Type,
+			v.dictionary.Count(), // worst case estimation.
+			v.dictionary.Seed(),
+			common.ZeroAddress,
+			func() (Value, Value) {
+				for {
+					// Meter computation for iterating the dictionary.
+					interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+					key, value := iterator.Next(interpreter)
+
+					// Also handles the end of dictionary case since iterator.Next() returns nil for that.
+					if key == nil {
+						return nil, nil
+					}
+
+					invocation := NewInvocation(
+						interpreter,
+						nil,
+						nil,
+						nil,
+						[]Value{key, value},
+						argumentTypes,
+						nil,
+						locationRange,
+					)
+
+					shouldInclude, ok := procedure.invoke(invocation).(BoolValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					// We found the next entry of the filtered dictionary.
+					if shouldInclude {
+						return key.Transfer(
+								interpreter,
+								locationRange,
+								atree.Address{},
+								false,
+								nil,
+								nil,
+							),
+							value.Transfer(
+								interpreter,
+								locationRange,
+								atree.Address{},
+								false,
+								nil,
+								nil,
+							)
+					}
+				}
+			},
+		)
+	})
+	return result
+}
+
+func (v *DictionaryValue) Map(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+	transformFunctionType *sema.FunctionType,
+) Value {
+	valueTypeSlice := []sema.Type{v.SemaType(interpreter).ValueType}
+
+	procedureStaticType, ok := ConvertSemaToStaticType(interpreter, transformFunctionType).(FunctionStaticType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	returnType := procedureStaticType.ReturnType(interpreter)
+
+	returnDictionaryStaticType := NewDictionaryStaticType(
+		interpreter,
+		v.Type.KeyType,
+		returnType,
+	)
+
+	iterator := v.Iterator()
+
+	// The keys of the mapped dictionary are the keys of this dictionary,
+	// in the same order, so the dictionary can be constructed using the same seed.
+	//
+	// The transform function may be impure, and the dictionary is constructed
+	// while iterating over this dictionary, so this dictionary must not be mutated
+	// during the construction
+
+	var result Value
+	interpreter.withMutationPrevention(v.StorageID(), func() {
+		result = newDictionaryValueWithIterator(
+			interpreter,
+			locationRange,
+			returnDictionaryStaticType,
+			v.dictionary.Count(),
+			v.dictionary.Seed(),
+			common.ZeroAddress,
+			func() (Value, Value) {
+
+				// Meter computation for iterating the dictionary.
+				interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+				key, value := iterator.Next(interpreter)
+				if key == nil {
+					return nil, nil
+				}
+
+				invocation := NewInvocation(
+					interpreter,
+					nil,
+					nil,
+					nil,
+					[]Value{value},
+					valueTypeSlice,
+					nil,
+					locationRange,
+				)
+
+				mappedValue := procedure.invoke(invocation)
+
+				return key.Transfer(
+						interpreter,
+						locationRange,
+						atree.Address{},
+						false,
+						nil,
+						nil,
+					),
+					mappedValue.Transfer(
+						interpreter,
+						locationRange,
+						atree.Address{},
+						false,
+						nil,
+						nil,
+					)
+			},
+		)
+	})
+	return result
+}
+
+func (v *DictionaryValue) ToEntries(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+) Value {
+	entryStaticType := NewDictionaryEntryStaticType(interpreter, v.Type)
+
+	iterator := v.Iterator()
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		NewVariableSizedStaticType(interpreter, entryStaticType),
+		common.ZeroAddress,
+		v.dictionary.Count(),
+		func() Value {
+
+			// Meter computation for iterating the dictionary.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			key, value := iterator.Next(interpreter)
+			if key == nil {
+				return nil
+			}
+
+			return NewDictionaryEntryValue(
+				interpreter,
+				entryStaticType,
+				key.Transfer(
+					interpreter,
+					locationRange,
+					atree.Address{},
+					false,
+					nil,
+					nil,
+				),
+				value.Transfer(
+					interpreter,
+					locationRange,
+					atree.Address{},
+					false,
+					nil,
+					nil,
+				),
+			)
+		},
+	)
+}
+
 func (v *DictionaryValue) ContainsKey(
 	interpreter *Interpreter,
 	locationRange LocationRange,
@@ -18869,7 +21138,7 @@ func (v *DictionaryValue) GetMember(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -19028,6 +21297,96 @@ func (v *DictionaryValue) GetMember(
 				return Void
 			},
 		)
+
+	case sema.DictionaryTypeForEachFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.DictionaryForEachFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEach(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+
+				return Void
+			},
+		)
+
+	case sema.DictionaryTypeFilterFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.DictionaryFilterFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Filter(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+			},
+		)
+
+	case sema.DictionaryTypeMapFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.DictionaryMapFunctionType(
+				interpreter,
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				transformFunctionType, ok := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Map(
+					interpreter,
+					invocation.LocationRange,
+					funcArgument,
+					transformFunctionType,
+				)
+			},
+		)
+
+	case sema.DictionaryTypeToEntriesFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.DictionaryToEntriesFunctionType(
+				interpreter,
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				return v.ToEntries(
+					invocation.Interpreter,
+					invocation.LocationRange,
+				)
+			},
+		)
 	}
 
 	return nil
@@ -19208,7 +21567,7 @@ func (v *DictionaryValue) ConformsToStaticType(
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 
@@ -19357,7 +21716,7 @@ func (v *DictionaryValue) Transfer(
 	)
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -19549,7 +21908,7 @@ func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
 	config := interpreter.SharedState.Config
 
 	if config.TracingEnabled {
-		startTime := time.Now()
+		startTime := interpreter.startTrace()
 
 		typeInfo := v.Type.String()
 		count := v.Count()
@@ -46,6 +46,7 @@ func NewAccountStorageValue(
 	var saveFunction *HostFunctionValue
 	var borrowFunction *HostFunctionValue
 	var checkFunction *HostFunctionValue
+	var queryFunction *HostFunctionValue
 
 	computeField := func(name string, inter *Interpreter, locationRange LocationRange) Value {
 		switch name {
@@ -77,6 +78,12 @@ func NewAccountStorageValue(
 			}
 			return forEachStoredFunction
 
+		case sema.Account_StorageTypeQueryFunctionName:
+			if queryFunction == nil {
+				queryFunction = inter.newStorageQueryFunction(address)
+			}
+			return queryFunction
+
 		case sema.Account_StorageTypeUsedFieldName:
 			return storageUsedGet(inter)
 
@@ -144,3 +151,31 @@ func NewAccountStorageValue(
 		stringer,
 	)
 }
+
+// Account.Storage.QueryResult
+
+var account_Storage_QueryResultTypeID = sema.Account_Storage_QueryResultType.ID()
+var account_Storage_QueryResultStaticType = ConvertSemaToStaticType(nil, sema.Account_Storage_QueryResultType) // unmetered
+var account_Storage_QueryResultFieldNames []string = nil
+
+// NewAccountStorageQueryResultValue constructs an Account.Storage.QueryResult value.
+func NewAccountStorageQueryResultValue(
+	gauge common.MemoryGauge,
+	paths *ArrayValue,
+	cursor OptionalValue,
+) Value {
+
+	return NewSimpleCompositeValue(
+		gauge,
+		account_Storage_QueryResultTypeID,
+		account_Storage_QueryResultStaticType,
+		account_Storage_QueryResultFieldNames,
+		map[string]Value{
+			sema.Account_Storage_QueryResultTypePathsFieldName:  paths,
+			sema.Account_Storage_QueryResultTypeCursorFieldName: cursor,
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,70 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// DictionaryEntry
+
+var dictionaryEntryFieldNames = []string{
+	sema.DictionaryEntryTypeKeyFieldName,
+	sema.DictionaryEntryTypeValueFieldName,
+}
+
+// NewDictionaryEntryStaticType returns the static type `DictionaryEntry<K, V>`
+// of the entries of a dictionary with the given static type `{K: V}`
+func NewDictionaryEntryStaticType(
+	gauge common.MemoryGauge,
+	dictionaryType *DictionaryStaticType,
+) *CompositeStaticType {
+	return NewInstantiatedCompositeStaticType(
+		gauge,
+		nil,
+		sema.DictionaryEntryTypeName,
+		[]StaticType{
+			dictionaryType.KeyType,
+			dictionaryType.ValueType,
+		},
+	)
+}
+
+func NewDictionaryEntryValue(
+	gauge common.MemoryGauge,
+	staticType *CompositeStaticType,
+	key Value,
+	value Value,
+) Value {
+
+	return NewSimpleCompositeValue(
+		gauge,
+		staticType.TypeID,
+		staticType,
+		dictionaryEntryFieldNames,
+		map[string]Value{
+			sema.DictionaryEntryTypeKeyFieldName:   key,
+			sema.DictionaryEntryTypeValueFieldName: value,
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
@@ -48,6 +48,9 @@ type InterpretedFunctionValue struct {
 	PreConditions    ast.Conditions
 	Statements       []ast.Statement
 	PostConditions   ast.Conditions
+	// typeArguments are the type arguments of the enclosing generic functions
+	// at the time the function was created, e.g. for closures in generic functions
+	typeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewInterpretedFunctionValue(
@@ -63,6 +66,17 @@ func NewInterpretedFunctionValue(
 
 	common.UseMemory(interpreter, common.InterpretedFunctionValueMemoryUsage)
 
+	var typeArguments *sema.TypeParameterTypeOrderedMap
+	if interpreter != nil {
+		typeArguments = interpreter.SharedState.currentTypeArguments
+
+		// The function type may refer to the type parameters of enclosing generic functions,
+		// e.g. for closures returned by generic functions
+		if functionType != nil {
+			functionType = interpreter.substituteTypeArguments(functionType).(*sema.FunctionType)
+		}
+	}
+
 	return &InterpretedFunctionValue{
 		Interpreter:      interpreter,
 		ParameterList:    parameterList,
@@ -72,6 +86,7 @@ func NewInterpretedFunctionValue(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   postConditions,
+		typeArguments:    typeArguments,
 	}
 }
 
@@ -0,0 +1,909 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	goerrors "errors"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/format"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// SetValue is a set of hashable values.
+//
+// Sets are backed by an atree ordered map,
+// where the keys are the elements of the set,
+// and the values are always Void.
+
+type SetValue struct {
+	Type        *SetStaticType
+	semaType    *sema.SetType
+	set         *atree.OrderedMap
+	elementSize uint
+}
+
+func NewSetValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	setType *SetStaticType,
+	elements ...Value,
+) *SetValue {
+	return NewSetValueWithAddress(
+		interpreter,
+		locationRange,
+		setType,
+		common.ZeroAddress,
+		elements...,
+	)
+}
+
+func NewSetValueWithAddress(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	setType *SetStaticType,
+	address common.Address,
+	elements ...Value,
+) *SetValue {
+
+	interpreter.ReportComputation(common.ComputationKindCreateSetValue, 1)
+
+	config := interpreter.SharedState.Config
+
+	constructor := func() *atree.OrderedMap {
+		set, err := atree.NewMap(
+			config.Storage,
+			atree.Address(address),
+			atree.NewDefaultDigesterBuilder(),
+			setType,
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		return set
+	}
+
+	// elements are added to the set after creation, not here
+	v := newSetValueFromConstructor(interpreter, setType, 0, constructor)
+
+	for _, element := range elements {
+		_ = v.Insert(interpreter, locationRange, element)
+	}
+
+	return v
+}
+
+func SetElementSize(staticType *SetStaticType) uint {
+	elementSize := staticType.ElementType.elementSize()
+	if elementSize == 0 {
+		return 0
+	}
+	return elementSize + uint(VoidStorable.ByteSize())
+}
+
+func newSetValueFromConstructor(
+	gauge common.MemoryGauge,
+	staticType *SetStaticType,
+	count uint64,
+	constructor func() *atree.OrderedMap,
+) *SetValue {
+
+	elementSize := SetElementSize(staticType)
+
+	overheadUsage, dataSlabs, metaDataSlabs :=
+		common.NewAtreeMapMemoryUsages(count, elementSize)
+	common.UseMemory(gauge, overheadUsage)
+	common.UseMemory(gauge, dataSlabs)
+	common.UseMemory(gauge, metaDataSlabs)
+
+	return newSetValueFromAtreeMap(
+		gauge,
+		staticType,
+		elementSize,
+		constructor(),
+	)
+}
+
+func newSetValueFromAtreeMap(
+	gauge common.MemoryGauge,
+	staticType *SetStaticType,
+	elementSize uint,
+	atreeOrderedMap *atree.OrderedMap,
+) *SetValue {
+
+	common.UseMemory(gauge, common.SetValueBaseMemoryUsage)
+
+	return &SetValue{
+		Type:        staticType,
+		set:         atreeOrderedMap,
+		elementSize: elementSize,
+	}
+}
+
+var _ Value = &SetValue{}
+var _ atree.Value = &SetValue{}
+var _ EquatableValue = &SetValue{}
+var _ MemberAccessibleValue = &SetValue{}
+
+func (*SetValue) isValue() {}
+
+func (v *SetValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitSetValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.Walk(interpreter, func(element Value) {
+		element.Accept(interpreter, visitor)
+	})
+}
+
+// Iterate iterates over the elements of the set.
+// The set must not be mutated during the iteration.
+func (v *SetValue) Iterate(
+	interpreter *Interpreter,
+	f func(element Value) (resume bool),
+) {
+	interpreter.withMutationPrevention(
+		v.StorageID(),
+		func() {
+			err := v.set.IterateKeys(func(element atree.Value) (resume bool, err error) {
+				// atree.OrderedMap iteration provides low-level atree.Value,
+				// convert to high-level interpreter.Value
+
+				resume = f(
+					MustConvertStoredValue(interpreter, element),
+				)
+
+				return resume, nil
+			})
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+		},
+	)
+}
+
+func (v *SetValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		walkChild(element)
+		return true
+	})
+}
+
+func (v *SetValue) StaticType(_ *Interpreter) StaticType {
+	// TODO meter
+	return v.Type
+}
+
+func (v *SetValue) IsImportable(inter *Interpreter) bool {
+	importable := true
+	v.Iterate(inter, func(element Value) (resume bool) {
+		if !element.IsImportable(inter) {
+			importable = false
+			// stop iteration
+			return false
+		}
+
+		// continue iteration
+		return true
+	})
+
+	return importable
+}
+
+func (v *SetValue) Count() int {
+	return int(v.set.Count())
+}
+
+func (v *SetValue) Contains(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	exists, err := v.set.Has(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	return AsBoolValue(exists)
+}
+
+// Insert inserts the given element into the set,
+// and returns true if the element was not already contained in the set.
+func (v *SetValue) Insert(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.StorageID(), locationRange)
+
+	interpreter.checkContainerMutation(v.Type.ElementType, element, locationRange)
+
+	// Check for an existing element first,
+	// so that the element is only transferred if it is actually inserted
+
+	if v.Contains(interpreter, locationRange, element) {
+		return FalseValue
+	}
+
+	// length increases by 1
+	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.set.Count(), v.elementSize, false)
+	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	address := v.set.Address()
+
+	preventTransfer := map[atree.StorageID]struct{}{
+		v.StorageID(): {},
+	}
+
+	element = element.Transfer(
+		interpreter,
+		locationRange,
+		address,
+		true,
+		nil,
+		preventTransfer,
+	)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	existingStorable, err := v.set.Set(
+		valueComparator,
+		hashInputProvider,
+		element,
+		Void,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.set)
+
+	if existingStorable != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	return TrueValue
+}
+
+// Remove removes the given element from the set,
+// and returns true if the element was contained in the set.
+func (v *SetValue) Remove(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.StorageID(), locationRange)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// No need to clean up storable for passed-in element,
+	// as atree never calls Storable()
+	existingElementStorable, _, err := v.set.Remove(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		var keyNotFoundError *atree.KeyNotFoundError
+		if goerrors.As(err, &keyNotFoundError) {
+			return FalseValue
+		}
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.set)
+
+	storage := interpreter.Storage()
+
+	existingElement := StoredValue(interpreter, existingElementStorable, storage)
+	existingElement.DeepRemove(interpreter)
+	interpreter.RemoveReferencedSlab(existingElementStorable)
+
+	return TrueValue
+}
+
+// Union returns a new set containing the elements of this set and the given set.
+func (v *SetValue) Union(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+	result := NewSetValue(interpreter, locationRange, v.Type)
+
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		_ = result.Insert(interpreter, locationRange, copySetElement(interpreter, locationRange, element))
+		return true
+	})
+
+	other.Iterate(interpreter, func(element Value) (resume bool) {
+		_ = result.Insert(interpreter, locationRange, copySetElement(interpreter, locationRange, element))
+		return true
+	})
+
+	return result
+}
+
+// Intersection returns a new set containing the elements
+// which are contained in both this set and the given set.
+func (v *SetValue) Intersection(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+	return v.filter(interpreter, locationRange, func(element Value) bool {
+		return bool(other.Contains(interpreter, locationRange, element))
+	})
+}
+
+// Difference returns a new set containing the elements of this set
+// which are not contained in the given set.
+func (v *SetValue) Difference(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+	return v.filter(interpreter, locationRange, func(element Value) bool {
+		return !bool(other.Contains(interpreter, locationRange, element))
+	})
+}
+
+func (v *SetValue) filter(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	include func(element Value) bool,
+) *SetValue {
+	result := NewSetValue(interpreter, locationRange, v.Type)
+
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		if include(element) {
+			_ = result.Insert(interpreter, locationRange, copySetElement(interpreter, locationRange, element))
+		}
+		return true
+	})
+
+	return result
+}
+
+// copySetElement returns a copy of the given element of a set.
+// Inserting an element into a set transfers it,
+// which would remove it from the storage of the set it was read from.
+func copySetElement(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) Value {
+	return element.Transfer(
+		interpreter,
+		locationRange,
+		atree.Address{},
+		false,
+		nil,
+		nil,
+	)
+}
+
+func (v *SetValue) ForEach(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) {
+	elementType := v.SemaType(interpreter).ElementType
+	argumentTypes := []sema.Type{elementType}
+
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+
+		// Meter computation for iterating the set.
+		interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+		invocation := NewInvocation(
+			interpreter,
+			nil,
+			nil,
+			nil,
+			[]Value{element},
+			argumentTypes,
+			nil,
+			locationRange,
+		)
+
+		shouldContinue, ok := procedure.invoke(invocation).(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		return bool(shouldContinue)
+	})
+}
+
+func (v *SetValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *SetValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences)
+}
+
+func (v *SetValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+
+	values := make([]string, 0, v.Count())
+
+	_ = v.set.IterateKeys(func(element atree.Value) (resume bool, err error) {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+
+		values = append(
+			values,
+			MustConvertStoredValue(memoryGauge, element).
+				MeteredString(memoryGauge, seenReferences),
+		)
+		return true, nil
+	})
+
+	// len = len("Set([])") + ((n-1) times comma+space)
+	//     = 7 + 2n - 2
+	//
+	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
+	//    len = 2n + 7
+	//
+	// String of each element is metered separately.
+	strLen := len(values)*2 + 7
+
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
+
+	return format.Set(values)
+}
+
+func (v *SetValue) GetMember(
+	interpreter *Interpreter,
+	_ LocationRange,
+	name string,
+) Value {
+
+	switch name {
+	case sema.SetTypeLengthFieldName:
+		return NewIntValueFromInt64(interpreter, int64(v.Count()))
+
+	case sema.SetTypeContainsFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.SetContainsFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				return v.Contains(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeInsertFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.SetInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeRemoveFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.SetRemoveFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeUnionFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Union)
+
+	case sema.SetTypeIntersectionFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Intersection)
+
+	case sema.SetTypeDifferenceFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Difference)
+
+	case sema.SetTypeForEachFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			sema.SetForEachFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(invocation Invocation) Value {
+				procedure, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEach(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					procedure,
+				)
+
+				return Void
+			},
+		)
+	}
+
+	return nil
+}
+
+func (v *SetValue) newSetOperationFunction(
+	interpreter *Interpreter,
+	operation func(
+		v *SetValue,
+		interpreter *Interpreter,
+		locationRange LocationRange,
+		other *SetValue,
+	) *SetValue,
+) *HostFunctionValue {
+	return NewHostFunctionValue(
+		interpreter,
+		sema.SetOperationFunctionType(
+			v.SemaType(interpreter),
+		),
+		func(invocation Invocation) Value {
+			other, ok := invocation.Arguments[0].(*SetValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return operation(
+				v,
+				invocation.Interpreter,
+				invocation.LocationRange,
+				other,
+			)
+		},
+	)
+}
+
+func (v *SetValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Sets have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Sets have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	results TypeConformanceResults,
+) bool {
+
+	elementType := v.Type.ElementType
+
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		atreeElement, err := iterator.NextKey()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if atreeElement == nil {
+			return true
+		}
+
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		element := MustConvertStoredValue(interpreter, atreeElement)
+
+		if !interpreter.IsSubType(element.StaticType(interpreter), elementType) {
+			return false
+		}
+
+		if !element.ConformsToStaticType(
+			interpreter,
+			locationRange,
+			results,
+		) {
+			return false
+		}
+	}
+}
+
+func (v *SetValue) Equal(interpreter *Interpreter, locationRange LocationRange, other Value) bool {
+
+	otherSet, ok := other.(*SetValue)
+	if !ok {
+		return false
+	}
+
+	if v.Count() != otherSet.Count() {
+		return false
+	}
+
+	if !v.Type.Equal(otherSet.Type) {
+		return false
+	}
+
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		atreeElement, err := iterator.NextKey()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if atreeElement == nil {
+			return true
+		}
+
+		// Do NOT use an iterator, as other value may be stored in another account,
+		// leading to a different iteration order, as the storage ID is used in the seed
+		element := MustConvertStoredValue(interpreter, atreeElement)
+		if !otherSet.Contains(interpreter, locationRange, element) {
+			return false
+		}
+	}
+}
+
+func (v *SetValue) Storable(
+	storage atree.SlabStorage,
+	address atree.Address,
+	maxInlineSize uint64,
+) (atree.Storable, error) {
+	return v.set.Storable(storage, address, maxInlineSize)
+}
+
+func (v *SetValue) Transfer(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+	preventTransfer map[atree.StorageID]struct{},
+) Value {
+
+	config := interpreter.SharedState.Config
+
+	interpreter.ReportComputation(
+		common.ComputationKindTransferSetValue,
+		uint(v.Count()),
+	)
+
+	currentStorageID := v.StorageID()
+
+	if preventTransfer == nil {
+		preventTransfer = map[atree.StorageID]struct{}{}
+	} else if _, ok := preventTransfer[currentStorageID]; ok {
+		panic(RecursiveTransferError{
+			LocationRange: locationRange,
+		})
+	}
+	preventTransfer[currentStorageID] = struct{}{}
+	defer delete(preventTransfer, currentStorageID)
+
+	// Sets are never resource-kinded,
+	// so they are always copied
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	elementCount := v.set.Count()
+
+	elementOverhead, dataUse, metaDataUse := common.NewAtreeMapMemoryUsages(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
+
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(config.MemoryGauge, elementMemoryUse)
+
+	set, err := atree.NewMapFromBatchData(
+		config.Storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, err := iterator.NextKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Transfer(interpreter, locationRange, address, remove, nil, preventTransfer)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	if remove {
+		err = v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+			interpreter.RemoveReferencedSlab(elementStorable)
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		interpreter.maybeValidateAtreeValue(v.set)
+
+		interpreter.RemoveReferencedSlab(storable)
+	}
+
+	res := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		set,
+	)
+
+	res.semaType = v.semaType
+
+	return res
+}
+
+func (v *SetValue) Clone(interpreter *Interpreter) Value {
+	config := interpreter.SharedState.Config
+
+	valueComparator := newValueComparator(interpreter, EmptyLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, EmptyLocationRange)
+
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	orderedMap, err := atree.NewMapFromBatchData(
+		config.Storage,
+		v.StorageAddress(),
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, err := iterator.NextKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Clone(interpreter)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	set := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		orderedMap,
+	)
+
+	set.semaType = v.semaType
+
+	return set
+}
+
+func (v *SetValue) DeepRemove(interpreter *Interpreter) {
+
+	// Remove nested values and storables
+
+	storage := v.set.Storage
+
+	err := v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+
+		element := StoredValue(interpreter, elementStorable, storage)
+		element.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(elementStorable)
+
+		interpreter.RemoveReferencedSlab(valueStorable)
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.set)
+}
+
+func (v *SetValue) GetOwner() common.Address {
+	return common.Address(v.StorageAddress())
+}
+
+func (v *SetValue) StorageID() atree.StorageID {
+	return v.set.StorageID()
+}
+
+func (v *SetValue) StorageAddress() atree.Address {
+	return v.set.Address()
+}
+
+func (v *SetValue) SemaType(interpreter *Interpreter) *sema.SetType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.SetType)
+	}
+	return v.semaType
+}
+
+func (v *SetValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageAddress()
+}
+
+func (*SetValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
@@ -557,6 +557,31 @@ func TestRuntimeParseLiteral(t *testing.T) {
 		require.Nil(t, value)
 	})
 
+	t.Run("Fix128, valid literal, negative", func(t *testing.T) {
+		expected, err := cadence.NewFix128FromParts(true, 1, big.NewInt(1))
+		require.NoError(t, err)
+
+		value, err := ParseLiteral(`-1.000000000000000000000001`, sema.Fix128Type, NewTestInterpreter(t))
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	})
+
+	t.Run("UFix128, valid literal, positive", func(t *testing.T) {
+		expected, err := cadence.NewUFix128FromParts(1, big.NewInt(1))
+		require.NoError(t, err)
+
+		value, err := ParseLiteral(`1.000000000000000000000001`, sema.UFix128Type, NewTestInterpreter(t))
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	})
+
+	t.Run("UFix128, invalid literal, negative", func(t *testing.T) {
+		value, err := ParseLiteral(`-1.0`, sema.UFix128Type, NewTestInterpreter(t))
+		RequireError(t, err)
+
+		require.Nil(t, value)
+	})
+
 	t.Run("FixedPoint, valid literal, positive", func(t *testing.T) {
 		expected, err := cadence.NewFix64FromParts(false, 1, 0)
 		require.NoError(t, err)
@@ -0,0 +1,74 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package meter
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// ComputationLimitExceededError is reported when the computation limit of a meter is exceeded
+
+type ComputationLimitExceededError struct {
+	Limit uint64
+	Used  uint64
+}
+
+var _ errors.UserError = ComputationLimitExceededError{}
+
+func (ComputationLimitExceededError) IsUserError() {}
+
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+func (ComputationLimitExceededError) ErrorCode() errors.ErrorCode {
+	return 6003
+}
+
+func (e ComputationLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"computation limit exceeded: limit %d, used %d",
+		e.Limit,
+		e.Used,
+	)
+}
+
+// MemoryLimitExceededError is reported when the memory limit of a meter is exceeded
+
+type MemoryLimitExceededError struct {
+	Limit uint64
+	Used  uint64
+}
+
+var _ errors.UserError = MemoryLimitExceededError{}
+
+func (MemoryLimitExceededError) IsUserError() {}
+
+func (MemoryLimitExceededError) ErrorCode() errors.ErrorCode {
+	return 6004
+}
+
+func (e MemoryLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"memory limit exceeded: limit %d, used %d",
+		e.Limit,
+		e.Used,
+	)
+}
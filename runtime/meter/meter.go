@@ -0,0 +1,376 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package meter implements configurable computation and memory metering,
+// e.g. for standalone hosts and tests, which have no metering of their own.
+package meter
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Weights are the weights of the kinds of computation and memory.
+//
+// The metered usage of an operation is its intensity (or amount)
+// multiplied by the weight of its kind.
+// Kinds without a weight are not metered.
+type Weights struct {
+	Computation map[common.ComputationKind]uint64
+	Memory      map[common.MemoryKind]uint64
+}
+
+// DefaultWeights returns weights which meter all kinds of computation and memory with weight 1.
+func DefaultWeights() Weights {
+	weights := Weights{
+		Computation: map[common.ComputationKind]uint64{},
+		Memory:      map[common.MemoryKind]uint64{},
+	}
+	for _, kind := range computationKindsByName() {
+		weights.Computation[kind] = 1
+	}
+	for _, kind := range memoryKindsByName() {
+		weights.Memory[kind] = 1
+	}
+	return weights
+}
+
+type weightsFile struct {
+	Computation map[string]uint64 `yaml:"computation"`
+	Memory      map[string]uint64 `yaml:"memory"`
+}
+
+// ParseWeights parses weights in YAML or JSON format, e.g.:
+//
+//	computation:
+//	  Statement: 1
+//	  FunctionInvocation: 2
+//	memory:
+//	  StringValue: 1
+//
+// Kinds are given by name (e.g. `Loop` for common.ComputationKindLoop), or by number.
+// Kinds which are not given are not metered.
+func ParseWeights(data []byte) (Weights, error) {
+	var file weightsFile
+	err := yaml.Unmarshal(data, &file)
+	if err != nil {
+		return Weights{}, fmt.Errorf("invalid meter weights: %w", err)
+	}
+
+	weights := Weights{
+		Computation: make(map[common.ComputationKind]uint64, len(file.Computation)),
+		Memory:      make(map[common.MemoryKind]uint64, len(file.Memory)),
+	}
+
+	computationKinds := computationKindsByName()
+	for name, weight := range file.Computation {
+		kind, ok := computationKinds[name]
+		if !ok {
+			number, err := strconv.ParseUint(name, 10, 64)
+			if err != nil {
+				return Weights{}, fmt.Errorf("invalid meter weights: unknown computation kind: %s", name)
+			}
+			kind = common.ComputationKind(number)
+		}
+		weights.Computation[kind] = weight
+	}
+
+	memoryKinds := memoryKindsByName()
+	for name, weight := range file.Memory {
+		kind, ok := memoryKinds[name]
+		if !ok {
+			number, err := strconv.ParseUint(name, 10, 64)
+			if err != nil || number >= uint64(common.MemoryKindLast) {
+				return Weights{}, fmt.Errorf("invalid meter weights: unknown memory kind: %s", name)
+			}
+			kind = common.MemoryKind(number)
+		}
+		weights.Memory[kind] = weight
+	}
+
+	return weights, nil
+}
+
+// LoadWeights reads and parses the weights file at the given path, see ParseWeights.
+func LoadWeights(path string) (Weights, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Weights{}, err
+	}
+	return ParseWeights(data)
+}
+
+func computationKindsByName() map[string]common.ComputationKind {
+	kinds := map[string]common.ComputationKind{}
+	// [1000,2000) is reserved for Cadence interpreter and runtime
+	for kind := common.ComputationKindRangeStart; kind < 2*common.ComputationKindRangeStart; kind++ {
+		computationKind := common.ComputationKind(kind)
+		name := computationKind.String()
+		if strings.HasPrefix(name, "ComputationKind(") {
+			continue
+		}
+		kinds[name] = computationKind
+	}
+	return kinds
+}
+
+func memoryKindsByName() map[string]common.MemoryKind {
+	kinds := map[string]common.MemoryKind{}
+	for kind := common.MemoryKindUnknown + 1; kind < common.MemoryKindLast; kind++ {
+		kinds[kind.String()] = kind
+	}
+	return kinds
+}
+
+// Limits are the hard limits of a meter.
+// A limit of zero means unlimited.
+type Limits struct {
+	Computation uint64
+	Memory      uint64
+}
+
+// Meter is a reusable implementation of computation and memory metering,
+// e.g. for runtime.Interface.MeterComputation and runtime.Interface.MeterMemory in standalone hosts.
+//
+// It weighs the usage of each kind, enforces the limits,
+// and keeps a breakdown of the usage by kind.
+type Meter struct {
+	weights         Weights
+	limits          Limits
+	mutex           sync.Mutex
+	computationUsed uint64
+	memoryUsed      uint64
+	computation     map[common.ComputationKind]*KindUsage
+	memory          map[common.MemoryKind]*KindUsage
+}
+
+var _ common.MemoryGauge = &Meter{}
+
+// New returns a meter which meters with the given weights, and enforces the given limits
+func New(weights Weights, limits Limits) *Meter {
+	return &Meter{
+		weights:     weights,
+		limits:      limits,
+		computation: map[common.ComputationKind]*KindUsage{},
+		memory:      map[common.MemoryKind]*KindUsage{},
+	}
+}
+
+// KindUsage is the usage of a kind of computation or memory
+type KindUsage struct {
+	Kind string `json:"kind"`
+	// Intensity is the total unweighted intensity (or amount)
+	Intensity uint64 `json:"intensity"`
+	// Used is the total weighted usage
+	Used uint64 `json:"used"`
+}
+
+func (u *KindUsage) add(intensity uint64, weight uint64) uint64 {
+	used := saturatingMul(intensity, weight)
+	u.Intensity = saturatingAdd(u.Intensity, intensity)
+	u.Used = saturatingAdd(u.Used, used)
+	return used
+}
+
+// MeterComputation meters the given computation.
+// It returns a ComputationLimitExceededError if the computation limit is exceeded.
+func (m *Meter) MeterComputation(kind common.ComputationKind, intensity uint) error {
+	weight, ok := m.weights.Computation[kind]
+	if !ok {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	usage, ok := m.computation[kind]
+	if !ok {
+		usage = &KindUsage{
+			Kind: kind.String(),
+		}
+		m.computation[kind] = usage
+	}
+
+	used := usage.add(uint64(intensity), weight)
+	m.computationUsed = saturatingAdd(m.computationUsed, used)
+
+	if m.limits.Computation > 0 && m.computationUsed > m.limits.Computation {
+		return ComputationLimitExceededError{
+			Limit: m.limits.Computation,
+			Used:  m.computationUsed,
+		}
+	}
+
+	return nil
+}
+
+// MeterMemory meters the given memory usage.
+// It returns a MemoryLimitExceededError if the memory limit is exceeded.
+func (m *Meter) MeterMemory(usage common.MemoryUsage) error {
+	weight, ok := m.weights.Memory[usage.Kind]
+	if !ok {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	kindUsage, ok := m.memory[usage.Kind]
+	if !ok {
+		kindUsage = &KindUsage{
+			Kind: usage.Kind.String(),
+		}
+		m.memory[usage.Kind] = kindUsage
+	}
+
+	used := kindUsage.add(usage.Amount, weight)
+	m.memoryUsed = saturatingAdd(m.memoryUsed, used)
+
+	if m.limits.Memory > 0 && m.memoryUsed > m.limits.Memory {
+		return MemoryLimitExceededError{
+			Limit: m.limits.Memory,
+			Used:  m.memoryUsed,
+		}
+	}
+
+	return nil
+}
+
+// ComputationUsed returns the total weighted computation used so far
+func (m *Meter) ComputationUsed() uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.computationUsed
+}
+
+// MemoryUsed returns the total weighted memory used so far
+func (m *Meter) MemoryUsed() uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.memoryUsed
+}
+
+// Report is a breakdown of the usage of a meter
+type Report struct {
+	ComputationUsed  uint64 `json:"computationUsed"`
+	ComputationLimit uint64 `json:"computationLimit,omitempty"`
+	MemoryUsed       uint64 `json:"memoryUsed"`
+	MemoryLimit      uint64 `json:"memoryLimit,omitempty"`
+	// Computation is the usage by kind of computation, ordered by descending usage
+	Computation []KindUsage `json:"computation"`
+	// Memory is the usage by kind of memory, ordered by descending usage
+	Memory []KindUsage `json:"memory"`
+}
+
+// Report returns a breakdown of the usage so far
+func (m *Meter) Report() *Report {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	report := &Report{
+		ComputationUsed:  m.computationUsed,
+		ComputationLimit: m.limits.Computation,
+		MemoryUsed:       m.memoryUsed,
+		MemoryLimit:      m.limits.Memory,
+		Computation:      make([]KindUsage, 0, len(m.computation)),
+		Memory:           make([]KindUsage, 0, len(m.memory)),
+	}
+
+	for _, usage := range m.computation {
+		report.Computation = append(report.Computation, *usage)
+	}
+	sortKindUsages(report.Computation)
+
+	for _, usage := range m.memory {
+		report.Memory = append(report.Memory, *usage)
+	}
+	sortKindUsages(report.Memory)
+
+	return report
+}
+
+func sortKindUsages(usages []KindUsage) {
+	sort.Slice(usages, func(i, j int) bool {
+		a, b := usages[i], usages[j]
+		if a.Used != b.Used {
+			return a.Used > b.Used
+		}
+		return a.Kind < b.Kind
+	})
+}
+
+// WriteTo writes the report as human-readable tables
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	var builder strings.Builder
+
+	writeTotal := func(name string, used uint64, limit uint64) {
+		if limit > 0 {
+			_, _ = fmt.Fprintf(&builder, "%s used: %d (limit %d)\n", name, used, limit)
+		} else {
+			_, _ = fmt.Fprintf(&builder, "%s used: %d\n", name, used)
+		}
+	}
+
+	writeTable := func(usages []KindUsage) {
+		tabWriter := tabwriter.NewWriter(&builder, 0, 8, 2, ' ', tabwriter.AlignRight)
+		_, _ = fmt.Fprintln(tabWriter, "Kind\tIntensity\tUsed\t")
+		for _, usage := range usages {
+			_, _ = fmt.Fprintf(tabWriter, "%s\t%d\t%d\t\n", usage.Kind, usage.Intensity, usage.Used)
+		}
+		_ = tabWriter.Flush()
+	}
+
+	writeTotal("Computation", r.ComputationUsed, r.ComputationLimit)
+	writeTable(r.Computation)
+	builder.WriteByte('\n')
+	writeTotal("Memory", r.MemoryUsed, r.MemoryLimit)
+	writeTable(r.Memory)
+
+	n, err := io.WriteString(w, builder.String())
+	return int64(n), err
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return sum
+}
+
+func saturatingMul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
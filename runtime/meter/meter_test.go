@@ -0,0 +1,299 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package meter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/meter"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+)
+
+func TestParseWeights(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("YAML", func(t *testing.T) {
+
+		t.Parallel()
+
+		weights, err := meter.ParseWeights([]byte(`
+computation:
+  Statement: 1
+  FunctionInvocation: 2
+  2001: 3
+memory:
+  StringValue: 4
+`))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			meter.Weights{
+				Computation: map[common.ComputationKind]uint64{
+					common.ComputationKindStatement:          1,
+					common.ComputationKindFunctionInvocation: 2,
+					common.ComputationKind(2001):             3,
+				},
+				Memory: map[common.MemoryKind]uint64{
+					common.MemoryKindStringValue: 4,
+				},
+			},
+			weights,
+		)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+
+		t.Parallel()
+
+		weights, err := meter.ParseWeights([]byte(`
+          {
+            "computation": {"Loop": 5},
+            "memory": {"1": 6}
+          }
+        `))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			meter.Weights{
+				Computation: map[common.ComputationKind]uint64{
+					common.ComputationKindLoop: 5,
+				},
+				Memory: map[common.MemoryKind]uint64{
+					common.MemoryKind(1): 6,
+				},
+			},
+			weights,
+		)
+	})
+
+	t.Run("unknown computation kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := meter.ParseWeights([]byte(`{"computation": {"Unknown": 1}}`))
+		require.ErrorContains(t, err, "unknown computation kind: Unknown")
+	})
+
+	t.Run("unknown memory kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := meter.ParseWeights([]byte(`{"memory": {"999999": 1}}`))
+		require.ErrorContains(t, err, "unknown memory kind: 999999")
+	})
+}
+
+func TestDefaultWeights(t *testing.T) {
+
+	t.Parallel()
+
+	weights := meter.DefaultWeights()
+
+	assert.Equal(t, uint64(1), weights.Computation[common.ComputationKindStatement])
+	assert.Equal(t, uint64(1), weights.Computation[common.ComputationKindLoop])
+	assert.Equal(t, uint64(1), weights.Memory[common.MemoryKindStringValue])
+	assert.NotContains(t, weights.Memory, common.MemoryKindUnknown)
+	assert.NotContains(t, weights.Memory, common.MemoryKindLast)
+}
+
+func TestMeter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("weights and report", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := meter.New(
+			meter.Weights{
+				Computation: map[common.ComputationKind]uint64{
+					common.ComputationKindStatement: 1,
+					common.ComputationKindLoop:      10,
+				},
+				Memory: map[common.MemoryKind]uint64{
+					common.MemoryKindStringValue: 2,
+				},
+			},
+			meter.Limits{},
+		)
+
+		require.NoError(t, m.MeterComputation(common.ComputationKindStatement, 3))
+		require.NoError(t, m.MeterComputation(common.ComputationKindLoop, 2))
+		require.NoError(t, m.MeterComputation(common.ComputationKindStatement, 1))
+		// Not weighted, so not metered
+		require.NoError(t, m.MeterComputation(common.ComputationKindFunctionInvocation, 100))
+
+		require.NoError(t, m.MeterMemory(common.MemoryUsage{Kind: common.MemoryKindStringValue, Amount: 5}))
+		require.NoError(t, m.MeterMemory(common.MemoryUsage{Kind: common.MemoryKindCharacterValue, Amount: 5}))
+
+		assert.Equal(t, uint64(24), m.ComputationUsed())
+		assert.Equal(t, uint64(10), m.MemoryUsed())
+
+		report := m.Report()
+		assert.Equal(t,
+			&meter.Report{
+				ComputationUsed: 24,
+				MemoryUsed:      10,
+				Computation: []meter.KindUsage{
+					{Kind: "Loop", Intensity: 2, Used: 20},
+					{Kind: "Statement", Intensity: 4, Used: 4},
+				},
+				Memory: []meter.KindUsage{
+					{Kind: "StringValue", Intensity: 5, Used: 10},
+				},
+			},
+			report,
+		)
+
+		var builder strings.Builder
+		_, err := report.WriteTo(&builder)
+		require.NoError(t, err)
+
+		output := builder.String()
+		assert.Contains(t, output, "Computation used: 24\n")
+		assert.Contains(t, output, "Memory used: 10\n")
+		assert.Contains(t, output, "Loop")
+		assert.Contains(t, output, "StringValue")
+	})
+
+	t.Run("limits", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := meter.New(
+			meter.DefaultWeights(),
+			meter.Limits{
+				Computation: 10,
+				Memory:      5,
+			},
+		)
+
+		require.NoError(t, m.MeterComputation(common.ComputationKindStatement, 10))
+
+		err := m.MeterComputation(common.ComputationKindStatement, 1)
+		require.Equal(t,
+			meter.ComputationLimitExceededError{
+				Limit: 10,
+				Used:  11,
+			},
+			err,
+		)
+
+		err = m.MeterMemory(common.MemoryUsage{Kind: common.MemoryKindStringValue, Amount: 6})
+		require.Equal(t,
+			meter.MemoryLimitExceededError{
+				Limit: 5,
+				Used:  6,
+			},
+			err,
+		)
+
+		report := m.Report()
+		assert.Equal(t, uint64(10), report.ComputationLimit)
+		assert.Equal(t, uint64(5), report.MemoryLimit)
+	})
+}
+
+func TestMeterRuntime(t *testing.T) {
+
+	t.Parallel()
+
+	const script = `
+      access(all) fun main(): Int {
+          var i = 0
+          while i < 10 {
+              i = i + 1
+          }
+          return i
+      }
+    `
+
+	execute := func(m *meter.Meter) (cadence.Value, error) {
+		rt := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Meter: m,
+		}
+
+		return rt.ExecuteScript(
+			runtime.Script{
+				Source: []byte(script),
+			},
+			runtime.Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("usage", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := meter.New(meter.DefaultWeights(), meter.Limits{})
+
+		result, err := execute(m)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(10), result)
+
+		report := m.Report()
+
+		var loopUsage *meter.KindUsage
+		for i, usage := range report.Computation {
+			if usage.Kind == common.ComputationKindLoop.String() {
+				loopUsage = &report.Computation[i]
+			}
+		}
+		require.NotNil(t, loopUsage)
+		assert.Equal(t, uint64(10), loopUsage.Intensity)
+
+		assert.NotZero(t, report.MemoryUsed)
+	})
+
+	t.Run("limit exceeded", func(t *testing.T) {
+
+		t.Parallel()
+
+		m := meter.New(
+			meter.Weights{
+				Computation: map[common.ComputationKind]uint64{
+					common.ComputationKindLoop: 1,
+				},
+			},
+			meter.Limits{
+				Computation: 5,
+			},
+		)
+
+		_, err := execute(m)
+		require.Error(t, err)
+
+		var limitErr meter.ComputationLimitExceededError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, uint64(5), limitErr.Limit)
+	})
+}
@@ -692,6 +692,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		p.memoryGauge,
 		identifiers,
 		location,
+		nil,
 		ast.NewRange(
 			p.memoryGauge,
 			startPosition,
@@ -818,6 +819,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -1057,6 +1059,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			nil,
 			conformances,
 			members,
 			docString,
@@ -647,6 +647,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 //	    'import'
 //	    ( identifier (',' identifier)* 'from' )?
 //	    ( string | hexadecimalLiteral | identifier )
+//	    ( 'hash' hexadecimalLiteral )?
 func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 	startPosition := p.current.StartPos
@@ -851,10 +852,33 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		)
 	}
 
+	var hash []byte
+
+	if isNextTokenHash(p) {
+		p.skipSpaceAndComments()
+
+		// Skip the `hash` keyword
+		p.nextSemanticToken()
+
+		if !p.current.Is(lexer.TokenHexadecimalIntegerLiteral) {
+			return nil, p.syntaxError(
+				"unexpected token in import declaration: got %s, expected hexadecimal hash",
+				p.current.Type,
+			)
+		}
+
+		hash = parseImportHash(p)
+		endPos = p.current.EndPos
+
+		// Skip the hash
+		p.next()
+	}
+
 	return ast.NewImportDeclaration(
 		p.memoryGauge,
 		identifiers,
 		location,
+		hash,
 		ast.NewRange(
 			p.memoryGauge,
 			startPosition,
@@ -888,6 +912,39 @@ func isNextTokenCommaOrFrom(p *parser) bool {
 	return false
 }
 
+// isNextTokenHash checks whether the token to follow is the `hash` keyword.
+func isNextTokenHash(p *parser) bool {
+	current := p.current
+	cursor := p.tokens.Cursor()
+	defer func() {
+		p.current = current
+		p.tokens.Revert(cursor)
+	}()
+
+	p.skipSpaceAndComments()
+
+	return p.isToken(p.current, lexer.TokenIdentifier, KeywordHash)
+}
+
+func parseImportHash(p *parser) []byte {
+	literal := string(p.currentTokenSource())
+
+	digits := strings.ReplaceAll(literal[2:], "_", "")
+
+	if len(digits) == 0 || len(digits)%2 == 1 {
+		p.reportSyntaxError("invalid import hash: expected an even number of hexadecimal digits")
+		return nil
+	}
+
+	hash, err := hex.DecodeString(digits)
+	if err != nil {
+		// unreachable, hex literal should always be valid
+		panic(errors.NewUnexpectedErrorFromCause(err))
+	}
+
+	return hash
+}
+
 func parseHexadecimalLocation(p *parser) common.AddressLocation {
 	// TODO: improve
 	literal := string(p.currentTokenSource())
@@ -980,6 +1037,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -1293,7 +1351,7 @@ func parseConformances(p *parser) ([]*ast.NominalType, error) {
 //
 //	conformances : ':' nominalType ( ',' nominalType )*
 //
-//	compositeDeclaration : compositeKind identifier conformances?
+//	compositeDeclaration : compositeKind identifier typeParameterList? conformances?
 //	                       '{' membersAndNestedDeclarations '}'
 //
 //	interfaceDeclaration : compositeKind 'interface' identifier conformances?
@@ -1355,6 +1413,16 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	}
 
+	var typeParameterList *ast.TypeParameterList
+
+	if p.config.TypeParametersEnabled && !isInterface {
+		var err error
+		typeParameterList, err = parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p.skipSpaceAndComments()
 
 	conformances, err := parseConformances(p)
@@ -1402,6 +1470,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			typeParameterList,
 			conformances,
 			members,
 			docString,
@@ -2419,6 +2419,122 @@ func TestParseImportDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("one identifier, address location, hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(` import foo from 0x42 hash 0xca_fe`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					Hash:        []byte{0xca, 0xfe},
+					LocationPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier location, hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(` import foo hash 0x01`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Location:    common.IdentifierLocation("foo"),
+					Hash:        []byte{0x01},
+					LocationPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, missing hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(` import foo from 0x42 hash "cafe"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in import declaration: got string, expected hexadecimal hash",
+					Pos:     ast.Position{Offset: 27, Line: 1, Column: 27},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, odd number of digits", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(` import foo from 0x42 hash 0xcaf`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected an even number of hexadecimal digits",
+					Pos:     ast.Position{Offset: 27, Line: 1, Column: 27},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          import hash from 0x42
+
+          /// doc
+          let x = 1
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		importDeclaration := result[0].(*ast.ImportDeclaration)
+		assert.Equal(t,
+			[]ast.Identifier{
+				{
+					Identifier: "hash",
+					Pos:        ast.Position{Line: 2, Column: 17, Offset: 18},
+				},
+			},
+			importDeclaration.Identifiers,
+		)
+		assert.Nil(t, importDeclaration.Hash)
+
+		variableDeclaration := result[1].(*ast.VariableDeclaration)
+		assert.Equal(t, " doc", variableDeclaration.DocString)
+	})
 }
 
 func TestParseEvent(t *testing.T) {
@@ -3219,6 +3335,93 @@ func TestParseCompositeDeclaration(t *testing.T) {
 		)
 	})
 
+	t.Run("struct, type parameters, enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(
+			nil,
+			[]byte(" struct S<T: AnyStruct, U> : I { }"),
+			Config{
+				TypeParametersEnabled: true,
+			},
+		)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessNotSpecified,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "S",
+						Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "AnyStruct",
+											Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+								},
+							},
+							{
+								Identifier: ast.Identifier{
+									Identifier: "U",
+									Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+							EndPos:   ast.Position{Line: 1, Column: 25, Offset: 25},
+						},
+					},
+					Conformances: []*ast.NominalType{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "I",
+								Pos:        ast.Position{Line: 1, Column: 29, Offset: 29},
+							},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("struct, type parameters, disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(" struct S<T> { }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '{'",
+					Pos:     ast.Position{Offset: 9, Line: 1, Column: 9},
+				},
+			},
+			errs,
+		)
+	})
+
 	t.Run("struct, with fields, functions, and special functions", func(t *testing.T) {
 
 		t.Parallel()
@@ -0,0 +1,66 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Error codes of the parser errors.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Parsing
+
+func (*SyntaxError) ErrorCode() errors.ErrorCode {
+	return 1001
+}
+
+func (*SyntaxErrorWithSuggestedReplacement) ErrorCode() errors.ErrorCode {
+	return 1002
+}
+
+func (*JuxtaposedUnaryOperatorsError) ErrorCode() errors.ErrorCode {
+	return 1003
+}
+
+func (*InvalidIntegerLiteralError) ErrorCode() errors.ErrorCode {
+	return 1004
+}
+
+func (ExpressionDepthLimitReachedError) ErrorCode() errors.ErrorCode {
+	return 1005
+}
+
+func (TypeDepthLimitReachedError) ErrorCode() errors.ErrorCode {
+	return 1006
+}
+
+func (*MissingCommaInParameterListError) ErrorCode() errors.ErrorCode {
+	return 1007
+}
+
+func (*CustomDestructorError) ErrorCode() errors.ErrorCode {
+	return 1008
+}
+
+func (*RestrictedTypeError) ErrorCode() errors.ErrorCode {
+	return 1009
+}
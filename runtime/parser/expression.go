@@ -436,6 +436,12 @@ func init() {
 		tokenType: lexer.TokenString,
 		nullDenotation: func(p *parser, token lexer.Token) (ast.Expression, error) {
 			literal := p.tokenSource(token)
+
+			interpolations, complete := findStringInterpolations(literal)
+			if len(interpolations) > 0 || !complete {
+				return parseStringTemplate(p, literal, interpolations, complete, token.Range)
+			}
+
 			parsedString := parseStringLiteral(p, literal)
 			return ast.NewStringExpression(
 				p.memoryGauge,
@@ -1597,6 +1603,214 @@ func parseStringLiteral(p *parser, literal []byte) (result string) {
 	return
 }
 
+// stringInterpolation is the location of an interpolated expression in a string literal,
+// i.e. the offsets of the expression source between `\(` and `)`.
+type stringInterpolation struct {
+	startOffset int
+	endOffset   int
+}
+
+// findStringInterpolations returns the locations of all interpolated expressions
+// in the given string literal, including start and end quotes.
+//
+// The result is incomplete if an interpolation is not terminated.
+func findStringInterpolations(literal []byte) (interpolations []stringInterpolation, complete bool) {
+	length := len(literal)
+
+	// NOTE: skip the start quote
+	for index := 1; index < length; {
+		switch literal[index] {
+		case '"':
+			return interpolations, true
+
+		case '\\':
+			if index+1 < length && literal[index+1] == '(' {
+				startOffset := index + 2
+				endOffset, ok := findStringInterpolationEnd(literal, startOffset)
+				if !ok {
+					return interpolations, false
+				}
+
+				interpolations = append(
+					interpolations,
+					stringInterpolation{
+						startOffset: startOffset,
+						endOffset:   endOffset,
+					},
+				)
+
+				index = endOffset + 1
+				continue
+			}
+
+			// skip the escaped character
+			index += 2
+
+		default:
+			index++
+		}
+	}
+
+	return interpolations, true
+}
+
+// findStringInterpolationEnd returns the offset of the parenthesis
+// which closes the interpolation starting at the given offset.
+// Nested parentheses and string literals are skipped.
+func findStringInterpolationEnd(literal []byte, startOffset int) (int, bool) {
+	length := len(literal)
+	depth := 1
+
+	for index := startOffset; index < length; index++ {
+		switch literal[index] {
+		case '\n':
+			return 0, false
+
+		case '(':
+			depth++
+
+		case ')':
+			depth--
+			if depth == 0 {
+				return index, true
+			}
+
+		case '"':
+			// skip the nested string literal,
+			// which may itself contain interpolations
+			index++
+			for index < length && literal[index] != '"' {
+				if literal[index] == '\\' {
+					if index+1 < length && literal[index+1] == '(' {
+						endOffset, ok := findStringInterpolationEnd(literal, index+2)
+						if !ok {
+							return 0, false
+						}
+						index = endOffset + 1
+						continue
+					}
+					index++
+				}
+				index++
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// parseStringTemplate parses a string literal with interpolated expressions,
+// e.g. `"balance: \(vault.balance)"`, including start and end quotes
+func parseStringTemplate(
+	p *parser,
+	literal []byte,
+	interpolations []stringInterpolation,
+	complete bool,
+	tokenRange ast.Range,
+) (ast.Expression, error) {
+
+	if !complete {
+		p.reportSyntaxError("invalid end of string interpolation: missing ')'")
+	}
+
+	values := make([]string, 0, len(interpolations)+1)
+	expressions := make([]ast.Expression, 0, len(interpolations))
+
+	// NOTE: skip the start quote
+	valueStartOffset := 1
+
+	for _, interpolation := range interpolations {
+
+		// NOTE: the value ends before `\(`
+		valueEndOffset := interpolation.startOffset - 2
+		values = append(
+			values,
+			parseStringLiteralContent(p, literal[valueStartOffset:valueEndOffset]),
+		)
+
+		// String literals cannot span multiple lines,
+		// so the interpolated expression starts on the same line as the literal
+
+		startPos := ast.NewPosition(
+			p.memoryGauge,
+			tokenRange.StartPos.Offset+interpolation.startOffset,
+			tokenRange.StartPos.Line,
+			tokenRange.StartPos.Column+utf8.RuneCount(literal[:interpolation.startOffset]),
+		)
+
+		expression, err := parseStringInterpolation(
+			p,
+			startPos,
+			tokenRange.StartPos.Offset+interpolation.endOffset,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		expressions = append(expressions, expression)
+
+		// NOTE: the next value starts after `)`
+		valueStartOffset = interpolation.endOffset + 1
+	}
+
+	if complete {
+		length := len(literal)
+		valueEndOffset := length
+		if length > valueStartOffset && literal[length-1] == '"' {
+			valueEndOffset = length - 1
+		} else {
+			p.reportSyntaxError("invalid end of string literal: missing '\"'")
+		}
+
+		values = append(
+			values,
+			parseStringLiteralContent(p, literal[valueStartOffset:valueEndOffset]),
+		)
+	} else {
+		values = append(values, "")
+	}
+
+	return ast.NewStringTemplateExpression(
+		p.memoryGauge,
+		values,
+		expressions,
+		tokenRange,
+	), nil
+}
+
+// parseStringInterpolation parses the interpolated expression of a string template,
+// which starts at the given position, and ends before the given end offset
+func parseStringInterpolation(p *parser, startPos ast.Position, endOffset int) (ast.Expression, error) {
+
+	tokens := lexer.LexInterpolation(
+		p.tokens.Input(),
+		startPos.Offset,
+		endOffset,
+		startPos,
+		p.memoryGauge,
+	)
+	defer tokens.Reclaim()
+
+	expression, errs := ParseTokenStream(
+		p.memoryGauge,
+		tokens,
+		func(nested *parser) (ast.Expression, error) {
+			// NOTE: the interpolated expression is nested in the current expression
+			nested.expressionDepth = p.expressionDepth
+			return parseExpression(nested, lowestBindingPower)
+		},
+		p.config,
+	)
+
+	if len(errs) > 0 {
+		lastIndex := len(errs) - 1
+		p.report(errs[:lastIndex]...)
+		return nil, errs[lastIndex]
+	}
+
+	return expression, nil
+}
+
 // parseStringLiteralContent parses the string literalExpr contents, excluding start and end quotes
 func parseStringLiteralContent(p *parser, s []byte) (result string) {
 
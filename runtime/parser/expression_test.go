@@ -5894,6 +5894,156 @@ func TestParseExpression(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid, single", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"a \(b) c"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"a ", " c"},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "b",
+							Pos:        ast.Position{Offset: 5, Line: 1, Column: 5},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+					EndPos:   ast.Position{Offset: 9, Line: 1, Column: 9},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("valid, multiple, escapes", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"\(a)\t\(b.c)\""`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", "\t", "\""},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Offset: 3, Line: 1, Column: 3},
+						},
+					},
+					&ast.MemberExpression{
+						Expression: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "b",
+								Pos:        ast.Position{Offset: 9, Line: 1, Column: 9},
+							},
+						},
+						AccessPos: ast.Position{Offset: 10, Line: 1, Column: 10},
+						Identifier: ast.Identifier{
+							Identifier: "c",
+							Pos:        ast.Position{Offset: 11, Line: 1, Column: 11},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+					EndPos:   ast.Position{Offset: 15, Line: 1, Column: 15},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("valid, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"\("\(a)")"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", ""},
+				Expressions: []ast.Expression{
+					&ast.StringTemplateExpression{
+						Values: []string{"", ""},
+						Expressions: []ast.Expression{
+							&ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Offset: 6, Line: 1, Column: 6},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Offset: 3, Line: 1, Column: 3},
+							EndPos:   ast.Position{Offset: 8, Line: 1, Column: 8},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+					EndPos:   ast.Position{Offset: 10, Line: 1, Column: 10},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, empty interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseExpression(`"\()"`)
+		require.NotEmpty(t, errs)
+	})
+
+	t.Run("invalid, missing end of interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseExpression(`"\(a"`)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid end of string interpolation: missing ')'",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid, expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseExpression(`"\(a b)"`)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token: identifier",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseStringEscapes(t *testing.T) {
 
 	t.Parallel()
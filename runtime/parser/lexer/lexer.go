@@ -40,6 +40,13 @@ var _ errors.UserError = TokenLimitReachedError{}
 
 func (TokenLimitReachedError) IsUserError() {}
 
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+func (TokenLimitReachedError) ErrorCode() errors.ErrorCode {
+	return 1011
+}
+
 func (TokenLimitReachedError) Error() string {
 	return fmt.Sprintf("limit of %d tokens exceeded", tokenLimit)
 }
@@ -153,6 +160,33 @@ func Lex(input []byte, memoryGauge common.MemoryGauge) TokenStream {
 	return l
 }
 
+// LexInterpolation creates a lexer which scans the part of the input
+// between the given start and end offsets, e.g. an interpolated expression in a string template.
+//
+// The positions of the tokens are relative to the whole input,
+// where the start offset is at the given start position.
+func LexInterpolation(
+	input []byte,
+	startOffset int,
+	endOffset int,
+	startPos ast.Position,
+	memoryGauge common.MemoryGauge,
+) TokenStream {
+	l := pool.Get().(*lexer)
+	l.clear()
+	l.memoryGauge = memoryGauge
+	l.input = input[:endOffset]
+	l.startOffset = startOffset
+	l.endOffset = startOffset
+	l.prevEndOffset = startOffset
+	l.startPos = position{
+		line:   startPos.Line,
+		column: startPos.Column,
+	}
+	l.run(rootState)
+	return l
+}
+
 // run executes the stateFn, which will scan the runes in the input
 // and emit tokens.
 //
@@ -401,12 +435,43 @@ func (l *lexer) scanString(quote rune) {
 				// NOTE: invalid end of string handled by parser
 				l.backupOne()
 				return
+			case '(':
+				if !l.scanStringInterpolation() {
+					return
+				}
 			}
 		}
 		r = l.next()
 	}
 }
 
+// scanStringInterpolation scans the interpolated expression of a string template,
+// i.e. everything following `\(` up to and including the matching closing parenthesis.
+// Nested parentheses and string literals are skipped.
+//
+// It returns false if the end of the line or input was reached before the closing parenthesis.
+func (l *lexer) scanStringInterpolation() bool {
+	depth := 1
+	for {
+		r := l.next()
+		switch r {
+		case '\n', EOF:
+			// NOTE: invalid end of string handled by parser
+			l.backupOne()
+			return false
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return true
+			}
+		case '"':
+			l.scanString('"')
+		}
+	}
+}
+
 func (l *lexer) scanBinaryRemainder() {
 	l.acceptWhile(func(r rune) bool {
 		return r == '0' || r == '1' || r == '_'
@@ -1187,6 +1187,138 @@ func TestLexString(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("valid, interpolation", func(t *testing.T) {
+		testLex(t,
+			`"a \(b) c"`,
+			[]token{
+				{
+					Token: Token{
+						Type: TokenString,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Source: `"a \(b) c"`,
+				},
+				{
+					Token: Token{
+						Type: TokenEOF,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+							EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("valid, nested interpolation", func(t *testing.T) {
+		testLex(t,
+			`"\(f("\(x)"))"`,
+			[]token{
+				{
+					Token: Token{
+						Type: TokenString,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+							EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					Source: `"\(f("\(x)"))"`,
+				},
+				{
+					Token: Token{
+						Type: TokenEOF,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("invalid, unterminated interpolation", func(t *testing.T) {
+		testLex(t,
+			`"\(x"`,
+			[]token{
+				{
+					Token: Token{
+						Type: TokenString,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+							EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+						},
+					},
+					Source: `"\(x"`,
+				},
+				{
+					Token: Token{
+						Type: TokenEOF,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+							EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+				},
+			},
+		)
+	})
+}
+
+func TestLexInterpolation(t *testing.T) {
+
+	t.Parallel()
+
+	input := []byte(`"a \(b+c)"`)
+
+	tokens := LexInterpolation(
+		input,
+		5,
+		8,
+		ast.Position{Line: 1, Column: 5, Offset: 5},
+		nil,
+	)
+
+	withTokens(tokens, func(actualTokens []Token) {
+		utils.AssertEqualWithDiff(t,
+			[]Token{
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+						EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+				},
+				{
+					Type: TokenPlus,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+						EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				{
+					Type: TokenEOF,
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+						EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+				},
+			},
+			actualTokens,
+		)
+	})
 }
 
 func TestLexBlockComment(t *testing.T) {
@@ -30,10 +30,10 @@ import (
 )
 
 // expressionDepthLimit is the limit of how deeply nested an expression can get
-const expressionDepthLimit = 1 << 4
+const expressionDepthLimit = ast.ExpressionDepthLimit
 
 // typeDepthLimit is the limit of how deeply nested a type can get
-const typeDepthLimit = 1 << 4
+const typeDepthLimit = ast.TypeDepthLimit
 
 // lowestBindingPower is the lowest binding power.
 // The binding power controls operator precedence:
@@ -682,6 +682,15 @@ func ParseProgram(memoryGauge common.MemoryGauge, code []byte, config Config) (p
 	return ParseProgramFromTokenStream(memoryGauge, tokens, config)
 }
 
+// ParseOrDecodeProgram decodes the given code if it is an encoded program (see ast.EncodeProgram),
+// without lexing and parsing it. Otherwise, the given code is parsed as source code.
+func ParseOrDecodeProgram(memoryGauge common.MemoryGauge, code []byte, config Config) (program *ast.Program, err error) {
+	if ast.IsEncodedProgram(code) {
+		return ast.DecodeProgram(memoryGauge, code)
+	}
+	return ParseProgram(memoryGauge, code, config)
+}
+
 func ParseProgramFromTokenStream(
 	memoryGauge common.MemoryGauge,
 	input lexer.TokenStream,
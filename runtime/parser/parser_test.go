@@ -112,9 +112,9 @@ func TestParseInvalid(t *testing.T) {
 		code string
 	}
 
-	unexpectedToken := "Parsing failed:\nerror: unexpected token: identifier"
-	unexpectedEndOfProgram := "Parsing failed:\nerror: unexpected end of program"
-	missingTypeAnnotation := "Parsing failed:\nerror: missing type annotation after comma"
+	unexpectedToken := "Parsing failed:\nerror[E1001]: unexpected token: identifier"
+	unexpectedEndOfProgram := "Parsing failed:\nerror[E1001]: unexpected end of program"
+	missingTypeAnnotation := "Parsing failed:\nerror[E1001]: missing type annotation after comma"
 
 	for _, test := range []test{
 		{unexpectedToken, "X"},
@@ -862,7 +862,7 @@ func TestParseInvalidSingleQuoteImport(t *testing.T) {
 
 	_, err := testParseProgram(`import 'X'`)
 
-	require.EqualError(t, err, "Parsing failed:\nerror: unrecognized character: U+0027 '''\n --> :1:7\n  |\n1 | import 'X'\n  |        ^\n\nerror: unexpected end in import declaration: expected string, address, or identifier\n --> :1:7\n  |\n1 | import 'X'\n  |        ^\n")
+	require.EqualError(t, err, "Parsing failed:\nerror[E1001]: unrecognized character: U+0027 '''\n --> :1:7\n  |\n1 | import 'X'\n  |        ^\n\nerror[E1001]: unexpected end in import declaration: expected string, address, or identifier\n --> :1:7\n  |\n1 | import 'X'\n  |        ^\n")
 }
 
 func TestParseExpressionDepthLimit(t *testing.T) {
@@ -89,6 +89,8 @@ func parseStatement(p *parser) (ast.Statement, error) {
 			return parseContinueStatement(p), nil
 		case KeywordIf:
 			return parseIfStatement(p)
+		case KeywordGuard:
+			return parseGuardStatement(p)
 		case KeywordSwitch:
 			return parseSwitchStatement(p)
 		case KeywordWhile:
@@ -395,6 +397,56 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 	return result, nil
 }
 
+func parseGuardStatement(p *parser) (*ast.GuardStatement, error) {
+
+	startPos := p.current.StartPos
+	p.nextSemanticToken()
+
+	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordLet) &&
+		!p.isToken(p.current, lexer.TokenIdentifier, KeywordVar) {
+
+		return nil, p.syntaxError(
+			"expected %s or %s after %s, got %s",
+			KeywordLet,
+			KeywordVar,
+			KeywordGuard,
+			p.current.Type,
+		)
+	}
+
+	variableDeclaration, err := parseVariableDeclaration(p, ast.AccessNotSpecified, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments()
+	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordElse) {
+		return nil, p.syntaxError(
+			"expected %s after %s declaration, got %s",
+			KeywordElse,
+			KeywordGuard,
+			p.current.Type,
+		)
+	}
+	p.nextSemanticToken()
+
+	elseBlock, err := parseBlock(p)
+	if err != nil {
+		return nil, err
+	}
+
+	guardStatement := ast.NewGuardStatement(
+		p.memoryGauge,
+		variableDeclaration,
+		elseBlock,
+		startPos,
+	)
+
+	variableDeclaration.ParentGuardStatement = guardStatement
+
+	return guardStatement, nil
+}
+
 func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 
 	startPos := p.current.StartPos
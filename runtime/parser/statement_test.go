@@ -576,6 +576,115 @@ func TestParseIfStatement(t *testing.T) {
 
 }
 
+func TestParseGuardStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("guard-let", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("guard let x = y else { return }")
+		require.Empty(t, errs)
+
+		expected := &ast.GuardStatement{
+			Test: &ast.VariableDeclaration{
+				Access:     ast.AccessNotSpecified,
+				IsConstant: true,
+				Identifier: ast.Identifier{
+					Identifier: "x",
+					Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+				Value: &ast.IdentifierExpression{
+					Identifier: ast.Identifier{
+						Identifier: "y",
+						Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+				},
+				Transfer: &ast.Transfer{
+					Operation: ast.TransferOperationCopy,
+					Pos:       ast.Position{Line: 1, Column: 12, Offset: 12},
+				},
+				StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+			},
+			Else: &ast.Block{
+				Statements: []ast.Statement{
+					&ast.ReturnStatement{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 23, Offset: 23},
+							EndPos:   ast.Position{Line: 1, Column: 28, Offset: 28},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+					EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+				},
+			},
+			StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+		}
+
+		expected.Test.ParentGuardStatement = expected
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				expected,
+			},
+			result,
+		)
+	})
+
+	t.Run("guard-var, move", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("guard var x <- y else { panic(\"\") }")
+		require.Empty(t, errs)
+		require.Len(t, result, 1)
+
+		guardStatement, ok := result[0].(*ast.GuardStatement)
+		require.True(t, ok)
+
+		assert.False(t, guardStatement.Test.IsConstant)
+		assert.Equal(t, ast.TransferOperationMove, guardStatement.Test.Transfer.Operation)
+		assert.Same(t, guardStatement, guardStatement.Test.ParentGuardStatement)
+	})
+
+	t.Run("missing declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("guard x else { return }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected let or var after guard, got identifier",
+					Pos:     ast.Position{Offset: 6, Line: 1, Column: 6},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing else", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("guard let x = y { return }")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected else after guard declaration, got '{'",
+					Pos:     ast.Position{Offset: 16, Line: 1, Column: 16},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseWhileStatement(t *testing.T) {
 
 	t.Parallel()
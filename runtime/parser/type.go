@@ -783,6 +783,28 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	}
 
 	p.skipSpaceAndComments()
+
+	// Parse the type arguments of a generic composite type, if any,
+	// e.g. `create R<Int>()`
+
+	var typeArguments []*ast.TypeAnnotation
+	if p.config.TypeParametersEnabled && p.current.Is(lexer.TokenLess) {
+		// consume the `<` token
+		p.next()
+
+		typeArguments, err = parseCommaSeparatedTypeAnnotations(p, lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+	}
+
 	parenOpenToken, err := p.mustOne(lexer.TokenParenOpen)
 	if err != nil {
 		return nil, err
@@ -812,7 +834,7 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	return ast.NewInvocationExpression(
 		p.memoryGauge,
 		invokedExpression,
-		nil,
+		typeArguments,
 		arguments,
 		argumentsStartPos,
 		endPos,
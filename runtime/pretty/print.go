@@ -55,6 +55,7 @@ func colorizeMeta(meta string) string {
 }
 
 const ErrorPrefix = "error"
+const StackTraceHeader = "Traceback (most recent call last):"
 const messageSeparator = ": "
 const excerptArrow = "--> "
 const excerptDots = "... "
@@ -167,6 +168,7 @@ func (p ErrorPrettyPrinter) PrettyPrintError(
 	}()
 
 	i := 0
+	inStackTrace := false
 	var printError func(err error, location common.Location) error
 	printError = func(err error, location common.Location) error {
 
@@ -203,6 +205,14 @@ func (p ErrorPrettyPrinter) PrettyPrintError(
 			p.writeString("\n")
 		}
 
+		// Introduce the frames of a stack trace with a header
+		_, isStackTraceFrame := err.(errors.StackTraceFrame)
+		if isStackTraceFrame && !inStackTrace {
+			p.writeString(StackTraceHeader)
+			p.writeString("\n")
+		}
+		inStackTrace = isStackTraceFrame
+
 		p.prettyPrintError(err, location, codes[location])
 		i++
 		return nil
@@ -218,6 +228,12 @@ func (p ErrorPrettyPrinter) prettyPrintError(err error, location common.Location
 		prefix = secondaryError.Prefix()
 	}
 
+	if prefix != "" {
+		if code, ok := errors.GetErrorCode(err); ok {
+			prefix = fmt.Sprintf("%s[%s]", prefix, code)
+		}
+	}
+
 	p.writeString(FormatErrorMessage(prefix, err.Error(), p.useColor))
 
 	message := ""
@@ -36,6 +36,36 @@ func (testError) Error() string {
 	return "test error"
 }
 
+type testStackTraceFrame struct {
+	ast.Range
+}
+
+func (testStackTraceFrame) IsStackTraceFrame() {}
+
+func (testStackTraceFrame) Error() string {
+	return ""
+}
+
+func (testStackTraceFrame) Prefix() string {
+	return ""
+}
+
+func (testStackTraceFrame) SecondaryError() string {
+	return "call"
+}
+
+type testParentError struct {
+	errs []error
+}
+
+func (e testParentError) Error() string {
+	return "test parent error"
+}
+
+func (e testParentError) ChildErrors() []error {
+	return e.errs
+}
+
 func TestPrintBrokenCode(t *testing.T) {
 
 	t.Parallel()
@@ -112,3 +142,61 @@ func TestPrintTabs(t *testing.T) {
 		sb.String(),
 	)
 }
+
+func TestPrintStackTrace(t *testing.T) {
+
+	t.Parallel()
+
+	const code = "f()\ng()\nx"
+
+	location := common.StringLocation("test")
+
+	lineRange := func(line, endColumn int) ast.Range {
+		return ast.Range{
+			StartPos: ast.Position{
+				Line:   line,
+				Column: 0,
+			},
+			EndPos: ast.Position{
+				Line:   line,
+				Column: endColumn,
+			},
+		}
+	}
+
+	var sb strings.Builder
+	printer := NewErrorPrettyPrinter(&sb, false)
+	err := printer.PrettyPrintError(
+		testParentError{
+			errs: []error{
+				testStackTraceFrame{Range: lineRange(1, 2)},
+				testStackTraceFrame{Range: lineRange(2, 2)},
+				testError{Range: lineRange(3, 0)},
+			},
+		},
+		location,
+		map[common.Location][]byte{
+			location: []byte(code),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"Traceback (most recent call last):\n"+
+			" --> test:1:0\n"+
+			"  |\n"+
+			"1 | f()\n"+
+			"  | ^^^ call\n"+
+			"\n"+
+			" --> test:2:0\n"+
+			"  |\n"+
+			"2 | g()\n"+
+			"  | ^^^ call\n"+
+			"\n"+
+			"error: test error\n"+
+			" --> test:3:0\n"+
+			"  |\n"+
+			"3 | x\n"+
+			"  | ^\n",
+		sb.String(),
+	)
+}
@@ -0,0 +1,414 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"container/list"
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// ProgramCache is a cache of parsed and checked programs,
+// which can be shared across executions, and is safe for concurrent use.
+//
+// Programs are keyed by location and code hash,
+// so a program is never returned for code that changed.
+// Each program also records the code hashes of all programs it (transitively) imports,
+// and it is only returned if the code of none of them changed.
+// Programs of transactions and scripts are not cached.
+//
+// The cache is bounded by a size, based on an estimate of the size of each program.
+// If the size is exceeded, the least recently used programs are evicted.
+//
+// Programs are invalidated when the code of a contract is updated or removed,
+// together with all programs that import it.
+type ProgramCache struct {
+	mutex     sync.Mutex
+	maxSize   uint64
+	size      uint64
+	entries   map[programCacheKey]*list.Element
+	evictList *list.List
+	stats     ProgramCacheStats
+}
+
+// ProgramCacheStats are statistics of a program cache.
+type ProgramCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Entries is the number of cached programs
+	Entries uint64
+	// Size is the estimated size of all cached programs
+	Size uint64
+}
+
+type programCacheKey struct {
+	location common.Location
+	codeHash [32]byte
+}
+
+type programCacheEntry struct {
+	program *interpreter.Program
+	// dependencies are the programs which were (transitively) imported
+	// when the program was loaded, in import order
+	dependencies []programCacheKey
+	// dependencySet is the set of the locations of the dependencies,
+	// only used while the program is loaded
+	dependencySet map[common.Location]struct{}
+	key           programCacheKey
+	size          uint64
+}
+
+// NewProgramCache returns a new program cache,
+// which holds programs up to the given estimated total size, in bytes.
+func NewProgramCache(maxSize uint64) *ProgramCache {
+	return &ProgramCache{
+		maxSize:   maxSize,
+		entries:   map[programCacheKey]*list.Element{},
+		evictList: list.New(),
+	}
+}
+
+// Stats returns the current statistics of the cache.
+func (c *ProgramCache) Stats() ProgramCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := c.stats
+	stats.Entries = uint64(len(c.entries))
+	stats.Size = c.size
+	return stats
+}
+
+// Invalidate removes the programs for the given location,
+// and all programs which (transitively) import it.
+func (c *ProgramCache) Invalidate(location common.Location) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// The dependencies of an entry are transitive,
+	// so a single pass removes all affected entries.
+	//
+	// Entries which are added concurrently with stale dependencies,
+	// or which depend on the location through an evicted entry,
+	// are also not returned, as their dependencies are checked on each hit
+
+	for element := c.evictList.Front(); element != nil; {
+		next := element.Next()
+
+		entry := element.Value.(*programCacheEntry)
+		if entry.dependsOn(location) {
+			c.removeElement(element)
+		}
+
+		element = next
+	}
+}
+
+func (e *programCacheEntry) dependsOn(location common.Location) bool {
+	if e.key.location == location {
+		return true
+	}
+	for _, dependency := range e.dependencies {
+		if dependency.location == location {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the entry for the given key, if any.
+// The entry is returned, even if its dependencies changed, see remove
+func (c *ProgramCache) get(key programCacheKey) *programCacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+
+	c.stats.Hits++
+	c.evictList.MoveToFront(element)
+	return element.Value.(*programCacheEntry)
+}
+
+// remove removes the given entry, e.g. because the code of one of its dependencies changed.
+// The lookup of the entry is counted as a miss instead of a hit
+func (c *ProgramCache) remove(entry *programCacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stats.Hits--
+	c.stats.Misses++
+
+	element, ok := c.entries[entry.key]
+	if !ok || element.Value != entry {
+		return
+	}
+
+	c.removeElement(element)
+}
+
+func (c *ProgramCache) add(entry *programCacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if entry.size > c.maxSize {
+		return
+	}
+
+	if element, ok := c.entries[entry.key]; ok {
+		c.removeElement(element)
+	}
+
+	for c.size+entry.size > c.maxSize {
+		c.removeElement(c.evictList.Back())
+		c.stats.Evictions++
+	}
+
+	c.entries[entry.key] = c.evictList.PushFront(entry)
+	c.size += entry.size
+}
+
+func (c *ProgramCache) removeElement(element *list.Element) {
+	entry := element.Value.(*programCacheEntry)
+	c.evictList.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func isCacheableProgramLocation(location common.Location) bool {
+	switch location.(type) {
+	case common.TransactionLocation, common.ScriptLocation:
+		return false
+	default:
+		return location != nil
+	}
+}
+
+// programCachingInterface is an Interface which loads programs through a program cache,
+// and delegates to the wrapped Interface.
+type programCachingInterface struct {
+	wrappedInterface
+	cache *ProgramCache
+	// programs are the programs returned in this execution.
+	// A program might get evicted from the cache during the execution,
+	// but the same program must be returned for the location
+	programs map[common.Location]programCachingResult
+	// codeHashes are the hashes of the code of the programs in this execution
+	codeHashes map[common.Location][32]byte
+	// loading is the stack of entries which are currently loaded
+	loading []*programCacheEntry
+	// contractsUpdated is set when a contract was updated or removed in this execution.
+	// Programs loaded afterwards are not cached, as the update might still get reverted
+	contractsUpdated bool
+}
+
+type programCachingResult struct {
+	program *interpreter.Program
+	err     error
+	// dependencies are the cached program itself and its dependencies,
+	// if the program is cacheable
+	dependencies []programCacheKey
+}
+
+var _ Interface = &programCachingInterface{}
+var _ Metrics = &programCachingInterface{}
+
+// withProgramCache returns an Interface which loads programs through the given cache, if any.
+// If no cache is given, the given Interface is returned.
+func withProgramCache(runtimeInterface Interface, cache *ProgramCache) Interface {
+	if cache == nil {
+		return runtimeInterface
+	}
+	return &programCachingInterface{
+		wrappedInterface: wrappedInterface{Interface: runtimeInterface},
+		cache:            cache,
+		programs:         map[common.Location]programCachingResult{},
+		codeHashes:       map[common.Location][32]byte{},
+	}
+}
+
+func (i *programCachingInterface) GetOrLoadProgram(
+	location Location,
+	load func() (*interpreter.Program, error),
+) (*interpreter.Program, error) {
+
+	result, ok := i.programs[location]
+	if !ok {
+		result = i.getOrLoadProgram(location, load)
+		i.programs[location] = result
+	}
+
+	i.recordDependencies(result.dependencies)
+
+	return result.program, result.err
+}
+
+func (i *programCachingInterface) getOrLoadProgram(
+	location Location,
+	load func() (*interpreter.Program, error),
+) (result programCachingResult) {
+
+	if !isCacheableProgramLocation(location) {
+		result.program, result.err = i.Interface.GetOrLoadProgram(location, load)
+		return
+	}
+
+	code, err := i.getCode(location)
+	if err != nil {
+		// Let the load function report the error
+		result.program, result.err = i.Interface.GetOrLoadProgram(location, load)
+		return
+	}
+
+	key := programCacheKey{
+		location: location,
+		codeHash: sha3.Sum256(code),
+	}
+	i.codeHashes[location] = key.codeHash
+
+	entry := i.cache.get(key)
+	if entry != nil {
+		if i.dependenciesUnchanged(entry.dependencies) {
+			// Report the hit, i.e. no time was spent on parsing and checking
+			i.ProgramParsed(location, 0)
+			i.ProgramChecked(location, 0)
+
+			result.program = entry.program
+			result.dependencies = append([]programCacheKey{key}, entry.dependencies...)
+			return
+		}
+
+		i.cache.remove(entry)
+	}
+
+	entry = &programCacheEntry{
+		key:           key,
+		dependencySet: map[common.Location]struct{}{},
+	}
+
+	i.loading = append(i.loading, entry)
+	result.program, result.err = i.Interface.GetOrLoadProgram(location, load)
+	i.loading = i.loading[:len(i.loading)-1]
+
+	entry.dependencySet = nil
+	result.dependencies = append([]programCacheKey{key}, entry.dependencies...)
+
+	if result.err != nil || result.program == nil || i.contractsUpdated {
+		return
+	}
+
+	entry.program = result.program
+	entry.size = estimateProgramSize(code, result.program)
+
+	i.cache.add(entry)
+
+	return
+}
+
+// recordDependencies records that the program currently being loaded, if any,
+// (transitively) imports the given programs
+func (i *programCachingInterface) recordDependencies(dependencies []programCacheKey) {
+	if len(i.loading) == 0 {
+		return
+	}
+	entry := i.loading[len(i.loading)-1]
+	for _, dependency := range dependencies {
+		if _, ok := entry.dependencySet[dependency.location]; ok {
+			continue
+		}
+		entry.dependencySet[dependency.location] = struct{}{}
+		entry.dependencies = append(entry.dependencies, dependency)
+	}
+}
+
+// dependenciesUnchanged returns true if the code of none of the given dependencies changed
+func (i *programCachingInterface) dependenciesUnchanged(dependencies []programCacheKey) bool {
+	for _, dependency := range dependencies {
+		codeHash, err := i.codeHash(dependency.location)
+		if err != nil || codeHash != dependency.codeHash {
+			return false
+		}
+	}
+	return true
+}
+
+// codeHash returns the hash of the current code of the program with the given location.
+// The hash is only computed once per execution, unless the contract is updated
+func (i *programCachingInterface) codeHash(location Location) ([32]byte, error) {
+	if codeHash, ok := i.codeHashes[location]; ok {
+		return codeHash, nil
+	}
+
+	code, err := i.getCode(location)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	codeHash := sha3.Sum256(code)
+	i.codeHashes[location] = codeHash
+	return codeHash, nil
+}
+
+func (i *programCachingInterface) getCode(location Location) ([]byte, error) {
+	if addressLocation, ok := location.(common.AddressLocation); ok {
+		return i.Interface.GetAccountContractCode(addressLocation)
+	}
+	return i.Interface.GetCode(location)
+}
+
+func (i *programCachingInterface) UpdateAccountContractCode(location common.AddressLocation, code []byte) error {
+	err := i.Interface.UpdateAccountContractCode(location, code)
+	if err != nil {
+		return err
+	}
+	i.contractsUpdated = true
+	delete(i.codeHashes, location)
+	i.cache.Invalidate(location)
+	return nil
+}
+
+func (i *programCachingInterface) RemoveAccountContractCode(location common.AddressLocation) error {
+	err := i.Interface.RemoveAccountContractCode(location)
+	if err != nil {
+		return err
+	}
+	i.contractsUpdated = true
+	delete(i.codeHashes, location)
+	i.cache.Invalidate(location)
+	return nil
+}
+
+// estimateProgramSize returns an estimate of the memory used by the given program, in bytes.
+// The AST is assumed to be proportional to the code.
+func estimateProgramSize(code []byte, program *interpreter.Program) uint64 {
+	const astSizePerCodeByte = 8
+	size := uint64(len(code)) * astSizePerCodeByte
+	if program.Elaboration != nil {
+		size += program.Elaboration.SizeEstimate()
+	}
+	return size
+}
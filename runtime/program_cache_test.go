@@ -0,0 +1,354 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeProgramCache(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contractC := func(answer string) []byte {
+		return []byte(`
+          access(all) contract C {
+              access(all) fun answer(): Int {
+                  return ` + answer + `
+              }
+          }
+        `)
+	}
+
+	contractD := []byte(`
+      import C from 0x1
+
+      access(all) contract D {
+          access(all) fun answer(): Int {
+              return C.answer()
+          }
+      }
+    `)
+
+	const script = `
+      import D from 0x1
+
+      access(all) fun main(): Int {
+          return D.answer()
+      }
+    `
+
+	locationC := common.NewAddressLocation(nil, address, "C")
+	locationD := common.NewAddressLocation(nil, address, "D")
+
+	type testEnvironment struct {
+		runtime      Runtime
+		cache        *ProgramCache
+		loads        map[common.Location]int
+		parsed       map[common.Location][]time.Duration
+		execute      func(code []byte) error
+		executeQuery func() (cadence.Value, error)
+		// setCode sets the code of a contract directly,
+		// without executing a transaction, i.e. without invalidating the cache
+		setCode func(location common.AddressLocation, code []byte)
+	}
+
+	newTestEnvironment := func(t *testing.T, maxSize uint64) *testEnvironment {
+
+		env := &testEnvironment{
+			runtime: NewTestInterpreterRuntime(),
+			cache:   NewProgramCache(maxSize),
+			loads:   map[common.Location]int{},
+			parsed:  map[common.Location][]time.Duration{},
+		}
+
+		var mutex sync.Mutex
+
+		ledger := NewTestLedger(nil, nil)
+		contracts := map[common.Location][]byte{}
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage:           ledger,
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				return contracts[location], nil
+			},
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				contracts[location] = code
+				return nil
+			},
+			OnRemoveAccountContractCode: func(location common.AddressLocation) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				delete(contracts, location)
+				return nil
+			},
+			OnEmitEvent: func(event cadence.Event) error {
+				return nil
+			},
+			OnGetAndSetProgram: func(
+				location Location,
+				load func() (*interpreter.Program, error),
+			) (*interpreter.Program, error) {
+				mutex.Lock()
+				env.loads[location]++
+				mutex.Unlock()
+
+				return load()
+			},
+			OnProgramParsed: func(location Location, duration time.Duration) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				env.parsed[location] = append(env.parsed[location], duration)
+			},
+			OnProgramChecked: func(_ Location, _ time.Duration) {},
+		}
+
+		nextTransactionLocation := NewTransactionLocationGenerator()
+		nextScriptLocation := NewScriptLocationGenerator()
+
+		env.setCode = func(location common.AddressLocation, code []byte) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			contracts[location] = code
+		}
+
+		env.execute = func(code []byte) error {
+			return env.runtime.ExecuteTransaction(
+				Script{
+					Source: code,
+				},
+				Context{
+					Interface:    runtimeInterface,
+					Location:     nextTransactionLocation(),
+					ProgramCache: env.cache,
+				},
+			)
+		}
+
+		env.executeQuery = func() (cadence.Value, error) {
+			mutex.Lock()
+			location := nextScriptLocation()
+			mutex.Unlock()
+
+			// Use a separate interface for each script,
+			// so scripts may be executed concurrently
+			scriptInterface := *runtimeInterface
+
+			return env.runtime.ExecuteScript(
+				Script{
+					Source: []byte(script),
+				},
+				Context{
+					Interface:    &scriptInterface,
+					Location:     location,
+					ProgramCache: env.cache,
+				},
+			)
+		}
+
+		err := env.execute(DeploymentTransaction("C", contractC("1")))
+		require.NoError(t, err)
+
+		err = env.execute(DeploymentTransaction("D", contractD))
+		require.NoError(t, err)
+
+		return env
+	}
+
+	t.Run("hit", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		for i := 0; i < 3; i++ {
+			value, err := env.executeQuery()
+			require.NoError(t, err)
+			assert.Equal(t, cadence.NewInt(1), value)
+		}
+
+		// C was loaded when deploying D, D when running the first script
+		assert.Equal(t, 1, env.loads[locationC])
+		assert.Equal(t, 1, env.loads[locationD])
+
+		// D was parsed when it was deployed and when running the first script.
+		// Hits are reported without any duration
+		require.Len(t, env.parsed[locationD], 4)
+		assert.NotZero(t, env.parsed[locationD][0])
+		assert.NotZero(t, env.parsed[locationD][1])
+		assert.Zero(t, env.parsed[locationD][2])
+		assert.Zero(t, env.parsed[locationD][3])
+
+		stats := env.cache.Stats()
+		assert.Equal(t, uint64(2), stats.Entries)
+		assert.Equal(t, uint64(0), stats.Evictions)
+		assert.GreaterOrEqual(t, stats.Hits, uint64(4))
+		assert.NotZero(t, stats.Size)
+	})
+
+	t.Run("update invalidates importers", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		value, err := env.executeQuery()
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(1), value)
+
+		require.Equal(t, uint64(2), env.cache.Stats().Entries)
+
+		err = env.execute(UpdateTransaction("C", contractC("2")))
+		require.NoError(t, err)
+
+		// Both C and D, which imports C, were invalidated
+		assert.Equal(t, uint64(0), env.cache.Stats().Entries)
+
+		loadsD := env.loads[locationD]
+
+		value, err = env.executeQuery()
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(2), value)
+
+		assert.Equal(t, loadsD+1, env.loads[locationD])
+		assert.Equal(t, uint64(2), env.cache.Stats().Entries)
+	})
+
+	t.Run("stale dependency", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		value, err := env.executeQuery()
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(1), value)
+
+		// The update of C is not noticed by the cache,
+		// like when D was added concurrently with the invalidation of C,
+		// or when the program of the imported contract got evicted
+
+		env.setCode(locationC, contractC("2"))
+
+		require.Equal(t, uint64(2), env.cache.Stats().Entries)
+
+		loadsD := env.loads[locationD]
+
+		// D is not returned, as the code of its dependency C changed
+
+		value, err = env.executeQuery()
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(2), value)
+
+		assert.Equal(t, loadsD+1, env.loads[locationD])
+	})
+
+	t.Run("removal", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		_, err := env.executeQuery()
+		require.NoError(t, err)
+
+		err = env.execute(RemovalTransaction("D"))
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), env.cache.Stats().Entries)
+
+		_, err = env.executeQuery()
+		RequireError(t, err)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		_, err := env.executeQuery()
+		require.NoError(t, err)
+
+		stats := env.cache.Stats()
+		require.Equal(t, uint64(2), stats.Entries)
+
+		// Only allow one of the two programs
+
+		env.cache = NewProgramCache(stats.Size - 1)
+
+		_, err = env.executeQuery()
+		require.NoError(t, err)
+
+		stats = env.cache.Stats()
+		assert.Equal(t, uint64(1), stats.Entries)
+		assert.Equal(t, uint64(1), stats.Evictions)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+
+		t.Parallel()
+
+		env := newTestEnvironment(t, 1<<20)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				value, err := env.executeQuery()
+				assert.NoError(t, err)
+				assert.Equal(t, cadence.NewInt(1), value)
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, uint64(2), env.cache.Stats().Entries)
+	})
+}
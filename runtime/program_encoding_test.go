@@ -0,0 +1,304 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/json"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeEncodedProgram(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	fooLocation := common.AddressLocation{
+		Address: address,
+		Name:    "Foo",
+	}
+
+	const fooContract = `
+      access(all) contract Foo {
+
+          access(all) struct Answer {
+              access(all) let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+          }
+
+          access(all) fun answer(): Answer {
+              return Answer(value: 42)
+          }
+      }
+    `
+
+	newRuntimeInterface := func(
+		storage TestLedger,
+		accountCodes map[Location][]byte,
+		events *[]cadence.Event,
+	) *TestRuntimeInterface {
+		return &TestRuntimeInterface{
+			Storage: storage,
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				return accountCodes[location], nil
+			},
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				accountCodes[location] = code
+				return nil
+			},
+			OnEmitEvent: func(event cadence.Event) error {
+				*events = append(*events, event)
+				return nil
+			},
+			OnDecodeArgument: func(b []byte, t cadence.Type) (value cadence.Value, err error) {
+				return json.Decode(nil, b)
+			},
+		}
+	}
+
+	// encodeContractCode replaces the deployed source code
+	// of the contract with the encoded program
+	encodeContractCode := func(accountCodes map[Location][]byte) {
+		program, err := parser.ParseProgram(nil, accountCodes[fooLocation], parser.Config{})
+		require.NoError(t, err)
+
+		encoded, err := ast.EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		accountCodes[fooLocation] = encoded
+	}
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := NewTestLedger(nil, nil)
+		accountCodes := map[Location][]byte{}
+		var events []cadence.Event
+
+		runtime := NewTestInterpreterRuntime()
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: DeploymentTransaction("Foo", []byte(fooContract)),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		encodeContractCode(accountCodes)
+
+		var parsedLocations []Location
+
+		runtimeInterface := newRuntimeInterface(storage, accountCodes, &events)
+		runtimeInterface.OnProgramParsed = func(location Location, _ time.Duration) {
+			parsedLocations = append(parsedLocations, location)
+		}
+
+		result, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  import Foo from 0x1
+
+                  access(all) fun main(): Int {
+                      return Foo.answer().value
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.NewInt(42), result)
+
+		require.Equal(t,
+			[]Location{
+				common.ScriptLocation{},
+				fooLocation,
+			},
+			parsedLocations,
+		)
+	})
+
+	t.Run("update", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := NewTestLedger(nil, nil)
+		accountCodes := map[Location][]byte{}
+		var events []cadence.Event
+
+		runtime := NewTestInterpreterRuntime()
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: DeploymentTransaction("Foo", []byte(fooContract)),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		encodeContractCode(accountCodes)
+
+		const updatedFooContract = `
+          access(all) contract Foo {
+
+              access(all) struct Answer {
+                  access(all) let value: Int
+
+                  init(value: Int) {
+                      self.value = value
+                  }
+              }
+
+              access(all) fun answer(): Answer {
+                  return Answer(value: 43)
+              }
+          }
+        `
+
+		err = runtime.ExecuteTransaction(
+			Script{
+				Source: UpdateTransaction("Foo", []byte(updatedFooContract)),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, []byte(updatedFooContract), accountCodes[fooLocation])
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := NewTestLedger(nil, nil)
+		accountCodes := map[Location][]byte{}
+		var events []cadence.Event
+
+		runtime := NewTestInterpreterRuntime()
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: DeploymentTransaction("Foo", []byte(fooContract)),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		encodeContractCode(accountCodes)
+
+		// Truncate the encoded program
+		encoded := accountCodes[fooLocation]
+		accountCodes[fooLocation] = encoded[:len(encoded)/2]
+
+		_, err = runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  import Foo from 0x1
+
+                  access(all) fun main(): Int {
+                      return Foo.answer().value
+                  }
+                `),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  common.ScriptLocation{},
+			},
+		)
+		RequireError(t, err)
+
+		var decodingErr ast.ProgramDecodingError
+		require.ErrorAs(t, err, &decodingErr)
+	})
+
+	t.Run("deploy", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := NewTestLedger(nil, nil)
+		accountCodes := map[Location][]byte{}
+		var events []cadence.Event
+
+		runtime := NewTestInterpreterRuntime()
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		program, err := parser.ParseProgram(nil, []byte(fooContract), parser.Config{})
+		require.NoError(t, err)
+
+		encoded, err := ast.EncodeProgram(program, nil)
+		require.NoError(t, err)
+
+		// Encoded programs may only be provided by the host,
+		// the code of a contract deployment must be source code
+
+		err = runtime.ExecuteTransaction(
+			Script{
+				Source: DeploymentTransaction("Foo", encoded),
+			},
+			Context{
+				Interface: newRuntimeInterface(storage, accountCodes, &events),
+				Location:  nextTransactionLocation(),
+			},
+		)
+		RequireError(t, err)
+
+		var notAllowedErr *EncodedProgramNotAllowedError
+		require.ErrorAs(t, err, &notAllowedErr)
+
+		require.Empty(t, accountCodes)
+	})
+}
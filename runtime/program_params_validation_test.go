@@ -488,6 +488,11 @@ func TestRuntimeScriptParameterTypeValidation(t *testing.T) {
 					},
 				).WithType(PublicKeyType)
 
+			case sema.DictionaryEntryCompositeType:
+				// Generic types must be instantiated
+				typeName = "DictionaryEntry<Int, Int>"
+				expectErrors = true
+
 			default:
 				// This test case only focuses on the type,
 				// and has no interest in the value.
@@ -1047,6 +1052,11 @@ func TestRuntimeTransactionParameterTypeValidation(t *testing.T) {
 					},
 				).WithType(PublicKeyType)
 
+			case sema.DictionaryEntryCompositeType:
+				// Generic types must be instantiated
+				typeName = "DictionaryEntry<Int, Int>"
+				expectErrors = true
+
 			default:
 				// This test case only focuses on the type,
 				// and has no interest in the value.
@@ -0,0 +1,285 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// RegisterID identifies a ledger register,
+// i.e. a key in the storage of an owner.
+type RegisterID struct {
+	Owner string
+	Key   string
+}
+
+func NewRegisterID(owner, key []byte) RegisterID {
+	return RegisterID{
+		Owner: string(owner),
+		Key:   string(key),
+	}
+}
+
+// contractCodeRegisterKeyPrefix is the prefix of the keys of the registers
+// which represent the code of contracts in read/write sets
+const contractCodeRegisterKeyPrefix = "code."
+
+// ContractCodeRegisterID returns the ID of the register which represents
+// the code of the contract with the given location in read/write sets.
+func ContractCodeRegisterID(location common.AddressLocation) RegisterID {
+	return RegisterID{
+		Owner: string(location.Address[:]),
+		Key:   contractCodeRegisterKeyPrefix + location.Name,
+	}
+}
+
+func (id RegisterID) compare(other RegisterID) int {
+	result := bytes.Compare([]byte(id.Owner), []byte(other.Owner))
+	if result != 0 {
+		return result
+	}
+	return bytes.Compare([]byte(id.Key), []byte(other.Key))
+}
+
+// ReadWriteSet records the state accessed by an executor:
+// the ledger registers read and written,
+// the code of contracts read and written (see ContractCodeRegisterID),
+// the accounts for which storage indices were allocated
+// and account IDs were generated,
+// and whether UUIDs were generated.
+//
+// Other state which is maintained by the host environment
+// (e.g. account keys, or balances)
+// is not recorded and must be tracked by the host.
+type ReadWriteSet struct {
+	Reads                   map[RegisterID]struct{}
+	Writes                  map[RegisterID]struct{}
+	StorageIndexAllocations map[common.Address]struct{}
+	AccountIDGenerations    map[common.Address]struct{}
+	UUIDGenerations         uint64
+}
+
+func NewReadWriteSet() *ReadWriteSet {
+	return &ReadWriteSet{
+		Reads:                   map[RegisterID]struct{}{},
+		Writes:                  map[RegisterID]struct{}{},
+		StorageIndexAllocations: map[common.Address]struct{}{},
+		AccountIDGenerations:    map[common.Address]struct{}{},
+	}
+}
+
+func (s *ReadWriteSet) RecordRead(owner, key []byte) {
+	s.Reads[NewRegisterID(owner, key)] = struct{}{}
+}
+
+func (s *ReadWriteSet) RecordWrite(owner, key []byte) {
+	s.Writes[NewRegisterID(owner, key)] = struct{}{}
+}
+
+func (s *ReadWriteSet) RecordStorageIndexAllocation(address common.Address) {
+	s.StorageIndexAllocations[address] = struct{}{}
+}
+
+func (s *ReadWriteSet) RecordAccountIDGeneration(address common.Address) {
+	s.AccountIDGenerations[address] = struct{}{}
+}
+
+func (s *ReadWriteSet) RecordUUIDGeneration() {
+	s.UUIDGenerations++
+}
+
+// ReadWriteConflicts describes the conflicts between two read/write sets.
+type ReadWriteConflicts struct {
+	// Registers which are written by one set,
+	// and read or written by the other set.
+	Registers []RegisterID
+	// Accounts for which both sets allocated storage indices.
+	StorageIndexAllocations []common.Address
+	// Accounts for which both sets generated account IDs.
+	AccountIDGenerations []common.Address
+	// UUIDGeneration is true if both sets generated UUIDs.
+	UUIDGeneration bool
+}
+
+// IsEmpty returns true if there are no conflicts.
+func (c ReadWriteConflicts) IsEmpty() bool {
+	return len(c.Registers) == 0 &&
+		len(c.StorageIndexAllocations) == 0 &&
+		len(c.AccountIDGenerations) == 0 &&
+		!c.UUIDGeneration
+}
+
+// Conflicts returns the conflicts between the two read/write sets.
+//
+// Two executions which have no conflicts may be executed in parallel,
+// and their results are the same as if they were executed serially, in any order.
+// The detection is conservative: Write-write conflicts are reported,
+// even if the later write does not depend on the earlier one.
+func (s *ReadWriteSet) Conflicts(other *ReadWriteSet) ReadWriteConflicts {
+	var conflicts ReadWriteConflicts
+
+	registers := map[RegisterID]struct{}{}
+
+	for id := range s.Writes { // nolint:maprange
+		_, read := other.Reads[id]
+		_, written := other.Writes[id]
+		if read || written {
+			registers[id] = struct{}{}
+		}
+	}
+
+	for id := range other.Writes { // nolint:maprange
+		if _, read := s.Reads[id]; read {
+			registers[id] = struct{}{}
+		}
+	}
+
+	for id := range registers { // nolint:maprange
+		conflicts.Registers = append(conflicts.Registers, id)
+	}
+	sort.Slice(conflicts.Registers, func(i, j int) bool {
+		return conflicts.Registers[i].compare(conflicts.Registers[j]) < 0
+	})
+
+	conflicts.StorageIndexAllocations = addressIntersection(
+		s.StorageIndexAllocations,
+		other.StorageIndexAllocations,
+	)
+
+	conflicts.AccountIDGenerations = addressIntersection(
+		s.AccountIDGenerations,
+		other.AccountIDGenerations,
+	)
+
+	conflicts.UUIDGeneration = s.UUIDGenerations > 0 && other.UUIDGenerations > 0
+
+	return conflicts
+}
+
+func addressIntersection(a, b map[common.Address]struct{}) []common.Address {
+	var result []common.Address
+	for address := range a { // nolint:maprange
+		if _, ok := b[address]; ok {
+			result = append(result, address)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i][:], result[j][:]) < 0
+	})
+	return result
+}
+
+// readWriteSetRecordingInterface is an Interface
+// which records all state accesses in a read/write set,
+// and delegates to the wrapped Interface.
+type readWriteSetRecordingInterface struct {
+	wrappedInterface
+	readWriteSet *ReadWriteSet
+}
+
+var _ Interface = &readWriteSetRecordingInterface{}
+var _ Metrics = &readWriteSetRecordingInterface{}
+
+// withReadWriteSetRecording returns an Interface which records all state accesses
+// to the given read/write set, if any. If no read/write set is given,
+// the Interface is returned as-is.
+func withReadWriteSetRecording(runtimeInterface Interface, readWriteSet *ReadWriteSet) Interface {
+	if readWriteSet == nil {
+		return runtimeInterface
+	}
+	return &readWriteSetRecordingInterface{
+		wrappedInterface: wrappedInterface{Interface: runtimeInterface},
+		readWriteSet:     readWriteSet,
+	}
+}
+
+func (i *readWriteSetRecordingInterface) GetValue(owner, key []byte) ([]byte, error) {
+	i.readWriteSet.RecordRead(owner, key)
+	return i.Interface.GetValue(owner, key)
+}
+
+func (i *readWriteSetRecordingInterface) SetValue(owner, key, value []byte) error {
+	i.readWriteSet.RecordWrite(owner, key)
+	return i.Interface.SetValue(owner, key, value)
+}
+
+func (i *readWriteSetRecordingInterface) ValueExists(owner, key []byte) (bool, error) {
+	i.readWriteSet.RecordRead(owner, key)
+	return i.Interface.ValueExists(owner, key)
+}
+
+func (i *readWriteSetRecordingInterface) AllocateStorageIndex(owner []byte) (atree.StorageIndex, error) {
+	address, err := common.BytesToAddress(owner)
+	if err != nil {
+		return atree.StorageIndex{}, err
+	}
+	i.readWriteSet.RecordStorageIndexAllocation(address)
+	return i.Interface.AllocateStorageIndex(owner)
+}
+
+func (i *readWriteSetRecordingInterface) GenerateUUID() (uint64, error) {
+	i.readWriteSet.RecordUUIDGeneration()
+	return i.Interface.GenerateUUID()
+}
+
+func (i *readWriteSetRecordingInterface) GenerateAccountID(address common.Address) (uint64, error) {
+	i.readWriteSet.RecordAccountIDGeneration(address)
+	return i.Interface.GenerateAccountID(address)
+}
+
+func (i *readWriteSetRecordingInterface) GetOrLoadProgram(
+	location Location,
+	load func() (*interpreter.Program, error),
+) (*interpreter.Program, error) {
+	// The program of a contract might be provided by the host without loading its code,
+	// but it still depends on the code
+	if addressLocation, ok := location.(common.AddressLocation); ok {
+		i.recordContractCodeRead(addressLocation)
+	}
+	return i.Interface.GetOrLoadProgram(location, load)
+}
+
+func (i *readWriteSetRecordingInterface) GetAccountContractCode(location common.AddressLocation) ([]byte, error) {
+	i.recordContractCodeRead(location)
+	return i.Interface.GetAccountContractCode(location)
+}
+
+func (i *readWriteSetRecordingInterface) UpdateAccountContractCode(location common.AddressLocation, code []byte) error {
+	i.recordContractCodeWrite(location)
+	return i.Interface.UpdateAccountContractCode(location, code)
+}
+
+func (i *readWriteSetRecordingInterface) RemoveAccountContractCode(location common.AddressLocation) error {
+	i.recordContractCodeWrite(location)
+	return i.Interface.RemoveAccountContractCode(location)
+}
+
+func (i *readWriteSetRecordingInterface) recordContractCodeRead(location common.AddressLocation) {
+	i.readWriteSet.Reads[ContractCodeRegisterID(location)] = struct{}{}
+}
+
+func (i *readWriteSetRecordingInterface) recordContractCodeWrite(location common.AddressLocation) {
+	i.readWriteSet.Writes[ContractCodeRegisterID(location)] = struct{}{}
+}
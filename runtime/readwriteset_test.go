@@ -0,0 +1,304 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeReadWriteSet(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	address1 := common.MustBytesToAddress([]byte{0x1})
+	address2 := common.MustBytesToAddress([]byte{0x2})
+
+	ledger := NewTestLedger(nil, nil)
+
+	execute := func(signer common.Address, code string) *ReadWriteSet {
+		readWriteSet := NewReadWriteSet()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: ledger,
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{signer}, nil
+			},
+		}
+
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface:    runtimeInterface,
+				Location:     nextTransactionLocation(),
+				ReadWriteSet: readWriteSet,
+			},
+		)
+		require.NoError(t, err)
+
+		return readWriteSet
+	}
+
+	save := execute(address1, `
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              signer.storage.save(1, to: /storage/x)
+          }
+      }
+    `)
+
+	load := execute(address1, `
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              signer.storage.borrow<&Int>(from: /storage/x)!
+          }
+      }
+    `)
+
+	issue := execute(address2, `
+      transaction {
+          prepare(signer: auth(Capabilities) &Account) {
+              signer.capabilities.storage.issue<&Int>(/storage/x)
+          }
+      }
+    `)
+
+	storageRegister := NewRegisterID(address1[:], []byte(common.PathDomainStorage.Identifier()))
+
+	assert.Contains(t, save.Writes, storageRegister)
+	assert.Contains(t, save.StorageIndexAllocations, address1)
+	assert.Contains(t, load.Reads, storageRegister)
+	assert.NotContains(t, load.Writes, storageRegister)
+	assert.Contains(t, issue.AccountIDGenerations, address2)
+
+	conflicts := save.Conflicts(load)
+	assert.Contains(t, conflicts.Registers, storageRegister)
+	assert.False(t, conflicts.IsEmpty())
+
+	assert.Equal(t, conflicts, load.Conflicts(save))
+
+	assert.True(t, save.Conflicts(issue).IsEmpty())
+	assert.True(t, load.Conflicts(issue).IsEmpty())
+}
+
+func TestRuntimeReadWriteSetContractCode(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contract := []byte(`
+      access(all) contract Test {
+          access(all) fun hello() {}
+      }
+    `)
+
+	ledger := NewTestLedger(nil, nil)
+	accountCodes := map[Location][]byte{}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	execute := func(code []byte) *ReadWriteSet {
+		readWriteSet := NewReadWriteSet()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: ledger,
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				return accountCodes[location], nil
+			},
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				accountCodes[location] = code
+				return nil
+			},
+			OnEmitEvent: func(_ cadence.Event) error {
+				return nil
+			},
+		}
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: code,
+			},
+			Context{
+				Interface:    runtimeInterface,
+				Location:     nextTransactionLocation(),
+				ReadWriteSet: readWriteSet,
+			},
+		)
+		require.NoError(t, err)
+
+		return readWriteSet
+	}
+
+	deploy := execute(DeploymentTransaction("Test", contract))
+
+	update := execute(UpdateTransaction("Test", contract))
+
+	imports := execute([]byte(`
+      import Test from 0x1
+
+      transaction {
+          prepare(signer: &Account) {
+              Test.hello()
+          }
+      }
+    `))
+
+	save := execute([]byte(`
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              signer.storage.save(1, to: /storage/x)
+          }
+      }
+    `))
+
+	codeRegister := ContractCodeRegisterID(common.NewAddressLocation(nil, address, "Test"))
+
+	assert.Contains(t, deploy.Writes, codeRegister)
+	assert.Contains(t, update.Writes, codeRegister)
+	assert.Contains(t, imports.Reads, codeRegister)
+	assert.NotContains(t, imports.Writes, codeRegister)
+
+	conflicts := update.Conflicts(imports)
+	assert.Contains(t, conflicts.Registers, codeRegister)
+
+	assert.Equal(t, conflicts, imports.Conflicts(update))
+
+	assert.NotContains(t, update.Conflicts(save).Registers, codeRegister)
+	assert.True(t, imports.Conflicts(save).IsEmpty())
+}
+
+func TestReadWriteSetConflicts(t *testing.T) {
+
+	t.Parallel()
+
+	address1 := common.MustBytesToAddress([]byte{0x1})
+	address2 := common.MustBytesToAddress([]byte{0x2})
+
+	t.Run("read-read", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := NewReadWriteSet()
+		a.RecordRead(address1[:], []byte("a"))
+
+		b := NewReadWriteSet()
+		b.RecordRead(address1[:], []byte("a"))
+
+		assert.True(t, a.Conflicts(b).IsEmpty())
+	})
+
+	t.Run("read-write", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := NewReadWriteSet()
+		a.RecordRead(address1[:], []byte("a"))
+		a.RecordRead(address1[:], []byte("b"))
+
+		b := NewReadWriteSet()
+		b.RecordWrite(address1[:], []byte("b"))
+		b.RecordWrite(address2[:], []byte("a"))
+
+		expected := ReadWriteConflicts{
+			Registers: []RegisterID{
+				NewRegisterID(address1[:], []byte("b")),
+			},
+		}
+		assert.Equal(t, expected, a.Conflicts(b))
+		assert.Equal(t, expected, b.Conflicts(a))
+	})
+
+	t.Run("write-write", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := NewReadWriteSet()
+		a.RecordWrite(address2[:], []byte("a"))
+		a.RecordWrite(address1[:], []byte("b"))
+		a.RecordWrite(address1[:], []byte("a"))
+
+		b := NewReadWriteSet()
+		b.RecordWrite(address1[:], []byte("a"))
+		b.RecordWrite(address1[:], []byte("b"))
+		b.RecordWrite(address2[:], []byte("a"))
+
+		assert.Equal(t,
+			ReadWriteConflicts{
+				Registers: []RegisterID{
+					NewRegisterID(address1[:], []byte("a")),
+					NewRegisterID(address1[:], []byte("b")),
+					NewRegisterID(address2[:], []byte("a")),
+				},
+			},
+			a.Conflicts(b),
+		)
+	})
+
+	t.Run("allocations", func(t *testing.T) {
+
+		t.Parallel()
+
+		a := NewReadWriteSet()
+		a.RecordStorageIndexAllocation(address1)
+		a.RecordAccountIDGeneration(address2)
+		a.RecordUUIDGeneration()
+
+		b := NewReadWriteSet()
+		b.RecordStorageIndexAllocation(address1)
+		b.RecordAccountIDGeneration(address1)
+
+		assert.Equal(t,
+			ReadWriteConflicts{
+				StorageIndexAllocations: []common.Address{address1},
+			},
+			a.Conflicts(b),
+		)
+
+		b.RecordUUIDGeneration()
+
+		assert.Equal(t,
+			ReadWriteConflicts{
+				StorageIndexAllocations: []common.Address{address1},
+				UUIDGeneration:          true,
+			},
+			a.Conflicts(b),
+		)
+	})
+}
@@ -339,3 +339,132 @@ func TestRuntimeRLPDecodeList(t *testing.T) {
 		test(testCase)
 	}
 }
+
+func TestRuntimeRLPEncode(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	type testCase struct {
+		name           string
+		script         string
+		expectedErrMsg string
+		output         []cadence.Value
+	}
+
+	tests := []testCase{
+		{
+			name: "dog",
+			script: `
+              access(all) fun main(): [UInt8] {
+                  return RLP.encodeString("dog".utf8)
+              }
+            `,
+			output: []cadence.Value{
+				cadence.UInt8(0x83),
+				cadence.UInt8('d'),
+				cadence.UInt8('o'),
+				cadence.UInt8('g'),
+			},
+		},
+		{
+			name: "set theoretical representation of three",
+			script: `
+              access(all) fun main(): [UInt8] {
+                  // [ [], [[]], [ [], [[]] ] ]
+                  let empty = RLP.encodeList([])
+                  let one = RLP.encodeList([empty])
+                  return RLP.encodeList([empty, one, RLP.encodeList([empty, one])])
+              }
+            `,
+			output: []cadence.Value{
+				cadence.UInt8(0xc7),
+				cadence.UInt8(0xc0),
+				cadence.UInt8(0xc1),
+				cadence.UInt8(0xc0),
+				cadence.UInt8(0xc3),
+				cadence.UInt8(0xc0),
+				cadence.UInt8(0xc1),
+				cadence.UInt8(0xc0),
+			},
+		},
+		{
+			name: "round-trip",
+			script: `
+              access(all) fun main(): [UInt8] {
+                  let cat = RLP.encodeString("cat".utf8)
+                  let dog = RLP.encodeString("dog".utf8)
+                  let items = RLP.decodeList(RLP.encodeList([cat, dog]))
+                  if items.length != 2 || items[0] != cat || items[1] != dog {
+                      return []
+                  }
+                  return RLP.decodeString(items[1])
+              }
+            `,
+			output: []cadence.Value{
+				cadence.UInt8('d'),
+				cadence.UInt8('o'),
+				cadence.UInt8('g'),
+			},
+		},
+		{
+			name: "invalid list item",
+			script: `
+              access(all) fun main(): [UInt8] {
+                  return RLP.encodeList([[0x83, 0x64]])
+              }
+            `,
+			expectedErrMsg: "failed to RLP-encode list: incomplete input! not enough bytes to read",
+		},
+		{
+			name: "list item with trailing bytes",
+			script: `
+              access(all) fun main(): [UInt8] {
+                  return RLP.encodeList([[0x1, 0x2]])
+              }
+            `,
+			expectedErrMsg: "failed to RLP-encode list: list item is not a single RLP-encoded value",
+		},
+	}
+
+	test := func(test testCase) {
+		t.Run(test.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			runtimeInterface := &TestRuntimeInterface{
+				Storage: NewTestLedger(nil, nil),
+			}
+
+			result, err := runtime.ExecuteScript(
+				Script{
+					Source: []byte(test.script),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{},
+				},
+			)
+			if len(test.expectedErrMsg) > 0 {
+				RequireError(t, err)
+
+				assert.ErrorContains(t, err, test.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t,
+					cadence.Array{
+						Values: test.output,
+					}.WithType(&cadence.VariableSizedArrayType{
+						ElementType: cadence.UInt8Type,
+					}),
+					result,
+				)
+			}
+		})
+	}
+
+	for _, testCase := range tests {
+		test(testCase)
+	}
+}
@@ -105,6 +105,19 @@ type Runtime interface {
 	// or if the execution fails.
 	ExecuteScript(Script, Context) (cadence.Value, error)
 
+	// ExecuteScriptBatch executes the given scripts against the same state.
+	//
+	// Imported programs and already loaded values, like contract values,
+	// are shared across the scripts, which avoids repeated loading and decoding.
+	// The scripts are isolated from each other: writes of a script are not visible
+	// to any other script, and are not written to the Interface.
+	// If the Interface implements ScriptBatchHandler, it is notified before and after each script,
+	// e.g. to reset the computation and memory meters for each script.
+	//
+	// The Location of the given Context is ignored, each script has its own location.
+	// The results are returned in the order of the given scripts.
+	ExecuteScriptBatch([]BatchScript, Context) []ScriptResult
+
 	// NewTransactionExecutor returns an executor which executes the given
 	// transaction.
 	NewTransactionExecutor(Script, Context) Executor
@@ -190,8 +203,17 @@ func validTopLevelDeclarations(location Location) common.DeclarationKindSet {
 func reportMetric(
 	f func(),
 	runtimeInterface Interface,
+	spanName string,
+	location Location,
 	report func(Metrics, time.Duration),
 ) {
+	// If the execution is traced, the operation is also traced as a span
+	tracer := spanTracerOf(runtimeInterface)
+	if tracer != nil {
+		span := tracer.startSpan(spanName, location, false)
+		defer tracer.endSpan(span)
+	}
+
 	metrics, ok := runtimeInterface.(Metrics)
 	if !ok {
 		f()
@@ -492,6 +514,8 @@ func hasValidStaticType(inter *interpreter.Interpreter, value interpreter.Value)
 	case *interpreter.DictionaryValue:
 		return value.Type.KeyType != nil &&
 			value.Type.ValueType != nil
+	case *interpreter.SetValue:
+		return value.Type.ElementType != nil
 	default:
 		// For other values, static type is NOT inferred.
 		// Hence no need to validate it here.
@@ -520,16 +544,22 @@ func (r *interpreterRuntime) ParseAndCheckProgram(
 		codesAndPrograms,
 	)
 
+	runtimeInterface := withProgramCache(context.Interface, context.ProgramCache)
+
 	environment := context.Environment
 	if environment == nil {
 		environment = NewBaseInterpreterEnvironment(r.defaultConfig)
 	}
 	environment.Configure(
-		context.Interface,
+		runtimeInterface,
 		codesAndPrograms,
 		nil,
 		context.CoverageReport,
 	)
+	environment.ConfigureExecution(ExecutionOptions{
+		Context:  context.Context,
+		Deadline: context.Deadline,
+	})
 
 	program, err = environment.ParseAndCheckProgram(
 		code,
@@ -564,6 +594,10 @@ func (r *interpreterRuntime) Storage(context Context) (*Storage, *interpreter.In
 		storage,
 		context.CoverageReport,
 	)
+	environment.ConfigureExecution(ExecutionOptions{
+		Context:  context.Context,
+		Deadline: context.Deadline,
+	})
 
 	_, inter, err := environment.Interpret(
 		location,
@@ -7898,7 +7898,7 @@ func TestRuntimeErrorExcerpts(t *testing.T) {
 	RequireError(t, err)
 
 	errorString := `Execution failed:
-error: unexpectedly found nil while forcing an Optional value
+error[E3014]: unexpectedly found nil while forcing an Optional value
   --> 0000000000000000000000000000000000000000000000000000000000000000:9:15
    |
  9 |         return a
@@ -7950,7 +7950,7 @@ func TestRuntimeErrorExcerptsMultiline(t *testing.T) {
 	RequireError(t, err)
 
 	errorString := `Execution failed:
-error: unexpectedly found nil while forcing an Optional value
+error[E3014]: unexpectedly found nil while forcing an Optional value
   --> 0000000000000000000000000000000000000000000000000000000000000000:9:15
    |
  9 |         return a
@@ -9016,6 +9016,22 @@ func TestRuntimeTypesAndConversions(t *testing.T) {
 		if _, isInclusiveRange := ty.(*sema.InclusiveRangeType); isInclusiveRange {
 			continue
 		}
+		// Set is a generic type, test an instantiation of it
+		if _, isSet := ty.(*sema.SetType); isSet {
+			ty = &sema.SetType{
+				ElementType: sema.StringType,
+			}
+		}
+		// Dictionary entry is a generic type, test an instantiation of it
+		if ty == sema.DictionaryEntryCompositeType {
+			ty = sema.NewDictionaryEntryCompositeType(
+				nil,
+				&sema.DictionaryType{
+					KeyType:   sema.StringType,
+					ValueType: sema.IntType,
+				},
+			)
+		}
 		test(name, ty)
 	}
 }
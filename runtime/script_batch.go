@@ -0,0 +1,215 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// BatchScript is a script which is executed as part of a batch,
+// see Runtime.ExecuteScriptBatch.
+type BatchScript struct {
+	Script
+	// Location is the location of the script.
+	// It must be a script location.
+	Location common.Location
+}
+
+// ScriptBatchHandler is an optional interface of the Interface which is used for a script batch,
+// see Runtime.ExecuteScriptBatch.
+//
+// All scripts of a batch are executed with the same Interface.
+// State which the Interface keeps for an execution, e.g. the computation and memory meters,
+// the UUID generator, or the storage index allocator, would therefore be shared by the scripts,
+// and the result of a script would depend on the scripts executed before it.
+// An Interface which implements this interface is notified about the boundaries of each script,
+// so it can reset such state.
+type ScriptBatchHandler interface {
+	// StartScript is called before the script at the given index of the batch is executed.
+	StartScript(index int, location Location)
+	// EndScript is called after the script at the given index of the batch was executed.
+	EndScript(index int, location Location)
+}
+
+// ScriptResult is the result of a script executed as part of a batch.
+type ScriptResult struct {
+	Value cadence.Value
+	Err   error
+}
+
+func (r *interpreterRuntime) ExecuteScriptBatch(scripts []BatchScript, context Context) []ScriptResult {
+
+	results := make([]ScriptResult, len(scripts))
+
+	batchInterface := newScriptBatchInterface(context.Interface)
+
+	batchHandler, _ := context.Interface.(ScriptBatchHandler)
+
+	environment := context.Environment
+	if environment == nil {
+		environment = NewScriptInterpreterEnvironment(r.defaultConfig)
+	}
+
+	var storage *Storage
+
+	for i, script := range scripts {
+
+		location := script.Location
+		if _, ok := location.(common.ScriptLocation); !ok {
+			results[i].Err = errors.NewUnexpectedError("invalid non-script location: %s", location)
+			continue
+		}
+
+		batchInterface.startScript(location)
+
+		if batchHandler != nil {
+			batchHandler.StartScript(i, location)
+		}
+
+		scriptContext := context
+		scriptContext.Interface = batchInterface
+		scriptContext.Location = location
+		scriptContext.Environment = environment
+
+		executor := newInterpreterScriptExecutor(r, script.Script, scriptContext)
+		executor.storage = storage
+
+		value, err := executor.Result()
+		results[i] = ScriptResult{
+			Value: value,
+			Err:   err,
+		}
+
+		if batchHandler != nil {
+			batchHandler.EndScript(i, location)
+		}
+
+		// Reuse the storage, and with it the already loaded values,
+		// only if the script left no traces.
+		// A failed script might have left the storage or the interpreters
+		// in an inconsistent state, e.g. uncommitted values or a non-empty call stack.
+		// A script which wrote to storage might have modified loaded values,
+		// e.g. the fields of a contract value.
+
+		if err != nil || batchInterface.written {
+			storage = nil
+			batchInterface.sharedState = nil
+		} else {
+			storage = executor.storage
+		}
+	}
+
+	return results
+}
+
+type batchProgram struct {
+	program *interpreter.Program
+	err     error
+}
+
+// scriptBatchInterface is an Interface which is used
+// for executing a batch of scripts against the same state.
+//
+// It shares the interpreter shared state and imported programs across scripts,
+// and keeps the writes of each script separate,
+// by recording them in an overlay which is discarded when the next script starts.
+type scriptBatchInterface struct {
+	wrappedInterface
+	scriptLocation common.Location
+	programs       map[common.Location]batchProgram
+	sharedState    *interpreter.SharedState
+	writes         map[RegisterID][]byte
+	written        bool
+}
+
+var _ Interface = &scriptBatchInterface{}
+var _ Metrics = &scriptBatchInterface{}
+
+func newScriptBatchInterface(runtimeInterface Interface) *scriptBatchInterface {
+	return &scriptBatchInterface{
+		wrappedInterface: wrappedInterface{Interface: runtimeInterface},
+		programs:         map[common.Location]batchProgram{},
+	}
+}
+
+func (i *scriptBatchInterface) startScript(location common.Location) {
+	i.scriptLocation = location
+	i.writes = nil
+	i.written = false
+}
+
+func (i *scriptBatchInterface) GetOrLoadProgram(
+	location Location,
+	load func() (*interpreter.Program, error),
+) (*interpreter.Program, error) {
+
+	// The program of the script itself is not shared,
+	// only the programs it imports
+
+	if location == i.scriptLocation {
+		return i.Interface.GetOrLoadProgram(location, load)
+	}
+
+	if result, ok := i.programs[location]; ok {
+		return result.program, result.err
+	}
+
+	program, err := i.Interface.GetOrLoadProgram(location, load)
+
+	i.programs[location] = batchProgram{
+		program: program,
+		err:     err,
+	}
+
+	return program, err
+}
+
+func (i *scriptBatchInterface) SetInterpreterSharedState(state *interpreter.SharedState) {
+	i.sharedState = state
+}
+
+func (i *scriptBatchInterface) GetInterpreterSharedState() *interpreter.SharedState {
+	return i.sharedState
+}
+
+func (i *scriptBatchInterface) GetValue(owner, key []byte) ([]byte, error) {
+	if value, ok := i.writes[NewRegisterID(owner, key)]; ok {
+		return value, nil
+	}
+	return i.Interface.GetValue(owner, key)
+}
+
+func (i *scriptBatchInterface) SetValue(owner, key, value []byte) error {
+	if i.writes == nil {
+		i.writes = map[RegisterID][]byte{}
+	}
+	i.writes[NewRegisterID(owner, key)] = value
+	i.written = true
+	return nil
+}
+
+func (i *scriptBatchInterface) ValueExists(owner, key []byte) (bool, error) {
+	if value, ok := i.writes[NewRegisterID(owner, key)]; ok {
+		return len(value) > 0, nil
+	}
+	return i.Interface.ValueExists(owner, key)
+}
@@ -0,0 +1,370 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/meter"
+	"github.com/onflow/cadence/runtime/stdlib"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeExecuteScriptBatch(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	const contract = `
+      access(all) contract C {
+
+          access(all) var count: Int
+
+          access(all) fun increment(): Int {
+              self.count = self.count + 1
+              return self.count
+          }
+
+          init() {
+              self.count = 0
+          }
+      }
+    `
+
+	const readScript = `
+      import C from 0x1
+
+      access(all) fun main(): Int {
+          return C.count
+      }
+    `
+
+	const incrementScript = `
+      import C from 0x1
+
+      access(all) fun main(): Int {
+          return C.increment()
+      }
+    `
+
+	const failingScript = `
+      import C from 0x1
+
+      access(all) fun main(): Int {
+          C.increment()
+          panic("failed")
+      }
+    `
+
+	newRuntimeInterface := func(
+		t *testing.T,
+		ledger TestLedger,
+		contracts map[common.Location][]byte,
+	) *TestRuntimeInterface {
+		return &TestRuntimeInterface{
+			Storage:           ledger,
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				return contracts[location], nil
+			},
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				contracts[location] = code
+				return nil
+			},
+			OnEmitEvent: func(event cadence.Event) error {
+				return nil
+			},
+		}
+	}
+
+	setup := func(t *testing.T, onRead func(owner, key, value []byte)) (*TestRuntimeInterface, Runtime) {
+		runtime := NewTestInterpreterRuntime()
+
+		ledger := NewTestLedger(onRead, nil)
+		contracts := map[common.Location][]byte{}
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: DeploymentTransaction("C", []byte(contract)),
+			},
+			Context{
+				Interface: newRuntimeInterface(t, ledger, contracts),
+				Location:  common.TransactionLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		return newRuntimeInterface(t, ledger, contracts), runtime
+	}
+
+	newBatch := func(sources ...string) []BatchScript {
+		nextScriptLocation := NewScriptLocationGenerator()
+
+		scripts := make([]BatchScript, 0, len(sources))
+		for _, source := range sources {
+			scripts = append(
+				scripts,
+				BatchScript{
+					Script: Script{
+						Source: []byte(source),
+					},
+					Location: nextScriptLocation(),
+				},
+			)
+		}
+		return scripts
+	}
+
+	t.Run("shared programs and values", func(t *testing.T) {
+
+		t.Parallel()
+
+		var contractReads int
+		onRead := func(_, key, _ []byte) {
+			if string(key) == StorageDomainContract {
+				contractReads++
+			}
+		}
+
+		runtimeInterface, runtime := setup(t, onRead)
+
+		contractLocation := common.NewAddressLocation(nil, address, "C")
+
+		var contractLoads int
+		runtimeInterface.OnGetAndSetProgram = func(
+			location Location,
+			load func() (*interpreter.Program, error),
+		) (*interpreter.Program, error) {
+			if location == contractLocation {
+				contractLoads++
+			}
+			return load()
+		}
+
+		contractReads = 0
+
+		results := runtime.ExecuteScriptBatch(
+			newBatch(readScript, readScript, readScript),
+			Context{
+				Interface: runtimeInterface,
+			},
+		)
+
+		require.Len(t, results, 3)
+		for _, result := range results {
+			require.NoError(t, result.Err)
+			assert.Equal(t, cadence.NewInt(0), result.Value)
+		}
+
+		assert.Equal(t, 1, contractLoads)
+		assert.Equal(t, 1, contractReads)
+	})
+
+	t.Run("isolated results", func(t *testing.T) {
+
+		t.Parallel()
+
+		var writes int
+		runtimeInterface, runtime := setup(t, nil)
+		runtimeInterface.Storage.OnSetValue = func(_, _, _ []byte) error {
+			writes++
+			return nil
+		}
+
+		results := runtime.ExecuteScriptBatch(
+			newBatch(
+				readScript,
+				incrementScript,
+				incrementScript,
+				failingScript,
+				readScript,
+			),
+			Context{
+				Interface: runtimeInterface,
+			},
+		)
+
+		require.Len(t, results, 5)
+
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, cadence.NewInt(0), results[0].Value)
+
+		require.NoError(t, results[1].Err)
+		assert.Equal(t, cadence.NewInt(1), results[1].Value)
+
+		require.NoError(t, results[2].Err)
+		assert.Equal(t, cadence.NewInt(1), results[2].Value)
+
+		RequireError(t, results[3].Err)
+		var panicErr stdlib.PanicError
+		require.ErrorAs(t, results[3].Err, &panicErr)
+		assert.Nil(t, results[3].Value)
+
+		require.NoError(t, results[4].Err)
+		assert.Equal(t, cadence.NewInt(0), results[4].Value)
+
+		assert.Equal(t, 0, writes)
+	})
+
+	t.Run("per-script metering", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface, runtime := setup(t, nil)
+
+		// Each script loops 100 times, so it fits into the limit,
+		// but two scripts together would exceed it
+
+		const loopScript = `
+          access(all) fun main(): Int {
+              var i = 0
+              while i < 100 {
+                  i = i + 1
+              }
+              return i
+          }
+        `
+
+		newMeter := func() *meter.Meter {
+			return meter.New(
+				meter.Weights{
+					Computation: map[common.ComputationKind]uint64{
+						common.ComputationKindLoop: 1,
+					},
+				},
+				meter.Limits{
+					Computation: 150,
+				},
+			)
+		}
+
+		t.Run("without handler", func(t *testing.T) {
+
+			runtimeInterface.Meter = newMeter()
+
+			results := runtime.ExecuteScriptBatch(
+				newBatch(loopScript, loopScript),
+				Context{
+					Interface: runtimeInterface,
+				},
+			)
+
+			require.Len(t, results, 2)
+			require.NoError(t, results[0].Err)
+			RequireError(t, results[1].Err)
+			require.ErrorAs(t, results[1].Err, &meter.ComputationLimitExceededError{})
+		})
+
+		t.Run("with handler", func(t *testing.T) {
+
+			handler := &testScriptBatchHandler{
+				TestRuntimeInterface: runtimeInterface,
+				newMeter:             newMeter,
+			}
+
+			results := runtime.ExecuteScriptBatch(
+				newBatch(loopScript, loopScript, loopScript),
+				Context{
+					Interface: handler,
+				},
+			)
+
+			require.Len(t, results, 3)
+			for _, result := range results {
+				require.NoError(t, result.Err)
+				assert.Equal(t, cadence.NewInt(100), result.Value)
+			}
+
+			assert.Equal(t,
+				[]string{
+					"start 0", "end 0",
+					"start 1", "end 1",
+					"start 2", "end 2",
+				},
+				handler.events,
+			)
+		})
+	})
+
+	t.Run("invalid location", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtimeInterface, runtime := setup(t, nil)
+
+		results := runtime.ExecuteScriptBatch(
+			[]BatchScript{
+				{
+					Script: Script{
+						Source: []byte(readScript),
+					},
+					Location: common.TransactionLocation{},
+				},
+				{
+					Script: Script{
+						Source: []byte(readScript),
+					},
+					Location: common.ScriptLocation{},
+				},
+			},
+			Context{
+				Interface: runtimeInterface,
+			},
+		)
+
+		require.Len(t, results, 2)
+
+		require.Error(t, results[0].Err)
+
+		require.NoError(t, results[1].Err)
+		assert.Equal(t, cadence.NewInt(0), results[1].Value)
+	})
+}
+
+// testScriptBatchHandler is a test runtime interface
+// which meters each script of a batch with a new meter
+type testScriptBatchHandler struct {
+	*TestRuntimeInterface
+	newMeter func() *meter.Meter
+	events   []string
+}
+
+var _ ScriptBatchHandler = &testScriptBatchHandler{}
+
+func (h *testScriptBatchHandler) StartScript(index int, _ Location) {
+	h.Meter = h.newMeter()
+	h.events = append(h.events, fmt.Sprintf("start %d", index))
+}
+
+func (h *testScriptBatchHandler) EndScript(index int, _ Location) {
+	h.events = append(h.events, fmt.Sprintf("end %d", index))
+}
@@ -34,6 +34,7 @@ type interpreterScriptExecutorPreparation struct {
 	program                *interpreter.Program
 	storage                *Storage
 	interpret              InterpretFunc
+	tracer                 *spanTracingInterface
 	preprocessOnce         sync.Once
 }
 
@@ -105,10 +106,25 @@ func (executor *interpreterScriptExecutor) preprocess() (err error) {
 		codesAndPrograms,
 	)
 
-	runtimeInterface := context.Interface
+	runtimeInterface := withReadWriteSetRecording(
+		context.Interface,
+		context.ReadWriteSet,
+	)
+	runtimeInterface = withProgramCache(runtimeInterface, context.ProgramCache)
+	runtimeInterface, executor.tracer = withSpanTracing(
+		runtimeInterface,
+		context.SpanExporter,
+		"script",
+		location,
+	)
 
-	storage := NewStorage(runtimeInterface, runtimeInterface)
-	executor.storage = storage
+	// The storage might already be set,
+	// e.g. when the storage is shared across a batch of scripts
+	storage := executor.storage
+	if storage == nil {
+		storage = NewStorage(runtimeInterface, runtimeInterface)
+		executor.storage = storage
+	}
 
 	environment := context.Environment
 	if environment == nil {
@@ -120,6 +136,10 @@ func (executor *interpreterScriptExecutor) preprocess() (err error) {
 		storage,
 		context.CoverageReport,
 	)
+	environment.ConfigureExecution(ExecutionOptions{
+		Context:  context.Context,
+		Deadline: context.Deadline,
+	})
 	executor.environment = environment
 
 	program, err := environment.ParseAndCheckProgram(
@@ -166,6 +186,10 @@ func (executor *interpreterScriptExecutor) preprocess() (err error) {
 }
 
 func (executor *interpreterScriptExecutor) execute() (val cadence.Value, err error) {
+	defer func() {
+		executor.tracer.finish(executor.context.Context, err)
+	}()
+
 	err = executor.Preprocess()
 	if err != nil {
 		return nil, err
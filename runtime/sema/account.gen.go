@@ -439,6 +439,109 @@ then the callback must stop iteration by returning false.
 Otherwise, iteration aborts.
 `
 
+const Account_StorageTypeQueryFunctionName = "query"
+
+var Account_StorageTypeQueryFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []Parameter{
+		{
+			Identifier:     "type",
+			TypeAnnotation: NewTypeAnnotation(MetaType),
+		},
+		{
+			Identifier:     "subtypes",
+			TypeAnnotation: NewTypeAnnotation(BoolType),
+		},
+		{
+			Identifier: "after",
+			TypeAnnotation: NewTypeAnnotation(&OptionalType{
+				Type: StoragePathType,
+			}),
+		},
+		{
+			Identifier:     "limit",
+			TypeAnnotation: NewTypeAnnotation(IntType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		Account_Storage_QueryResultType,
+	),
+}
+
+const Account_StorageTypeQueryFunctionDocString = `
+Returns the paths of the stored objects which have the given type,
+in ascending order of the paths' identifiers.
+
+If ` + "`subtypes`" + ` is true, objects which have a subtype of the given type are also included.
+
+Only paths which are ordered after the given ` + "`after`" + ` path are returned, if any.
+At most ` + "`limit`" + ` paths are returned. The limit must be positive.
+
+If there may be further matching objects,
+the result contains a cursor which can be passed as the ` + "`after`" + ` argument
+to query the next page of paths.
+`
+
+const Account_Storage_QueryResultTypePathsFieldName = "paths"
+
+var Account_Storage_QueryResultTypePathsFieldType = &VariableSizedType{
+	Type: StoragePathType,
+}
+
+const Account_Storage_QueryResultTypePathsFieldDocString = `
+The paths of the matching stored objects,
+in ascending order of the paths' identifiers.
+`
+
+const Account_Storage_QueryResultTypeCursorFieldName = "cursor"
+
+var Account_Storage_QueryResultTypeCursorFieldType = &OptionalType{
+	Type: StoragePathType,
+}
+
+const Account_Storage_QueryResultTypeCursorFieldDocString = `
+The path which can be passed as the ` + "`after`" + ` argument of a query
+to query the next page of paths,
+or nil if there are no further matching objects.
+`
+
+const Account_Storage_QueryResultTypeName = "QueryResult"
+
+var Account_Storage_QueryResultType = func() *CompositeType {
+	var t = &CompositeType{
+		Identifier:         Account_Storage_QueryResultTypeName,
+		Kind:               common.CompositeKindStructure,
+		ImportableBuiltin:  false,
+		HasComputedMembers: true,
+	}
+
+	return t
+}()
+
+func init() {
+	var members = []*Member{
+		NewUnmeteredFieldMember(
+			Account_Storage_QueryResultType,
+			PrimitiveAccess(ast.AccessAll),
+			ast.VariableKindConstant,
+			Account_Storage_QueryResultTypePathsFieldName,
+			Account_Storage_QueryResultTypePathsFieldType,
+			Account_Storage_QueryResultTypePathsFieldDocString,
+		),
+		NewUnmeteredFieldMember(
+			Account_Storage_QueryResultType,
+			PrimitiveAccess(ast.AccessAll),
+			ast.VariableKindConstant,
+			Account_Storage_QueryResultTypeCursorFieldName,
+			Account_Storage_QueryResultTypeCursorFieldType,
+			Account_Storage_QueryResultTypeCursorFieldDocString,
+		),
+	}
+
+	Account_Storage_QueryResultType.Members = MembersAsMap(members)
+	Account_Storage_QueryResultType.Fields = MembersFieldNames(members)
+}
+
 const Account_StorageTypeName = "Storage"
 
 var Account_StorageType = func() *CompositeType {
@@ -449,6 +552,7 @@ var Account_StorageType = func() *CompositeType {
 		HasComputedMembers: true,
 	}
 
+	t.SetNestedType(Account_Storage_QueryResultTypeName, Account_Storage_QueryResultType)
 	return t
 }()
 
@@ -554,6 +658,13 @@ func init() {
 			Account_StorageTypeForEachStoredFunctionType,
 			Account_StorageTypeForEachStoredFunctionDocString,
 		),
+		NewUnmeteredFunctionMember(
+			Account_StorageType,
+			PrimitiveAccess(ast.AccessAll),
+			Account_StorageTypeQueryFunctionName,
+			Account_StorageTypeQueryFunctionType,
+			Account_StorageTypeQueryFunctionDocString,
+		),
 	}
 
 	Account_StorageType.Members = MembersAsMap(members)
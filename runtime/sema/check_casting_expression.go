@@ -62,10 +62,12 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 
 			// If the failable casted type is a resource, the failable cast expression
 			// must occur in an optional binding, i.e. inside a variable declaration
-			// as the if-statement test element
+			// as the if-statement or guard-statement test element
 
-			if expression.ParentVariableDeclaration == nil ||
-				expression.ParentVariableDeclaration.ParentIfStatement == nil {
+			parentVariableDeclaration := expression.ParentVariableDeclaration
+			if parentVariableDeclaration == nil ||
+				(parentVariableDeclaration.ParentIfStatement == nil &&
+					parentVariableDeclaration.ParentGuardStatement == nil) {
 
 				checker.report(
 					&InvalidFailableResourceDowncastOutsideOptionalBindingError{
@@ -83,9 +85,10 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 			}
 
 			// NOTE: Counter-intuitively, do not *always* invalidate the casted expression:
-			// As the failable cast must occur in an if-statement, the statement itself
-			// takes care of the invalidation:
-			// - In the then-branch, the cast succeeded, so the casted variable becomes invalidated
+			// As the failable cast must occur in an if-statement or guard-statement,
+			// the statement itself takes care of the invalidation:
+			// - In the then-branch, or after the guard-statement, the cast succeeded,
+			//   so the casted variable becomes invalidated
 			// - Whereas in the else-branch, the cast failed, and the casted variable is still available
 
 		} else {
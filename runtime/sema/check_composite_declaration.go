@@ -182,6 +182,8 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 	checker.enterValueScope()
 	defer checker.leaveValueScope(declaration.EndPosition, false)
 
+	checker.declareCompositeLikeTypeParameters(declaration, compositeType)
+
 	checker.declareCompositeLikeNestedTypes(declaration, true)
 
 	var initializationInfo *InitializationInfo
@@ -666,6 +668,12 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 			checker.explicitInterfaceConformances(declaration, compositeType)
 	}
 
+	// Convert type parameters (if any)
+
+	if compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration); ok {
+		checker.compositeTypeParameters(compositeDeclaration, compositeType)
+	}
+
 	// Register in elaboration
 
 	checker.Elaboration.SetCompositeDeclarationType(declaration, compositeType)
@@ -727,6 +735,62 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 	return compositeType
 }
 
+// compositeTypeParameters converts the type parameters of the given composite declaration (if any),
+// and sets them as the type parameters of the given composite type.
+//
+// NOTE: Only structures and resources may have type parameters
+func (checker *Checker) compositeTypeParameters(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	typeParameterList := declaration.TypeParameterList
+	if typeParameterList.IsEmpty() {
+		return
+	}
+
+	switch declaration.CompositeKind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource:
+
+		if checker.Config.TypeParametersEnabled {
+			break
+		}
+		fallthrough
+
+	default:
+		checker.report(
+			&InvalidTypeParameterizedCompositeError{
+				CompositeKind: declaration.CompositeKind,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					typeParameterList,
+				),
+			},
+		)
+	}
+
+	compositeType.SetTypeParameters(
+		checker.typeParameters(typeParameterList, false),
+	)
+}
+
+// declareCompositeLikeTypeParameters declares the type parameters of the given composite declaration (if any)
+// as generic types in the current type activation
+func (checker *Checker) declareCompositeLikeTypeParameters(
+	declaration ast.CompositeLikeDeclaration,
+	compositeType *CompositeType,
+) {
+	compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration)
+	if !ok || len(compositeType.typeParameters) == 0 {
+		return
+	}
+
+	checker.declareTypeParameters(
+		compositeDeclaration.TypeParameterList,
+		compositeType.typeParameters,
+	)
+}
+
 func (checker *Checker) declareAttachmentMembersAndValue(declaration *ast.AttachmentDeclaration) {
 	checker.declareCompositeLikeMembersAndValue(declaration)
 }
@@ -762,7 +826,9 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 		checker.enterValueScope()
 		defer checker.leaveValueScope(declaration.EndPosition, false)
 
-		// Declare nested types
+		// Declare type parameters and nested types
+
+		checker.declareCompositeLikeTypeParameters(declaration, compositeType)
 
 		checker.declareCompositeLikeNestedTypes(declaration, false)
 
@@ -901,6 +967,7 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 
 		compositeType.Members = members
 		compositeType.Fields = fields
+		compositeType.InstantiateMembers()
 		if checker.PositionInfo != nil {
 			checker.PositionInfo.recordMemberOrigins(compositeType, origins)
 		}
@@ -1600,6 +1667,7 @@ func CompositeLikeConstructorType(
 	constructorFunctionType = &FunctionType{
 		Purity:               compositeType.ConstructorPurity,
 		IsConstructor:        true,
+		TypeParameters:       compositeType.typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
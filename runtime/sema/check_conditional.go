@@ -53,19 +53,7 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) (_ struct{}
 				checker.enterValueScope()
 				defer checker.leaveValueScope(thenElement.EndPosition, true)
 
-				if castingExpression, ok := test.Value.(*ast.CastingExpression); ok &&
-					castingExpression.Operation == ast.OperationFailableCast {
-
-					castingTypes := checker.Elaboration.CastingExpressionTypes(castingExpression)
-					leftHandType := castingTypes.StaticValueType
-					if leftHandType.IsResourceType() {
-						checker.recordResourceInvalidation(
-							castingExpression.Expression,
-							leftHandType,
-							ResourceInvalidationKindMoveDefinite,
-						)
-					}
-				}
+				checker.recordOptionalBindingCastInvalidation(test)
 				checker.declareVariableDeclaration(test, declarationType)
 
 				checker.checkBlock(thenElement)
@@ -86,6 +74,64 @@ func (checker *Checker) VisitIfStatement(statement *ast.IfStatement) (_ struct{}
 	return
 }
 
+func (checker *Checker) VisitGuardStatement(statement *ast.GuardStatement) (_ struct{}) {
+
+	test := statement.Test
+
+	declarationType := checker.visitVariableDeclarationValues(test, true)
+
+	checker.checkConditionalBranches(
+		func() Type {
+			checker.recordOptionalBindingCastInvalidation(test)
+			return nil
+		},
+		func() Type {
+			checker.checkBlock(statement.Else)
+
+			// The else-block must not fall through,
+			// as the binding would be unavailable after the statement
+
+			returnInfo := checker.functionActivations.Current().ReturnInfo
+			if !returnInfo.IsUnreachable() {
+				checker.report(
+					&MissingGuardElseExitError{
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, statement.Else),
+					},
+				)
+			}
+
+			return nil
+		},
+	)
+
+	// The binding is declared in the current scope,
+	// so it remains available after the statement
+
+	checker.declareVariableDeclaration(test, declarationType)
+
+	return
+}
+
+// recordOptionalBindingCastInvalidation records the invalidation of the casted resource
+// in an optional binding with a failable cast, e.g. `if let r <- x as? @R`.
+// The invalidation must only be recorded in the branch where the cast succeeded.
+func (checker *Checker) recordOptionalBindingCastInvalidation(declaration *ast.VariableDeclaration) {
+	castingExpression, ok := declaration.Value.(*ast.CastingExpression)
+	if !ok || castingExpression.Operation != ast.OperationFailableCast {
+		return
+	}
+
+	castingTypes := checker.Elaboration.CastingExpressionTypes(castingExpression)
+	leftHandType := castingTypes.StaticValueType
+	if leftHandType.IsResourceType() {
+		checker.recordResourceInvalidation(
+			castingExpression.Expression,
+			leftHandType,
+			ResourceInvalidationKindMoveDefinite,
+		)
+	}
+}
+
 func (checker *Checker) VisitConditionalExpression(expression *ast.ConditionalExpression) Type {
 
 	expectedType := checker.expectedType
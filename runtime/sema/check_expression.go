@@ -218,7 +218,6 @@ func (checker *Checker) VisitIntegerExpression(expression *ast.IntegerExpression
 }
 
 func (checker *Checker) VisitFixedPointExpression(expression *ast.FixedPointExpression) Type {
-	// TODO: adjust once/if we support more fixed point types
 
 	// If the contextually expected type is a subtype of FixedPoint, then take that.
 	// Otherwise, infer the type from the expression itself.
@@ -229,10 +228,12 @@ func (checker *Checker) VisitFixedPointExpression(expression *ast.FixedPointExpr
 
 	if IsSameTypeKind(expectedType, FixedPointType) {
 		actualType = expectedType
-	} else if expression.Negative {
-		actualType = Fix64Type
+	} else if expectedType == NumberType || expectedType == SignedNumberType {
+		// If a number is expected, e.g. for the argument of a number conversion function,
+		// infer the 128-bit fixed-point types if the scale of the literal requires it
+		actualType = inferredFixedPointLiteralType(expression, expression.Scale > Fix64Scale)
 	} else {
-		actualType = UFix64Type
+		actualType = inferredFixedPointLiteralType(expression, false)
 	}
 
 	CheckFixedPointLiteral(checker.memoryGauge, expression, actualType, checker.report)
@@ -242,6 +243,19 @@ func (checker *Checker) VisitFixedPointExpression(expression *ast.FixedPointExpr
 	return actualType
 }
 
+func inferredFixedPointLiteralType(expression *ast.FixedPointExpression, is128Bit bool) Type {
+	switch {
+	case expression.Negative && is128Bit:
+		return Fix128Type
+	case expression.Negative:
+		return Fix64Type
+	case is128Bit:
+		return UFix128Type
+	default:
+		return UFix64Type
+	}
+}
+
 func (checker *Checker) VisitStringExpression(expression *ast.StringExpression) Type {
 	expectedType := UnwrapOptionalType(checker.expectedType)
 
@@ -257,6 +271,39 @@ func (checker *Checker) VisitStringExpression(expression *ast.StringExpression)
 	return actualType
 }
 
+func (checker *Checker) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) Type {
+
+	// Interpolated expressions must be strings, characters,
+	// or values which can be converted to a string using `toString`
+
+	for _, interpolatedExpression := range expression.Expressions {
+		valueType := checker.VisitExpression(interpolatedExpression, nil)
+
+		if !valueType.IsInvalidType() &&
+			!IsValidStringTemplateValueType(valueType) {
+
+			checker.report(
+				&InvalidStringTemplateValueTypeError{
+					Type:  valueType,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, interpolatedExpression),
+				},
+			)
+		}
+	}
+
+	return StringType
+}
+
+// IsValidStringTemplateValueType returns true if values of the given type
+// can be interpolated in a string template
+func IsValidStringTemplateValueType(ty Type) bool {
+	return IsSubType(ty, StringType) ||
+		IsSubType(ty, CharacterType) ||
+		IsSubType(ty, NumberType) ||
+		IsSubType(ty, TheAddressType) ||
+		IsSubType(ty, PathType)
+}
+
 func (checker *Checker) VisitIndexExpression(expression *ast.IndexExpression) Type {
 	return checker.visitIndexExpression(expression, false)
 }
@@ -126,6 +126,17 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.SetFunctionDeclarationFunctionType(declaration, functionType)
 
+	// Declare the type parameters of the function, if any,
+	// so they can be referred to in the function body
+
+	typeParameterList := declaration.TypeParameterList
+	if !typeParameterList.IsEmpty() {
+		checker.typeActivations.Enter()
+		defer checker.typeActivations.Leave(declaration.EndPosition)
+
+		checker.declareTypeParameters(typeParameterList, functionType.TypeParameters)
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -1305,8 +1305,11 @@ func (checker *Checker) functionType(
 	var convertedTypeParameters []*TypeParameter
 	if typeParameterList != nil {
 
-		// Only native functions may have type parameters at the moment
-		if !isNative && !typeParameterList.IsEmpty() {
+		// Non-native functions may only have type parameters if enabled
+		if !isNative &&
+			!typeParameterList.IsEmpty() &&
+			!checker.Config.TypeParametersEnabled {
+
 			checker.report(&InvalidTypeParameterizedNonNativeFunctionError{
 				Range: ast.NewRangeFromPositioned(
 					checker.memoryGauge,
@@ -1327,24 +1330,9 @@ func (checker *Checker) functionType(
 		// All type parameters are converted at once,
 		// so type bounds may currently not refer to previous type parameters
 
-		convertedTypeParameters = checker.typeParameters(typeParameterList)
-
-		for typeParameterIndex, typeParameter := range typeParameterList.TypeParameters {
-			convertedTypeParameter := convertedTypeParameters[typeParameterIndex]
+		convertedTypeParameters = checker.typeParameters(typeParameterList, isNative)
 
-			genericType := &GenericType{
-				TypeParameter: convertedTypeParameter,
-			}
-
-			_, err := checker.typeActivations.declareType(typeDeclaration{
-				identifier:               typeParameter.Identifier,
-				ty:                       genericType,
-				declarationKind:          common.DeclarationKindTypeParameter,
-				allowOuterScopeShadowing: false,
-			})
-			checker.report(err)
-
-		}
+		checker.declareTypeParameters(typeParameterList, convertedTypeParameters)
 	}
 
 	// Convert parameters
@@ -1367,7 +1355,13 @@ func (checker *Checker) functionType(
 	}
 }
 
-func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList) []*TypeParameter {
+// typeParameters converts the given type parameters.
+//
+// The bodies of non-native declarations are checked,
+// so their type parameters without a type bound are implicitly bounded by `AnyStruct`:
+// Resource type arguments must be explicitly allowed by a resource type bound,
+// so that values of the generic type are moved instead of copied.
+func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList, isNative bool) []*TypeParameter {
 
 	var typeParameters []*TypeParameter
 
@@ -1383,6 +1377,8 @@ func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList)
 				convertedTypeBoundAnnotation := checker.ConvertTypeAnnotation(typeBoundAnnotation)
 				checker.checkTypeAnnotation(convertedTypeBoundAnnotation, typeBoundAnnotation)
 				convertedTypeBound = convertedTypeBoundAnnotation.Type
+			} else if !isNative {
+				convertedTypeBound = AnyStructType
 			}
 
 			typeParameters[i] = &TypeParameter{
@@ -1395,6 +1391,29 @@ func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList)
 	return typeParameters
 }
 
+// declareTypeParameters declares the given converted type parameters as generic types
+// in the current type activation
+func (checker *Checker) declareTypeParameters(
+	typeParameterList *ast.TypeParameterList,
+	convertedTypeParameters []*TypeParameter,
+) {
+	for typeParameterIndex, typeParameter := range typeParameterList.TypeParameters {
+		convertedTypeParameter := convertedTypeParameters[typeParameterIndex]
+
+		genericType := &GenericType{
+			TypeParameter: convertedTypeParameter,
+		}
+
+		_, err := checker.typeActivations.declareType(typeDeclaration{
+			identifier:               typeParameter.Identifier,
+			ty:                       genericType,
+			declarationKind:          common.DeclarationKindTypeParameter,
+			allowOuterScopeShadowing: false,
+		})
+		checker.report(err)
+	}
+}
+
 func (checker *Checker) parameters(parameterList *ast.ParameterList) []Parameter {
 
 	// TODO: required for initializer conformance checking at the moment, optimize/refactor
@@ -2443,7 +2462,7 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	}
 
 	parameterizedType, ok := ty.(ParameterizedType)
-	if !ok {
+	if !ok || len(parameterizedType.TypeParameters()) == 0 {
 
 		// The type is not parameterized,
 		// report an error for all type arguments
@@ -55,4 +55,7 @@ type Config struct {
 	AllowStaticDeclarations bool
 	// AttachmentsEnabled determines if attachments are enabled
 	AttachmentsEnabled bool
+	// TypeParametersEnabled determines if non-native functions and composite types
+	// may declare type parameters
+	TypeParametersEnabled bool
 }
@@ -0,0 +1,113 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const DictionaryEntryTypeName = "DictionaryEntry"
+
+const DictionaryEntryTypeKeyFieldName = "key"
+
+const dictionaryEntryTypeKeyFieldDocString = `
+The key of the dictionary entry
+`
+
+const DictionaryEntryTypeValueFieldName = "value"
+
+const dictionaryEntryTypeValueFieldDocString = `
+The value of the dictionary entry
+`
+
+var DictionaryEntryTypeKeyTypeParameter = &TypeParameter{
+	Name: "K",
+}
+
+var DictionaryEntryTypeValueTypeParameter = &TypeParameter{
+	Name: "V",
+}
+
+// DictionaryEntryCompositeType is the generic type `DictionaryEntry<K, V>`
+// of the key-value pairs of a dictionary of type `{K: V}`.
+//
+// NOTE: not to be confused with DictionaryEntryType,
+// the type of an entry of a dictionary literal
+var DictionaryEntryCompositeType = func() *CompositeType {
+	var t = &CompositeType{
+		Identifier:         DictionaryEntryTypeName,
+		Kind:               common.CompositeKindStructure,
+		ImportableBuiltin:  false,
+		HasComputedMembers: true,
+	}
+
+	t.SetTypeParameters([]*TypeParameter{
+		DictionaryEntryTypeKeyTypeParameter,
+		DictionaryEntryTypeValueTypeParameter,
+	})
+
+	return t
+}()
+
+func init() {
+	var members = []*Member{
+		NewUnmeteredFieldMember(
+			DictionaryEntryCompositeType,
+			PrimitiveAccess(ast.AccessAll),
+			ast.VariableKindConstant,
+			DictionaryEntryTypeKeyFieldName,
+			&GenericType{
+				TypeParameter: DictionaryEntryTypeKeyTypeParameter,
+			},
+			dictionaryEntryTypeKeyFieldDocString,
+		),
+		NewUnmeteredFieldMember(
+			DictionaryEntryCompositeType,
+			PrimitiveAccess(ast.AccessAll),
+			ast.VariableKindConstant,
+			DictionaryEntryTypeValueFieldName,
+			&GenericType{
+				TypeParameter: DictionaryEntryTypeValueTypeParameter,
+			},
+			dictionaryEntryTypeValueFieldDocString,
+		),
+	}
+
+	DictionaryEntryCompositeType.Members = MembersAsMap(members)
+	DictionaryEntryCompositeType.Fields = MembersFieldNames(members)
+	DictionaryEntryCompositeType.InstantiateMembers()
+}
+
+// NewDictionaryEntryCompositeType returns the instantiation `DictionaryEntry<K, V>`
+// for the key type and value type of the given dictionary type.
+func NewDictionaryEntryCompositeType(
+	memoryGauge common.MemoryGauge,
+	dictionaryType *DictionaryType,
+) *CompositeType {
+	return DictionaryEntryCompositeType.Instantiate(
+		memoryGauge,
+		[]Type{
+			dictionaryType.KeyType,
+			dictionaryType.ValueType,
+		},
+		nil,
+		nil,
+	).(*CompositeType)
+}
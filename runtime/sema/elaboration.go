@@ -1070,3 +1070,77 @@ func (e *Elaboration) ForStatementType(statement *ast.ForStatement) (types ForSt
 	}
 	return e.forStatementTypes[statement]
 }
+
+// SizeEstimate returns a rough estimate of the memory used by the elaboration, in bytes,
+// based on the number of recorded types and declarations.
+// It is intended for accounting, e.g. in caches.
+func (e *Elaboration) SizeEstimate() uint64 {
+	const baseSize = 1024
+	const entrySize = 64
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	entries := len(e.fixedPointExpressionTypes)
+	entries += len(e.swapStatementTypes)
+	entries += len(e.forStatementTypes)
+	entries += len(e.assignmentStatementTypes)
+	entries += len(e.compositeDeclarationTypes)
+	entries += len(e.compositeTypeDeclarations)
+	entries += len(e.transactionDeclarationTypes)
+	entries += len(e.constructorFunctionTypes)
+	entries += len(e.functionExpressionFunctionTypes)
+	entries += len(e.invocationExpressionTypes)
+	entries += len(e.castingExpressionTypes)
+	entries += len(e.binaryExpressionTypes)
+	entries += len(e.memberExpressionMemberAccessInfos)
+	entries += len(e.memberExpressionExpectedTypes)
+	entries += len(e.arrayExpressionTypes)
+	entries += len(e.dictionaryExpressionTypes)
+	entries += len(e.integerExpressionTypes)
+	entries += len(e.stringExpressionTypes)
+	entries += len(e.returnStatementTypes)
+	entries += len(e.functionDeclarationFunctionTypes)
+	entries += len(e.variableDeclarationTypes)
+	entries += len(e.nestedResourceMoveExpressions)
+	entries += len(e.compositeNestedDeclarations)
+	entries += len(e.interfaceNestedDeclarations)
+	entries += len(e.defaultDestroyDeclarations)
+	entries += len(e.postConditionsRewrites)
+	entries += len(e.emitStatementEventTypes)
+	entries += len(e.compositeTypes)
+	entries += len(e.interfaceTypes)
+	entries += len(e.entitlementTypes)
+	entries += len(e.entitlementMapTypes)
+	entries += len(e.identifierInInvocationTypes)
+	entries += len(e.importDeclarationsResolvedLocations)
+	entries += len(e.numberConversionArgumentTypes)
+	entries += len(e.runtimeCastTypes)
+	entries += len(e.referenceExpressionBorrowTypes)
+	entries += len(e.indexExpressionTypes)
+	entries += len(e.attachmentAccessTypes)
+	entries += len(e.attachmentRemoveTypes)
+	entries += len(e.attachTypes)
+	entries += len(e.forceExpressionTypes)
+	entries += len(e.staticCastTypes)
+	entries += len(e.expressionTypes)
+	entries += len(e.TransactionTypes)
+	entries += len(e.semanticAccesses)
+	if e.interfaceTypesAndDeclarationsBiMap != nil {
+		entries += e.interfaceTypesAndDeclarationsBiMap.Size()
+	}
+	if e.entitlementTypesAndDeclarationsBiMap != nil {
+		entries += e.entitlementTypesAndDeclarationsBiMap.Size()
+	}
+	if e.entitlementMapTypesAndDeclarationsBiMap != nil {
+		entries += e.entitlementMapTypesAndDeclarationsBiMap.Size()
+	}
+	if e.globalValues != nil {
+		entries += e.globalValues.Len()
+	}
+	if e.globalTypes != nil {
+		entries += e.globalTypes.Len()
+	}
+
+	return baseSize + uint64(entries)*entrySize
+}
@@ -0,0 +1,730 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Error codes of the checker errors.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Checking
+
+func (*InvalidPragmaError) ErrorCode() errors.ErrorCode {
+	return 2001
+}
+
+func (CheckerError) ErrorCode() errors.ErrorCode {
+	return 2002
+}
+
+func (*RedeclarationError) ErrorCode() errors.ErrorCode {
+	return 2003
+}
+
+func (*NotDeclaredError) ErrorCode() errors.ErrorCode {
+	return 2004
+}
+
+func (*AssignmentToConstantError) ErrorCode() errors.ErrorCode {
+	return 2005
+}
+
+func (*TypeMismatchError) ErrorCode() errors.ErrorCode {
+	return 2006
+}
+
+func (*TypeMismatchWithDescriptionError) ErrorCode() errors.ErrorCode {
+	return 2007
+}
+
+func (*NotIndexableTypeError) ErrorCode() errors.ErrorCode {
+	return 2008
+}
+
+func (*NotIndexingAssignableTypeError) ErrorCode() errors.ErrorCode {
+	return 2009
+}
+
+func (*NotEquatableTypeError) ErrorCode() errors.ErrorCode {
+	return 2010
+}
+
+func (*NotCallableError) ErrorCode() errors.ErrorCode {
+	return 2011
+}
+
+func (*InsufficientArgumentsError) ErrorCode() errors.ErrorCode {
+	return 2012
+}
+
+func (*ExcessiveArgumentsError) ErrorCode() errors.ErrorCode {
+	return 2013
+}
+
+func (*MissingArgumentLabelError) ErrorCode() errors.ErrorCode {
+	return 2014
+}
+
+func (*IncorrectArgumentLabelError) ErrorCode() errors.ErrorCode {
+	return 2015
+}
+
+func (*InvalidUnaryOperandError) ErrorCode() errors.ErrorCode {
+	return 2016
+}
+
+func (*InvalidBinaryOperandError) ErrorCode() errors.ErrorCode {
+	return 2017
+}
+
+func (*InvalidBinaryOperandsError) ErrorCode() errors.ErrorCode {
+	return 2018
+}
+
+func (*ControlStatementError) ErrorCode() errors.ErrorCode {
+	return 2019
+}
+
+func (*InvalidAccessModifierError) ErrorCode() errors.ErrorCode {
+	return 2020
+}
+
+func (*MissingAccessModifierError) ErrorCode() errors.ErrorCode {
+	return 2021
+}
+
+func (*InvalidStaticModifierError) ErrorCode() errors.ErrorCode {
+	return 2022
+}
+
+func (*InvalidNativeModifierError) ErrorCode() errors.ErrorCode {
+	return 2023
+}
+
+func (*NativeFunctionWithImplementationError) ErrorCode() errors.ErrorCode {
+	return 2024
+}
+
+func (*InvalidNameError) ErrorCode() errors.ErrorCode {
+	return 2025
+}
+
+func (*UnknownSpecialFunctionError) ErrorCode() errors.ErrorCode {
+	return 2026
+}
+
+func (*InvalidVariableKindError) ErrorCode() errors.ErrorCode {
+	return 2027
+}
+
+func (*InvalidDeclarationError) ErrorCode() errors.ErrorCode {
+	return 2028
+}
+
+func (*MissingInitializerError) ErrorCode() errors.ErrorCode {
+	return 2029
+}
+
+func (*NotDeclaredMemberError) ErrorCode() errors.ErrorCode {
+	return 2030
+}
+
+func (*AssignmentToConstantMemberError) ErrorCode() errors.ErrorCode {
+	return 2031
+}
+
+func (*FieldReinitializationError) ErrorCode() errors.ErrorCode {
+	return 2032
+}
+
+func (*FieldUninitializedError) ErrorCode() errors.ErrorCode {
+	return 2033
+}
+
+func (*FieldTypeNotStorableError) ErrorCode() errors.ErrorCode {
+	return 2034
+}
+
+func (*FunctionExpressionInConditionError) ErrorCode() errors.ErrorCode {
+	return 2035
+}
+
+func (*InvalidEmitConditionError) ErrorCode() errors.ErrorCode {
+	return 2036
+}
+
+func (*MissingReturnValueError) ErrorCode() errors.ErrorCode {
+	return 2037
+}
+
+func (*InvalidImplementationError) ErrorCode() errors.ErrorCode {
+	return 2038
+}
+
+func (*InvalidConformanceError) ErrorCode() errors.ErrorCode {
+	return 2039
+}
+
+func (*InvalidEnumRawTypeError) ErrorCode() errors.ErrorCode {
+	return 2040
+}
+
+func (*MissingEnumRawTypeError) ErrorCode() errors.ErrorCode {
+	return 2041
+}
+
+func (*InvalidEnumConformancesError) ErrorCode() errors.ErrorCode {
+	return 2042
+}
+
+func (*ConformanceError) ErrorCode() errors.ErrorCode {
+	return 2043
+}
+
+func (*DuplicateConformanceError) ErrorCode() errors.ErrorCode {
+	return 2044
+}
+
+func (CyclicConformanceError) ErrorCode() errors.ErrorCode {
+	return 2045
+}
+
+func (*MultipleInterfaceDefaultImplementationsError) ErrorCode() errors.ErrorCode {
+	return 2046
+}
+
+func (*SpecialFunctionDefaultImplementationError) ErrorCode() errors.ErrorCode {
+	return 2047
+}
+
+func (*InterfaceMemberConflictError) ErrorCode() errors.ErrorCode {
+	return 2048
+}
+
+func (*MissingConformanceError) ErrorCode() errors.ErrorCode {
+	return 2049
+}
+
+func (*UnresolvedImportError) ErrorCode() errors.ErrorCode {
+	return 2050
+}
+
+func (*NotExportedError) ErrorCode() errors.ErrorCode {
+	return 2051
+}
+
+func (*ImportedProgramError) ErrorCode() errors.ErrorCode {
+	return 2052
+}
+
+func (*AlwaysFailingNonResourceCastingTypeError) ErrorCode() errors.ErrorCode {
+	return 2053
+}
+
+func (*AlwaysFailingResourceCastingTypeError) ErrorCode() errors.ErrorCode {
+	return 2054
+}
+
+func (*UnsupportedOverloadingError) ErrorCode() errors.ErrorCode {
+	return 2055
+}
+
+func (*CompositeKindMismatchError) ErrorCode() errors.ErrorCode {
+	return 2056
+}
+
+func (*InvalidIntegerLiteralRangeError) ErrorCode() errors.ErrorCode {
+	return 2057
+}
+
+func (*InvalidAddressLiteralError) ErrorCode() errors.ErrorCode {
+	return 2058
+}
+
+func (*InvalidFixedPointLiteralRangeError) ErrorCode() errors.ErrorCode {
+	return 2059
+}
+
+func (*InvalidFixedPointLiteralScaleError) ErrorCode() errors.ErrorCode {
+	return 2060
+}
+
+func (*MissingReturnStatementError) ErrorCode() errors.ErrorCode {
+	return 2061
+}
+
+func (*InvalidStringTemplateValueTypeError) ErrorCode() errors.ErrorCode {
+	return 2062
+}
+
+func (*MissingGuardElseExitError) ErrorCode() errors.ErrorCode {
+	return 2063
+}
+
+func (*UnsupportedOptionalChainingAssignmentError) ErrorCode() errors.ErrorCode {
+	return 2064
+}
+
+func (*MissingResourceAnnotationError) ErrorCode() errors.ErrorCode {
+	return 2065
+}
+
+func (*InvalidNestedResourceMoveError) ErrorCode() errors.ErrorCode {
+	return 2066
+}
+
+func (*InvalidInterfaceConditionResourceInvalidationError) ErrorCode() errors.ErrorCode {
+	return 2067
+}
+
+func (*InvalidResourceAnnotationError) ErrorCode() errors.ErrorCode {
+	return 2068
+}
+
+func (*InvalidInterfaceTypeError) ErrorCode() errors.ErrorCode {
+	return 2069
+}
+
+func (*InvalidInterfaceDeclarationError) ErrorCode() errors.ErrorCode {
+	return 2070
+}
+
+func (*IncorrectTransferOperationError) ErrorCode() errors.ErrorCode {
+	return 2071
+}
+
+func (*InvalidConstructionError) ErrorCode() errors.ErrorCode {
+	return 2072
+}
+
+func (*InvalidDestructionError) ErrorCode() errors.ErrorCode {
+	return 2073
+}
+
+func (*ResourceLossError) ErrorCode() errors.ErrorCode {
+	return 2074
+}
+
+func (*ResourceUseAfterInvalidationError) ErrorCode() errors.ErrorCode {
+	return 2075
+}
+
+func (*MissingCreateError) ErrorCode() errors.ErrorCode {
+	return 2076
+}
+
+func (*MissingMoveOperationError) ErrorCode() errors.ErrorCode {
+	return 2077
+}
+
+func (*InvalidMoveOperationError) ErrorCode() errors.ErrorCode {
+	return 2078
+}
+
+func (*ResourceCapturingError) ErrorCode() errors.ErrorCode {
+	return 2079
+}
+
+func (*InvalidResourceFieldError) ErrorCode() errors.ErrorCode {
+	return 2080
+}
+
+func (*InvalidSwapExpressionError) ErrorCode() errors.ErrorCode {
+	return 2081
+}
+
+func (*InvalidEventParameterTypeError) ErrorCode() errors.ErrorCode {
+	return 2082
+}
+
+func (*InvalidEventUsageError) ErrorCode() errors.ErrorCode {
+	return 2083
+}
+
+func (*EmitNonEventError) ErrorCode() errors.ErrorCode {
+	return 2084
+}
+
+func (*EmitDefaultDestroyEventError) ErrorCode() errors.ErrorCode {
+	return 2085
+}
+
+func (*EmitImportedEventError) ErrorCode() errors.ErrorCode {
+	return 2086
+}
+
+func (*InvalidResourceAssignmentError) ErrorCode() errors.ErrorCode {
+	return 2087
+}
+
+func (*ResourceFieldNotInvalidatedError) ErrorCode() errors.ErrorCode {
+	return 2088
+}
+
+func (*UninitializedFieldAccessError) ErrorCode() errors.ErrorCode {
+	return 2089
+}
+
+func (*UnreachableStatementError) ErrorCode() errors.ErrorCode {
+	return 2090
+}
+
+func (*UninitializedUseError) ErrorCode() errors.ErrorCode {
+	return 2091
+}
+
+func (*InvalidResourceArrayMemberError) ErrorCode() errors.ErrorCode {
+	return 2092
+}
+
+func (*InvalidResourceDictionaryMemberError) ErrorCode() errors.ErrorCode {
+	return 2093
+}
+
+func (*InvalidResourceOptionalMemberError) ErrorCode() errors.ErrorCode {
+	return 2094
+}
+
+func (*NonReferenceTypeReferenceError) ErrorCode() errors.ErrorCode {
+	return 2095
+}
+
+func (*InvalidResourceCreationError) ErrorCode() errors.ErrorCode {
+	return 2096
+}
+
+func (*NonResourceTypeError) ErrorCode() errors.ErrorCode {
+	return 2097
+}
+
+func (*InvalidAssignmentTargetError) ErrorCode() errors.ErrorCode {
+	return 2098
+}
+
+func (*ResourceMethodBindingError) ErrorCode() errors.ErrorCode {
+	return 2099
+}
+
+func (*InvalidDictionaryKeyTypeError) ErrorCode() errors.ErrorCode {
+	return 2100
+}
+
+func (*MissingFunctionBodyError) ErrorCode() errors.ErrorCode {
+	return 2101
+}
+
+func (*InvalidOptionalChainingError) ErrorCode() errors.ErrorCode {
+	return 2102
+}
+
+func (*InvalidAccessError) ErrorCode() errors.ErrorCode {
+	return 2103
+}
+
+func (*InvalidAssignmentAccessError) ErrorCode() errors.ErrorCode {
+	return 2104
+}
+
+func (*UnauthorizedReferenceAssignmentError) ErrorCode() errors.ErrorCode {
+	return 2105
+}
+
+func (*InvalidCharacterLiteralError) ErrorCode() errors.ErrorCode {
+	return 2106
+}
+
+func (*InvalidFailableResourceDowncastOutsideOptionalBindingError) ErrorCode() errors.ErrorCode {
+	return 2107
+}
+
+func (*InvalidNonIdentifierFailableResourceDowncast) ErrorCode() errors.ErrorCode {
+	return 2108
+}
+
+func (*ReadOnlyTargetAssignmentError) ErrorCode() errors.ErrorCode {
+	return 2109
+}
+
+func (*InvalidTransactionBlockError) ErrorCode() errors.ErrorCode {
+	return 2110
+}
+
+func (*TransactionMissingPrepareError) ErrorCode() errors.ErrorCode {
+	return 2111
+}
+
+func (*InvalidResourceTransactionParameterError) ErrorCode() errors.ErrorCode {
+	return 2112
+}
+
+func (*InvalidNonImportableTransactionParameterTypeError) ErrorCode() errors.ErrorCode {
+	return 2113
+}
+
+func (*InvalidTransactionFieldAccessModifierError) ErrorCode() errors.ErrorCode {
+	return 2114
+}
+
+func (*InvalidTransactionPrepareParameterTypeError) ErrorCode() errors.ErrorCode {
+	return 2115
+}
+
+func (*InvalidNestedDeclarationError) ErrorCode() errors.ErrorCode {
+	return 2116
+}
+
+func (*InvalidNestedTypeError) ErrorCode() errors.ErrorCode {
+	return 2117
+}
+
+func (*InvalidEnumCaseError) ErrorCode() errors.ErrorCode {
+	return 2118
+}
+
+func (*InvalidNonEnumCaseError) ErrorCode() errors.ErrorCode {
+	return 2119
+}
+
+func (*DeclarationKindMismatchError) ErrorCode() errors.ErrorCode {
+	return 2120
+}
+
+func (*InvalidTopLevelDeclarationError) ErrorCode() errors.ErrorCode {
+	return 2121
+}
+
+func (*InvalidSelfInvalidationError) ErrorCode() errors.ErrorCode {
+	return 2122
+}
+
+func (*InvalidMoveError) ErrorCode() errors.ErrorCode {
+	return 2123
+}
+
+func (*ConstantSizedArrayLiteralSizeError) ErrorCode() errors.ErrorCode {
+	return 2124
+}
+
+func (*InvalidIntersectedTypeError) ErrorCode() errors.ErrorCode {
+	return 2125
+}
+
+func (*IntersectionCompositeKindMismatchError) ErrorCode() errors.ErrorCode {
+	return 2126
+}
+
+func (*InvalidIntersectionTypeDuplicateError) ErrorCode() errors.ErrorCode {
+	return 2127
+}
+
+func (*IntersectionMemberClashError) ErrorCode() errors.ErrorCode {
+	return 2128
+}
+
+func (*AmbiguousIntersectionTypeError) ErrorCode() errors.ErrorCode {
+	return 2129
+}
+
+func (*InvalidPathDomainError) ErrorCode() errors.ErrorCode {
+	return 2130
+}
+
+func (*InvalidTypeArgumentCountError) ErrorCode() errors.ErrorCode {
+	return 2131
+}
+
+func (*MissingTypeArgumentError) ErrorCode() errors.ErrorCode {
+	return 2132
+}
+
+func (*InvalidTypeArgumentError) ErrorCode() errors.ErrorCode {
+	return 2133
+}
+
+func (*TypeParameterTypeInferenceError) ErrorCode() errors.ErrorCode {
+	return 2134
+}
+
+func (*InvalidConstantSizedTypeBaseError) ErrorCode() errors.ErrorCode {
+	return 2135
+}
+
+func (*InvalidConstantSizedTypeSizeError) ErrorCode() errors.ErrorCode {
+	return 2136
+}
+
+func (*UnsupportedResourceForLoopError) ErrorCode() errors.ErrorCode {
+	return 2137
+}
+
+func (*TypeParameterTypeMismatchError) ErrorCode() errors.ErrorCode {
+	return 2138
+}
+
+func (*UnparameterizedTypeInstantiationError) ErrorCode() errors.ErrorCode {
+	return 2139
+}
+
+func (*TypeAnnotationRequiredError) ErrorCode() errors.ErrorCode {
+	return 2140
+}
+
+func (*CyclicImportsError) ErrorCode() errors.ErrorCode {
+	return 2141
+}
+
+func (*SwitchDefaultPositionError) ErrorCode() errors.ErrorCode {
+	return 2142
+}
+
+func (*MissingSwitchCaseStatementsError) ErrorCode() errors.ErrorCode {
+	return 2143
+}
+
+func (*MissingEntryPointError) ErrorCode() errors.ErrorCode {
+	return 2144
+}
+
+func (*InvalidEntryPointTypeError) ErrorCode() errors.ErrorCode {
+	return 2145
+}
+
+func (*PurityError) ErrorCode() errors.ErrorCode {
+	return 2146
+}
+
+func (*InvalidatedResourceReferenceError) ErrorCode() errors.ErrorCode {
+	return 2147
+}
+
+func (*InvalidEntitlementAccessError) ErrorCode() errors.ErrorCode {
+	return 2148
+}
+
+func (*InvalidEntitlementMappingTypeError) ErrorCode() errors.ErrorCode {
+	return 2149
+}
+
+func (*InvalidNonEntitlementTypeInMapError) ErrorCode() errors.ErrorCode {
+	return 2150
+}
+
+func (*InvalidMappedEntitlementMemberError) ErrorCode() errors.ErrorCode {
+	return 2151
+}
+
+func (*InvalidAttachmentMappedEntitlementMemberError) ErrorCode() errors.ErrorCode {
+	return 2152
+}
+
+func (*InvalidNonEntitlementAccessError) ErrorCode() errors.ErrorCode {
+	return 2153
+}
+
+func (*MappingAccessMissingKeywordError) ErrorCode() errors.ErrorCode {
+	return 2154
+}
+
+func (*DirectEntitlementAnnotationError) ErrorCode() errors.ErrorCode {
+	return 2155
+}
+
+func (*UnrepresentableEntitlementMapOutputError) ErrorCode() errors.ErrorCode {
+	return 2156
+}
+
+func (*InvalidMappedAuthorizationOutsideOfFieldError) ErrorCode() errors.ErrorCode {
+	return 2157
+}
+
+func (*InvalidEntitlementMappingInclusionError) ErrorCode() errors.ErrorCode {
+	return 2158
+}
+
+func (*DuplicateEntitlementMappingInclusionError) ErrorCode() errors.ErrorCode {
+	return 2159
+}
+
+func (*CyclicEntitlementMappingError) ErrorCode() errors.ErrorCode {
+	return 2160
+}
+
+func (*InvalidBaseTypeError) ErrorCode() errors.ErrorCode {
+	return 2161
+}
+
+func (*InvalidAttachmentAnnotationError) ErrorCode() errors.ErrorCode {
+	return 2162
+}
+
+func (*InvalidAttachmentUsageError) ErrorCode() errors.ErrorCode {
+	return 2163
+}
+
+func (*AttachNonAttachmentError) ErrorCode() errors.ErrorCode {
+	return 2164
+}
+
+func (*AttachToInvalidTypeError) ErrorCode() errors.ErrorCode {
+	return 2165
+}
+
+func (*InvalidAttachmentRemoveError) ErrorCode() errors.ErrorCode {
+	return 2166
+}
+
+func (*InvalidTypeIndexingError) ErrorCode() errors.ErrorCode {
+	return 2167
+}
+
+func (*AttachmentsNotEnabledError) ErrorCode() errors.ErrorCode {
+	return 2168
+}
+
+func (*InvalidAttachmentEntitlementError) ErrorCode() errors.ErrorCode {
+	return 2169
+}
+
+func (*DefaultDestroyEventInNonResourceError) ErrorCode() errors.ErrorCode {
+	return 2170
+}
+
+func (*DefaultDestroyInvalidArgumentError) ErrorCode() errors.ErrorCode {
+	return 2171
+}
+
+func (*DefaultDestroyInvalidParameterError) ErrorCode() errors.ErrorCode {
+	return 2172
+}
+
+func (*InvalidTypeParameterizedNonNativeFunctionError) ErrorCode() errors.ErrorCode {
+	return 2173
+}
+
+func (*InvalidTypeParameterizedCompositeError) ErrorCode() errors.ErrorCode {
+	return 2174
+}
+
+func (*NestedReferenceError) ErrorCode() errors.ErrorCode {
+	return 2175
+}
@@ -2030,6 +2030,54 @@ func (e *MissingReturnStatementError) Error() string {
 	return "missing return statement"
 }
 
+// InvalidStringTemplateValueTypeError
+
+type InvalidStringTemplateValueTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidStringTemplateValueTypeError{}
+var _ errors.UserError = &InvalidStringTemplateValueTypeError{}
+var _ errors.SecondaryError = &InvalidStringTemplateValueTypeError{}
+
+func (*InvalidStringTemplateValueTypeError) isSemanticError() {}
+
+func (*InvalidStringTemplateValueTypeError) IsUserError() {}
+
+func (e *InvalidStringTemplateValueTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot interpolate value of type `%s` in string template",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidStringTemplateValueTypeError) SecondaryError() string {
+	return "only strings, characters, numbers, addresses, and paths can be interpolated"
+}
+
+// MissingGuardElseExitError
+
+type MissingGuardElseExitError struct {
+	ast.Range
+}
+
+var _ SemanticError = &MissingGuardElseExitError{}
+var _ errors.UserError = &MissingGuardElseExitError{}
+var _ errors.SecondaryError = &MissingGuardElseExitError{}
+
+func (*MissingGuardElseExitError) isSemanticError() {}
+
+func (*MissingGuardElseExitError) IsUserError() {}
+
+func (e *MissingGuardElseExitError) Error() string {
+	return "guard statement else-block must not fall through"
+}
+
+func (e *MissingGuardElseExitError) SecondaryError() string {
+	return "end the block with a return, break, or continue statement, or a call to a function that never returns, like `panic`"
+}
+
 // UnsupportedOptionalChainingAssignmentError
 
 type UnsupportedOptionalChainingAssignmentError struct {
@@ -4753,6 +4801,32 @@ func (e *InvalidTypeParameterizedNonNativeFunctionError) Error() string {
 	return "invalid type parameters in non-native function"
 }
 
+// InvalidTypeParameterizedCompositeError
+
+type InvalidTypeParameterizedCompositeError struct {
+	CompositeKind common.CompositeKind
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.UserError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.SecondaryError = &InvalidTypeParameterizedCompositeError{}
+
+func (*InvalidTypeParameterizedCompositeError) isSemanticError() {}
+
+func (*InvalidTypeParameterizedCompositeError) IsUserError() {}
+
+func (e *InvalidTypeParameterizedCompositeError) Error() string {
+	return fmt.Sprintf(
+		"invalid type parameters in %s declaration",
+		e.CompositeKind.Name(),
+	)
+}
+
+func (*InvalidTypeParameterizedCompositeError) SecondaryError() string {
+	return "only structures and resources may have type parameters"
+}
+
 // NestedReferenceError
 type NestedReferenceError struct {
 	Type *ReferenceType
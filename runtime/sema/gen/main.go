@@ -1284,10 +1284,12 @@ func (*generator) VisitImportDeclaration(_ *ast.ImportDeclaration) struct{} {
 
 const typeNameSeparator = '_'
 
+// joinTypeName joins the given full type name of a parent type,
+// which is already escaped, and the given name of a nested type.
 func joinTypeName(parentFullTypeName string, typeName string) string {
 	return fmt.Sprintf(
 		"%s%c%s",
-		escapeTypeName(parentFullTypeName),
+		parentFullTypeName,
 		typeNameSeparator,
 		escapeTypeName(typeName),
 	)
@@ -1295,7 +1297,7 @@ func joinTypeName(parentFullTypeName string, typeName string) string {
 
 func (g *generator) newFullTypeName(typeName string) string {
 	if len(g.typeStack) == 0 {
-		return typeName
+		return escapeTypeName(typeName)
 	}
 	parentFullTypeName := g.typeStack[len(g.typeStack)-1].fullTypeName
 	return joinTypeName(parentFullTypeName, typeName)
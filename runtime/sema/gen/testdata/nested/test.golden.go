@@ -57,6 +57,54 @@ const Foo_BarTypeBarFunctionDocString = `
 bar
 `
 
+const Foo_BarTypeBazFieldName = "baz"
+
+var Foo_BarTypeBazFieldType = Foo_Bar_BazType
+
+const Foo_BarTypeBazFieldDocString = `
+Baz
+`
+
+const Foo_Bar_BazTypeBazFunctionName = "baz"
+
+var Foo_Bar_BazTypeBazFunctionType = &sema.FunctionType{
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		sema.VoidType,
+	),
+}
+
+const Foo_Bar_BazTypeBazFunctionDocString = `
+baz
+`
+
+const Foo_Bar_BazTypeName = "Baz"
+
+var Foo_Bar_BazType = func() *sema.CompositeType {
+	var t = &sema.CompositeType{
+		Identifier:         Foo_Bar_BazTypeName,
+		Kind:               common.CompositeKindStructure,
+		ImportableBuiltin:  false,
+		HasComputedMembers: true,
+	}
+
+	return t
+}()
+
+func init() {
+	var members = []*sema.Member{
+		sema.NewUnmeteredFunctionMember(
+			Foo_Bar_BazType,
+			sema.PrimitiveAccess(ast.AccessAll),
+			Foo_Bar_BazTypeBazFunctionName,
+			Foo_Bar_BazTypeBazFunctionType,
+			Foo_Bar_BazTypeBazFunctionDocString,
+		),
+	}
+
+	Foo_Bar_BazType.Members = sema.MembersAsMap(members)
+	Foo_Bar_BazType.Fields = sema.MembersFieldNames(members)
+}
+
 const Foo_BarTypeName = "Bar"
 
 var Foo_BarType = func() *sema.CompositeType {
@@ -67,6 +115,7 @@ var Foo_BarType = func() *sema.CompositeType {
 		HasComputedMembers: true,
 	}
 
+	t.SetNestedType(Foo_Bar_BazTypeName, Foo_Bar_BazType)
 	return t
 }()
 
@@ -79,6 +128,14 @@ func init() {
 			Foo_BarTypeBarFunctionType,
 			Foo_BarTypeBarFunctionDocString,
 		),
+		sema.NewUnmeteredFieldMember(
+			Foo_BarType,
+			sema.PrimitiveAccess(ast.AccessAll),
+			ast.VariableKindConstant,
+			Foo_BarTypeBazFieldName,
+			Foo_BarTypeBazFieldType,
+			Foo_BarTypeBazFieldDocString,
+		),
 	}
 
 	Foo_BarType.Members = sema.MembersAsMap(members)
@@ -0,0 +1,168 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+var RoundingModeType = func() *CompositeType {
+	ty := newNativeEnumType(
+		RoundingModeTypeName,
+		UInt8Type,
+		nil,
+	)
+	// Rounding modes are only used as arguments of functions,
+	// there is no need to import them
+	ty.ImportableBuiltin = false
+	return ty
+}()
+
+var RoundingModeTypeAnnotation = NewTypeAnnotation(RoundingModeType)
+
+type RoundingMode uint8
+
+// NOTE: only add new rounding modes, do *NOT* change existing items,
+// reuse raw values for other items, swap the order, etc.
+//
+// # Existing stored values use these raw values and should not change
+//
+// IMPORTANT: update RoundingModes
+const (
+	RoundingModeTowardZero RoundingMode = iota
+	RoundingModeAwayFromZero
+	RoundingModeTowardNegativeInfinity
+	RoundingModeTowardPositiveInfinity
+	RoundingModeNearestHalfAwayFromZero
+	RoundingModeNearestHalfEven
+)
+
+var RoundingModes = []RoundingMode{
+	RoundingModeTowardZero,
+	RoundingModeAwayFromZero,
+	RoundingModeTowardNegativeInfinity,
+	RoundingModeTowardPositiveInfinity,
+	RoundingModeNearestHalfAwayFromZero,
+	RoundingModeNearestHalfEven,
+}
+
+var _ NativeEnumCase = RoundingModeTowardZero
+
+// Name returns the name of the enum case of this rounding mode.
+func (mode RoundingMode) Name() string {
+	switch mode {
+	case RoundingModeTowardZero:
+		return "towardZero"
+	case RoundingModeAwayFromZero:
+		return "awayFromZero"
+	case RoundingModeTowardNegativeInfinity:
+		return "towardNegativeInfinity"
+	case RoundingModeTowardPositiveInfinity:
+		return "towardPositiveInfinity"
+	case RoundingModeNearestHalfAwayFromZero:
+		return "nearestHalfAwayFromZero"
+	case RoundingModeNearestHalfEven:
+		return "nearestHalfEven"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (mode RoundingMode) RawValue() uint8 {
+	// NOTE: only add new rounding modes, do *NOT* change existing items,
+	// reuse raw values for other items, swap the order, etc.
+	//
+	// Existing stored values use these raw values and should not change
+
+	switch mode {
+	case RoundingModeTowardZero:
+		return 0
+	case RoundingModeAwayFromZero:
+		return 1
+	case RoundingModeTowardNegativeInfinity:
+		return 2
+	case RoundingModeTowardPositiveInfinity:
+		return 3
+	case RoundingModeNearestHalfAwayFromZero:
+		return 4
+	case RoundingModeNearestHalfEven:
+		return 5
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (mode RoundingMode) DocString() string {
+	switch mode {
+	case RoundingModeTowardZero:
+		return RoundingModeDocStringTowardZero
+	case RoundingModeAwayFromZero:
+		return RoundingModeDocStringAwayFromZero
+	case RoundingModeTowardNegativeInfinity:
+		return RoundingModeDocStringTowardNegativeInfinity
+	case RoundingModeTowardPositiveInfinity:
+		return RoundingModeDocStringTowardPositiveInfinity
+	case RoundingModeNearestHalfAwayFromZero:
+		return RoundingModeDocStringNearestHalfAwayFromZero
+	case RoundingModeNearestHalfEven:
+		return RoundingModeDocStringNearestHalfEven
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (mode RoundingMode) IsValid() bool {
+	switch mode {
+	case RoundingModeTowardZero,
+		RoundingModeAwayFromZero,
+		RoundingModeTowardNegativeInfinity,
+		RoundingModeTowardPositiveInfinity,
+		RoundingModeNearestHalfAwayFromZero,
+		RoundingModeNearestHalfEven:
+		return true
+	}
+	return false
+}
+
+const RoundingModeTypeName = "RoundingMode"
+
+const RoundingModeDocStringTowardZero = `
+towardZero rounds to the nearest value with a smaller or equal magnitude, i.e. it truncates.
+This is how the arithmetic operators of fixed-point types round
+`
+
+const RoundingModeDocStringAwayFromZero = `
+awayFromZero rounds to the nearest value with a larger or equal magnitude
+`
+
+const RoundingModeDocStringTowardNegativeInfinity = `
+towardNegativeInfinity rounds to the nearest smaller or equal value, i.e. it rounds down
+`
+
+const RoundingModeDocStringTowardPositiveInfinity = `
+towardPositiveInfinity rounds to the nearest larger or equal value, i.e. it rounds up
+`
+
+const RoundingModeDocStringNearestHalfAwayFromZero = `
+nearestHalfAwayFromZero rounds to the nearest value, and values exactly halfway between two values away from zero
+`
+
+const RoundingModeDocStringNearestHalfEven = `
+nearestHalfEven rounds to the nearest value, and values exactly halfway between two values to the value with an even last digit
+`
@@ -96,6 +96,20 @@ var DictionaryTypeFunctionType = NewSimpleFunctionType(
 	OptionalMetaTypeAnnotation,
 )
 
+const SetTypeFunctionName = "SetType"
+
+var SetTypeFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "element",
+			TypeAnnotation: MetaTypeAnnotation,
+		},
+	},
+	OptionalMetaTypeAnnotation,
+)
+
 const CompositeTypeFunctionName = "CompositeType"
 
 var CompositeTypeFunctionType = NewSimpleFunctionType(
@@ -224,6 +238,13 @@ var runtimeTypeConstructors = []*RuntimeTypeConstructor{
 		Returns nil if the key type is not a valid dictionary key.`,
 	},
 
+	{
+		Name:  SetTypeFunctionName,
+		Value: SetTypeFunctionType,
+		DocString: `Creates a run-time type representing a set type of the given run-time element type.
+		Returns nil if the element type is not a valid set element.`,
+	},
+
 	{
 		Name:  CompositeTypeFunctionName,
 		Value: CompositeTypeFunctionType,
@@ -18,6 +18,8 @@
 
 package sema
 
+//go:generate go run ./gen string.cdc string.gen.go
+
 import (
 	"github.com/onflow/cadence/runtime/errors"
 )
@@ -42,141 +44,19 @@ const StringTypeJoinFunctionDocString = `
 Returns a string after joining the array of strings with the provided separator.
 `
 
-const StringTypeSplitFunctionName = "split"
-const StringTypeSplitFunctionDocString = `
-Returns a variable-sized array of strings after splitting the string on the delimiter.
-`
+var StringTypeAnnotation = NewTypeAnnotation(StringType)
 
-// StringType represents the string type
-var StringType = &SimpleType{
-	Name:          "String",
-	QualifiedName: "String",
-	TypeID:        "String",
-	TypeTag:       StringTypeTag,
-	IsResource:    false,
-	Storable:      true,
-	Primitive:     true,
-	Equatable:     true,
-	Comparable:    true,
-	Exportable:    true,
-	Importable:    true,
-	ValueIndexingInfo: ValueIndexingInfo{
+func init() {
+	StringType.ValueIndexingInfo = ValueIndexingInfo{
 		IsValueIndexableType:          true,
 		AllowsValueIndexingAssignment: false,
 		ElementType: func(_ bool) Type {
 			return CharacterType
 		},
 		IndexingType: IntegerType,
-	},
-}
-
-var StringTypeAnnotation = NewTypeAnnotation(StringType)
-
-func init() {
-	StringType.Members = func(t *SimpleType) map[string]MemberResolver {
-		return MembersAsResolvers([]*Member{
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeConcatFunctionName,
-				StringTypeConcatFunctionType,
-				stringTypeConcatFunctionDocString,
-			),
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeSliceFunctionName,
-				StringTypeSliceFunctionType,
-				stringTypeSliceFunctionDocString,
-			),
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeDecodeHexFunctionName,
-				StringTypeDecodeHexFunctionType,
-				stringTypeDecodeHexFunctionDocString,
-			),
-			NewUnmeteredPublicConstantFieldMember(
-				t,
-				StringTypeUtf8FieldName,
-				ByteArrayType,
-				stringTypeUtf8FieldDocString,
-			),
-			NewUnmeteredPublicConstantFieldMember(
-				t,
-				StringTypeLengthFieldName,
-				IntType,
-				stringTypeLengthFieldDocString,
-			),
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeToLowerFunctionName,
-				StringTypeToLowerFunctionType,
-				stringTypeToLowerFunctionDocString,
-			),
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeSplitFunctionName,
-				StringTypeSplitFunctionType,
-				StringTypeSplitFunctionDocString,
-			),
-			NewUnmeteredPublicFunctionMember(
-				t,
-				StringTypeReplaceAllFunctionName,
-				StringTypeReplaceAllFunctionType,
-				StringTypeReplaceAllFunctionDocString,
-			),
-		})
 	}
 }
 
-var StringTypeConcatFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	[]Parameter{
-		{
-			Label:          ArgumentLabelNotRequired,
-			Identifier:     "other",
-			TypeAnnotation: StringTypeAnnotation,
-		},
-	},
-	StringTypeAnnotation,
-)
-
-const StringTypeConcatFunctionName = "concat"
-
-const stringTypeConcatFunctionDocString = `
-Returns a new string which contains the given string concatenated to the end of the original string, but does not modify the original string
-`
-
-var StringTypeSliceFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	[]Parameter{
-		{
-			Identifier:     "from",
-			TypeAnnotation: IntTypeAnnotation,
-		},
-		{
-			Identifier:     "upTo",
-			TypeAnnotation: IntTypeAnnotation,
-		},
-	},
-	StringTypeAnnotation,
-)
-
-const StringTypeSliceFunctionName = "slice"
-
-const stringTypeSliceFunctionDocString = `
-Returns a new string containing the slice of the characters in the given string from start index ` + "`from`" + ` up to, but not including, the end index ` + "`upTo`" + `.
-
-This function creates a new string whose length is ` + "`upTo - from`" + `.
-It does not modify the original string.
-If either of the parameters are out of the bounds of the string, or the indices are invalid (` + "`from > upTo`" + `), then the function will fail
-`
-
-const StringTypeReplaceAllFunctionName = "replaceAll"
-const StringTypeReplaceAllFunctionDocString = `
-Returns a new string after replacing all the occurrences of parameter ` + "`of` with the parameter `with`" + `.
-
-If ` + "`with`" + ` is empty, it matches at the beginning of the string and after each UTF-8 sequence, yielding k+1 replacements for a string of length k.
-`
-
 // ByteArrayType represents the type [UInt8]
 var ByteArrayType = &VariableSizedType{
 	Type: UInt8Type,
@@ -191,45 +71,6 @@ var ByteArrayArrayType = &VariableSizedType{
 
 var ByteArrayArrayTypeAnnotation = NewTypeAnnotation(ByteArrayArrayType)
 
-var StringTypeDecodeHexFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	nil,
-	ByteArrayTypeAnnotation,
-)
-
-const StringTypeDecodeHexFunctionName = "decodeHex"
-
-const stringTypeDecodeHexFunctionDocString = `
-Returns an array containing the bytes represented by the given hexadecimal string.
-
-The given string must only contain hexadecimal characters and must have an even length.
-If the string is malformed, the program aborts
-`
-
-const StringTypeLengthFieldName = "length"
-
-const stringTypeLengthFieldDocString = `
-The number of characters in the string
-`
-
-const StringTypeUtf8FieldName = "utf8"
-
-const stringTypeUtf8FieldDocString = `
-The byte array of the UTF-8 encoding
-`
-
-var StringTypeToLowerFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	nil,
-	StringTypeAnnotation,
-)
-
-const StringTypeToLowerFunctionName = "toLower"
-
-const stringTypeToLowerFunctionDocString = `
-Returns the string with upper case letters replaced with lowercase
-`
-
 const stringFunctionDocString = "Creates an empty string"
 
 var StringFunctionType = func() *FunctionType {
@@ -360,33 +201,3 @@ var StringTypeJoinFunctionType = NewSimpleFunctionType(
 	},
 	StringTypeAnnotation,
 )
-
-var StringTypeSplitFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	[]Parameter{
-		{
-			Identifier:     "separator",
-			TypeAnnotation: StringTypeAnnotation,
-		},
-	},
-	NewTypeAnnotation(
-		&VariableSizedType{
-			Type: StringType,
-		},
-	),
-)
-
-var StringTypeReplaceAllFunctionType = NewSimpleFunctionType(
-	FunctionPurityView,
-	[]Parameter{
-		{
-			Identifier:     "of",
-			TypeAnnotation: StringTypeAnnotation,
-		},
-		{
-			Identifier:     "with",
-			TypeAnnotation: StringTypeAnnotation,
-		},
-	},
-	StringTypeAnnotation,
-)
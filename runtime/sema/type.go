@@ -944,8 +944,12 @@ func (t *GenericType) Equal(other Type) bool {
 	return t.TypeParameter == otherType.TypeParameter
 }
 
-func (*GenericType) IsResourceType() bool {
-	return false
+// NOTE: A generic type is only known by its type bound,
+// so the properties of the generic type are the properties of the type bound (if any)
+
+func (t *GenericType) IsResourceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsResourceType()
 }
 
 func (*GenericType) IsPrimitiveType() bool {
@@ -956,32 +960,39 @@ func (*GenericType) IsInvalidType() bool {
 	return false
 }
 
-func (*GenericType) IsOrContainsReferenceType() bool {
-	return false
+func (t *GenericType) IsOrContainsReferenceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsOrContainsReferenceType()
 }
 
-func (*GenericType) IsStorable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsStorable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsStorable(results)
 }
 
-func (*GenericType) IsExportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsExportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsExportable(results)
 }
 
-func (t *GenericType) IsImportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsImportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsImportable(results)
 }
 
-func (*GenericType) IsEquatable() bool {
-	return false
+func (t *GenericType) IsEquatable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsEquatable()
 }
 
-func (*GenericType) IsComparable() bool {
-	return false
+func (t *GenericType) IsComparable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsComparable()
 }
 
 func (t *GenericType) ContainFieldsOrElements() bool {
-	return false
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.ContainFieldsOrElements()
 }
 
 func (*GenericType) TypeAnnotationState() TypeAnnotationState {
@@ -1048,10 +1059,19 @@ func (t *GenericType) Map(_ common.MemoryGauge, typeParamMap map[*TypeParameter]
 			TypeParameter: param,
 		})
 	}
-	panic(errors.NewUnreachableError())
+
+	// The type parameter is declared outside of the mapped type,
+	// e.g. the type parameter of a generic composite type
+	// used in the type of a member function
+
+	return f(t)
 }
 
 func (t *GenericType) GetMembers() map[string]MemberResolver {
+	typeBound := t.TypeParameter.TypeBound
+	if typeBound != nil {
+		return typeBound.GetMembers()
+	}
 	return withBuiltinMembers(t, nil)
 }
 
@@ -1909,6 +1929,37 @@ var (
 		})
 
 	UFix64TypeAnnotation = NewTypeAnnotation(UFix64Type)
+
+	// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+			WithTag(Fix128TypeTag).
+			WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+			WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingFunctions(SaturatingArithmeticSupport{
+			Add:      true,
+			Subtract: true,
+			Multiply: true,
+			Divide:   true,
+		})
+
+	Fix128TypeAnnotation = NewTypeAnnotation(Fix128Type)
+
+	// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+			WithTag(UFix128TypeTag).
+			WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+			WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingFunctions(SaturatingArithmeticSupport{
+			Add:      true,
+			Subtract: true,
+			Multiply: true,
+		})
+
+	UFix128TypeAnnotation = NewTypeAnnotation(UFix128Type)
 )
 
 // Numeric type ranges
@@ -2035,6 +2086,26 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinBig = fixedpoint.Fix128TypeMinBig
+	Fix128TypeMaxBig = fixedpoint.Fix128TypeMaxBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinBig = fixedpoint.UFix128TypeMinBig
+	UFix128TypeMaxBig = fixedpoint.UFix128TypeMaxBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 // size constants (in bytes) for fixed-width numeric types
@@ -2053,6 +2124,8 @@ const (
 	Word64TypeSize  uint = 8
 	Fix64TypeSize   uint = 8
 	UFix64TypeSize  uint = 8
+	Fix128TypeSize  uint = 16
+	UFix128TypeSize uint = 16
 	Int128TypeSize  uint = 16
 	UInt128TypeSize uint = 16
 	Int256TypeSize  uint = 32
@@ -2062,6 +2135,8 @@ const (
 const Fix64Scale = fixedpoint.Fix64Scale
 const Fix64Factor = fixedpoint.Fix64Factor
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 const Fix64TypeMinInt = fixedpoint.Fix64TypeMinInt
 const Fix64TypeMaxInt = fixedpoint.Fix64TypeMaxInt
 
@@ -2195,6 +2270,58 @@ const arrayTypeMapFunctionDocString = `
 Returns a new array whose elements are produced by applying the mapper function on each element of the original array.
 `
 
+const ArrayTypeLastIndexFunctionName = "lastIndex"
+
+const arrayTypeLastIndexFunctionDocString = `
+Returns the index of the last element matching the given object in the array, nil if no match.
+Available if the array element type is not resource-kinded and equatable.
+`
+
+const ArrayTypeIndexOfFunctionName = "indexOf"
+
+const arrayTypeIndexOfFunctionDocString = `
+Returns the index of the first element of the array for which the given predicate function returns true, nil if no match.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeAllSatisfyFunctionName = "allSatisfy"
+
+const arrayTypeAllSatisfyFunctionDocString = `
+Returns true if the given predicate function returns true for all elements of the array.
+Returns true if the array is empty.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeAnySatisfyFunctionName = "anySatisfy"
+
+const arrayTypeAnySatisfyFunctionDocString = `
+Returns true if the given predicate function returns true for at least one element of the array.
+Returns false if the array is empty.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeSortFunctionName = "sort"
+
+const arrayTypeSortFunctionDocString = `
+Returns a new array with the elements of the original array, sorted using the given comparison function.
+
+The comparison function must return true if the first argument should be ordered before the second argument.
+The sort is stable, i.e. elements which are not ordered before each other retain their original order.
+The original array is not modified.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeReduceFunctionName = "reduce"
+
+const arrayTypeReduceFunctionDocString = `
+Returns the result of combining the elements of the array using the given function.
+
+The function is called with the initial value and the first element of the array,
+then with the result of the previous call and the next element, and so on.
+If the array is empty, the initial value is returned.
+Available if the array element type is not resource-kinded.
+`
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -2388,6 +2515,200 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		ArrayTypeLastIndexFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// It is impossible for an array of resources to have a `lastIndex` function:
+				// if the resource is passed as an argument, it cannot be inside the array
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				if !elementType.IsEquatable() {
+					report(
+						&NotEquatableTypeError{
+							Type:  elementType,
+							Range: ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayLastIndexFunctionType(elementType),
+					arrayTypeLastIndexFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeIndexOfFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayIndexOfFunctionType(elementType),
+					arrayTypeIndexOfFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeAllSatisfyFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayAllSatisfyFunctionType(elementType),
+					arrayTypeAllSatisfyFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeAnySatisfyFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayAnySatisfyFunctionType(elementType),
+					arrayTypeAnySatisfyFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeSortFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// Sorting produces a new array, and it is impossible for a resource to be present in two arrays.
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArraySortFunctionType(arrayType),
+					arrayTypeSortFunctionDocString,
+				)
+			},
+		},
+		ArrayTypeReduceFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(
+				memoryGauge common.MemoryGauge,
+				identifier string,
+				targetRange ast.HasPosition,
+				report func(error),
+			) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayReduceFunctionType(elementType),
+					arrayTypeReduceFunctionDocString,
+				)
+			},
+		},
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -2954,16 +3275,175 @@ func ArrayMapFunctionType(memoryGauge common.MemoryGauge, arrayType ArrayType) *
 	}
 }
 
-// VariableSizedType is a variable sized array type
-type VariableSizedType struct {
-	Type                Type
-	memberResolvers     map[string]MemberResolver
-	memberResolversOnce sync.Once
+func ArrayLastIndexFunctionType(elementType Type) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Identifier:     "of",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		NewTypeAnnotation(
+			&OptionalType{Type: IntType},
+		),
+	)
 }
 
-var _ Type = &VariableSizedType{}
-var _ ArrayType = &VariableSizedType{}
-var _ ValueIndexableType = &VariableSizedType{}
+// arrayPredicateFunctionType returns the type of a predicate function
+// for the given element type, i.e. `view fun(T): Bool`
+func arrayPredicateFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+		Purity:               FunctionPurityView,
+	}
+}
+
+func ArrayIndexOfFunctionType(elementType Type) *FunctionType {
+	// fun indexOf(where predicate: view fun(T): Bool): Int?
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          "where",
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		NewTypeAnnotation(
+			&OptionalType{Type: IntType},
+		),
+	)
+}
+
+func ArrayAllSatisfyFunctionType(elementType Type) *FunctionType {
+	// fun allSatisfy(_ predicate: view fun(T): Bool): Bool
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+func ArrayAnySatisfyFunctionType(elementType Type) *FunctionType {
+	// fun anySatisfy(_ predicate: view fun(T): Bool): Bool
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+func ArraySortFunctionType(arrayType ArrayType) *FunctionType {
+	// For [T] or [T; N]
+	// fun sort(by isLess: view fun(T, T): Bool): [T]
+	//               or
+	// fun sort(by isLess: view fun(T, T): Bool): [T; N]
+
+	elementType := arrayType.ElementType(false)
+
+	// isLessFuncType: (elementType, elementType) -> Bool
+	isLessFuncType := &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "a",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+			{
+				Identifier:     "b",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+		Purity:               FunctionPurityView,
+	}
+
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          "by",
+				Identifier:     "isLess",
+				TypeAnnotation: NewTypeAnnotation(isLessFuncType),
+			},
+		},
+		NewTypeAnnotation(arrayType),
+	)
+}
+
+func ArrayReduceFunctionType(elementType Type) *FunctionType {
+	// fun reduce(initial: U, _ combine: fun(U, T): U): U
+
+	typeParameter := &TypeParameter{
+		Name: "U",
+	}
+
+	typeU := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	// combineFuncType: (U, elementType) -> U
+	combineFuncType := &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "result",
+				TypeAnnotation: NewTypeAnnotation(typeU),
+			},
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []Parameter{
+			{
+				Identifier:     "initial",
+				TypeAnnotation: NewTypeAnnotation(typeU),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "combine",
+				TypeAnnotation: NewTypeAnnotation(combineFuncType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+}
+
+// VariableSizedType is a variable sized array type
+type VariableSizedType struct {
+	Type                Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+var _ Type = &VariableSizedType{}
+var _ ArrayType = &VariableSizedType{}
+var _ ValueIndexableType = &VariableSizedType{}
 var _ EntitlementSupportingType = &VariableSizedType{}
 
 func NewVariableSizedType(memoryGauge common.MemoryGauge, typ Type) *VariableSizedType {
@@ -4075,11 +4555,21 @@ func (t *FunctionType) Map(gauge common.MemoryGauge, typeParamMap map[*TypeParam
 				continue
 			}
 
-			newTypeParameterTypeBound := parameter.TypeBound.Map(gauge, typeParamMap, f)
-			newParam := &TypeParameter{
-				Name:      parameter.Name,
-				Optional:  parameter.Optional,
-				TypeBound: newTypeParameterTypeBound,
+			var newTypeParameterTypeBound Type
+			if parameter.TypeBound != nil {
+				newTypeParameterTypeBound = parameter.TypeBound.Map(gauge, typeParamMap, f)
+			}
+
+			// Keep the type parameter if its type bound is unaffected by the mapping,
+			// so type arguments for it, e.g. inferred at an invocation of a member function
+			// of an instantiation of a generic composite type, also apply to the original function
+			newParam := parameter
+			if newTypeParameterTypeBound != parameter.TypeBound {
+				newParam = &TypeParameter{
+					Name:      parameter.Name,
+					Optional:  parameter.Optional,
+					TypeBound: newTypeParameterTypeBound,
+				}
 			}
 			typeParamMap[parameter] = newParam
 
@@ -4189,11 +4679,14 @@ func init() {
 			PublicKeyType,
 			SignatureAlgorithmType,
 			HashAlgorithmType,
+			RoundingModeType,
 			StorageCapabilityControllerType,
 			AccountCapabilityControllerType,
 			DeploymentResultType,
 			HashableStructType,
 			&InclusiveRangeType{},
+			&SetType{},
+			DictionaryEntryCompositeType,
 		},
 	)
 
@@ -4203,6 +4696,17 @@ func init() {
 
 	addToBaseActivation(IdentityType)
 
+	// The following types were added after programs were already able to declare
+	// their own types with the same names, so such declarations may shadow them
+
+	for _, typeName := range []string{
+		SetTypeName,
+		RoundingModeTypeName,
+		DictionaryEntryTypeName,
+	} {
+		BaseTypeActivation.Find(typeName).IsShadowable = true
+	}
+
 	// The AST contains empty type annotations, resolve them to Void
 
 	BaseTypeActivation.Set(
@@ -4255,10 +4759,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = common.Concat(
@@ -4770,6 +5276,23 @@ type CompositeType struct {
 	// Only applicable for native composite types
 	ImportableBuiltin     bool
 	supportedEntitlements *EntitlementOrderedSet
+
+	// typeParameters are the type parameters of a generic composite type.
+	// Instantiations share the type parameters of their generic type
+	typeParameters []*TypeParameter
+	// typeArguments are the type arguments of an instantiation of a generic composite type
+	typeArguments []Type
+	// genericType is the generic composite type of an instantiation
+	genericType *CompositeType
+	// membersDeclared is true if the members of the generic composite type were declared
+	membersDeclared bool
+	// membersInstantiated is true if the members of the instantiation were instantiated.
+	// Members are instantiated lazily, as the member types of a generic composite type
+	// may refer to further instantiations of it, e.g. `fun map<U>(): Box<U>`.
+	// This also happens at run-time, and elaborations are shared between concurrent executions,
+	// so it is guarded by membersInstantiationLock
+	membersInstantiated      bool
+	membersInstantiationLock sync.Mutex
 }
 
 var _ Type = &CompositeType{}
@@ -4778,6 +5301,7 @@ var _ ContainedType = &CompositeType{}
 var _ LocatedType = &CompositeType{}
 var _ CompositeKindedType = &CompositeType{}
 var _ TypeIndexableType = &CompositeType{}
+var _ ParameterizedType = &CompositeType{}
 
 func (t *CompositeType) Tag() TypeTag {
 	return CompositeTypeTag
@@ -4813,11 +5337,27 @@ func (t *CompositeType) EffectiveInterfaceConformances() []Conformance {
 func (*CompositeType) IsType() {}
 
 func (t *CompositeType) String() string {
-	return t.Identifier
+	if t.typeArguments == nil {
+		return t.Identifier
+	}
+	return formatTypeArguments(
+		t.Identifier,
+		t.typeArguments,
+		Type.String,
+		", ",
+	)
 }
 
 func (t *CompositeType) QualifiedString() string {
-	return t.QualifiedIdentifier()
+	if t.typeArguments == nil {
+		return t.QualifiedIdentifier()
+	}
+	return formatTypeArguments(
+		t.QualifiedIdentifier(),
+		t.typeArguments,
+		Type.QualifiedString,
+		", ",
+	)
 }
 
 func (t *CompositeType) GetContainerType() Type {
@@ -4933,6 +5473,17 @@ func (t *CompositeType) initializeIdentifiers() {
 
 	typeID := common.NewTypeIDFromQualifiedName(nil, t.Location, identifier)
 
+	if t.typeArguments != nil && !t.isIdentityInstantiation() {
+		typeID = TypeID(formatTypeArguments(
+			string(typeID),
+			t.typeArguments,
+			func(ty Type) string {
+				return string(ty.ID())
+			},
+			",",
+		))
+	}
+
 	t.cachedIdentifiers = &struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -4942,6 +5493,26 @@ func (t *CompositeType) initializeIdentifiers() {
 	}
 }
 
+// QualifiedIdentifierWithTypeArguments returns the qualified identifier of the composite type.
+// If the composite type is an instantiation of a generic composite type,
+// the qualified identifier is followed by the type IDs of the type arguments, e.g. `Box<Int>`
+func (t *CompositeType) QualifiedIdentifierWithTypeArguments() string {
+	identifier := t.QualifiedIdentifier()
+
+	if t.typeArguments == nil || t.isIdentityInstantiation() {
+		return identifier
+	}
+
+	return formatTypeArguments(
+		identifier,
+		t.typeArguments,
+		func(ty Type) string {
+			return string(ty.ID())
+		},
+		",",
+	)
+}
+
 func (t *CompositeType) Equal(other Type) bool {
 	otherStructure, ok := other.(*CompositeType)
 	if !ok {
@@ -4953,6 +5524,8 @@ func (t *CompositeType) Equal(other Type) bool {
 }
 
 func (t *CompositeType) MemberMap() *StringMemberOrderedMap {
+	t.instantiateMembersIfNeeded()
+
 	return t.Members
 }
 
@@ -4962,6 +5535,8 @@ func (t *CompositeType) SupportedEntitlements() (set *EntitlementOrderedSet) {
 		return supportedEntitlements
 	}
 
+	t.instantiateMembersIfNeeded()
+
 	set = orderedmap.New[EntitlementOrderedSet](t.Members.Len())
 	t.Members.Foreach(func(_ string, member *Member) {
 		switch access := member.Access.(type) {
@@ -5010,6 +5585,8 @@ func (*CompositeType) IsOrContainsReferenceType() bool {
 }
 
 func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
+	t.instantiateMembersIfNeeded()
+
 	if t.HasComputedMembers {
 		return false
 	}
@@ -5044,6 +5621,8 @@ func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
 }
 
 func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
+	t.instantiateMembersIfNeeded()
+
 	// Use the pre-determined flag for native types
 	if t.Location == nil {
 		return t.ImportableBuiltin
@@ -5075,6 +5654,8 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 }
 
 func (t *CompositeType) IsExportable(results map[*Member]bool) bool {
+	t.instantiateMembersIfNeeded()
+
 	// Only structures, resources, attachment, and enums can be stored
 
 	switch t.Kind {
@@ -5123,19 +5704,75 @@ func (t *CompositeType) RewriteWithIntersectionTypes() (result Type, rewritten b
 	return t, false
 }
 
-func (*CompositeType) Unify(
-	_ Type,
-	_ *TypeParameterTypeOrderedMap,
-	_ func(err error),
-	_ common.MemoryGauge,
-	_ ast.HasPosition,
+func (t *CompositeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
 ) bool {
-	// TODO:
-	return false
+	if t.typeArguments == nil {
+		return false
+	}
+
+	otherComposite, ok := other.(*CompositeType)
+	if !ok ||
+		otherComposite.genericType != t.genericType ||
+		len(otherComposite.typeArguments) != len(t.typeArguments) {
+
+		return false
+	}
+
+	result := false
+
+	for i, typeArgument := range t.typeArguments {
+		if typeArgument.Unify(
+			otherComposite.typeArguments[i],
+			typeParameters,
+			report,
+			memoryGauge,
+			outerRange,
+		) {
+			result = true
+		}
+	}
+
+	return result
 }
 
-func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	return t
+func (t *CompositeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+
+	// The generic composite type is resolved to the instantiation
+	// with the type arguments for its type parameters,
+	// e.g. when resolving the return type of the constructor function
+
+	if t.genericType == nil {
+		if len(t.typeParameters) == 0 {
+			return t
+		}
+
+		resolvedTypeArguments := make([]Type, 0, len(t.typeParameters))
+		for _, typeParameter := range t.typeParameters {
+			typeArgument, ok := typeArguments.Get(typeParameter)
+			if !ok || typeArgument == nil {
+				return nil
+			}
+			resolvedTypeArguments = append(resolvedTypeArguments, typeArgument)
+		}
+
+		return t.instantiate(resolvedTypeArguments)
+	}
+
+	resolvedTypeArguments := make([]Type, 0, len(t.typeArguments))
+	for _, typeArgument := range t.typeArguments {
+		resolvedTypeArgument := typeArgument.Resolve(typeArguments)
+		if resolvedTypeArgument == nil {
+			return nil
+		}
+		resolvedTypeArguments = append(resolvedTypeArguments, resolvedTypeArgument)
+	}
+
+	return t.genericType.instantiate(resolvedTypeArguments)
 }
 
 func (t *CompositeType) IsContainerType() bool {
@@ -5214,8 +5851,45 @@ func CompositeForEachAttachmentFunctionType(t common.CompositeKind) *FunctionTyp
 	}
 }
 
-func (t *CompositeType) Map(_ common.MemoryGauge, _ map[*TypeParameter]*TypeParameter, f func(Type) Type) Type {
-	return f(t)
+func (t *CompositeType) Map(gauge common.MemoryGauge, typeParamMap map[*TypeParameter]*TypeParameter, f func(Type) Type) Type {
+	genericType := t.genericType
+	typeArguments := t.typeArguments
+
+	if genericType == nil {
+		if len(t.typeParameters) == 0 {
+			return f(t)
+		}
+
+		// The generic composite type is mapped like its identity instantiation,
+		// e.g. the type of `self` in the declaration of `struct S<T> {}` is `S<T>`
+
+		genericType = t
+		typeArguments = make([]Type, 0, len(t.typeParameters))
+		for _, typeParameter := range t.typeParameters {
+			typeArguments = append(
+				typeArguments,
+				&GenericType{
+					TypeParameter: typeParameter,
+				},
+			)
+		}
+	}
+
+	mapped := false
+	mappedTypeArguments := make([]Type, 0, len(typeArguments))
+	for _, typeArgument := range typeArguments {
+		mappedTypeArgument := typeArgument.Map(gauge, typeParamMap, f)
+		if mappedTypeArgument != typeArgument {
+			mapped = true
+		}
+		mappedTypeArguments = append(mappedTypeArguments, mappedTypeArgument)
+	}
+
+	if !mapped {
+		return f(t)
+	}
+
+	return f(genericType.instantiate(mappedTypeArguments))
 }
 
 func (t *CompositeType) GetMembers() map[string]MemberResolver {
@@ -5229,6 +5903,8 @@ func (t *CompositeType) initializeMemberResolvers() {
 
 func (t *CompositeType) initializerMemberResolversFunc() func() {
 	return func() {
+		t.instantiateMembersIfNeeded()
+
 		memberResolvers := MembersMapAsResolvers(t.Members)
 
 		// Check conformances.
@@ -5272,6 +5948,8 @@ func (t *CompositeType) initializerMemberResolversFunc() func() {
 }
 
 func (t *CompositeType) ResolveMembers() {
+	t.instantiateMembersIfNeeded()
+
 	if t.Members.Len() != len(t.GetMembers()) {
 		t.initializerMemberResolversFunc()()
 	}
@@ -5300,6 +5978,8 @@ func (t *CompositeType) SetNestedType(name string, nestedType ContainedType) {
 }
 
 func (t *CompositeType) ConstructorFunctionType() *FunctionType {
+	t.instantiateMembersIfNeeded()
+
 	return &FunctionType{
 		IsConstructor:        true,
 		Purity:               t.ConstructorPurity,
@@ -5309,6 +5989,8 @@ func (t *CompositeType) ConstructorFunctionType() *FunctionType {
 }
 
 func (t *CompositeType) InitializerFunctionType() *FunctionType {
+	t.instantiateMembersIfNeeded()
+
 	return &FunctionType{
 		IsConstructor:        true,
 		Purity:               t.ConstructorPurity,
@@ -5318,6 +6000,8 @@ func (t *CompositeType) InitializerFunctionType() *FunctionType {
 }
 
 func (t *CompositeType) InitializerEffectiveArgumentLabels() []string {
+	t.instantiateMembersIfNeeded()
+
 	parameters := t.ConstructorParameters
 	if len(parameters) == 0 {
 		return nil
@@ -5345,49 +6029,250 @@ func (t *CompositeType) CheckInstantiated(pos ast.HasPosition, memoryGauge commo
 	for _, typ := range t.ExplicitInterfaceConformances {
 		typ.CheckInstantiated(pos, memoryGauge, report)
 	}
-}
 
-// Member
+	if len(t.typeParameters) > 0 {
+		if t.typeArguments == nil {
+			for _, typeParameter := range t.typeParameters {
+				report(
+					&MissingTypeArgumentError{
+						TypeArgumentName: typeParameter.Name,
+						Range: ast.NewRange(
+							memoryGauge,
+							pos.StartPosition(),
+							pos.EndPosition(memoryGauge),
+						),
+					},
+				)
+			}
+		} else {
+			for _, typeArgument := range t.typeArguments {
+				typeArgument.CheckInstantiated(pos, memoryGauge, report)
+			}
+		}
+	}
+}
 
-type Member struct {
-	TypeAnnotation TypeAnnotation
-	// Parent type where this member can be resolved
-	ContainerType  Type
-	DocString      string
-	ArgumentLabels []string
-	Identifier     ast.Identifier
-	Access         Access
-	// TODO: replace with dedicated MemberKind enum
-	DeclarationKind common.DeclarationKind
-	VariableKind    ast.VariableKind
-	// Predeclared fields can be considered initialized
-	Predeclared       bool
-	HasImplementation bool
-	HasConditions     bool
-	// IgnoreInSerialization determines if the field is ignored in serialization
-	IgnoreInSerialization bool
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.typeParameters
 }
 
-func NewUnmeteredPublicFunctionMember(
-	containerType Type,
-	identifier string,
-	functionType *FunctionType,
-	docString string,
-) *Member {
-	return NewPublicFunctionMember(
-		nil,
-		containerType,
-		identifier,
-		functionType,
-		docString,
-	)
+// SetTypeParameters sets the type parameters of a generic composite type.
+// The members of the generic composite type must not have been declared yet,
+// see InstantiateMembers
+func (t *CompositeType) SetTypeParameters(typeParameters []*TypeParameter) {
+	t.typeParameters = typeParameters
 }
 
-func NewPublicFunctionMember(
-	memoryGauge common.MemoryGauge,
-	containerType Type,
-	identifier string,
-	functionType *FunctionType,
+func (t *CompositeType) Instantiate(
+	_ common.MemoryGauge,
+	typeArguments []Type,
+	_ []*ast.TypeAnnotation,
+	_ func(err error),
+) Type {
+	genericType := t
+	if t.genericType != nil {
+		genericType = t.genericType
+	}
+	return genericType.instantiate(typeArguments)
+}
+
+func (t *CompositeType) BaseType() Type {
+	if t.genericType == nil {
+		return nil
+	}
+	return t.genericType
+}
+
+func (t *CompositeType) TypeArguments() []Type {
+	return t.typeArguments
+}
+
+// isIdentityInstantiation returns true if the composite type is an instantiation
+// of its generic type with the type parameters of the generic type,
+// e.g. `S<T>` in the declaration of `struct S<T> {}`.
+// The identity instantiation is equal to the generic type
+func (t *CompositeType) isIdentityInstantiation() bool {
+	if t.genericType == nil {
+		return false
+	}
+
+	for i, typeArgument := range t.typeArguments {
+		genericTypeArgument, ok := typeArgument.(*GenericType)
+		if !ok || genericTypeArgument.TypeParameter != t.typeParameters[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *CompositeType) instantiate(typeArguments []Type) *CompositeType {
+	return &CompositeType{
+		Location:                      t.Location,
+		Kind:                          t.Kind,
+		Identifier:                    t.Identifier,
+		containerType:                 t.containerType,
+		NestedTypes:                   t.NestedTypes,
+		ExplicitInterfaceConformances: t.ExplicitInterfaceConformances,
+		DefaultDestroyEvent:           t.DefaultDestroyEvent,
+		HasComputedMembers:            t.HasComputedMembers,
+		typeParameters:                t.typeParameters,
+		typeArguments:                 typeArguments,
+		genericType:                   t,
+		Members:                       &StringMemberOrderedMap{},
+	}
+}
+
+// InstantiateMembers must be called once the members of the generic composite type are declared.
+// The members of instantiations are instantiated on demand afterwards.
+func (t *CompositeType) InstantiateMembers() {
+	if len(t.typeParameters) == 0 {
+		return
+	}
+
+	t.membersDeclared = true
+}
+
+// instantiateMembersIfNeeded instantiates the members of an instantiation of a generic composite type,
+// if they were not instantiated yet and the members of the generic composite type are declared.
+func (t *CompositeType) instantiateMembersIfNeeded() {
+	genericType := t.genericType
+	if genericType == nil {
+		return
+	}
+
+	t.membersInstantiationLock.Lock()
+	defer t.membersInstantiationLock.Unlock()
+
+	if t.membersInstantiated || !genericType.membersDeclared {
+		return
+	}
+
+	t.membersInstantiated = true
+
+	typeArguments := &TypeParameterTypeOrderedMap{}
+	for i, typeParameter := range genericType.typeParameters {
+		typeArguments.Set(typeParameter, t.typeArguments[i])
+	}
+
+	members := &StringMemberOrderedMap{}
+	genericType.Members.Foreach(func(name string, member *Member) {
+		instantiatedMember := *member
+		instantiatedMember.TypeAnnotation = TypeAnnotation{
+			IsResource: member.TypeAnnotation.IsResource,
+			Type:       SubstituteTypeParameters(nil, member.TypeAnnotation.Type, typeArguments),
+		}
+		members.Set(name, &instantiatedMember)
+	})
+
+	var constructorParameters []Parameter
+	if genericType.ConstructorParameters != nil {
+		constructorParameters = make([]Parameter, 0, len(genericType.ConstructorParameters))
+		for _, parameter := range genericType.ConstructorParameters {
+			parameter.TypeAnnotation = TypeAnnotation{
+				IsResource: parameter.TypeAnnotation.IsResource,
+				Type:       SubstituteTypeParameters(nil, parameter.TypeAnnotation.Type, typeArguments),
+			}
+			constructorParameters = append(constructorParameters, parameter)
+		}
+	}
+
+	t.Members = members
+	t.Fields = genericType.Fields
+	t.ConstructorParameters = constructorParameters
+	t.ConstructorPurity = genericType.ConstructorPurity
+}
+
+// SubstituteTypeParameters returns the given type,
+// with all generic types of the given type parameters replaced by the given type arguments.
+func SubstituteTypeParameters(
+	memoryGauge common.MemoryGauge,
+	ty Type,
+	typeArguments *TypeParameterTypeOrderedMap,
+) Type {
+	if typeArguments == nil || typeArguments.Len() == 0 {
+		return ty
+	}
+
+	return ty.Map(
+		memoryGauge,
+		make(map[*TypeParameter]*TypeParameter),
+		func(ty Type) Type {
+			genericType, ok := ty.(*GenericType)
+			if !ok {
+				return ty
+			}
+
+			typeArgument, ok := typeArguments.Get(genericType.TypeParameter)
+			if !ok {
+				return ty
+			}
+
+			return typeArgument
+		},
+	)
+}
+
+func formatTypeArguments[T any](
+	identifier string,
+	typeArguments []T,
+	format func(T) string,
+	separator string,
+) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteByte('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(format(typeArgument))
+	}
+	builder.WriteByte('>')
+	return builder.String()
+}
+
+// Member
+
+type Member struct {
+	TypeAnnotation TypeAnnotation
+	// Parent type where this member can be resolved
+	ContainerType  Type
+	DocString      string
+	ArgumentLabels []string
+	Identifier     ast.Identifier
+	Access         Access
+	// TODO: replace with dedicated MemberKind enum
+	DeclarationKind common.DeclarationKind
+	VariableKind    ast.VariableKind
+	// Predeclared fields can be considered initialized
+	Predeclared       bool
+	HasImplementation bool
+	HasConditions     bool
+	// IgnoreInSerialization determines if the field is ignored in serialization
+	IgnoreInSerialization bool
+}
+
+func NewUnmeteredPublicFunctionMember(
+	containerType Type,
+	identifier string,
+	functionType *FunctionType,
+	docString string,
+) *Member {
+	return NewPublicFunctionMember(
+		nil,
+		containerType,
+		identifier,
+		functionType,
+		docString,
+	)
+}
+
+func NewPublicFunctionMember(
+	memoryGauge common.MemoryGauge,
+	containerType Type,
+	identifier string,
+	functionType *FunctionType,
 	docString string,
 ) *Member {
 	return NewFunctionMember(
@@ -6191,6 +7076,38 @@ since no intermediate storage is allocated.
 The order of iteration is undefined
 `
 
+const DictionaryTypeForEachFunctionName = "forEach"
+
+const dictionaryTypeForEachFunctionDocString = `
+Iterate over each key-value pair in this dictionary, exiting early if the passed function returns false.
+
+The order of iteration is undefined
+`
+
+const DictionaryTypeFilterFunctionName = "filter"
+
+const dictionaryTypeFilterFunctionDocString = `
+Returns a new dictionary whose entries are filtered by applying the filter function on each key-value pair of the original dictionary.
+Available if the dictionary key type and value type are not resource-kinded.
+`
+
+const DictionaryTypeMapFunctionName = "map"
+
+const dictionaryTypeMapFunctionDocString = `
+Returns a new dictionary with the same keys as the original dictionary,
+whose values are produced by applying the mapper function on each value of the original dictionary.
+Available if the dictionary key type and value type are not resource-kinded.
+`
+
+const DictionaryTypeToEntriesFunctionName = "toEntries"
+
+const dictionaryTypeToEntriesFunctionDocString = `
+Returns a new array containing all key-value pairs of the dictionary.
+
+The order of the entries is undefined.
+Available if the dictionary key type and value type are not resource-kinded.
+`
+
 const dictionaryTypeValuesFieldDocString = `
 An array containing all values of the dictionary
 `
@@ -6382,6 +7299,82 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						)
 					},
 				},
+				DictionaryTypeForEachFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						targetRange ast.HasPosition,
+						report func(error),
+					) *Member {
+						t.reportInvalidResourceMember(memoryGauge, identifier, targetRange, report)
+
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							DictionaryForEachFunctionType(t),
+							dictionaryTypeForEachFunctionDocString,
+						)
+					},
+				},
+				DictionaryTypeFilterFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						targetRange ast.HasPosition,
+						report func(error),
+					) *Member {
+						t.reportInvalidResourceMember(memoryGauge, identifier, targetRange, report)
+
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							DictionaryFilterFunctionType(t),
+							dictionaryTypeFilterFunctionDocString,
+						)
+					},
+				},
+				DictionaryTypeMapFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						targetRange ast.HasPosition,
+						report func(error),
+					) *Member {
+						t.reportInvalidResourceMember(memoryGauge, identifier, targetRange, report)
+
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							DictionaryMapFunctionType(memoryGauge, t),
+							dictionaryTypeMapFunctionDocString,
+						)
+					},
+				},
+				DictionaryTypeToEntriesFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						targetRange ast.HasPosition,
+						report func(error),
+					) *Member {
+						t.reportInvalidResourceMember(memoryGauge, identifier, targetRange, report)
+
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							DictionaryToEntriesFunctionType(memoryGauge, t),
+							dictionaryTypeToEntriesFunctionDocString,
+						)
+					},
+				},
 			},
 		)
 	})
@@ -6469,69 +7462,208 @@ func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
 	)
 }
 
-func (*DictionaryType) isValueIndexableType() bool {
-	return true
-}
-
-func (t *DictionaryType) ElementType(_ bool) Type {
-	return &OptionalType{Type: t.ValueType}
-}
-
-func (*DictionaryType) AllowsValueIndexingAssignment() bool {
-	return true
-}
-
-func (t *DictionaryType) IndexingType() Type {
-	return t.KeyType
-}
-
-type DictionaryEntryType struct {
-	KeyType   Type
-	ValueType Type
-}
-
-func (t *DictionaryType) Unify(
-	other Type,
-	typeParameters *TypeParameterTypeOrderedMap,
-	report func(err error),
+// reportInvalidResourceMember reports an error for the given member
+// if the key type or the value type of the dictionary is resource-kinded.
+// Resource-kinded keys and values cannot be passed to functions, or copied into a new value
+func (t *DictionaryType) reportInvalidResourceMember(
 	memoryGauge common.MemoryGauge,
-	outerRange ast.HasPosition,
-) bool {
-
-	otherDictionary, ok := other.(*DictionaryType)
-	if !ok {
-		return false
+	identifier string,
+	targetRange ast.HasPosition,
+	report func(error),
+) {
+	if !t.KeyType.IsResourceType() && !t.ValueType.IsResourceType() {
+		return
 	}
 
-	keyUnified := t.KeyType.Unify(
-		otherDictionary.KeyType,
-		typeParameters,
-		report,
-		memoryGauge,
-		outerRange,
-	)
-
-	valueUnified := t.ValueType.Unify(
-		otherDictionary.ValueType,
-		typeParameters,
-		report,
-		memoryGauge,
-		outerRange,
+	report(
+		&InvalidResourceDictionaryMemberError{
+			Name:            identifier,
+			DeclarationKind: common.DeclarationKindFunction,
+			Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+		},
 	)
-
-	return keyUnified || valueUnified
 }
 
-func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
-	newKeyType := t.KeyType.Resolve(typeArguments)
-	if newKeyType == nil {
-		return nil
-	}
-
-	newValueType := t.ValueType.Resolve(typeArguments)
-	if newValueType == nil {
-		return nil
-	}
+func DictionaryForEachFunctionType(t *DictionaryType) *FunctionType {
+	const functionPurity = FunctionPurityImpure
+
+	// fun(K, V): Bool
+	funcType := NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+			{
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun forEach(_ function: fun(K, V): Bool): Void
+	return NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		VoidTypeAnnotation,
+	)
+}
+
+func DictionaryFilterFunctionType(t *DictionaryType) *FunctionType {
+	// view fun(K, V): Bool
+	funcType := NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+			{
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun filter(_ f: view fun(K, V): Bool): {K: V}
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "f",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		NewTypeAnnotation(t),
+	)
+}
+
+func DictionaryMapFunctionType(memoryGauge common.MemoryGauge, t *DictionaryType) *FunctionType {
+	// fun map<U>(_ transform: fun(V): U): {K: U}
+
+	typeParameter := &TypeParameter{
+		Name: "U",
+	}
+
+	typeU := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	// transformFuncType: V -> U
+	transformFuncType := &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "transform",
+				TypeAnnotation: NewTypeAnnotation(transformFuncType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			NewDictionaryType(memoryGauge, t.KeyType, typeU),
+		),
+	}
+}
+
+func DictionaryToEntriesFunctionType(memoryGauge common.MemoryGauge, t *DictionaryType) *FunctionType {
+	// fun toEntries(): [DictionaryEntry<K, V>]
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		nil,
+		NewTypeAnnotation(
+			NewVariableSizedType(
+				memoryGauge,
+				NewDictionaryEntryCompositeType(memoryGauge, t),
+			),
+		),
+	)
+}
+
+func (*DictionaryType) isValueIndexableType() bool {
+	return true
+}
+
+func (t *DictionaryType) ElementType(_ bool) Type {
+	return &OptionalType{Type: t.ValueType}
+}
+
+func (*DictionaryType) AllowsValueIndexingAssignment() bool {
+	return true
+}
+
+func (t *DictionaryType) IndexingType() Type {
+	return t.KeyType
+}
+
+type DictionaryEntryType struct {
+	KeyType   Type
+	ValueType Type
+}
+
+func (t *DictionaryType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
+) bool {
+
+	otherDictionary, ok := other.(*DictionaryType)
+	if !ok {
+		return false
+	}
+
+	keyUnified := t.KeyType.Unify(
+		otherDictionary.KeyType,
+		typeParameters,
+		report,
+		memoryGauge,
+		outerRange,
+	)
+
+	valueUnified := t.ValueType.Unify(
+		otherDictionary.ValueType,
+		typeParameters,
+		report,
+		memoryGauge,
+		outerRange,
+	)
+
+	return keyUnified || valueUnified
+}
+
+func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newKeyType := t.KeyType.Resolve(typeArguments)
+	if newKeyType == nil {
+		return nil
+	}
+
+	newValueType := t.ValueType.Resolve(typeArguments)
+	if newValueType == nil {
+		return nil
+	}
 
 	return &DictionaryType{
 		KeyType:   newKeyType,
@@ -6655,132 +7787,612 @@ func (t *InclusiveRangeType) TypeAnnotationState() TypeAnnotationState {
 		return TypeAnnotationStateValid
 	}
 
-	return t.MemberType.TypeAnnotationState()
+	return t.MemberType.TypeAnnotationState()
+}
+
+func (t *InclusiveRangeType) RewriteWithIntersectionTypes() (Type, bool) {
+	if t.MemberType == nil {
+		return t, false
+	}
+	rewrittenMemberType, rewritten := t.MemberType.RewriteWithIntersectionTypes()
+	if rewritten {
+		return &InclusiveRangeType{
+			MemberType: rewrittenMemberType,
+		}, true
+	}
+	return t, false
+}
+
+func (t *InclusiveRangeType) BaseType() Type {
+	if t.MemberType == nil {
+		return nil
+	}
+	return &InclusiveRangeType{}
+}
+
+func (t *InclusiveRangeType) Instantiate(
+	memoryGauge common.MemoryGauge,
+	typeArguments []Type,
+	astTypeArguments []*ast.TypeAnnotation,
+	report func(err error),
+) Type {
+	memberType := typeArguments[0]
+
+	if astTypeArguments == nil || astTypeArguments[0] == nil {
+		panic(errors.NewUnreachableError())
+	}
+	paramAstRange := ast.NewRangeFromPositioned(memoryGauge, astTypeArguments[0])
+
+	// memberType must only be a leaf integer type.
+	for _, ty := range AllNonLeafIntegerTypes {
+		if memberType == ty {
+			report(&InvalidTypeArgumentError{
+				TypeArgumentName: inclusiveRangeTypeParameter.Name,
+				Range:            paramAstRange,
+				Details:          fmt.Sprintf("Creation of InclusiveRange<%s> is disallowed", memberType),
+			})
+		}
+	}
+
+	return &InclusiveRangeType{
+		MemberType: memberType,
+	}
+}
+
+func (t *InclusiveRangeType) TypeArguments() []Type {
+	memberType := t.MemberType
+	return []Type{
+		memberType,
+	}
+}
+
+func (t *InclusiveRangeType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
+	CheckParameterizedTypeInstantiated(t, pos, memoryGauge, report)
+}
+
+var inclusiveRangeTypeParameter = &TypeParameter{
+	Name:      "T",
+	TypeBound: IntegerType,
+}
+
+func (*InclusiveRangeType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		inclusiveRangeTypeParameter,
+	}
+}
+
+const InclusiveRangeTypeStartFieldName = "start"
+const inclusiveRangeTypeStartFieldDocString = `
+The start of the InclusiveRange sequence
+`
+const InclusiveRangeTypeEndFieldName = "end"
+const inclusiveRangeTypeEndFieldDocString = `
+The end of the InclusiveRange sequence
+`
+
+const InclusiveRangeTypeStepFieldName = "step"
+const inclusiveRangeTypeStepFieldDocString = `
+The step size of the InclusiveRange sequence
+`
+
+var InclusiveRangeTypeFieldNames = []string{
+	InclusiveRangeTypeStartFieldName,
+	InclusiveRangeTypeEndFieldName,
+	InclusiveRangeTypeStepFieldName,
+}
+
+const InclusiveRangeTypeContainsFunctionName = "contains"
+
+const inclusiveRangeTypeContainsFunctionDocString = `
+Returns true if the given integer is in the InclusiveRange sequence
+`
+
+func (t *InclusiveRangeType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func InclusiveRangeContainsFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+func (t *InclusiveRangeType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+		t.memberResolvers = withBuiltinMembers(
+			t,
+			map[string]MemberResolver{
+				InclusiveRangeTypeStartFieldName: {
+					Kind: common.DeclarationKindField,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicConstantFieldMember(
+							memoryGauge,
+							t,
+							identifier,
+							t.MemberType,
+							inclusiveRangeTypeStartFieldDocString,
+						)
+					},
+				},
+				InclusiveRangeTypeEndFieldName: {
+					Kind: common.DeclarationKindField,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicConstantFieldMember(
+							memoryGauge,
+							t,
+							identifier,
+							t.MemberType,
+							inclusiveRangeTypeEndFieldDocString,
+						)
+					},
+				},
+				InclusiveRangeTypeStepFieldName: {
+					Kind: common.DeclarationKindField,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicConstantFieldMember(
+							memoryGauge,
+							t,
+							identifier,
+							t.MemberType,
+							inclusiveRangeTypeStepFieldDocString,
+						)
+					},
+				},
+				InclusiveRangeTypeContainsFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						targetRange ast.HasPosition,
+						report func(error),
+					) *Member {
+						elementType := t.MemberType
+
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							InclusiveRangeContainsFunctionType(elementType),
+							inclusiveRangeTypeContainsFunctionDocString,
+						)
+					},
+				},
+			},
+		)
+	})
+}
+
+func (*InclusiveRangeType) AllowsValueIndexingAssignment() bool {
+	return false
+}
+
+func (t *InclusiveRangeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
+) bool {
+	otherRange, ok := other.(*InclusiveRangeType)
+	if !ok {
+		return false
+	}
+
+	return t.MemberType.Unify(
+		otherRange.MemberType,
+		typeParameters,
+		report,
+		memoryGauge,
+		outerRange,
+	)
+}
+
+func (t *InclusiveRangeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	memberType := t.MemberType.Resolve(typeArguments)
+	if memberType == nil {
+		return nil
+	}
+
+	return &InclusiveRangeType{
+		MemberType: memberType,
+	}
+}
+
+func (t *InclusiveRangeType) IsPrimitiveType() bool {
+	return false
+}
+
+func (t *InclusiveRangeType) ContainFieldsOrElements() bool {
+	return false
+}
+
+func (t *InclusiveRangeType) Map(
+	gauge common.MemoryGauge,
+	typeParamMap map[*TypeParameter]*TypeParameter,
+	f func(Type) Type,
+) Type {
+	mappedMemberType := t.MemberType.Map(gauge, typeParamMap, f)
+	return f(NewInclusiveRangeType(gauge, mappedMemberType))
+}
+
+// SetType
+
+const SetTypeName = "Set"
+
+type SetType struct {
+	ElementType         Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+var _ Type = &SetType{}
+var _ ParameterizedType = &SetType{}
+var _ EntitlementSupportingType = &SetType{}
+
+func NewSetType(memoryGauge common.MemoryGauge, elementType Type) *SetType {
+	common.UseMemory(memoryGauge, common.SetSemaTypeMemoryUsage)
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetType) IsType() {}
+
+func (*SetType) Tag() TypeTag {
+	return SetTypeTag
+}
+
+func (t *SetType) String() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.String())
+	}
+	return fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementString,
+	)
+}
+
+func (t *SetType) QualifiedString() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.QualifiedString())
+	}
+	return fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementString,
+	)
+}
+
+// SetTypeID returns the type ID of a set type with the given element type ID.
+// The type ID must never change, as it is part of stored and exported data.
+func SetTypeID(elementTypeID string) TypeID {
+	if elementTypeID != "" {
+		elementTypeID = fmt.Sprintf("<%s>", elementTypeID)
+	}
+	return TypeID(fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementTypeID,
+	))
+}
+
+func (t *SetType) ID() TypeID {
+	var elementTypeID string
+	if t.ElementType != nil {
+		elementTypeID = string(t.ElementType.ID())
+	}
+	return SetTypeID(elementTypeID)
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+	if otherSet.ElementType == nil {
+		return t.ElementType == nil
+	}
+
+	return otherSet.ElementType.Equal(t.ElementType)
+}
+
+func (*SetType) IsResourceType() bool {
+	return false
+}
+
+func (t *SetType) IsInvalidType() bool {
+	return t.ElementType != nil && t.ElementType.IsInvalidType()
+}
+
+func (t *SetType) IsOrContainsReferenceType() bool {
+	return t.ElementType != nil && t.ElementType.IsOrContainsReferenceType()
+}
+
+func (t *SetType) IsStorable(results map[*Member]bool) bool {
+	return t.ElementType != nil &&
+		t.ElementType.IsStorable(results)
+}
+
+func (t *SetType) IsExportable(results map[*Member]bool) bool {
+	return t.ElementType != nil &&
+		t.ElementType.IsExportable(results)
+}
+
+func (t *SetType) IsImportable(results map[*Member]bool) bool {
+	return t.ElementType != nil &&
+		t.ElementType.IsImportable(results)
+}
+
+func (t *SetType) IsEquatable() bool {
+	return t.ElementType != nil &&
+		t.ElementType.IsEquatable()
+}
+
+func (*SetType) IsComparable() bool {
+	return false
+}
+
+func (t *SetType) TypeAnnotationState() TypeAnnotationState {
+	if t.ElementType == nil {
+		return TypeAnnotationStateValid
+	}
+
+	return t.ElementType.TypeAnnotationState()
 }
 
-func (t *InclusiveRangeType) RewriteWithIntersectionTypes() (Type, bool) {
-	if t.MemberType == nil {
+func (t *SetType) RewriteWithIntersectionTypes() (Type, bool) {
+	if t.ElementType == nil {
 		return t, false
 	}
-	rewrittenMemberType, rewritten := t.MemberType.RewriteWithIntersectionTypes()
+	rewrittenElementType, rewritten := t.ElementType.RewriteWithIntersectionTypes()
 	if rewritten {
-		return &InclusiveRangeType{
-			MemberType: rewrittenMemberType,
+		return &SetType{
+			ElementType: rewrittenElementType,
 		}, true
 	}
 	return t, false
 }
 
-func (t *InclusiveRangeType) BaseType() Type {
-	if t.MemberType == nil {
+func (t *SetType) BaseType() Type {
+	if t.ElementType == nil {
 		return nil
 	}
-	return &InclusiveRangeType{}
+	return &SetType{}
 }
 
-func (t *InclusiveRangeType) Instantiate(
-	memoryGauge common.MemoryGauge,
+func (t *SetType) Instantiate(
+	_ common.MemoryGauge,
 	typeArguments []Type,
-	astTypeArguments []*ast.TypeAnnotation,
-	report func(err error),
+	_ []*ast.TypeAnnotation,
+	_ func(err error),
 ) Type {
-	memberType := typeArguments[0]
-
-	if astTypeArguments == nil || astTypeArguments[0] == nil {
-		panic(errors.NewUnreachableError())
-	}
-	paramAstRange := ast.NewRangeFromPositioned(memoryGauge, astTypeArguments[0])
-
-	// memberType must only be a leaf integer type.
-	for _, ty := range AllNonLeafIntegerTypes {
-		if memberType == ty {
-			report(&InvalidTypeArgumentError{
-				TypeArgumentName: inclusiveRangeTypeParameter.Name,
-				Range:            paramAstRange,
-				Details:          fmt.Sprintf("Creation of InclusiveRange<%s> is disallowed", memberType),
-			})
-		}
-	}
-
-	return &InclusiveRangeType{
-		MemberType: memberType,
+	return &SetType{
+		ElementType: typeArguments[0],
 	}
 }
 
-func (t *InclusiveRangeType) TypeArguments() []Type {
-	memberType := t.MemberType
+func (t *SetType) TypeArguments() []Type {
 	return []Type{
-		memberType,
+		t.ElementType,
 	}
 }
 
-func (t *InclusiveRangeType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
+func (t *SetType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
 	CheckParameterizedTypeInstantiated(t, pos, memoryGauge, report)
 }
 
-var inclusiveRangeTypeParameter = &TypeParameter{
+var setTypeParameter = &TypeParameter{
 	Name:      "T",
-	TypeBound: IntegerType,
+	TypeBound: HashableStructType,
 }
 
-func (*InclusiveRangeType) TypeParameters() []*TypeParameter {
+func (*SetType) TypeParameters() []*TypeParameter {
 	return []*TypeParameter{
-		inclusiveRangeTypeParameter,
+		setTypeParameter,
 	}
 }
 
-const InclusiveRangeTypeStartFieldName = "start"
-const inclusiveRangeTypeStartFieldDocString = `
-The start of the InclusiveRange sequence
+const SetTypeLengthFieldName = "length"
+
+const setTypeLengthFieldDocString = `
+The number of elements in the set
 `
-const InclusiveRangeTypeEndFieldName = "end"
-const inclusiveRangeTypeEndFieldDocString = `
-The end of the InclusiveRange sequence
+
+const SetTypeContainsFunctionName = "contains"
+
+const setTypeContainsFunctionDocString = `
+Returns true if the given element is in the set
 `
 
-const InclusiveRangeTypeStepFieldName = "step"
-const inclusiveRangeTypeStepFieldDocString = `
-The step size of the InclusiveRange sequence
+const SetTypeInsertFunctionName = "insert"
+
+const setTypeInsertFunctionDocString = `
+Inserts the given element into the set.
+
+Returns true if the element was inserted, or false if the set already contained the element
 `
 
-var InclusiveRangeTypeFieldNames = []string{
-	InclusiveRangeTypeStartFieldName,
-	InclusiveRangeTypeEndFieldName,
-	InclusiveRangeTypeStepFieldName,
-}
+const SetTypeRemoveFunctionName = "remove"
 
-const InclusiveRangeTypeContainsFunctionName = "contains"
+const setTypeRemoveFunctionDocString = `
+Removes the given element from the set.
 
-const inclusiveRangeTypeContainsFunctionDocString = `
-Returns true if the given integer is in the InclusiveRange sequence
+Returns true if the element was removed, or false if the set did not contain the element
 `
 
-func (t *InclusiveRangeType) GetMembers() map[string]MemberResolver {
+const SetTypeUnionFunctionName = "union"
+
+const setTypeUnionFunctionDocString = `
+Returns a new set containing the elements of this set and the given set
+`
+
+const SetTypeIntersectionFunctionName = "intersection"
+
+const setTypeIntersectionFunctionDocString = `
+Returns a new set containing the elements which are in both this set and the given set
+`
+
+const SetTypeDifferenceFunctionName = "difference"
+
+const setTypeDifferenceFunctionDocString = `
+Returns a new set containing the elements of this set which are not in the given set
+`
+
+const SetTypeForEachFunctionName = "forEach"
+
+const setTypeForEachFunctionDocString = `
+Iterates over each element in this set, exiting early if the passed function returns false.
+
+The iteration order is unspecified.
+`
+
+func (t *SetType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
 }
 
-func InclusiveRangeContainsFunctionType(elementType Type) *FunctionType {
-	return &FunctionType{
-		Parameters: []Parameter{
+func SetContainsFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
 				Identifier:     "element",
-				TypeAnnotation: NewTypeAnnotation(elementType),
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
 			},
 		},
-		ReturnTypeAnnotation: NewTypeAnnotation(
-			BoolType,
-		),
-	}
+		BoolTypeAnnotation,
+	)
 }
 
-func (t *InclusiveRangeType) initializeMemberResolvers() {
+func SetInsertFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityImpure,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+func SetRemoveFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityImpure,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+// SetOperationFunctionType returns the type of the set operations
+// `union`, `intersection` and `difference`
+func SetOperationFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
+			},
+		},
+		NewTypeAnnotation(t),
+	)
+}
+
+func SetForEachFunctionType(t *SetType) *FunctionType {
+	const functionPurity = FunctionPurityImpure
+
+	// fun(T): Bool
+	funcType := NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun forEach(_ function: fun(T): Bool): Void
+	return NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		VoidTypeAnnotation,
+	)
+}
+
+func (t *SetType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
+
+		setOperationMemberResolver := func(docString string) MemberResolver {
+			return MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(
+					memoryGauge common.MemoryGauge,
+					identifier string,
+					_ ast.HasPosition,
+					_ func(error),
+				) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						SetOperationFunctionType(t),
+						docString,
+					)
+				},
+			}
+		}
+
 		t.memberResolvers = withBuiltinMembers(
 			t,
 			map[string]MemberResolver{
-				InclusiveRangeTypeStartFieldName: {
+				SetTypeLengthFieldName: {
 					Kind: common.DeclarationKindField,
 					Resolve: func(
 						memoryGauge common.MemoryGauge,
@@ -6792,61 +8404,81 @@ func (t *InclusiveRangeType) initializeMemberResolvers() {
 							memoryGauge,
 							t,
 							identifier,
-							t.MemberType,
-							inclusiveRangeTypeStartFieldDocString,
+							IntType,
+							setTypeLengthFieldDocString,
 						)
 					},
 				},
-				InclusiveRangeTypeEndFieldName: {
-					Kind: common.DeclarationKindField,
+				SetTypeContainsFunctionName: {
+					Kind: common.DeclarationKindFunction,
 					Resolve: func(
 						memoryGauge common.MemoryGauge,
 						identifier string,
 						_ ast.HasPosition,
 						_ func(error),
 					) *Member {
-						return NewPublicConstantFieldMember(
+						return NewPublicFunctionMember(
 							memoryGauge,
 							t,
 							identifier,
-							t.MemberType,
-							inclusiveRangeTypeEndFieldDocString,
+							SetContainsFunctionType(t),
+							setTypeContainsFunctionDocString,
 						)
 					},
 				},
-				InclusiveRangeTypeStepFieldName: {
-					Kind: common.DeclarationKindField,
+				SetTypeInsertFunctionName: {
+					Kind: common.DeclarationKindFunction,
 					Resolve: func(
 						memoryGauge common.MemoryGauge,
 						identifier string,
 						_ ast.HasPosition,
 						_ func(error),
 					) *Member {
-						return NewPublicConstantFieldMember(
+						return NewFunctionMember(
 							memoryGauge,
 							t,
+							insertMutateEntitledAccess,
 							identifier,
-							t.MemberType,
-							inclusiveRangeTypeStepFieldDocString,
+							SetInsertFunctionType(t),
+							setTypeInsertFunctionDocString,
 						)
 					},
 				},
-				InclusiveRangeTypeContainsFunctionName: {
+				SetTypeRemoveFunctionName: {
 					Kind: common.DeclarationKindFunction,
 					Resolve: func(
 						memoryGauge common.MemoryGauge,
 						identifier string,
-						targetRange ast.HasPosition,
-						report func(error),
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewFunctionMember(
+							memoryGauge,
+							t,
+							removeMutateEntitledAccess,
+							identifier,
+							SetRemoveFunctionType(t),
+							setTypeRemoveFunctionDocString,
+						)
+					},
+				},
+				SetTypeUnionFunctionName:        setOperationMemberResolver(setTypeUnionFunctionDocString),
+				SetTypeIntersectionFunctionName: setOperationMemberResolver(setTypeIntersectionFunctionDocString),
+				SetTypeDifferenceFunctionName:   setOperationMemberResolver(setTypeDifferenceFunctionDocString),
+				SetTypeForEachFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
 					) *Member {
-						elementType := t.MemberType
-
 						return NewPublicFunctionMember(
 							memoryGauge,
 							t,
 							identifier,
-							InclusiveRangeContainsFunctionType(elementType),
-							inclusiveRangeTypeContainsFunctionDocString,
+							SetForEachFunctionType(t),
+							setTypeForEachFunctionDocString,
 						)
 					},
 				},
@@ -6855,24 +8487,20 @@ func (t *InclusiveRangeType) initializeMemberResolvers() {
 	})
 }
 
-func (*InclusiveRangeType) AllowsValueIndexingAssignment() bool {
-	return false
-}
-
-func (t *InclusiveRangeType) Unify(
+func (t *SetType) Unify(
 	other Type,
 	typeParameters *TypeParameterTypeOrderedMap,
 	report func(err error),
 	memoryGauge common.MemoryGauge,
 	outerRange ast.HasPosition,
 ) bool {
-	otherRange, ok := other.(*InclusiveRangeType)
-	if !ok {
+	otherSet, ok := other.(*SetType)
+	if !ok || t.ElementType == nil || otherSet.ElementType == nil {
 		return false
 	}
 
-	return t.MemberType.Unify(
-		otherRange.MemberType,
+	return t.ElementType.Unify(
+		otherSet.ElementType,
 		typeParameters,
 		report,
 		memoryGauge,
@@ -6880,32 +8508,44 @@ func (t *InclusiveRangeType) Unify(
 	)
 }
 
-func (t *InclusiveRangeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
-	memberType := t.MemberType.Resolve(typeArguments)
-	if memberType == nil {
+func (t *SetType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	if t.ElementType == nil {
 		return nil
 	}
 
-	return &InclusiveRangeType{
-		MemberType: memberType,
+	elementType := t.ElementType.Resolve(typeArguments)
+	if elementType == nil {
+		return nil
+	}
+
+	return &SetType{
+		ElementType: elementType,
 	}
 }
 
-func (t *InclusiveRangeType) IsPrimitiveType() bool {
+func (*SetType) IsPrimitiveType() bool {
 	return false
 }
 
-func (t *InclusiveRangeType) ContainFieldsOrElements() bool {
-	return false
+func (*SetType) ContainFieldsOrElements() bool {
+	return true
 }
 
-func (t *InclusiveRangeType) Map(
+func (t *SetType) Map(
 	gauge common.MemoryGauge,
 	typeParamMap map[*TypeParameter]*TypeParameter,
 	f func(Type) Type,
 ) Type {
-	mappedMemberType := t.MemberType.Map(gauge, typeParamMap, f)
-	return f(NewInclusiveRangeType(gauge, mappedMemberType))
+	if t.ElementType == nil {
+		return f(t)
+	}
+
+	mappedElementType := t.ElementType.Map(gauge, typeParamMap, f)
+	return f(NewSetType(gauge, mappedElementType))
+}
+
+func (*SetType) SupportedEntitlements() *EntitlementOrderedSet {
+	return arrayDictionaryEntitlements
 }
 
 // ReferenceType represents the reference to a value
@@ -7354,6 +8994,9 @@ func IsPrimitiveOrNonResourceContainer(referencedType Type) bool {
 	case *DictionaryType:
 		return !ty.IsResourceType()
 
+	case *SetType:
+		return true
+
 	default:
 		return ty.IsPrimitiveType()
 	}
@@ -7437,6 +9080,16 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A generic type `T` is a subtype of a type `V`:
+	// if the type bound of `T` is a subtype of `V`
+
+	if genericSubType, ok := subType.(*GenericType); ok {
+		typeBound := genericSubType.TypeParameter.TypeBound
+		if typeBound != nil && IsSubType(typeBound, superType) {
+			return true
+		}
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -7525,7 +9178,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -7535,7 +9188,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
@@ -8835,12 +10488,18 @@ var PublicKeyVerifyPoPFunctionType = NewSimpleFunctionType(
 	BoolTypeAnnotation,
 )
 
-type CryptoAlgorithm interface {
+// NativeEnumCase is a case of an enum type which is declared natively,
+// e.g. a signature algorithm
+type NativeEnumCase interface {
 	RawValue() uint8
 	Name() string
 	DocString() string
 }
 
+type CryptoAlgorithm interface {
+	NativeEnumCase
+}
+
 func MembersAsMap(members []*Member) *StringMemberOrderedMap {
 	membersMap := &StringMemberOrderedMap{}
 	for _, member := range members {
@@ -9279,8 +10938,10 @@ func init() {
 		PublicKeyType,
 		HashAlgorithmType,
 		SignatureAlgorithmType,
+		RoundingModeType,
 		AccountType,
 		DeploymentResultType,
+		DictionaryEntryCompositeType,
 	}
 
 	for len(compositeTypes) > 0 {
@@ -50,6 +50,8 @@ const (
 	Word128TypeName = "Word128"
 	Word256TypeName = "Word256"
 
-	Fix64TypeName  = "Fix64"
-	UFix64TypeName = "UFix64"
+	Fix64TypeName   = "Fix64"
+	Fix128TypeName  = "Fix128"
+	UFix64TypeName  = "UFix64"
+	UFix128TypeName = "UFix128"
 )
@@ -171,14 +171,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -227,6 +227,7 @@ const (
 	functionTypeMask
 	hashableStructMask
 	inclusiveRangeTypeMask
+	setTypeMask
 
 	invalidTypeMask
 )
@@ -267,10 +268,12 @@ var (
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -308,8 +311,10 @@ var (
 	Word128TypeTag = newTypeTagFromLowerMask(word128TypeMask)
 	Word256TypeTag = newTypeTagFromLowerMask(word256TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -346,6 +351,7 @@ var (
 	IntersectionTypeTag                = newTypeTagFromUpperMask(intersectionTypeMask)
 	CapabilityTypeTag                  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InclusiveRangeTypeTag              = newTypeTagFromUpperMask(inclusiveRangeTypeMask)
+	SetTypeTag                         = newTypeTagFromUpperMask(setTypeMask)
 	InvalidTypeTag                     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag                 = newTypeTagFromUpperMask(transactionTypeMask)
 	AnyResourceAttachmentTypeTag       = newTypeTagFromUpperMask(anyResourceAttachmentMask)
@@ -388,7 +394,8 @@ var (
 				Or(StorageCapabilityControllerTypeTag).
 				Or(AccountCapabilityControllerTypeTag).
 				Or(HashableStructTypeTag).
-				Or(InclusiveRangeTypeTag)
+				Or(InclusiveRangeTypeTag).
+				Or(SetTypeTag)
 
 	AnyResourceTypeTag = newTypeTagFromLowerMask(anyResourceTypeMask).
 				Or(AnyResourceAttachmentTypeTag)
@@ -597,8 +604,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -696,6 +707,9 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 		inclusiveRangeTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 
+	case setTypeMask:
+		return commonSuperTypeOfSets(types)
+
 	case hashableStructMask:
 		return HashableStructType
 
@@ -866,6 +880,47 @@ func commonSuperTypeOfDictionaries(types []Type) Type {
 	}
 }
 
+func commonSuperTypeOfSets(types []Type) Type {
+	// We reach here if all types are set types.
+	// Therefore, decide the common supertype based on the element types.
+
+	var elementTypes []Type
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		setType, ok := typ.(*SetType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected set type, found %s", typ))
+		}
+
+		// Unparameterized set types have no element type
+		if setType.ElementType == nil {
+			return getSuperTypeOfDerivedTypes(types)
+		}
+
+		elementTypes = append(elementTypes, setType.ElementType)
+	}
+
+	elementSuperType := leastCommonSuperType(elementTypes...)
+
+	if elementSuperType == InvalidType {
+		return InvalidType
+	}
+
+	if !IsSubType(elementSuperType, HashableStructType) {
+		return commonSuperTypeOfHeterogeneousTypes(types)
+	}
+
+	return &SetType{
+		ElementType: elementSuperType,
+	}
+}
+
 func commonSuperTypeOfHeterogeneousTypes(types []Type) Type {
 	var hasStructs, hasResources, allHashableStructs bool
 	allHashableStructs = true
@@ -20,6 +20,7 @@ package sema
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -2979,3 +2980,66 @@ func TestType_IsOrContainsReference(t *testing.T) {
 		test(testCase)
 	}
 }
+
+func TestCompositeType_Instantiate(t *testing.T) {
+
+	t.Parallel()
+
+	newGenericType := func() *CompositeType {
+		typeParameter := &TypeParameter{
+			Name: "T",
+		}
+
+		genericType := &CompositeType{
+			Location:   common.StringLocation("test"),
+			Identifier: "Box",
+			Kind:       common.CompositeKindStructure,
+			Members:    &StringMemberOrderedMap{},
+			Fields:     []string{"value"},
+			typeParameters: []*TypeParameter{
+				typeParameter,
+			},
+		}
+
+		genericType.Members.Set(
+			"value",
+			NewUnmeteredPublicConstantFieldMember(
+				genericType,
+				"value",
+				&GenericType{
+					TypeParameter: typeParameter,
+				},
+				"",
+			),
+		)
+
+		genericType.InstantiateMembers()
+
+		return genericType
+	}
+
+	t.Run("concurrent member instantiation", func(t *testing.T) {
+
+		t.Parallel()
+
+		genericType := newGenericType()
+		instantiation := genericType.instantiate([]Type{IntType})
+
+		const goroutines = 8
+
+		var wg sync.WaitGroup
+		wg.Add(goroutines)
+
+		for i := 0; i < goroutines; i++ {
+			go func() {
+				defer wg.Done()
+
+				member, ok := instantiation.MemberMap().Get("value")
+				assert.True(t, ok)
+				assert.Equal(t, IntType, member.TypeAnnotation.Type)
+			}()
+		}
+
+		wg.Wait()
+	})
+}
@@ -45,4 +45,6 @@ type Variable struct {
 	ActivationDepth int
 	// IsConstant indicates if the variable is read-only
 	IsConstant bool
+	// IsShadowable indicates if the built-in variable may be redeclared by programs
+	IsShadowable bool
 }
@@ -135,6 +135,7 @@ func (a *VariableActivation) DeclareValue(declaration ValueDeclaration) {
 		Pos:             declaration.ValueDeclarationPosition(),
 		DocString:       declaration.ValueDeclarationDocString(),
 		ActivationDepth: 0,
+		IsShadowable:    declaration.ValueDeclarationIsShadowable(),
 	})
 }
 
@@ -279,10 +280,11 @@ func (a *VariableActivations) declare(declaration variableDeclaration) (*Variabl
 	// Check if a variable with this name is already declared.
 	// Report an error if shadowing variables of outer scopes is not allowed,
 	// or the existing variable is declared in the current scope,
-	// or the existing variable is a built-in.
+	// or the existing variable is a built-in which may not be shadowed.
 
 	existingVariable := a.Find(declaration.identifier)
 	if existingVariable != nil &&
+		!existingVariable.IsShadowable &&
 		(!declaration.allowOuterScopeShadowing ||
 			existingVariable.ActivationDepth == depth ||
 			existingVariable.ActivationDepth == 0) {
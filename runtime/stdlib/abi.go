@@ -0,0 +1,579 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+//go:generate go run ../sema/gen -p stdlib abi.cdc abi.gen.go
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib/abi"
+)
+
+type ABIEncodingError struct {
+	interpreter.LocationRange
+	Msg string
+}
+
+var _ errors.UserError = ABIEncodingError{}
+
+func (ABIEncodingError) IsUserError() {}
+
+func (e ABIEncodingError) Error() string {
+	return fmt.Sprintf("failed to ABI-encode values: %s", e.Msg)
+}
+
+type ABIDecodingError struct {
+	interpreter.LocationRange
+	Msg string
+}
+
+var _ errors.UserError = ABIDecodingError{}
+
+func (ABIDecodingError) IsUserError() {}
+
+func (e ABIDecodingError) Error() string {
+	return fmt.Sprintf("failed to ABI-decode data: %s", e.Msg)
+}
+
+// abiIntegerTypes maps the Cadence integer types to their ABI types.
+// The arbitrary-precision types Int and UInt are mapped to the widest ABI types
+var abiIntegerTypes = map[sema.Type]*abi.Type{
+	sema.UIntType:    abi.NewUintType(256),
+	sema.UInt8Type:   abi.NewUintType(8),
+	sema.UInt16Type:  abi.NewUintType(16),
+	sema.UInt32Type:  abi.NewUintType(32),
+	sema.UInt64Type:  abi.NewUintType(64),
+	sema.UInt128Type: abi.NewUintType(128),
+	sema.UInt256Type: abi.NewUintType(256),
+	sema.Word8Type:   abi.NewUintType(8),
+	sema.Word16Type:  abi.NewUintType(16),
+	sema.Word32Type:  abi.NewUintType(32),
+	sema.Word64Type:  abi.NewUintType(64),
+	sema.Word128Type: abi.NewUintType(128),
+	sema.Word256Type: abi.NewUintType(256),
+	sema.IntType:     abi.NewIntType(256),
+	sema.Int8Type:    abi.NewIntType(8),
+	sema.Int16Type:   abi.NewIntType(16),
+	sema.Int32Type:   abi.NewIntType(32),
+	sema.Int64Type:   abi.NewIntType(64),
+	sema.Int128Type:  abi.NewIntType(128),
+	sema.Int256Type:  abi.NewIntType(256),
+}
+
+// abiTypeForType returns the ABI type for the given Cadence type.
+//
+// User-defined structs are mapped to tuples of their fields, in declaration order.
+// The structs which are currently being mapped are tracked, as recursive types cannot be represented.
+//
+// If structs is nil, struct types are rejected. This is the case when decoding:
+// a decoded struct would be created without running its initializer,
+// which would bypass the checks and preconditions of the initializer.
+func abiTypeForType(
+	semaType sema.Type,
+	structs map[*sema.CompositeType]struct{},
+) (
+	*abi.Type,
+	error,
+) {
+	if integerType, ok := abiIntegerTypes[semaType]; ok {
+		return integerType, nil
+	}
+
+	switch semaType := semaType.(type) {
+	case *sema.SimpleType:
+		switch semaType {
+		case sema.BoolType:
+			return abi.BoolType, nil
+		case sema.StringType:
+			return abi.StringType, nil
+		}
+
+	case *sema.VariableSizedType:
+		if semaType.Type == sema.UInt8Type {
+			return abi.BytesType, nil
+		}
+		elementType, err := abiArrayElementType(semaType.Type, structs)
+		if err != nil {
+			return nil, err
+		}
+		return abi.NewArrayType(elementType), nil
+
+	case *sema.ConstantSizedType:
+		elementType, err := abiArrayElementType(semaType.Type, structs)
+		if err != nil {
+			return nil, err
+		}
+		return abi.NewFixedArrayType(elementType, int(semaType.Size)), nil
+
+	case *sema.CompositeType:
+		if semaType.Kind != common.CompositeKindStructure ||
+			semaType.Location == nil {
+
+			break
+		}
+
+		if structs == nil {
+			return nil, fmt.Errorf("struct type `%s` cannot be decoded", semaType.QualifiedString())
+		}
+
+		if _, ok := structs[semaType]; ok {
+			return nil, fmt.Errorf("recursive type `%s` is not supported", semaType.QualifiedString())
+		}
+		structs[semaType] = struct{}{}
+		defer delete(structs, semaType)
+
+		components := make([]*abi.Type, len(semaType.Fields))
+		for i, fieldName := range semaType.Fields {
+			component, err := abiTypeForType(structFieldType(semaType, fieldName), structs)
+			if err != nil {
+				return nil, err
+			}
+			components[i] = component
+		}
+		return abi.NewTupleType(components...), nil
+	}
+
+	return nil, fmt.Errorf("type `%s` is not supported", semaType.QualifiedString())
+}
+
+// abiArrayElementType returns the ABI type for the given Cadence array element type.
+//
+// Element types with an empty encoding, e.g. empty structs or `[T; 0]`, are rejected:
+// the number of elements of an array of such a type is not bounded by the size of the encoding.
+func abiArrayElementType(
+	semaType sema.Type,
+	structs map[*sema.CompositeType]struct{},
+) (
+	*abi.Type,
+	error,
+) {
+	elementType, err := abiTypeForType(semaType, structs)
+	if err != nil {
+		return nil, err
+	}
+	if elementType.IsZeroSize() {
+		return nil, fmt.Errorf("array element type `%s` has an empty encoding", semaType.QualifiedString())
+	}
+	return elementType, nil
+}
+
+func structFieldType(compositeType *sema.CompositeType, fieldName string) sema.Type {
+	member, ok := compositeType.MemberMap().Get(fieldName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return member.TypeAnnotation.Type
+}
+
+// abiValue converts the given value of the given type to an ABI value.
+// The type must have been successfully mapped to an ABI type using abiTypeForType
+func abiValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+	semaType sema.Type,
+) any {
+	if _, ok := abiIntegerTypes[semaType]; ok {
+		return interpreter.ConvertInt(inter, value, locationRange).BigInt
+	}
+
+	switch semaType := semaType.(type) {
+	case *sema.SimpleType:
+		switch value := value.(type) {
+		case interpreter.BoolValue:
+			return bool(value)
+		case *interpreter.StringValue:
+			return value.Str
+		}
+
+	case sema.ArrayType:
+		array, ok := value.(*interpreter.ArrayValue)
+		if !ok {
+			break
+		}
+
+		elementType := semaType.ElementType(false)
+
+		if _, ok := semaType.(*sema.VariableSizedType); ok && elementType == sema.UInt8Type {
+			bytes, err := interpreter.ByteArrayValueToByteSlice(inter, array, locationRange)
+			if err != nil {
+				panic(ABIEncodingError{
+					Msg:           err.Error(),
+					LocationRange: locationRange,
+				})
+			}
+			return bytes
+		}
+
+		elements := make([]any, 0, array.Count())
+		array.Iterate(inter, func(element interpreter.Value) (resume bool) {
+			elements = append(elements, abiValue(inter, locationRange, element, elementType))
+
+			// Continue iteration
+			return true
+		})
+		return elements
+
+	case *sema.CompositeType:
+		composite, ok := value.(*interpreter.CompositeValue)
+		if !ok {
+			break
+		}
+
+		components := make([]any, len(semaType.Fields))
+		for i, fieldName := range semaType.Fields {
+			field := composite.GetField(inter, locationRange, fieldName)
+			components[i] = abiValue(inter, locationRange, field, structFieldType(semaType, fieldName))
+		}
+		return components
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+// valueFromABI converts the given ABI value to a value of the given type.
+// The type must have been successfully mapped to an ABI type using abiTypeForType, without structs
+func valueFromABI(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value any,
+	semaType sema.Type,
+) interpreter.Value {
+	if _, ok := abiIntegerTypes[semaType]; ok {
+		integer, ok := value.(*big.Int)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+		intValue := interpreter.NewIntValueFromBigInt(
+			inter,
+			common.NewBigIntMemoryUsage(common.BigIntByteLength(integer)),
+			func() *big.Int {
+				return integer
+			},
+		)
+		return inter.ConvertAndBox(locationRange, intValue, sema.IntType, semaType)
+	}
+
+	switch value := value.(type) {
+	case bool:
+		return interpreter.AsBoolValue(value)
+
+	case string:
+		return interpreter.NewStringValue(
+			inter,
+			common.NewStringMemoryUsage(len(value)),
+			func() string {
+				return value
+			},
+		)
+
+	case []byte:
+		return interpreter.ByteSliceToByteArrayValue(inter, value)
+
+	case []any:
+		arrayType, ok := semaType.(sema.ArrayType)
+		if !ok {
+			break
+		}
+
+		elementType := arrayType.ElementType(false)
+		elements := make([]interpreter.Value, len(value))
+		for i, element := range value {
+			elements[i] = valueFromABI(inter, locationRange, element, elementType)
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			locationRange,
+			interpreter.ConvertSemaArrayTypeToStaticArrayType(inter, arrayType),
+			common.ZeroAddress,
+			elements...,
+		)
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func abiEncode(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	valuesArray *interpreter.ArrayValue,
+	packed bool,
+) []byte {
+	count := valuesArray.Count()
+	types := make([]*abi.Type, 0, count)
+	values := make([]any, 0, count)
+
+	valuesArray.Iterate(inter, func(value interpreter.Value) (resume bool) {
+		semaType := inter.MustConvertStaticToSemaType(value.StaticType(inter))
+
+		abiType, err := abiTypeForType(semaType, map[*sema.CompositeType]struct{}{})
+		if err != nil {
+			panic(ABIEncodingError{
+				Msg:           err.Error(),
+				LocationRange: locationRange,
+			})
+		}
+
+		types = append(types, abiType)
+		values = append(values, abiValue(inter, locationRange, value, semaType))
+
+		// Continue iteration
+		return true
+	})
+
+	var result []byte
+	var err error
+	if packed {
+		result, err = abi.EncodePacked(types, values)
+	} else {
+		result, err = abi.Encode(types, values)
+	}
+	if err != nil {
+		panic(ABIEncodingError{
+			Msg:           err.Error(),
+			LocationRange: locationRange,
+		})
+	}
+
+	inter.ReportComputation(common.ComputationKindSTDLIBABIEncode, uint(len(result)))
+
+	return result
+}
+
+func newABIEncodeFunction(gauge common.MemoryGauge) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		gauge,
+		ABITypeEncodeFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			valuesArray, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			result := abiEncode(inter, invocation.LocationRange, valuesArray, false)
+
+			return interpreter.ByteSliceToByteArrayValue(inter, result)
+		},
+	)
+}
+
+func newABIEncodePackedFunction(gauge common.MemoryGauge) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		gauge,
+		ABITypeEncodePackedFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			valuesArray, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			result := abiEncode(inter, invocation.LocationRange, valuesArray, true)
+
+			return interpreter.ByteSliceToByteArrayValue(inter, result)
+		},
+	)
+}
+
+// abiFunctionSelectorLength is the length of a function selector,
+// the prefix of the Keccak-256 hash of the function signature
+const abiFunctionSelectorLength = 4
+
+func newABIEncodeWithSignatureFunction(
+	gauge common.MemoryGauge,
+	hasher Hasher,
+) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		gauge,
+		ABITypeEncodeWithSignatureFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			signatureValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			valuesArray, ok := invocation.Arguments[1].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			var hash []byte
+			var err error
+			errors.WrapPanic(func() {
+				hash, err = hasher.Hash(
+					[]byte(signatureValue.Str),
+					"",
+					sema.HashAlgorithmKECCAK_256,
+				)
+			})
+			if err != nil {
+				panic(interpreter.WrappedExternalError(err))
+			}
+			if len(hash) < abiFunctionSelectorLength {
+				panic(errors.NewUnexpectedError("invalid Keccak-256 hash length: %d", len(hash)))
+			}
+
+			encoded := abiEncode(inter, invocation.LocationRange, valuesArray, false)
+
+			result := make([]byte, 0, abiFunctionSelectorLength+len(encoded))
+			result = append(result, hash[:abiFunctionSelectorLength]...)
+			result = append(result, encoded...)
+
+			return interpreter.ByteSliceToByteArrayValue(inter, result)
+		},
+	)
+}
+
+func newABIDecodeFunction(gauge common.MemoryGauge) *interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		gauge,
+		ABITypeDecodeFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			typesArray, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			dataArray, ok := invocation.Arguments[1].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			inter.ReportComputation(common.ComputationKindSTDLIBABIDecode, uint(dataArray.Count()))
+
+			count := typesArray.Count()
+			semaTypes := make([]sema.Type, 0, count)
+			abiTypes := make([]*abi.Type, 0, count)
+
+			typesArray.Iterate(inter, func(element interpreter.Value) (resume bool) {
+				typeValue, ok := element.(interpreter.TypeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				if typeValue.Type == nil {
+					panic(ABIDecodingError{
+						Msg:           "invalid type",
+						LocationRange: locationRange,
+					})
+				}
+
+				semaType := inter.MustConvertStaticToSemaType(typeValue.Type)
+
+				abiType, err := abiTypeForType(semaType, nil)
+				if err != nil {
+					panic(ABIDecodingError{
+						Msg:           err.Error(),
+						LocationRange: locationRange,
+					})
+				}
+
+				semaTypes = append(semaTypes, semaType)
+				abiTypes = append(abiTypes, abiType)
+
+				// Continue iteration
+				return true
+			})
+
+			data, err := interpreter.ByteArrayValueToByteSlice(inter, dataArray, locationRange)
+			if err != nil {
+				panic(ABIDecodingError{
+					Msg:           err.Error(),
+					LocationRange: locationRange,
+				})
+			}
+
+			decoded, err := abi.Decode(abiTypes, data)
+			if err != nil {
+				panic(ABIDecodingError{
+					Msg:           err.Error(),
+					LocationRange: locationRange,
+				})
+			}
+
+			values := make([]interpreter.Value, len(decoded))
+			for i, value := range decoded {
+				values[i] = valueFromABI(inter, locationRange, value, semaTypes[i])
+			}
+
+			return interpreter.NewArrayValue(
+				inter,
+				locationRange,
+				interpreter.NewVariableSizedStaticType(
+					inter,
+					interpreter.PrimitiveStaticTypeAnyStruct,
+				),
+				common.ZeroAddress,
+				values...,
+			)
+		},
+	)
+}
+
+var ABITypeStaticType = interpreter.ConvertSemaToStaticType(nil, ABIType)
+
+// NewABIContract returns the ABI contract, which encodes and decodes values
+// according to the Solidity contract ABI specification.
+//
+// The contract is not part of the default standard library,
+// hosts can opt in by declaring it.
+func NewABIContract(
+	gauge common.MemoryGauge,
+	hasher Hasher,
+) StandardLibraryValue {
+	abiContractFields := map[string]interpreter.Value{
+		ABITypeEncodeFunctionName:              newABIEncodeFunction(gauge),
+		ABITypeEncodePackedFunctionName:        newABIEncodePackedFunction(gauge),
+		ABITypeEncodeWithSignatureFunctionName: newABIEncodeWithSignatureFunction(gauge, hasher),
+		ABITypeDecodeFunctionName:              newABIDecodeFunction(gauge),
+	}
+
+	abiContractValue := interpreter.NewSimpleCompositeValue(
+		gauge,
+		ABIType.ID(),
+		ABITypeStaticType,
+		nil,
+		abiContractFields,
+		nil,
+		nil,
+		nil,
+	)
+
+	return StandardLibraryValue{
+		Name:  ABITypeName,
+		Type:  ABIType,
+		Value: abiContractValue,
+		Kind:  common.DeclarationKindContract,
+	}
+}
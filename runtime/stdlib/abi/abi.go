@@ -0,0 +1,710 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package abi implements the Ethereum contract ABI encoding,
+// see https://docs.soliditylang.org/en/latest/abi-spec.html
+package abi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+const WordSize = 32
+
+var (
+	ErrValueTypeMismatch     = errors.New("value doesn't match the type")
+	ErrIntegerOutOfRange     = errors.New("integer is out of the range of the type")
+	ErrArrayLengthMismatch   = errors.New("array length doesn't match the length of the type")
+	ErrUnsupportedPackedType = errors.New("type is not supported in packed encoding")
+	ErrIncompleteInput       = errors.New("incomplete input! not enough bytes to read")
+	ErrInvalidOffset         = errors.New("offset or length is out of the range of the input")
+	ErrInvalidPadding        = errors.New("padding bytes of the value are invalid")
+	ErrInvalidBool           = errors.New("boolean value is neither 0 nor 1")
+	ErrInvalidString         = errors.New("string is not valid UTF-8")
+	ErrInputReused           = errors.New("decoded values are larger than the input, which references the same data multiple times")
+	ErrZeroSizeElement       = errors.New("array element type has an encoding of size zero")
+)
+
+type Kind uint8
+
+const (
+	KindUint Kind = iota
+	KindInt
+	KindBool
+	KindString
+	KindBytes
+	KindFixedArray
+	KindArray
+	KindTuple
+)
+
+// Type is an ABI type.
+//
+// Values of the types are represented as follows:
+//   - uint<M> and int<M>: *big.Int
+//   - bool: bool
+//   - string: string
+//   - bytes: []byte
+//   - <type>[<k>], <type>[] and tuples: []any
+type Type struct {
+	Kind Kind
+	// Bits is the number of bits of integer types (uint<M> and int<M>)
+	Bits int
+	// Length is the number of elements of fixed-size arrays (<type>[<k>])
+	Length int
+	// Element is the element type of array types (<type>[<k>] and <type>[])
+	Element *Type
+	// Components are the component types of tuple types
+	Components []*Type
+}
+
+func NewUintType(bits int) *Type {
+	return &Type{Kind: KindUint, Bits: bits}
+}
+
+func NewIntType(bits int) *Type {
+	return &Type{Kind: KindInt, Bits: bits}
+}
+
+var BoolType = &Type{Kind: KindBool}
+var StringType = &Type{Kind: KindString}
+var BytesType = &Type{Kind: KindBytes}
+
+func NewFixedArrayType(element *Type, length int) *Type {
+	return &Type{Kind: KindFixedArray, Element: element, Length: length}
+}
+
+func NewArrayType(element *Type) *Type {
+	return &Type{Kind: KindArray, Element: element}
+}
+
+func NewTupleType(components ...*Type) *Type {
+	return &Type{Kind: KindTuple, Components: components}
+}
+
+// String returns the canonical name of the type, as used in function signatures
+func (t *Type) String() string {
+	switch t.Kind {
+	case KindUint:
+		return fmt.Sprintf("uint%d", t.Bits)
+	case KindInt:
+		return fmt.Sprintf("int%d", t.Bits)
+	case KindBool:
+		return "bool"
+	case KindString:
+		return "string"
+	case KindBytes:
+		return "bytes"
+	case KindFixedArray:
+		return fmt.Sprintf("%s[%d]", t.Element, t.Length)
+	case KindArray:
+		return fmt.Sprintf("%s[]", t.Element)
+	case KindTuple:
+		components := make([]string, len(t.Components))
+		for i, component := range t.Components {
+			components[i] = component.String()
+		}
+		return fmt.Sprintf("(%s)", strings.Join(components, ","))
+	default:
+		return fmt.Sprintf("<unknown kind %d>", t.Kind)
+	}
+}
+
+// IsDynamic returns true if the encoding of values of the type has a variable size.
+func (t *Type) IsDynamic() bool {
+	switch t.Kind {
+	case KindString, KindBytes, KindArray:
+		return true
+	case KindFixedArray:
+		return t.Element.IsDynamic()
+	case KindTuple:
+		for _, component := range t.Components {
+			if component.IsDynamic() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// headSize returns the number of bytes a value of the type occupies in the head of an enclosing tuple,
+// i.e. the size of the encoding for static types, and the size of the offset for dynamic types.
+// The size saturates at math.MaxInt.
+func (t *Type) headSize() int {
+	if t.IsDynamic() {
+		return WordSize
+	}
+
+	switch t.Kind {
+	case KindFixedArray:
+		return saturatingMul(t.Length, t.Element.headSize())
+	case KindTuple:
+		return tupleHeadSize(t.Components)
+	default:
+		return WordSize
+	}
+}
+
+// IsZeroSize returns true if the encoding of values of the type is empty,
+// e.g. for empty tuples and fixed-size arrays of length zero.
+// Arrays of such types are not supported, as their length would not be bounded by the size of the input.
+func (t *Type) IsZeroSize() bool {
+	return t.headSize() == 0
+}
+
+// tupleHeadSize returns the total number of bytes the heads of a tuple of the given types occupy
+func tupleHeadSize(types []*Type) int {
+	var size int
+	for _, t := range types {
+		size = saturatingAdd(size, t.headSize())
+	}
+	return size
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
+
+// Encode encodes the given values of the given types as a tuple,
+// like Solidity's abi.encode
+func Encode(types []*Type, values []any) ([]byte, error) {
+	if len(types) != len(values) {
+		return nil, ErrArrayLengthMismatch
+	}
+
+	return encodeSequence(
+		len(types),
+		func(i int) *Type {
+			return types[i]
+		},
+		values,
+	)
+}
+
+func encode(t *Type, value any) ([]byte, error) {
+	switch t.Kind {
+	case KindUint, KindInt:
+		integer, ok := value.(*big.Int)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeInteger(t, integer, WordSize)
+
+	case KindBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeBool(b, WordSize), nil
+
+	case KindString:
+		str, ok := value.(string)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeBytes([]byte(str)), nil
+
+	case KindBytes:
+		bytes, ok := value.([]byte)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeBytes(bytes), nil
+
+	case KindFixedArray:
+		elements, ok := value.([]any)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		if len(elements) != t.Length {
+			return nil, ErrArrayLengthMismatch
+		}
+		return encodeSequence(
+			len(elements),
+			func(_ int) *Type {
+				return t.Element
+			},
+			elements,
+		)
+
+	case KindArray:
+		elements, ok := value.([]any)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		encodedElements, err := encodeSequence(
+			len(elements),
+			func(_ int) *Type {
+				return t.Element
+			},
+			elements,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return append(encodeLength(len(elements)), encodedElements...), nil
+
+	case KindTuple:
+		components, ok := value.([]any)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		if len(components) != len(t.Components) {
+			return nil, ErrArrayLengthMismatch
+		}
+		return encodeSequence(
+			len(components),
+			func(i int) *Type {
+				return t.Components[i]
+			},
+			components,
+		)
+
+	default:
+		return nil, ErrValueTypeMismatch
+	}
+}
+
+// encodeSequence encodes the given values as a tuple of the given types:
+// first the heads of all values, i.e. the encoding of static values and the offsets of dynamic values,
+// then the tails of all values, i.e. the encoding of dynamic values.
+func encodeSequence(count int, typeAt func(i int) *Type, values []any) ([]byte, error) {
+	var headSize int
+	for i := 0; i < count; i++ {
+		headSize = saturatingAdd(headSize, typeAt(i).headSize())
+	}
+
+	var heads, tails []byte
+	for i := 0; i < count; i++ {
+		elementType := typeAt(i)
+
+		encoded, err := encode(elementType, values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if elementType.IsDynamic() {
+			offset := headSize + len(tails)
+			heads = append(heads, encodeLength(offset)...)
+			tails = append(tails, encoded...)
+		} else {
+			heads = append(heads, encoded...)
+		}
+	}
+
+	return append(heads, tails...), nil
+}
+
+// encodeInteger encodes the given integer in big-endian, in two's complement for signed integers,
+// padded to the given size
+func encodeInteger(t *Type, integer *big.Int, size int) ([]byte, error) {
+	if !integerInRange(t, integer) {
+		return nil, ErrIntegerOutOfRange
+	}
+
+	result := make([]byte, size)
+
+	if integer.Sign() < 0 {
+		// two's complement: 2^(8*size) + integer
+		twosComplement := new(big.Int).Lsh(big.NewInt(1), uint(size*8))
+		twosComplement.Add(twosComplement, integer)
+		twosComplement.FillBytes(result)
+	} else {
+		integer.FillBytes(result)
+	}
+
+	return result, nil
+}
+
+func integerInRange(t *Type, integer *big.Int) bool {
+	switch t.Kind {
+	case KindUint:
+		return integer.Sign() >= 0 &&
+			integer.BitLen() <= t.Bits
+
+	case KindInt:
+		if integer.Sign() >= 0 {
+			return integer.BitLen() < t.Bits
+		}
+		// the minimum value is -2^(bits-1),
+		// so the absolute value of the integer minus one must fit into bits-1 bits
+		magnitude := new(big.Int).Neg(integer)
+		magnitude.Sub(magnitude, big.NewInt(1))
+		return magnitude.BitLen() < t.Bits
+
+	default:
+		return false
+	}
+}
+
+func encodeBool(b bool, size int) []byte {
+	result := make([]byte, size)
+	if b {
+		result[size-1] = 1
+	}
+	return result
+}
+
+func encodeLength(length int) []byte {
+	result := make([]byte, WordSize)
+	new(big.Int).SetUint64(uint64(length)).FillBytes(result)
+	return result
+}
+
+// encodeBytes encodes the given bytes as their length,
+// followed by the bytes, padded on the right to a multiple of the word size
+func encodeBytes(bytes []byte) []byte {
+	paddedLength := (len(bytes) + WordSize - 1) / WordSize * WordSize
+	result := make([]byte, WordSize+paddedLength)
+	copy(result, encodeLength(len(bytes)))
+	copy(result[WordSize:], bytes)
+	return result
+}
+
+// EncodePacked encodes the given values of the given types in the non-standard packed mode,
+// like Solidity's abi.encodePacked:
+//   - integers and booleans are encoded using the minimal number of bytes for the type
+//   - strings and bytes are encoded in-place, without padding and length
+//   - elements of arrays are padded to the word size, and arrays are encoded without length
+//
+// Tuples, and arrays of dynamic types or of arrays, are not supported.
+func EncodePacked(types []*Type, values []any) ([]byte, error) {
+	if len(types) != len(values) {
+		return nil, ErrArrayLengthMismatch
+	}
+
+	var result []byte
+	for i, t := range types {
+		encoded, err := encodePacked(t, values[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded...)
+	}
+	return result, nil
+}
+
+func encodePacked(t *Type, value any) ([]byte, error) {
+	switch t.Kind {
+	case KindUint, KindInt:
+		integer, ok := value.(*big.Int)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeInteger(t, integer, t.Bits/8)
+
+	case KindBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return encodeBool(b, 1), nil
+
+	case KindString:
+		str, ok := value.(string)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return []byte(str), nil
+
+	case KindBytes:
+		bytes, ok := value.([]byte)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		return bytes, nil
+
+	case KindFixedArray, KindArray:
+		switch t.Element.Kind {
+		case KindUint, KindInt, KindBool:
+			break
+		default:
+			return nil, ErrUnsupportedPackedType
+		}
+
+		elements, ok := value.([]any)
+		if !ok {
+			return nil, ErrValueTypeMismatch
+		}
+		if t.Kind == KindFixedArray && len(elements) != t.Length {
+			return nil, ErrArrayLengthMismatch
+		}
+
+		var result []byte
+		for _, element := range elements {
+			encoded, err := encode(t.Element, element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, encoded...)
+		}
+		return result, nil
+
+	default:
+		return nil, ErrUnsupportedPackedType
+	}
+}
+
+// Decode decodes the given data as a tuple of the given types,
+// like Solidity's abi.decode
+func Decode(types []*Type, data []byte) ([]any, error) {
+	d := &decoder{
+		data:   data,
+		budget: len(data),
+	}
+	return d.decodeSequence(
+		len(types),
+		func(i int) *Type {
+			return types[i]
+		},
+		tupleHeadSize(types),
+		0,
+	)
+}
+
+// decoder decodes values from ABI-encoded data.
+//
+// Dynamic values are referenced through offsets, so an input could reference the same data many times,
+// e.g. all elements of an array could reference the same large string.
+// To prevent decoding values which are much larger than the input,
+// the decoder keeps track of the size of the decoded values (including offsets and lengths),
+// which can never exceed the size of the input for a valid encoding.
+type decoder struct {
+	data   []byte
+	budget int
+}
+
+func (d *decoder) consume(size int) error {
+	if size > d.budget {
+		return ErrInputReused
+	}
+	d.budget -= size
+	return nil
+}
+
+func (d *decoder) decode(t *Type, start int) (any, error) {
+	switch t.Kind {
+	case KindUint, KindInt:
+		word, err := d.readWord(start)
+		if err != nil {
+			return nil, err
+		}
+		return decodeInteger(t, word)
+
+	case KindBool:
+		word, err := d.readWord(start)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range word[:WordSize-1] {
+			if b != 0 {
+				return nil, ErrInvalidBool
+			}
+		}
+		switch word[WordSize-1] {
+		case 0:
+			return false, nil
+		case 1:
+			return true, nil
+		default:
+			return nil, ErrInvalidBool
+		}
+
+	case KindString:
+		bytes, err := d.decodeBytes(start)
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(bytes) {
+			return nil, ErrInvalidString
+		}
+		return string(bytes), nil
+
+	case KindBytes:
+		return d.decodeBytes(start)
+
+	case KindFixedArray:
+		if t.Element.IsZeroSize() {
+			return nil, ErrZeroSizeElement
+		}
+		return d.decodeSequence(
+			t.Length,
+			func(_ int) *Type {
+				return t.Element
+			},
+			saturatingMul(t.Length, t.Element.headSize()),
+			start,
+		)
+
+	case KindArray:
+		if t.Element.IsZeroSize() {
+			return nil, ErrZeroSizeElement
+		}
+		length, err := d.readLength(start)
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeSequence(
+			length,
+			func(_ int) *Type {
+				return t.Element
+			},
+			saturatingMul(length, t.Element.headSize()),
+			start+WordSize,
+		)
+
+	case KindTuple:
+		return d.decodeSequence(
+			len(t.Components),
+			func(i int) *Type {
+				return t.Components[i]
+			},
+			tupleHeadSize(t.Components),
+			start,
+		)
+
+	default:
+		return nil, ErrValueTypeMismatch
+	}
+}
+
+// decodeSequence decodes a tuple of the given types, starting at the given index of the data.
+// Offsets of dynamic values are relative to the start of the tuple.
+func (d *decoder) decodeSequence(
+	count int,
+	typeAt func(i int) *Type,
+	headSize int,
+	start int,
+) ([]any, error) {
+	data := d.data
+
+	// Check that the heads of all values are available,
+	// before allocating the result
+	if start > len(data) || headSize > len(data)-start {
+		return nil, ErrIncompleteInput
+	}
+
+	values := make([]any, count)
+
+	headStart := start
+	for i := 0; i < count; i++ {
+		elementType := typeAt(i)
+
+		var value any
+		var err error
+
+		if elementType.IsDynamic() {
+			var offset int
+			offset, err = d.readLength(headStart)
+			if err != nil {
+				return nil, err
+			}
+			if offset > len(data)-start {
+				return nil, ErrInvalidOffset
+			}
+			value, err = d.decode(elementType, start+offset)
+		} else {
+			value, err = d.decode(elementType, headStart)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+		headStart = saturatingAdd(headStart, elementType.headSize())
+	}
+
+	return values, nil
+}
+
+func (d *decoder) readWord(start int) ([]byte, error) {
+	data := d.data
+	if start < 0 || start > len(data)-WordSize {
+		return nil, ErrIncompleteInput
+	}
+	err := d.consume(WordSize)
+	if err != nil {
+		return nil, err
+	}
+	return data[start : start+WordSize], nil
+}
+
+// readLength reads an unsigned integer word which is used as an offset or length,
+// and which must be within the bounds of the data
+func (d *decoder) readLength(start int) (int, error) {
+	word, err := d.readWord(start)
+	if err != nil {
+		return 0, err
+	}
+	length := new(big.Int).SetBytes(word)
+	if !length.IsInt64() || length.Int64() > int64(len(d.data)) {
+		return 0, ErrInvalidOffset
+	}
+	return int(length.Int64()), nil
+}
+
+func decodeInteger(t *Type, word []byte) (*big.Int, error) {
+	integer := new(big.Int).SetBytes(word)
+
+	if t.Kind == KindInt && word[0]&0x80 != 0 {
+		// negative: integer - 2^256
+		integer.Sub(integer, new(big.Int).Lsh(big.NewInt(1), WordSize*8))
+	}
+
+	// The value must be padded properly (zero-extended for unsigned integers,
+	// sign-extended for signed integers), i.e. it must be in the range of the type
+	if !integerInRange(t, integer) {
+		return nil, ErrInvalidPadding
+	}
+
+	return integer, nil
+}
+
+func (d *decoder) decodeBytes(start int) ([]byte, error) {
+	data := d.data
+	length, err := d.readLength(start)
+	if err != nil {
+		return nil, err
+	}
+	bytesStart := start + WordSize
+	if length > len(data)-bytesStart {
+		return nil, ErrIncompleteInput
+	}
+	err = d.consume(length)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]byte, length)
+	copy(result, data[bytesStart:bytesStart+length])
+	return result, nil
+}
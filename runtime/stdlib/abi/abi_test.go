@@ -0,0 +1,430 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package abi_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/stdlib/abi"
+)
+
+// words decodes the given hex-encoded words, ignoring whitespace and comments
+func words(t *testing.T, lines string) []byte {
+	var b strings.Builder
+	for _, line := range strings.Split(lines, "\n") {
+		line, _, _ = strings.Cut(line, "//")
+		b.WriteString(strings.TrimSpace(line))
+	}
+	result, err := hex.DecodeString(b.String())
+	require.NoError(t, err)
+	return result
+}
+
+func TestTypeString(t *testing.T) {
+
+	t.Parallel()
+
+	require.Equal(t,
+		"(uint256,int8[2],bool,string,bytes[])",
+		abi.NewTupleType(
+			abi.NewUintType(256),
+			abi.NewFixedArrayType(abi.NewIntType(8), 2),
+			abi.BoolType,
+			abi.StringType,
+			abi.NewArrayType(abi.BytesType),
+		).String(),
+	)
+}
+
+func TestEncodeDecode(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		types   []*abi.Type
+		values  []any
+		encoded string
+	}
+
+	tests := []testCase{
+		{
+			// baz(uint32,bool) from the specification
+			name:   "static",
+			types:  []*abi.Type{abi.NewUintType(32), abi.BoolType},
+			values: []any{big.NewInt(69), true},
+			encoded: `
+                0000000000000000000000000000000000000000000000000000000000000045
+                0000000000000000000000000000000000000000000000000000000000000001
+            `,
+		},
+		{
+			name:   "negative integer",
+			types:  []*abi.Type{abi.NewIntType(8), abi.NewIntType(256)},
+			values: []any{big.NewInt(-1), big.NewInt(-128)},
+			encoded: `
+                ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+                ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80
+            `,
+		},
+		{
+			// sam(bytes,bool,uint256[]) from the specification
+			name: "dynamic",
+			types: []*abi.Type{
+				abi.BytesType,
+				abi.BoolType,
+				abi.NewArrayType(abi.NewUintType(256)),
+			},
+			values: []any{
+				[]byte("dave"),
+				true,
+				[]any{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+			},
+			encoded: `
+                0000000000000000000000000000000000000000000000000000000000000060 // offset of "dave"
+                0000000000000000000000000000000000000000000000000000000000000001 // true
+                00000000000000000000000000000000000000000000000000000000000000a0 // offset of [1, 2, 3]
+                0000000000000000000000000000000000000000000000000000000000000004 // length of "dave"
+                6461766500000000000000000000000000000000000000000000000000000000 // "dave"
+                0000000000000000000000000000000000000000000000000000000000000003 // length of [1, 2, 3]
+                0000000000000000000000000000000000000000000000000000000000000001
+                0000000000000000000000000000000000000000000000000000000000000002
+                0000000000000000000000000000000000000000000000000000000000000003
+            `,
+		},
+		{
+			// g(uint256[][],string[]) from the specification
+			name: "nested dynamic",
+			types: []*abi.Type{
+				abi.NewArrayType(abi.NewArrayType(abi.NewUintType(256))),
+				abi.NewArrayType(abi.StringType),
+			},
+			values: []any{
+				[]any{
+					[]any{big.NewInt(1), big.NewInt(2)},
+					[]any{big.NewInt(3)},
+				},
+				[]any{"one", "two", "three"},
+			},
+			encoded: `
+                0000000000000000000000000000000000000000000000000000000000000040 // offset of [[1, 2], [3]]
+                0000000000000000000000000000000000000000000000000000000000000140 // offset of ["one", "two", "three"]
+                0000000000000000000000000000000000000000000000000000000000000002 // length of [[1, 2], [3]]
+                0000000000000000000000000000000000000000000000000000000000000040 // offset of [1, 2]
+                00000000000000000000000000000000000000000000000000000000000000a0 // offset of [3]
+                0000000000000000000000000000000000000000000000000000000000000002 // length of [1, 2]
+                0000000000000000000000000000000000000000000000000000000000000001
+                0000000000000000000000000000000000000000000000000000000000000002
+                0000000000000000000000000000000000000000000000000000000000000001 // length of [3]
+                0000000000000000000000000000000000000000000000000000000000000003
+                0000000000000000000000000000000000000000000000000000000000000003 // length of ["one", "two", "three"]
+                0000000000000000000000000000000000000000000000000000000000000060 // offset of "one"
+                00000000000000000000000000000000000000000000000000000000000000a0 // offset of "two"
+                00000000000000000000000000000000000000000000000000000000000000e0 // offset of "three"
+                0000000000000000000000000000000000000000000000000000000000000003 // length of "one"
+                6f6e650000000000000000000000000000000000000000000000000000000000
+                0000000000000000000000000000000000000000000000000000000000000003 // length of "two"
+                74776f0000000000000000000000000000000000000000000000000000000000
+                0000000000000000000000000000000000000000000000000000000000000005 // length of "three"
+                7468726565000000000000000000000000000000000000000000000000000000
+            `,
+		},
+		{
+			name: "static tuple and fixed array",
+			types: []*abi.Type{
+				abi.NewTupleType(
+					abi.NewFixedArrayType(abi.NewUintType(8), 2),
+					abi.BoolType,
+				),
+				abi.NewUintType(16),
+			},
+			values: []any{
+				[]any{
+					[]any{big.NewInt(1), big.NewInt(2)},
+					false,
+				},
+				big.NewInt(3),
+			},
+			encoded: `
+                0000000000000000000000000000000000000000000000000000000000000001
+                0000000000000000000000000000000000000000000000000000000000000002
+                0000000000000000000000000000000000000000000000000000000000000000
+                0000000000000000000000000000000000000000000000000000000000000003
+            `,
+		},
+		{
+			name: "dynamic tuple",
+			types: []*abi.Type{
+				abi.NewTupleType(
+					abi.StringType,
+					abi.NewUintType(8),
+				),
+			},
+			values: []any{
+				[]any{"a", big.NewInt(1)},
+			},
+			encoded: `
+                0000000000000000000000000000000000000000000000000000000000000020 // offset of the tuple
+                0000000000000000000000000000000000000000000000000000000000000040 // offset of "a", relative to the tuple
+                0000000000000000000000000000000000000000000000000000000000000001
+                0000000000000000000000000000000000000000000000000000000000000001 // length of "a"
+                6100000000000000000000000000000000000000000000000000000000000000
+            `,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			expected := words(t, test.encoded)
+
+			encoded, err := abi.Encode(test.types, test.values)
+			require.NoError(t, err)
+			require.Equal(t, expected, encoded)
+
+			// round-trip
+			decoded, err := abi.Decode(test.types, encoded)
+			require.NoError(t, err)
+			require.Equal(t, test.values, decoded)
+		})
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		t           *abi.Type
+		value       any
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{"uint overflow", abi.NewUintType(8), big.NewInt(256), abi.ErrIntegerOutOfRange},
+		{"uint underflow", abi.NewUintType(8), big.NewInt(-1), abi.ErrIntegerOutOfRange},
+		{"int overflow", abi.NewIntType(8), big.NewInt(128), abi.ErrIntegerOutOfRange},
+		{"int underflow", abi.NewIntType(8), big.NewInt(-129), abi.ErrIntegerOutOfRange},
+		{"type mismatch", abi.BoolType, "true", abi.ErrValueTypeMismatch},
+		{
+			"fixed array length mismatch",
+			abi.NewFixedArrayType(abi.BoolType, 2),
+			[]any{true},
+			abi.ErrArrayLengthMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := abi.Encode([]*abi.Type{test.t}, []any{test.value})
+		require.Equal(t, test.expectedErr, err, test.name)
+	}
+}
+
+func TestEncodePacked(t *testing.T) {
+
+	t.Parallel()
+
+	encoded, err := abi.EncodePacked(
+		[]*abi.Type{
+			abi.NewIntType(16),
+			abi.NewUintType(16),
+			abi.StringType,
+			abi.BytesType,
+			abi.BoolType,
+			abi.NewArrayType(abi.NewUintType(8)),
+		},
+		[]any{
+			big.NewInt(-1),
+			big.NewInt(3),
+			"Hello, world!",
+			[]byte{0x42},
+			true,
+			[]any{big.NewInt(1)},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		words(t, `
+            ffff                       // int16(-1)
+            0003                       // uint16(3)
+            48656c6c6f2c20776f726c6421 // "Hello, world!"
+            42                         // bytes
+            01                         // true
+            0000000000000000000000000000000000000000000000000000000000000001 // [uint8(1)]
+        `),
+		encoded,
+	)
+
+	_, err = abi.EncodePacked(
+		[]*abi.Type{abi.NewTupleType(abi.BoolType)},
+		[]any{[]any{true}},
+	)
+	require.Equal(t, abi.ErrUnsupportedPackedType, err)
+
+	_, err = abi.EncodePacked(
+		[]*abi.Type{abi.NewArrayType(abi.StringType)},
+		[]any{[]any{"a"}},
+	)
+	require.Equal(t, abi.ErrUnsupportedPackedType, err)
+}
+
+func TestDecodeErrors(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		types       []*abi.Type
+		data        string
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:        "empty input",
+			types:       []*abi.Type{abi.NewUintType(8)},
+			data:        ``,
+			expectedErr: abi.ErrIncompleteInput,
+		},
+		{
+			name:        "short word",
+			types:       []*abi.Type{abi.NewUintType(8)},
+			data:        `00000000000000000000000000000000000000000000000000000000000001`,
+			expectedErr: abi.ErrIncompleteInput,
+		},
+		{
+			name:        "uint with invalid padding",
+			types:       []*abi.Type{abi.NewUintType(8)},
+			data:        `0000000000000000000000000000000000000000000000000000000000000100`,
+			expectedErr: abi.ErrInvalidPadding,
+		},
+		{
+			name:        "int with invalid sign extension",
+			types:       []*abi.Type{abi.NewIntType(8)},
+			data:        `00000000000000000000000000000000000000000000000000000000000000ff`,
+			expectedErr: abi.ErrInvalidPadding,
+		},
+		{
+			name:        "invalid bool",
+			types:       []*abi.Type{abi.BoolType},
+			data:        `0000000000000000000000000000000000000000000000000000000000000002`,
+			expectedErr: abi.ErrInvalidBool,
+		},
+		{
+			name:  "offset out of range",
+			types: []*abi.Type{abi.BytesType},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000060
+                0000000000000000000000000000000000000000000000000000000000000000
+            `,
+			expectedErr: abi.ErrInvalidOffset,
+		},
+		{
+			name:  "huge array length",
+			types: []*abi.Type{abi.NewArrayType(abi.NewUintType(8))},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020
+                ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+            `,
+			expectedErr: abi.ErrInvalidOffset,
+		},
+		{
+			name:  "array length larger than the data",
+			types: []*abi.Type{abi.NewArrayType(abi.NewUintType(8))},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020
+                0000000000000000000000000000000000000000000000000000000000000002
+                0000000000000000000000000000000000000000000000000000000000000001
+            `,
+			expectedErr: abi.ErrIncompleteInput,
+		},
+		{
+			name:  "bytes length larger than the data",
+			types: []*abi.Type{abi.BytesType},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020
+                0000000000000000000000000000000000000000000000000000000000000021
+                0000000000000000000000000000000000000000000000000000000000000001
+            `,
+			expectedErr: abi.ErrIncompleteInput,
+		},
+		{
+			name:  "reused data",
+			types: []*abi.Type{abi.NewArrayType(abi.StringType)},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020 // offset of the array
+                0000000000000000000000000000000000000000000000000000000000000002 // length of the array
+                0000000000000000000000000000000000000000000000000000000000000040 // offset of the first string
+                0000000000000000000000000000000000000000000000000000000000000040 // offset of the second string
+                0000000000000000000000000000000000000000000000000000000000000001 // length of the string
+                6100000000000000000000000000000000000000000000000000000000000000
+            `,
+			expectedErr: abi.ErrInputReused,
+		},
+		{
+			name:  "invalid UTF-8 string",
+			types: []*abi.Type{abi.StringType},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020
+                0000000000000000000000000000000000000000000000000000000000000001
+                ff00000000000000000000000000000000000000000000000000000000000000
+            `,
+			expectedErr: abi.ErrInvalidString,
+		},
+		{
+			name:        "huge fixed-size array of empty tuples",
+			types:       []*abi.Type{abi.NewFixedArrayType(abi.NewTupleType(), 1<<40)},
+			data:        ``,
+			expectedErr: abi.ErrZeroSizeElement,
+		},
+		{
+			name: "huge fixed-size array of empty fixed-size arrays",
+			types: []*abi.Type{
+				abi.NewFixedArrayType(abi.NewFixedArrayType(abi.NewUintType(8), 0), 1<<40),
+			},
+			data:        ``,
+			expectedErr: abi.ErrZeroSizeElement,
+		},
+		{
+			name:  "array of empty tuples",
+			types: []*abi.Type{abi.NewArrayType(abi.NewTupleType())},
+			data: `
+                0000000000000000000000000000000000000000000000000000000000000020
+                0000000000000000000000000000000000000000000000000000000000000001
+            `,
+			expectedErr: abi.ErrZeroSizeElement,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := abi.Decode(test.types, words(t, test.data))
+		require.Equal(t, test.expectedErr, err, test.name)
+	}
+}
@@ -0,0 +1,362 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+type testKeccakHasher struct{}
+
+var _ Hasher = testKeccakHasher{}
+
+func (testKeccakHasher) Hash(data []byte, _ string, algorithm sema.HashAlgorithm) ([]byte, error) {
+	if algorithm != sema.HashAlgorithmKECCAK_256 {
+		return nil, errors.NewDefaultUserError("unsupported hash algorithm: %s", algorithm)
+	}
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil), nil
+}
+
+func newABITestInterpreter(t *testing.T, code string) *interpreter.Interpreter {
+	return newInterpreter(t,
+		code,
+		AssertFunction,
+		NewABIContract(nil, testKeccakHasher{}),
+	)
+}
+
+func invokeABITestFunction(t *testing.T, code string) ([]byte, error) {
+	inter := newABITestInterpreter(t, code)
+
+	result, err := inter.Invoke("test")
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := result.(*interpreter.ArrayValue)
+	require.True(t, ok)
+
+	bytes, err := interpreter.ByteArrayValueToByteSlice(inter, array, interpreter.EmptyLocationRange)
+	require.NoError(t, err)
+
+	return bytes, nil
+}
+
+func TestABIEncode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("static", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              return ABI.encode([UInt32(69), true])
+          }
+        `)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			"0000000000000000000000000000000000000000000000000000000000000045"+
+				"0000000000000000000000000000000000000000000000000000000000000001",
+			hex.EncodeToString(result),
+		)
+	})
+
+	t.Run("dynamic", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              let dave: [UInt8] = "dave".utf8
+              let numbers: [UInt256] = [1, 2, 3]
+              return ABI.encode([dave, true, numbers])
+          }
+        `)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			"0000000000000000000000000000000000000000000000000000000000000060"+
+				"0000000000000000000000000000000000000000000000000000000000000001"+
+				"00000000000000000000000000000000000000000000000000000000000000a0"+
+				"0000000000000000000000000000000000000000000000000000000000000004"+
+				"6461766500000000000000000000000000000000000000000000000000000000"+
+				"0000000000000000000000000000000000000000000000000000000000000003"+
+				"0000000000000000000000000000000000000000000000000000000000000001"+
+				"0000000000000000000000000000000000000000000000000000000000000002"+
+				"0000000000000000000000000000000000000000000000000000000000000003",
+			hex.EncodeToString(result),
+		)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := invokeABITestFunction(t, `
+          access(all) struct Point {
+              access(all) let x: Int8
+              access(all) let y: Word16
+
+              init(x: Int8, y: Word16) {
+                  self.x = x
+                  self.y = y
+              }
+          }
+
+          access(all) fun test(): [UInt8] {
+              return ABI.encode([Point(x: -1, y: 2)])
+          }
+        `)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"+
+				"0000000000000000000000000000000000000000000000000000000000000002",
+			hex.EncodeToString(result),
+		)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              return ABI.encode([0x1 as Address])
+          }
+        `)
+		require.ErrorAs(t, err, &ABIEncodingError{})
+		require.ErrorContains(t, err, "type `Address` is not supported")
+	})
+
+	t.Run("heterogeneous array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              let values: [AnyStruct] = [1, true]
+              return ABI.encode([values])
+          }
+        `)
+		require.ErrorAs(t, err, &ABIEncodingError{})
+		require.ErrorContains(t, err, "type `AnyStruct` is not supported")
+	})
+
+	t.Run("recursive type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := invokeABITestFunction(t, `
+          access(all) struct Node {
+              access(all) let children: [Node]
+
+              init() {
+                  self.children = []
+              }
+          }
+
+          access(all) fun test(): [UInt8] {
+              return ABI.encode([Node()])
+          }
+        `)
+		require.ErrorAs(t, err, &ABIEncodingError{})
+		require.ErrorContains(t, err, "recursive type `Node` is not supported")
+	})
+
+	t.Run("integer out of range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              let max = UInt(UInt256.max)
+              return ABI.encode([max + 1])
+          }
+        `)
+		require.ErrorAs(t, err, &ABIEncodingError{})
+	})
+}
+
+func TestABIEncodePacked(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("values", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := invokeABITestFunction(t, `
+          access(all) fun test(): [UInt8] {
+              return ABI.encodePacked([Int16(-1), UInt16(3), "Hello, world!"])
+          }
+        `)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			"ffff"+
+				"0003"+
+				"48656c6c6f2c20776f726c6421",
+			hex.EncodeToString(result),
+		)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := invokeABITestFunction(t, `
+          access(all) struct S {}
+
+          access(all) fun test(): [UInt8] {
+              return ABI.encodePacked([S()])
+          }
+        `)
+		require.ErrorAs(t, err, &ABIEncodingError{})
+	})
+}
+
+func TestABIEncodeWithSignature(t *testing.T) {
+
+	t.Parallel()
+
+	result, err := invokeABITestFunction(t, `
+      access(all) fun test(): [UInt8] {
+          return ABI.encodeWithSignature("baz(uint32,bool)", [UInt32(69), true])
+      }
+    `)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		"cdcd77c0"+
+			"0000000000000000000000000000000000000000000000000000000000000045"+
+			"0000000000000000000000000000000000000000000000000000000000000001",
+		hex.EncodeToString(result),
+	)
+}
+
+func TestABIDecode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("round-trip", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newABITestInterpreter(t, `
+          access(all) fun test() {
+              let bytes: [UInt8] = [1, 2, 3]
+              let fixed: [Bool; 2] = [true, false]
+              let tags: [[String]] = [["a", "bc"], []]
+              let data = ABI.encode([UInt8(255), Int(-1), bytes, fixed, tags, "✨"])
+
+              let values = ABI.decode(
+                  types: [
+                      Type<UInt8>(),
+                      Type<Int>(),
+                      Type<[UInt8]>(),
+                      Type<[Bool; 2]>(),
+                      Type<[[String]]>(),
+                      Type<String>()
+                  ],
+                  data: data
+              )
+
+              assert(values.length == 6)
+              assert((values[0] as! UInt8) == 255)
+              assert((values[1] as! Int) == -1)
+              assert((values[2] as! [UInt8]) == bytes)
+              assert((values[3] as! [Bool; 2]) == fixed)
+              assert((values[4] as! [[String]]) == tags)
+              assert((values[5] as! String) == "✨")
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newABITestInterpreter(t, `
+          access(all) fun test(): [AnyStruct] {
+              return ABI.decode(types: [Type<Bool>()], data: ABI.encode([UInt8(2)]))
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &ABIDecodingError{})
+	})
+
+	t.Run("huge array of empty arrays", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newABITestInterpreter(t, `
+          access(all) fun test(): [AnyStruct] {
+              return ABI.decode(types: [Type<[[UInt8; 0]; 1099511627776]>()], data: [])
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &ABIDecodingError{})
+		require.ErrorContains(t, err, "array element type `[UInt8; 0]` has an empty encoding")
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newABITestInterpreter(t, `
+          access(all) struct Positive {
+              access(all) let value: Int
+
+              init(value: Int) {
+                  pre {
+                      value > 0
+                  }
+                  self.value = value
+              }
+          }
+
+          access(all) fun test(): [AnyStruct] {
+              return ABI.decode(types: [Type<Positive>()], data: ABI.encode([Int(-1)]))
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &ABIDecodingError{})
+		require.ErrorContains(t, err, "struct type `Positive` cannot be decoded")
+	})
+}
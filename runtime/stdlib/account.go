@@ -1595,11 +1595,14 @@ func changeAccountContracts(
 		memoryGauge := invocation.Interpreter.SharedState.Config.MemoryGauge
 		legacyUpgradeEnabled := invocation.Interpreter.SharedState.Config.LegacyContractUpgradeEnabled
 
-		oldProgram, err := parser.ParseProgram(
+		oldProgram, err := parser.ParseOrDecodeProgram(
 			memoryGauge,
 			oldCode,
 			parser.Config{
 				IgnoreLeadingIdentifierEnabled: true,
+				// The existing code was already accepted,
+				// so it may have declared type parameters
+				TypeParametersEnabled: true,
 			},
 		)
 
@@ -2031,7 +2034,7 @@ func newAccountContractsRemoveFunction(
 				// NOTE: *DO NOT* call setProgram – the program removal
 				// should not be effective during the execution, only after
 
-				existingProgram, err := parser.ParseProgram(gauge, code, parser.Config{})
+				existingProgram, err := parser.ParseOrDecodeProgram(gauge, code, parser.Config{})
 
 				// If the existing code is not parsable (i.e: `err != nil`),
 				// that shouldn't be a reason to fail the contract removal.
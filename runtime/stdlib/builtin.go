@@ -43,8 +43,11 @@ func DefaultStandardLibraryValues(handler StandardLibraryHandler) []StandardLibr
 		AssertFunction,
 		PanicFunction,
 		SignatureAlgorithmConstructor,
+		RoundingModeConstructor,
 		RLPContract,
+		FixedPointMathContract,
 		InclusiveRangeConstructorFunction,
+		SetConstructorFunction,
 		NewLogFunction(handler),
 		NewRevertibleRandomFunction(handler),
 		NewGetBlockFunction(handler),
@@ -221,6 +221,7 @@ func checkDeclarationUpdatability(
 
 	if newDecl, ok := newDeclaration.(*ast.CompositeDeclaration); ok {
 		if oldDecl, ok := oldDeclaration.(*ast.CompositeDeclaration); ok {
+			checkTypeParameters(validator, oldDecl, newDecl)
 			checkConformance(validator, oldDecl, newDecl)
 		}
 	}
@@ -444,6 +445,62 @@ func checkEnumCases(
 	}
 }
 
+func checkTypeParameters(
+	validator UpdateValidator,
+	oldDecl *ast.CompositeDeclaration,
+	newDecl *ast.CompositeDeclaration,
+) {
+	// The type parameters of a generic composite type must not change:
+	// Stored values of instantiations of the type record the type arguments,
+	// and the stored field values have the types of the type arguments.
+	// Adding, removing, or reordering type parameters, or changing their type bounds,
+	// could lead to type-safety issues
+
+	var oldTypeParameters, newTypeParameters []*ast.TypeParameter
+	if oldDecl.TypeParameterList != nil {
+		oldTypeParameters = oldDecl.TypeParameterList.TypeParameters
+	}
+	if newDecl.TypeParameterList != nil {
+		newTypeParameters = newDecl.TypeParameterList.TypeParameters
+	}
+
+	report := func() {
+		validator.report(&TypeParameterMismatchError{
+			DeclName: newDecl.Identifier.Identifier,
+			Range:    ast.NewUnmeteredRangeFromPositioned(newDecl.Identifier),
+		})
+	}
+
+	if len(oldTypeParameters) != len(newTypeParameters) {
+		report()
+		return
+	}
+
+	for index, oldTypeParameter := range oldTypeParameters {
+		newTypeParameter := newTypeParameters[index]
+
+		if oldTypeParameter.Identifier.Identifier != newTypeParameter.Identifier.Identifier {
+			report()
+			return
+		}
+
+		oldTypeBound := oldTypeParameter.TypeBound
+		newTypeBound := newTypeParameter.TypeBound
+
+		if (oldTypeBound == nil) != (newTypeBound == nil) {
+			report()
+			return
+		}
+
+		if oldTypeBound != nil &&
+			oldTypeBound.Type.CheckEqual(newTypeBound.Type, validator) != nil {
+
+			report()
+			return
+		}
+	}
+}
+
 func checkConformance(
 	validator UpdateValidator,
 	oldDecl *ast.CompositeDeclaration,
@@ -672,6 +729,21 @@ func (e *ConformanceMismatchError) Error() string {
 	return fmt.Sprintf("conformances does not match in `%s`", e.DeclName)
 }
 
+// TypeParameterMismatchError is reported during a contract update, when the type parameters
+// of a composite declaration of the new program do not match the existing ones.
+type TypeParameterMismatchError struct {
+	DeclName string
+	ast.Range
+}
+
+var _ errors.UserError = &TypeParameterMismatchError{}
+
+func (*TypeParameterMismatchError) IsUserError() {}
+
+func (e *TypeParameterMismatchError) Error() string {
+	return fmt.Sprintf("type parameters do not match in `%s`", e.DeclName)
+}
+
 // EnumCaseMismatchError is reported during an enum update, when an updated enum case
 // does not match the existing enum case.
 type EnumCaseMismatchError struct {
@@ -60,6 +60,11 @@ func CryptoContractInitializerTypes() []sema.Type {
 }
 
 func initCrypto() {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	for _, valueDeclaration := range CryptoContractNativeValues(nil) {
+		baseValueActivation.DeclareValue(valueDeclaration)
+	}
+
 	program, err := parser.ParseProgram(
 		nil,
 		contracts.Crypto,
@@ -75,6 +80,9 @@ func initCrypto() {
 		nil,
 		&sema.Config{
 			AccessCheckMode: sema.AccessCheckModeStrict,
+			BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+				return baseValueActivation
+			},
 		},
 	)
 	if err != nil {
@@ -123,7 +131,7 @@ func NewCryptoContract(
 	return compositeValue, nil
 }
 
-func cryptoAlgorithmEnumConstructorType[T sema.CryptoAlgorithm](
+func nativeEnumConstructorType[T sema.NativeEnumCase](
 	enumType *sema.CompositeType,
 	enumCases []T,
 ) *sema.FunctionType {
@@ -158,7 +166,7 @@ func cryptoAlgorithmEnumConstructorType[T sema.CryptoAlgorithm](
 
 type enumCaseConstructor func(rawValue interpreter.UInt8Value) interpreter.MemberAccessibleValue
 
-func cryptoAlgorithmEnumValueAndCaseValues[T sema.CryptoAlgorithm](
+func nativeEnumValueAndCaseValues[T sema.NativeEnumCase](
 	enumType *sema.CompositeType,
 	enumCases []T,
 	caseConstructor enumCaseConstructor,
@@ -0,0 +1,144 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// Error codes of the standard library errors.
+//
+// NOTE: Error codes are stable and part of the public API:
+// Never change or reuse the code of an error, only add new codes.
+
+// Execution
+
+func (ABIEncodingError) ErrorCode() errors.ErrorCode {
+	return 3042
+}
+
+func (ABIDecodingError) ErrorCode() errors.ErrorCode {
+	return 3043
+}
+
+func (AssertionError) ErrorCode() errors.ErrorCode {
+	return 3044
+}
+
+func (FixedPointMathError) ErrorCode() errors.ErrorCode {
+	return 3045
+}
+
+func (PanicError) ErrorCode() errors.ErrorCode {
+	return 3046
+}
+
+func (RLPDecodeStringError) ErrorCode() errors.ErrorCode {
+	return 3047
+}
+
+func (RLPDecodeListError) ErrorCode() errors.ErrorCode {
+	return 3048
+}
+
+func (RLPEncodeStringError) ErrorCode() errors.ErrorCode {
+	return 3049
+}
+
+func (RLPEncodeListError) ErrorCode() errors.ErrorCode {
+	return 3050
+}
+
+func (TestFailedError) ErrorCode() errors.ErrorCode {
+	return 3051
+}
+
+// Capability
+
+func (CapabilityControllersMutatedDuringIterationError) ErrorCode() errors.ErrorCode {
+	return 5003
+}
+
+// Account
+
+func (*InvalidContractDeploymentError) ErrorCode() errors.ErrorCode {
+	return 7001
+}
+
+func (*InvalidContractDeploymentOriginError) ErrorCode() errors.ErrorCode {
+	return 7002
+}
+
+func (*InvalidContractArgumentError) ErrorCode() errors.ErrorCode {
+	return 7003
+}
+
+func (*ContractRemovalError) ErrorCode() errors.ErrorCode {
+	return 7004
+}
+
+// Contract update
+
+func (*AuthorizationMismatchError) ErrorCode() errors.ErrorCode {
+	return 8001
+}
+
+func (*ContractUpdateError) ErrorCode() errors.ErrorCode {
+	return 8002
+}
+
+func (*FieldMismatchError) ErrorCode() errors.ErrorCode {
+	return 8003
+}
+
+func (*TypeMismatchError) ErrorCode() errors.ErrorCode {
+	return 8004
+}
+
+func (*ExtraneousFieldError) ErrorCode() errors.ErrorCode {
+	return 8005
+}
+
+func (*ContractNotFoundError) ErrorCode() errors.ErrorCode {
+	return 8006
+}
+
+func (*InvalidDeclarationKindChangeError) ErrorCode() errors.ErrorCode {
+	return 8007
+}
+
+func (*ConformanceMismatchError) ErrorCode() errors.ErrorCode {
+	return 8008
+}
+
+func (*TypeParameterMismatchError) ErrorCode() errors.ErrorCode {
+	return 8009
+}
+
+func (*EnumCaseMismatchError) ErrorCode() errors.ErrorCode {
+	return 8010
+}
+
+func (*MissingEnumCasesError) ErrorCode() errors.ErrorCode {
+	return 8011
+}
+
+func (*MissingDeclarationError) ErrorCode() errors.ErrorCode {
+	return 8012
+}
@@ -0,0 +1,415 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+//go:generate go run ../sema/gen -p stdlib fixedpointmath.cdc fixedpointmath.gen.go
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence/fixedpoint"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+type FixedPointMathError struct {
+	interpreter.LocationRange
+	Msg string
+}
+
+var _ errors.UserError = FixedPointMathError{}
+
+func (FixedPointMathError) IsUserError() {}
+
+func (e FixedPointMathError) Error() string {
+	return fmt.Sprintf("failed to compute fixed-point result: %s", e.Msg)
+}
+
+func panicFixedPointMathError(err error, locationRange interpreter.LocationRange) {
+	switch err {
+	case fixedpoint.ErrOverflow:
+		panic(interpreter.OverflowError{
+			LocationRange: locationRange,
+		})
+
+	case fixedpoint.ErrUnderflow:
+		panic(interpreter.UnderflowError{
+			LocationRange: locationRange,
+		})
+
+	case fixedpoint.ErrDivisionByZero:
+		panic(interpreter.DivisionByZeroError{
+			LocationRange: locationRange,
+		})
+
+	default:
+		panic(FixedPointMathError{
+			Msg:           err.Error(),
+			LocationRange: locationRange,
+		})
+	}
+}
+
+// fixedPointMathRoundingModes maps the rounding modes of the RoundingMode enum
+// to the rounding modes of the fixedpoint package
+var fixedPointMathRoundingModes = map[sema.RoundingMode]fixedpoint.RoundingMode{
+	sema.RoundingModeTowardZero:              fixedpoint.RoundTowardZero,
+	sema.RoundingModeAwayFromZero:            fixedpoint.RoundAwayFromZero,
+	sema.RoundingModeTowardNegativeInfinity:  fixedpoint.RoundTowardNegativeInfinity,
+	sema.RoundingModeTowardPositiveInfinity:  fixedpoint.RoundTowardPositiveInfinity,
+	sema.RoundingModeNearestHalfAwayFromZero: fixedpoint.RoundNearestHalfAwayFromZero,
+	sema.RoundingModeNearestHalfEven:         fixedpoint.RoundNearestHalfEven,
+}
+
+func fixedPointMathRoundingMode(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) fixedpoint.RoundingMode {
+	roundingMode, ok := fixedPointMathRoundingModes[NewRoundingModeFromValue(inter, locationRange, value)]
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return roundingMode
+}
+
+// fixedPointMathOperands returns the raw values of the given fixed-point values,
+// and the math for their type.
+//
+// All values must have the same type.
+// This is not guaranteed by the checker, e.g. when the type argument is explicitly `FixedPoint`.
+func fixedPointMathOperands(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	values ...interpreter.Value,
+) (
+	fixedpoint.Math,
+	[]*big.Int,
+) {
+	var math fixedpoint.Math
+	operands := make([]*big.Int, len(values))
+
+	for i, value := range values {
+		var operandMath fixedpoint.Math
+
+		switch value := value.(type) {
+		case interpreter.Fix64Value:
+			operandMath = fixedpoint.Fix64Math
+			operands[i] = big.NewInt(int64(value))
+
+		case interpreter.UFix64Value:
+			operandMath = fixedpoint.UFix64Math
+			operands[i] = new(big.Int).SetUint64(uint64(value))
+
+		case interpreter.Fix128Value:
+			operandMath = fixedpoint.Fix128Math
+			operands[i] = value.BigInt
+
+		case interpreter.UFix128Value:
+			operandMath = fixedpoint.UFix128Math
+			operands[i] = value.BigInt
+
+		default:
+			panic(errors.NewUnreachableError())
+		}
+
+		if i > 0 && values[0].StaticType(inter) != value.StaticType(inter) {
+			panic(FixedPointMathError{
+				Msg: fmt.Sprintf(
+					"mismatched types: %s and %s",
+					values[0].StaticType(inter),
+					value.StaticType(inter),
+				),
+				LocationRange: locationRange,
+			})
+		}
+
+		math = operandMath
+	}
+
+	return math, operands
+}
+
+// fixedPointMathResult returns the fixed-point value of the given type for the given raw value.
+// The raw value must be in the range of the type
+func fixedPointMathResult(
+	inter *interpreter.Interpreter,
+	semaType sema.Type,
+	raw *big.Int,
+) interpreter.Value {
+	switch semaType {
+	case sema.Fix64Type:
+		return interpreter.NewFix64Value(
+			inter,
+			func() int64 {
+				return raw.Int64()
+			},
+		)
+
+	case sema.UFix64Type:
+		return interpreter.NewUFix64Value(
+			inter,
+			func() uint64 {
+				return raw.Uint64()
+			},
+		)
+
+	case sema.Fix128Type:
+		return interpreter.NewFix128Value(
+			inter,
+			func() *big.Int {
+				return raw
+			},
+		)
+
+	case sema.UFix128Type:
+		return interpreter.NewUFix128Value(
+			inter,
+			func() *big.Int {
+				return raw
+			},
+		)
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func fixedPointMathOperandType(
+	inter *interpreter.Interpreter,
+	value interpreter.Value,
+) sema.Type {
+	return inter.MustConvertStaticToSemaType(value.StaticType(inter))
+}
+
+var fixedPointMathMulDivFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypeMulDivFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathMulDiv, 1)
+
+		math, operands := fixedPointMathOperands(inter, locationRange, arguments[0], arguments[1], arguments[2])
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[3])
+
+		result, err := math.MulDiv(operands[0], operands[1], operands[2], roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, fixedPointMathOperandType(inter, arguments[0]), result)
+	},
+)
+
+var fixedPointMathDivFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypeDivFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathDiv, 1)
+
+		math, operands := fixedPointMathOperands(inter, locationRange, arguments[0], arguments[1])
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[2])
+
+		result, err := math.Div(operands[0], operands[1], roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, fixedPointMathOperandType(inter, arguments[0]), result)
+	},
+)
+
+var fixedPointMathSqrtFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypeSqrtFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathSqrt, 1)
+
+		math, operands := fixedPointMathOperands(inter, locationRange, arguments[0])
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[1])
+
+		result, err := math.Sqrt(operands[0], roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, fixedPointMathOperandType(inter, arguments[0]), result)
+	},
+)
+
+var fixedPointMathPowIntFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypePowIntFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		exponentValue, ok := arguments[1].(interpreter.IntValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		math, operands := fixedPointMathOperands(inter, locationRange, arguments[0])
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[2])
+
+		if !exponentValue.BigInt.IsInt64() {
+			panicFixedPointMathError(fixedpoint.ErrExponentTooLarge, locationRange)
+		}
+		exponent := exponentValue.BigInt.Int64()
+
+		// The cost of the exponentiation grows with the magnitude of the exponent,
+		// which is bounded, unless the result is trivial
+		intensity := new(big.Int).Abs(exponentValue.BigInt)
+		if intensity.Cmp(big.NewInt(fixedpoint.MaxIntegerExponent)) > 0 {
+			intensity.SetInt64(fixedpoint.MaxIntegerExponent)
+		}
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathPowInt, uint(intensity.Uint64())+1)
+
+		result, err := math.PowInt(operands[0], exponent, roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, fixedPointMathOperandType(inter, arguments[0]), result)
+	},
+)
+
+var fixedPointMathPowFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypePowFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		base, ok := arguments[0].(interpreter.UFix64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		exponent, ok := arguments[1].(interpreter.Fix64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[2])
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathPow, 1)
+
+		result, err := fixedpoint.UFix64Math.Pow(
+			new(big.Int).SetUint64(uint64(base)),
+			big.NewInt(int64(exponent)),
+			roundingMode,
+		)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, sema.UFix64Type, result)
+	},
+)
+
+var fixedPointMathExpFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypeExpFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		x, ok := arguments[0].(interpreter.Fix64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[1])
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathExp, 1)
+
+		result, err := fixedpoint.UFix64Math.Exp(big.NewInt(int64(x)), roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, sema.UFix64Type, result)
+	},
+)
+
+var fixedPointMathLnFunction = interpreter.NewUnmeteredHostFunctionValue(
+	FixedPointMathTypeLnFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+		arguments := invocation.Arguments
+
+		x, ok := arguments[0].(interpreter.UFix64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		roundingMode := fixedPointMathRoundingMode(inter, locationRange, arguments[1])
+
+		inter.ReportComputation(common.ComputationKindSTDLIBFixedPointMathLn, 1)
+
+		result, err := fixedpoint.Fix64Math.Ln(new(big.Int).SetUint64(uint64(x)), roundingMode)
+		if err != nil {
+			panicFixedPointMathError(err, locationRange)
+		}
+
+		return fixedPointMathResult(inter, sema.Fix64Type, result)
+	},
+)
+
+var fixedPointMathContractFields = map[string]interpreter.Value{
+	FixedPointMathTypeMulDivFunctionName: fixedPointMathMulDivFunction,
+	FixedPointMathTypeDivFunctionName:    fixedPointMathDivFunction,
+	FixedPointMathTypeSqrtFunctionName:   fixedPointMathSqrtFunction,
+	FixedPointMathTypePowIntFunctionName: fixedPointMathPowIntFunction,
+	FixedPointMathTypePowFunctionName:    fixedPointMathPowFunction,
+	FixedPointMathTypeExpFunctionName:    fixedPointMathExpFunction,
+	FixedPointMathTypeLnFunctionName:     fixedPointMathLnFunction,
+}
+
+var FixedPointMathTypeStaticType = interpreter.ConvertSemaToStaticType(nil, FixedPointMathType)
+
+var fixedPointMathContractValue = interpreter.NewSimpleCompositeValue(
+	nil,
+	FixedPointMathType.ID(),
+	FixedPointMathTypeStaticType,
+	nil,
+	fixedPointMathContractFields,
+	nil,
+	nil,
+	nil,
+)
+
+var FixedPointMathContract = StandardLibraryValue{
+	Name:  FixedPointMathTypeName,
+	Type:  FixedPointMathType,
+	Value: fixedPointMathContractValue,
+	Kind:  common.DeclarationKindContract,
+}
@@ -0,0 +1,207 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func newFixedPointMathTestInterpreter(t *testing.T, code string) *interpreter.Interpreter {
+	return newInterpreter(t,
+		code,
+		AssertFunction,
+		RoundingModeConstructor,
+		FixedPointMathContract,
+	)
+}
+
+func TestFixedPointMath(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, expression string, expected interpreter.Value) {
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := newFixedPointMathTestInterpreter(t,
+				"access(all) fun test(): AnyStruct { return "+expression+" }",
+			)
+
+			result, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	test(
+		"mulDiv",
+		"FixedPointMath.mulDiv(1.0, 2.0, 3.0, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix64Value(66666666),
+	)
+	test(
+		"mulDiv, rounding up",
+		"FixedPointMath.mulDiv(1.0, 2.0, 3.0, rounding: RoundingMode.towardPositiveInfinity)",
+		interpreter.NewUnmeteredUFix64Value(66666667),
+	)
+	test(
+		"mulDiv, large intermediate",
+		"FixedPointMath.mulDiv(184467440737.0, 100.0, 200.0, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix64Value(9223372036850000000),
+	)
+	test(
+		"div, Fix64, negative",
+		"FixedPointMath.div(-1.0, 3.0, rounding: RoundingMode.towardNegativeInfinity)",
+		interpreter.NewUnmeteredFix64Value(-33333334),
+	)
+	test(
+		"div, half even",
+		"FixedPointMath.div(0.00000005, 2.0, rounding: RoundingMode.nearestHalfEven)",
+		interpreter.NewUnmeteredUFix64Value(2),
+	)
+	test(
+		"sqrt",
+		"FixedPointMath.sqrt(2.0, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix64Value(141421356),
+	)
+	test(
+		"sqrt, exact",
+		"FixedPointMath.sqrt(6.25, rounding: RoundingMode.awayFromZero)",
+		interpreter.NewUnmeteredUFix64Value(250000000),
+	)
+	test(
+		"powInt",
+		"FixedPointMath.powInt(1.5, 3, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix64Value(337500000),
+	)
+	test(
+		"powInt, negative exponent",
+		"FixedPointMath.powInt(Fix64(-2.0), -2, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredFix64Value(25000000),
+	)
+	test(
+		"pow",
+		"FixedPointMath.pow(4.0, 0.5, rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix64Value(200000000),
+	)
+	test(
+		"exp",
+		"FixedPointMath.exp(1.0, rounding: RoundingMode.nearestHalfEven)",
+		interpreter.NewUnmeteredUFix64Value(271828183),
+	)
+	test(
+		"ln",
+		"FixedPointMath.ln(0.5, rounding: RoundingMode.nearestHalfEven)",
+		interpreter.NewUnmeteredFix64Value(-69314718),
+	)
+	test(
+		"mulDiv, UFix128",
+		"FixedPointMath.mulDiv(UFix128(1.0), UFix128(2.0), UFix128(3.0), rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix128Value(testBigInt("666666666666666666666666")),
+	)
+	test(
+		"div, Fix128, negative",
+		"FixedPointMath.div(Fix128(-1.0), Fix128(3.0), rounding: RoundingMode.towardNegativeInfinity)",
+		interpreter.NewUnmeteredFix128Value(testBigInt("-333333333333333333333334")),
+	)
+	test(
+		"sqrt, UFix128",
+		"FixedPointMath.sqrt(UFix128(2.0), rounding: RoundingMode.towardZero)",
+		interpreter.NewUnmeteredUFix128Value(testBigInt("1414213562373095048801688")),
+	)
+}
+
+func testBigInt(s string) *big.Int {
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big integer: " + s)
+	}
+	return value
+}
+
+func TestFixedPointMathErrors(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, expression string, check func(t *testing.T, err error)) {
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := newFixedPointMathTestInterpreter(t,
+				"access(all) fun test(): AnyStruct { return "+expression+" }",
+			)
+
+			_, err := inter.Invoke("test")
+			require.Error(t, err)
+
+			check(t, err)
+		})
+	}
+
+	test(
+		"overflow",
+		"FixedPointMath.mulDiv(184467440737.0, 2.0, 1.0, rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &interpreter.OverflowError{})
+		},
+	)
+	test(
+		"underflow",
+		"FixedPointMath.powInt(Fix64(-92233720368.0), 3, rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &interpreter.UnderflowError{})
+		},
+	)
+	test(
+		"division by zero",
+		"FixedPointMath.div(1.0, 0.0, rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+		},
+	)
+	test(
+		"ln of zero",
+		"FixedPointMath.ln(0.0, rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &FixedPointMathError{})
+		},
+	)
+	test(
+		"exponent too large",
+		"FixedPointMath.powInt(1.0, 100000000000000000000, rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &FixedPointMathError{})
+		},
+	)
+	test(
+		"mismatched types",
+		"FixedPointMath.div<FixedPoint>(Fix64(1.0), UFix64(1.0), rounding: RoundingMode.towardZero)",
+		func(t *testing.T, err error) {
+			require.ErrorAs(t, err, &FixedPointMathError{})
+		},
+	)
+}
@@ -161,7 +161,7 @@ func hash(
 
 func NewHashAlgorithmConstructor(hasher Hasher) StandardLibraryValue {
 
-	hashAlgorithmConstructorValue, _ := cryptoAlgorithmEnumValueAndCaseValues(
+	hashAlgorithmConstructorValue, _ := nativeEnumValueAndCaseValues(
 		sema.HashAlgorithmType,
 		sema.HashAlgorithms,
 		func(rawValue interpreter.UInt8Value) interpreter.MemberAccessibleValue {
@@ -173,7 +173,7 @@ func NewHashAlgorithmConstructor(hasher Hasher) StandardLibraryValue {
 
 	return StandardLibraryValue{
 		Name: sema.HashAlgorithmTypeName,
-		Type: cryptoAlgorithmEnumConstructorType(
+		Type: nativeEnumConstructorType(
 			sema.HashAlgorithmType,
 			sema.HashAlgorithms,
 		),
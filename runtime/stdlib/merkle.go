@@ -0,0 +1,468 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"bytes"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib/rlp"
+)
+
+// The Crypto contract is implemented in Cadence (see contracts/crypto.cdc).
+// Functionality which would be too costly to implement in Cadence is implemented natively.
+// The native functions are only declared for the location of the Crypto contract,
+// and are wrapped by functions of the contract.
+
+// CryptoContractNativeValues returns the native values used by the Crypto contract.
+// They must be declared for the location of the Crypto contract (CryptoCheckerLocation).
+func CryptoContractNativeValues(hasher Hasher) []StandardLibraryValue {
+	return []StandardLibraryValue{
+		newCryptoVerifyMerkleProofFunction(hasher),
+		newCryptoVerifyEthereumTrieProofFunction(hasher),
+	}
+}
+
+var byteArrayType = &sema.VariableSizedType{
+	Type: sema.UInt8Type,
+}
+
+var byteArraysType = &sema.VariableSizedType{
+	Type: byteArrayType,
+}
+
+// nativeVerifyMerkleProof
+
+const cryptoVerifyMerkleProofFunctionName = "nativeVerifyMerkleProof"
+
+var cryptoVerifyMerkleProofFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "proof",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArraysType),
+		},
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "root",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "leaf",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "index",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.OptionalType{
+					Type: sema.UInt64Type,
+				},
+			),
+		},
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "algorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.HashAlgorithmType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.BoolType),
+}
+
+func newCryptoVerifyMerkleProofFunction(hasher Hasher) StandardLibraryValue {
+	return NewStandardLibraryFunction(
+		cryptoVerifyMerkleProofFunctionName,
+		cryptoVerifyMerkleProofFunctionType,
+		"",
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			proof := byteArraysValueToByteSlices(inter, locationRange, invocation.Arguments[0])
+			root := byteArrayValueToByteSlice(inter, locationRange, invocation.Arguments[1])
+			leaf := byteArrayValueToByteSlice(inter, locationRange, invocation.Arguments[2])
+
+			var index *uint64
+			switch indexValue := invocation.Arguments[3].(type) {
+			case interpreter.NilValue:
+				// NO-OP
+			case *interpreter.SomeValue:
+				innerValue, ok := indexValue.InnerValue(inter, locationRange).(interpreter.UInt64Value)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				position := uint64(innerValue)
+				index = &position
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			hashAlgorithm := NewHashAlgorithmFromValue(inter, locationRange, invocation.Arguments[4])
+
+			result := verifyMerkleProof(
+				proof,
+				root,
+				leaf,
+				index,
+				newMerkleProofHashFunction(inter, hasher, hashAlgorithm),
+			)
+
+			return interpreter.AsBoolValue(result)
+		},
+	)
+}
+
+// nativeVerifyEthereumTrieProof
+
+const cryptoVerifyEthereumTrieProofFunctionName = "nativeVerifyEthereumTrieProof"
+
+var cryptoVerifyEthereumTrieProofFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "proof",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArraysType),
+		},
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "root",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "key",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.OptionalType{
+			Type: byteArrayType,
+		},
+	),
+}
+
+func newCryptoVerifyEthereumTrieProofFunction(hasher Hasher) StandardLibraryValue {
+	return NewStandardLibraryFunction(
+		cryptoVerifyEthereumTrieProofFunctionName,
+		cryptoVerifyEthereumTrieProofFunctionType,
+		"",
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			proof := byteArraysValueToByteSlices(inter, locationRange, invocation.Arguments[0])
+			root := byteArrayValueToByteSlice(inter, locationRange, invocation.Arguments[1])
+			key := byteArrayValueToByteSlice(inter, locationRange, invocation.Arguments[2])
+
+			value, ok := verifyEthereumTrieProof(
+				proof,
+				root,
+				key,
+				newMerkleProofHashFunction(inter, hasher, sema.HashAlgorithmKECCAK_256),
+			)
+			if !ok {
+				return interpreter.Nil
+			}
+
+			return interpreter.NewSomeValueNonCopying(
+				inter,
+				interpreter.ByteSliceToByteArrayValue(inter, value),
+			)
+		},
+	)
+}
+
+func byteArrayValueToByteSlice(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) []byte {
+	result, err := interpreter.ByteArrayValueToByteSlice(inter, value, locationRange)
+	if err != nil {
+		panic(errors.NewUnexpectedError("failed to get bytes. %w", err))
+	}
+	return result
+}
+
+func byteArraysValueToByteSlices(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) [][]byte {
+	arrayValue, ok := value.(*interpreter.ArrayValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	result := make([][]byte, 0, arrayValue.Count())
+	arrayValue.Iterate(inter, func(element interpreter.Value) (resume bool) {
+		result = append(result, byteArrayValueToByteSlice(inter, locationRange, element))
+		return true
+	})
+	return result
+}
+
+// newMerkleProofHashFunction returns a function which hashes data using the given hasher and algorithm.
+// Computation is reported for each hash.
+func newMerkleProofHashFunction(
+	inter *interpreter.Interpreter,
+	hasher Hasher,
+	hashAlgorithm sema.HashAlgorithm,
+) func(data []byte) []byte {
+	return func(data []byte) []byte {
+		inter.ReportComputation(common.ComputationKindSTDLIBCryptoMerkleProofHash, 1)
+
+		var result []byte
+		var err error
+		errors.WrapPanic(func() {
+			result, err = hasher.Hash(data, "", hashAlgorithm)
+		})
+		if err != nil {
+			panic(interpreter.WrappedExternalError(err))
+		}
+		return result
+	}
+}
+
+// verifyMerkleProof returns true if the given proof proves
+// that the given leaf (hash) is included in the binary Merkle tree with the given root.
+// The proof consists of the sibling nodes on the path from the leaf to the root.
+//
+// If the index of the leaf is nil, sibling nodes are paired in sorted order,
+// i.e. the bytewise smaller node is hashed first.
+// Otherwise, sibling nodes are paired by position:
+// The bits of the index, starting with the least significant bit,
+// determine if the node is the left (0) or the right (1) child of its parent.
+func verifyMerkleProof(
+	proof [][]byte,
+	root []byte,
+	leaf []byte,
+	index *uint64,
+	hash func(data []byte) []byte,
+) bool {
+
+	var position uint64
+	if index != nil {
+		position = *index
+	}
+
+	node := leaf
+
+	for _, sibling := range proof {
+		var isRight bool
+		if index == nil {
+			isRight = bytes.Compare(node, sibling) > 0
+		} else {
+			isRight = position&1 == 1
+			position >>= 1
+		}
+
+		data := make([]byte, 0, len(node)+len(sibling))
+		if isRight {
+			data = append(data, sibling...)
+			data = append(data, node...)
+		} else {
+			data = append(data, node...)
+			data = append(data, sibling...)
+		}
+
+		node = hash(data)
+	}
+
+	// The index must not be larger than the number of leaves of the tree
+
+	if position != 0 {
+		return false
+	}
+
+	return bytes.Equal(node, root)
+}
+
+const ethereumTrieBranchNodeItemCount = 17
+const ethereumTrieShortNodeItemCount = 2
+const ethereumTrieHashLength = 32
+
+// verifyEthereumTrieProof returns the value stored under the given key
+// in the Ethereum Merkle-Patricia trie with the given root hash,
+// if the given proof proves the inclusion of the key.
+//
+// The proof consists of the RLP-encoded nodes on the path from the root to the value,
+// like the proofs returned by Ethereum's `eth_getProof`.
+// Nodes which are embedded in their parent nodes do not have to be included.
+//
+// Returns false if the proof is invalid, or if it proves the exclusion of the key.
+func verifyEthereumTrieProof(
+	proof [][]byte,
+	root []byte,
+	key []byte,
+	hash func(data []byte) []byte,
+) (
+	value []byte,
+	ok bool,
+) {
+	path := keyToNibbles(key)
+
+	expectedHash := root
+	proofIndex := 0
+
+	// node is the current node, if it was embedded in its parent node.
+	// Otherwise, the node is the next node of the proof,
+	// and must have the expected hash
+	var node []byte
+
+	for {
+		if node == nil {
+			if proofIndex >= len(proof) {
+				return nil, false
+			}
+
+			node = proof[proofIndex]
+			proofIndex++
+
+			if !bytes.Equal(hash(node), expectedHash) {
+				return nil, false
+			}
+		}
+
+		items, bytesRead, err := rlp.DecodeList(node, 0)
+		if err != nil || bytesRead != len(node) {
+			return nil, false
+		}
+
+		var child []byte
+
+		switch len(items) {
+		case ethereumTrieBranchNodeItemCount:
+			if len(path) == 0 {
+				return decodeEthereumTrieValue(items[ethereumTrieBranchNodeItemCount-1])
+			}
+
+			child = items[path[0]]
+			path = path[1:]
+
+		case ethereumTrieShortNodeItemCount:
+			encodedNodePath, ok := decodeRLPString(items[0])
+			if !ok {
+				return nil, false
+			}
+
+			nodePath, isLeaf, ok := decodeHexPrefix(encodedNodePath)
+			if !ok {
+				return nil, false
+			}
+
+			if isLeaf {
+				if !bytes.Equal(nodePath, path) {
+					return nil, false
+				}
+
+				return decodeEthereumTrieValue(items[1])
+			}
+
+			// Extension node
+			if len(nodePath) == 0 || !bytes.HasPrefix(path, nodePath) {
+				return nil, false
+			}
+
+			child = items[1]
+			path = path[len(nodePath):]
+
+		default:
+			return nil, false
+		}
+
+		// The child is either a reference to the next node (its hash),
+		// an empty string if there is no child,
+		// or the node itself, if it is embedded
+
+		isString, _, _, err := rlp.ReadSize(child, 0)
+		if err != nil {
+			return nil, false
+		}
+
+		if isString {
+			childHash, ok := decodeRLPString(child)
+			if !ok || len(childHash) != ethereumTrieHashLength {
+				return nil, false
+			}
+
+			expectedHash = childHash
+			node = nil
+		} else {
+			node = child
+		}
+	}
+}
+
+func decodeRLPString(encoded []byte) ([]byte, bool) {
+	str, bytesRead, err := rlp.DecodeString(encoded, 0)
+	if err != nil || bytesRead != len(encoded) {
+		return nil, false
+	}
+	return str, true
+}
+
+func decodeEthereumTrieValue(encoded []byte) ([]byte, bool) {
+	value, ok := decodeRLPString(encoded)
+	if !ok || len(value) == 0 {
+		return nil, false
+	}
+	return value, true
+}
+
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+// decodeHexPrefix decodes the hex-prefix encoded path of a leaf or extension node
+func decodeHexPrefix(encoded []byte) (nibbles []byte, isLeaf bool, ok bool) {
+	if len(encoded) == 0 {
+		return nil, false, false
+	}
+
+	flags := encoded[0] >> 4
+	if flags > 3 {
+		return nil, false, false
+	}
+
+	isLeaf = flags&2 != 0
+	isOdd := flags&1 != 0
+
+	nibbles = make([]byte, 0, len(encoded)*2)
+
+	if isOdd {
+		nibbles = append(nibbles, encoded[0]&0x0f)
+	} else if encoded[0]&0x0f != 0 {
+		return nil, false, false
+	}
+
+	nibbles = append(nibbles, keyToNibbles(encoded[1:])...)
+
+	return nibbles, isLeaf, true
+}
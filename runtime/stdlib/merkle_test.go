@@ -0,0 +1,297 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/stdlib/rlp"
+)
+
+func testKeccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
+
+func testSHA3_256(data []byte) []byte {
+	hash := sha3.Sum256(data)
+	return hash[:]
+}
+
+func TestVerifyMerkleProof(t *testing.T) {
+
+	t.Parallel()
+
+	hashPair := func(left, right []byte) []byte {
+		return testSHA3_256(append(append([]byte{}, left...), right...))
+	}
+
+	// Tree with four leaves:
+	//
+	//          root
+	//        /      \
+	//     n01        n23
+	//    /   \      /   \
+	//   l0   l1    l2   l3
+
+	l0 := testSHA3_256([]byte("a"))
+	l1 := testSHA3_256([]byte("b"))
+	l2 := testSHA3_256([]byte("c"))
+	l3 := testSHA3_256([]byte("d"))
+
+	t.Run("positional", func(t *testing.T) {
+
+		t.Parallel()
+
+		n01 := hashPair(l0, l1)
+		n23 := hashPair(l2, l3)
+		root := hashPair(n01, n23)
+
+		index := func(i uint64) *uint64 {
+			return &i
+		}
+
+		assert.True(t, verifyMerkleProof([][]byte{l1, n23}, root, l0, index(0), testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l0, n23}, root, l1, index(1), testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l3, n01}, root, l2, index(2), testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l2, n01}, root, l3, index(3), testSHA3_256))
+
+		// Wrong index
+		assert.False(t, verifyMerkleProof([][]byte{l1, n23}, root, l0, index(1), testSHA3_256))
+		// Index larger than the tree
+		assert.False(t, verifyMerkleProof([][]byte{l1, n23}, root, l0, index(4), testSHA3_256))
+		// Wrong leaf
+		assert.False(t, verifyMerkleProof([][]byte{l1, n23}, root, l2, index(0), testSHA3_256))
+		// Incomplete proof
+		assert.False(t, verifyMerkleProof([][]byte{l1}, root, l0, index(0), testSHA3_256))
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+
+		t.Parallel()
+
+		hashSortedPair := func(a, b []byte) []byte {
+			if string(a) > string(b) {
+				a, b = b, a
+			}
+			return hashPair(a, b)
+		}
+
+		n01 := hashSortedPair(l0, l1)
+		n23 := hashSortedPair(l2, l3)
+		root := hashSortedPair(n01, n23)
+
+		assert.True(t, verifyMerkleProof([][]byte{l1, n23}, root, l0, nil, testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l0, n23}, root, l1, nil, testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l3, n01}, root, l2, nil, testSHA3_256))
+		assert.True(t, verifyMerkleProof([][]byte{l2, n01}, root, l3, nil, testSHA3_256))
+
+		// Wrong leaf
+		assert.False(t, verifyMerkleProof([][]byte{l1, n23}, root, l2, nil, testSHA3_256))
+		// Wrong root
+		assert.False(t, verifyMerkleProof([][]byte{l1, n23}, n01, l0, nil, testSHA3_256))
+	})
+
+	t.Run("empty proof", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.True(t, verifyMerkleProof(nil, l0, l0, nil, testSHA3_256))
+		assert.False(t, verifyMerkleProof(nil, l1, l0, nil, testSHA3_256))
+	})
+}
+
+func TestVerifyEthereumTrieProof(t *testing.T) {
+
+	t.Parallel()
+
+	encodeString := func(str []byte) []byte {
+		encoded, err := rlp.EncodeString(str)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	encodeList := func(items ...[]byte) []byte {
+		encoded, err := rlp.EncodeList(items)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	hexPrefix := func(nibbles []byte, isLeaf bool) []byte {
+		var flags byte
+		if isLeaf {
+			flags = 2
+		}
+
+		var result []byte
+		if len(nibbles)%2 == 1 {
+			result = append(result, (flags+1)<<4|nibbles[0])
+			nibbles = nibbles[1:]
+		} else {
+			result = append(result, flags<<4)
+		}
+
+		for i := 0; i < len(nibbles); i += 2 {
+			result = append(result, nibbles[i]<<4|nibbles[i+1])
+		}
+
+		return result
+	}
+
+	newBranch := func(children map[byte][]byte) []byte {
+		items := make([][]byte, ethereumTrieBranchNodeItemCount)
+		for i := range items {
+			child, ok := children[byte(i)]
+			if ok {
+				items[i] = child
+			} else {
+				items[i] = encodeString(nil)
+			}
+		}
+		return encodeList(items...)
+	}
+
+	t.Run("hashed nodes", func(t *testing.T) {
+
+		t.Parallel()
+
+		key1 := testKeccak256([]byte("key1"))
+		key2 := testKeccak256([]byte("key2"))
+		require.NotEqual(t, key1[0]>>4, key2[0]>>4)
+
+		value1 := testKeccak256([]byte("value1"))
+		value2 := testKeccak256([]byte("value2"))
+
+		leaf1 := encodeList(
+			encodeString(hexPrefix(keyToNibbles(key1)[1:], true)),
+			encodeString(value1),
+		)
+		leaf2 := encodeList(
+			encodeString(hexPrefix(keyToNibbles(key2)[1:], true)),
+			encodeString(value2),
+		)
+
+		branch := newBranch(map[byte][]byte{
+			key1[0] >> 4: encodeString(testKeccak256(leaf1)),
+			key2[0] >> 4: encodeString(testKeccak256(leaf2)),
+		})
+
+		root := testKeccak256(branch)
+
+		value, ok := verifyEthereumTrieProof([][]byte{branch, leaf1}, root, key1, testKeccak256)
+		require.True(t, ok)
+		assert.Equal(t, value1, value)
+
+		value, ok = verifyEthereumTrieProof([][]byte{branch, leaf2}, root, key2, testKeccak256)
+		require.True(t, ok)
+		assert.Equal(t, value2, value)
+
+		// Proof for other key
+		_, ok = verifyEthereumTrieProof([][]byte{branch, leaf2}, root, key1, testKeccak256)
+		assert.False(t, ok)
+
+		// Missing node
+		_, ok = verifyEthereumTrieProof([][]byte{branch}, root, key1, testKeccak256)
+		assert.False(t, ok)
+
+		// Wrong root
+		_, ok = verifyEthereumTrieProof([][]byte{branch, leaf1}, testKeccak256(leaf1), key1, testKeccak256)
+		assert.False(t, ok)
+
+		// Tampered value
+		tamperedLeaf1 := encodeList(
+			encodeString(hexPrefix(keyToNibbles(key1)[1:], true)),
+			encodeString(value2),
+		)
+		_, ok = verifyEthereumTrieProof([][]byte{branch, tamperedLeaf1}, root, key1, testKeccak256)
+		assert.False(t, ok)
+
+		// Key not in trie
+		var key3 []byte
+		for i := 0; key3 == nil; i++ {
+			candidate := testKeccak256([]byte{byte(i)})
+			nibble := candidate[0] >> 4
+			if nibble != key1[0]>>4 && nibble != key2[0]>>4 {
+				key3 = candidate
+			}
+		}
+		_, ok = verifyEthereumTrieProof([][]byte{branch}, root, key3, testKeccak256)
+		assert.False(t, ok)
+
+		// Invalid node encoding
+		_, ok = verifyEthereumTrieProof([][]byte{{0x1, 0x2}}, testKeccak256([]byte{0x1, 0x2}), key1, testKeccak256)
+		assert.False(t, ok)
+	})
+
+	t.Run("extension and embedded nodes", func(t *testing.T) {
+
+		t.Parallel()
+
+		key1 := []byte{0xab, 0x01}
+		key2 := []byte{0xab, 0x02}
+
+		// Short nodes are embedded in their parents
+
+		leaf1 := encodeList(
+			encodeString(hexPrefix(nil, true)),
+			encodeString([]byte("a")),
+		)
+		leaf2 := encodeList(
+			encodeString(hexPrefix(nil, true)),
+			encodeString([]byte("b")),
+		)
+
+		branch := newBranch(map[byte][]byte{
+			1: leaf1,
+			2: leaf2,
+		})
+
+		extension := encodeList(
+			encodeString(hexPrefix([]byte{0xa, 0xb, 0x0}, false)),
+			branch,
+		)
+
+		root := testKeccak256(extension)
+
+		value, ok := verifyEthereumTrieProof([][]byte{extension}, root, key1, testKeccak256)
+		require.True(t, ok)
+		assert.Equal(t, []byte("a"), value)
+
+		value, ok = verifyEthereumTrieProof([][]byte{extension}, root, key2, testKeccak256)
+		require.True(t, ok)
+		assert.Equal(t, []byte("b"), value)
+
+		// Key diverging from extension
+		_, ok = verifyEthereumTrieProof([][]byte{extension}, root, []byte{0xac, 0x01}, testKeccak256)
+		assert.False(t, ok)
+
+		// Key not in branch
+		_, ok = verifyEthereumTrieProof([][]byte{extension}, root, []byte{0xab, 0x03}, testKeccak256)
+		assert.False(t, ok)
+
+		// Key ending at branch without value
+		_, ok = verifyEthereumTrieProof([][]byte{extension}, root, []byte{0xab}, testKeccak256)
+		assert.False(t, ok)
+	})
+}
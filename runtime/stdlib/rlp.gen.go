@@ -82,6 +82,62 @@ if the encoded value type does not match, or it has trailing unnecessary bytes,
 If any error is encountered while decoding, the program aborts.
 `
 
+const RLPTypeEncodeStringFunctionName = "encodeString"
+
+var RLPTypeEncodeStringFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []sema.Parameter{
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "input",
+			TypeAnnotation: sema.NewTypeAnnotation(&sema.VariableSizedType{
+				Type: sema.UInt8Type,
+			}),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.VariableSizedType{
+			Type: sema.UInt8Type,
+		},
+	),
+}
+
+const RLPTypeEncodeStringFunctionDocString = `
+Encodes the given byte array as an RLP-encoded byte array (called string in the context of RLP).
+The result is in the canonical form, so it can be decoded using ` + "`decodeString`" + `.
+If the byte array is too large to be encoded, the program aborts.
+`
+
+const RLPTypeEncodeListFunctionName = "encodeList"
+
+var RLPTypeEncodeListFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
+	Parameters: []sema.Parameter{
+		{
+			Label:      sema.ArgumentLabelNotRequired,
+			Identifier: "items",
+			TypeAnnotation: sema.NewTypeAnnotation(&sema.VariableSizedType{
+				Type: &sema.VariableSizedType{
+					Type: sema.UInt8Type,
+				},
+			}),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.VariableSizedType{
+			Type: sema.UInt8Type,
+		},
+	),
+}
+
+const RLPTypeEncodeListFunctionDocString = `
+Encodes the given array of RLP-encoded items as an RLP-encoded list.
+Note that this function does not recursively encode, so each element of the given array must already be RLP-encoded data,
+e.g. the result of ` + "`encodeString`" + ` or ` + "`encodeList`" + `, which allows encoding nested lists.
+The result is in the canonical form, so it can be decoded using ` + "`decodeList`" + `.
+If any item is not a single RLP-encoded value, or the list is too large to be encoded, the program aborts.
+`
+
 const RLPTypeName = "RLP"
 
 var RLPType = func() *sema.CompositeType {
@@ -111,6 +167,20 @@ func init() {
 			RLPTypeDecodeListFunctionType,
 			RLPTypeDecodeListFunctionDocString,
 		),
+		sema.NewUnmeteredFunctionMember(
+			RLPType,
+			sema.PrimitiveAccess(ast.AccessAll),
+			RLPTypeEncodeStringFunctionName,
+			RLPTypeEncodeStringFunctionType,
+			RLPTypeEncodeStringFunctionDocString,
+		),
+		sema.NewUnmeteredFunctionMember(
+			RLPType,
+			sema.PrimitiveAccess(ast.AccessAll),
+			RLPTypeEncodeListFunctionName,
+			RLPTypeEncodeListFunctionType,
+			RLPTypeEncodeListFunctionDocString,
+		),
 	}
 
 	RLPType.Members = sema.MembersAsMap(members)
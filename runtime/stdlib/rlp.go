@@ -147,9 +147,118 @@ var rlpDecodeListFunction = interpreter.NewUnmeteredHostFunctionValue(
 	},
 )
 
+type RLPEncodeStringError struct {
+	interpreter.LocationRange
+	Msg string
+}
+
+var _ errors.UserError = RLPEncodeStringError{}
+
+func (RLPEncodeStringError) IsUserError() {}
+
+func (e RLPEncodeStringError) Error() string {
+	return fmt.Sprintf("failed to RLP-encode string: %s", e.Msg)
+}
+
+var rlpEncodeStringFunction = interpreter.NewUnmeteredHostFunctionValue(
+	RLPTypeEncodeStringFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		input, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		invocation.Interpreter.ReportComputation(common.ComputationKindSTDLIBRLPEncodeString, uint(input.Count()))
+
+		locationRange := invocation.LocationRange
+
+		convertedInput, err := interpreter.ByteArrayValueToByteSlice(invocation.Interpreter, input, locationRange)
+		if err != nil {
+			panic(RLPEncodeStringError{
+				Msg:           err.Error(),
+				LocationRange: locationRange,
+			})
+		}
+
+		output, err := rlp.EncodeString(convertedInput)
+		if err != nil {
+			panic(RLPEncodeStringError{
+				Msg:           err.Error(),
+				LocationRange: locationRange,
+			})
+		}
+
+		return interpreter.ByteSliceToByteArrayValue(invocation.Interpreter, output)
+	},
+)
+
+type RLPEncodeListError struct {
+	interpreter.LocationRange
+	Msg string
+}
+
+var _ errors.UserError = RLPEncodeListError{}
+
+func (RLPEncodeListError) IsUserError() {}
+
+func (e RLPEncodeListError) Error() string {
+	return fmt.Sprintf("failed to RLP-encode list: %s", e.Msg)
+}
+
+var rlpEncodeListFunction = interpreter.NewUnmeteredHostFunctionValue(
+	RLPTypeEncodeListFunctionType,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		input, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+
+		items := make([][]byte, 0, input.Count())
+
+		input.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				itemArray, ok := element.(*interpreter.ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter.ReportComputation(common.ComputationKindSTDLIBRLPEncodeList, uint(itemArray.Count()))
+
+				item, err := interpreter.ByteArrayValueToByteSlice(inter, itemArray, locationRange)
+				if err != nil {
+					panic(RLPEncodeListError{
+						Msg:           err.Error(),
+						LocationRange: locationRange,
+					})
+				}
+
+				items = append(items, item)
+
+				return true
+			},
+		)
+
+		output, err := rlp.EncodeList(items)
+		if err != nil {
+			panic(RLPEncodeListError{
+				Msg:           err.Error(),
+				LocationRange: locationRange,
+			})
+		}
+
+		return interpreter.ByteSliceToByteArrayValue(inter, output)
+	},
+)
+
 var rlpContractFields = map[string]interpreter.Value{
 	RLPTypeDecodeListFunctionName:   rlpDecodeListFunction,
 	RLPTypeDecodeStringFunctionName: rlpDecodeStringFunction,
+	RLPTypeEncodeListFunctionName:   rlpEncodeListFunction,
+	RLPTypeEncodeStringFunctionName: rlpEncodeStringFunction,
 }
 
 var RLPTypeStaticType = interpreter.ConvertSemaToStaticType(nil, RLPType)
@@ -47,6 +47,7 @@ var (
 	ErrDataSizeTooLarge  = errors.New("data size is larger than what is supported")
 	ErrListSizeMismatch  = errors.New("list size doesn't match the size of items")
 	ErrTypeMismatch      = errors.New("type extracted from input doesn't match the function")
+	ErrInvalidListItem   = errors.New("list item is not a single RLP-encoded value")
 )
 
 // ReadSize looks at the first byte at startIndex to decode the type and reads as many bytes as needed
@@ -242,3 +243,108 @@ func DecodeList(inp []byte, startIndex int) (encodedItems [][]byte, bytesRead in
 
 	return retList, itemEndIndex - startIndex, nil
 }
+
+// EncodeString encodes the given byte array as a RLP string (byte array).
+// it only produces the RLP canonical form:
+//   - a single byte in the range [0x00, 0x7f] is its own encoding
+//   - a string of 0-55 bytes is prefixed with a single byte [0x80, 0xb7] holding its length
+//   - a longer string is prefixed with a byte [0xb8, 0xbf] holding the number of bytes of its length,
+//     followed by the length, encoded in big-endian without leading zeros
+func EncodeString(str []byte) ([]byte, error) {
+	// single character special case
+	if len(str) == 1 && str[0] <= ByteRangeEnd {
+		return []byte{str[0]}, nil
+	}
+
+	prefix, err := encodeSizePrefix(len(str), ShortStringRangeStart, ShortStringRangeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0, len(prefix)+len(str))
+	result = append(result, prefix...)
+	result = append(result, str...)
+	return result, nil
+}
+
+// EncodeList encodes the given RLP-encoded items as a RLP list.
+// Like the result of DecodeList, each item must be a single RLP-encoded value (a string or a list),
+// so nested lists can be encoded by encoding the inner lists first.
+// it only produces the RLP canonical form, see EncodeString.
+func EncodeList(encodedItems [][]byte) ([]byte, error) {
+	var payloadSize int
+	for _, item := range encodedItems {
+		err := checkEncodedItem(item)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(item) > MaxLongLengthAllowed-payloadSize {
+			return nil, ErrDataSizeTooLarge
+		}
+		payloadSize += len(item)
+	}
+
+	prefix, err := encodeSizePrefix(payloadSize, ShortListRangeStart, ShortListRangeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0, len(prefix)+payloadSize)
+	result = append(result, prefix...)
+	for _, item := range encodedItems {
+		result = append(result, item...)
+	}
+	return result, nil
+}
+
+// encodeSizePrefix returns the prefix for a string or list payload of the given size,
+// given the range of first bytes used for short payloads of the type.
+func encodeSizePrefix(size int, shortRangeStart, shortRangeEnd byte) ([]byte, error) {
+	if size < 0 || size > MaxLongLengthAllowed {
+		return nil, ErrDataSizeTooLarge
+	}
+
+	if size <= MaxShortLengthAllowed {
+		return []byte{shortRangeStart + byte(size)}, nil
+	}
+
+	// encode the size in big-endian, without leading zero bytes
+	var sizeData [8]byte
+	binary.BigEndian.PutUint64(sizeData[:], uint64(size))
+
+	start := 0
+	for sizeData[start] == 0 {
+		start++
+	}
+	bytesForSize := len(sizeData) - start
+
+	prefix := make([]byte, 0, 1+bytesForSize)
+	prefix = append(prefix, shortRangeEnd+byte(bytesForSize))
+	prefix = append(prefix, sizeData[start:]...)
+	return prefix, nil
+}
+
+// checkEncodedItem checks that the given item is exactly one canonical RLP-encoded value.
+func checkEncodedItem(item []byte) error {
+	isString, dataStartIndex, dataSize, err := ReadSize(item, 0)
+	if err != nil {
+		return err
+	}
+
+	if isString {
+		_, bytesRead, err := DecodeString(item, 0)
+		if err != nil {
+			return err
+		}
+		if bytesRead != len(item) {
+			return ErrInvalidListItem
+		}
+		return nil
+	}
+
+	if dataSize != len(item)-dataStartIndex {
+		return ErrInvalidListItem
+	}
+	return nil
+}
@@ -462,3 +462,152 @@ func TestDecodeList(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeString(t *testing.T) {
+
+	t.Parallel()
+
+	tests := []struct {
+		str     []byte
+		encoded []byte
+	}{
+		// empty string
+		{[]byte{}, []byte{0x80}},
+		// single character
+		{[]byte{0x00}, []byte{0x00}},
+		{[]byte{0x7f}, []byte{0x7f}},
+		// single character outside of the character range
+		{[]byte{0x80}, []byte{0x81, 0x80}},
+		// short string
+		{[]byte("dog"), []byte{0x83, 0x64, 0x6f, 0x67}},
+		// end of short string
+		{make([]byte, 55), append([]byte{0xb7}, make([]byte, 55)...)},
+		// start of long string
+		{make([]byte, 56), append([]byte{0xb8, 0x38}, make([]byte, 56)...)},
+		// long string with several bytes for the length
+		{make([]byte, 258), append([]byte{0xb9, 0x01, 0x02}, make([]byte, 258)...)},
+		{make([]byte, 65536), append([]byte{0xba, 0x01, 0x00, 0x00}, make([]byte, 65536)...)},
+	}
+
+	for _, test := range tests {
+		encoded, err := rlp.EncodeString(test.str)
+		require.NoError(t, err)
+		require.Equal(t, test.encoded, encoded)
+
+		// round-trip
+		decoded, bytesRead, err := rlp.DecodeString(encoded, 0)
+		require.NoError(t, err)
+		require.Equal(t, len(encoded), bytesRead)
+		require.Equal(t, test.str, decoded)
+	}
+}
+
+func TestEncodeList(t *testing.T) {
+
+	t.Parallel()
+
+	// 28 items of 2 bytes each, i.e. a payload of 56 bytes
+	longItems := make([][]byte, 28)
+	longItemsEncoded := []byte{0xf8, 0x38}
+	for i := range longItems {
+		longItems[i] = []byte{0x81, 0x80 + byte(i)}
+		longItemsEncoded = append(longItemsEncoded, longItems[i]...)
+	}
+
+	tests := []struct {
+		items       [][]byte
+		encoded     []byte
+		expectedErr error
+	}{
+		{
+			[][]byte{}, // empty list
+			[]byte{0xc0},
+			nil,
+		},
+		{
+			[][]byte{{0xc0}}, // list with an empty list
+			[]byte{0xc1, 0xc0},
+			nil,
+		},
+		{
+			[][]byte{{0x41}, {0xc1, 0x42}, {0x82, 0x41, 0x42}}, // mixed encoded values
+			[]byte{0xc6, 0x41, 0xc1, 0x42, 0x82, 0x41, 0x42},
+			nil,
+		},
+		{
+			longItems, // long list
+			longItemsEncoded,
+			nil,
+		},
+		{
+			[][]byte{{}}, // empty item
+			nil,
+			rlp.ErrEmptyInput,
+		},
+		{
+			[][]byte{{0x82, 0x41}}, // incomplete item
+			nil,
+			rlp.ErrIncompleteInput,
+		},
+		{
+			[][]byte{{0x41, 0x42}}, // item with trailing bytes
+			nil,
+			rlp.ErrInvalidListItem,
+		},
+		{
+			[][]byte{{0xc1, 0x41, 0x42}}, // list item with trailing bytes
+			nil,
+			rlp.ErrInvalidListItem,
+		},
+		{
+			[][]byte{{0x81, 0x41}}, // non-canonical item
+			nil,
+			rlp.ErrNonCanonicalInput,
+		},
+	}
+
+	for _, test := range tests {
+		encoded, err := rlp.EncodeList(test.items)
+		if test.expectedErr != nil {
+			require.Equal(t, test.expectedErr, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, test.encoded, encoded)
+
+		// round-trip
+		decoded, bytesRead, err := rlp.DecodeList(encoded, 0)
+		require.NoError(t, err)
+		require.Equal(t, len(encoded), bytesRead)
+		require.Equal(t, test.items, decoded)
+	}
+}
+
+func TestEncodeNestedList(t *testing.T) {
+
+	t.Parallel()
+
+	// [ [], [[]], [ [], [[]] ] ]
+	// see https://ethereum.org/en/developers/docs/data-structures-and-encoding/rlp/
+
+	encode := func(items ...[]byte) []byte {
+		encoded, err := rlp.EncodeList(items)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	emptyList := encode()
+	nestedEmptyList := encode(emptyList)
+
+	encoded := encode(
+		emptyList,
+		nestedEmptyList,
+		encode(emptyList, nestedEmptyList),
+	)
+
+	require.Equal(t,
+		[]byte{0xc7, 0xc0, 0xc1, 0xc0, 0xc3, 0xc0, 0xc1, 0xc0},
+		encoded,
+	)
+}
@@ -0,0 +1,85 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var roundingModeStaticType interpreter.StaticType = interpreter.ConvertSemaCompositeTypeToStaticCompositeType(
+	nil,
+	sema.RoundingModeType,
+)
+
+func NewRoundingModeCase(rawValue interpreter.UInt8Value) interpreter.MemberAccessibleValue {
+
+	fields := map[string]interpreter.Value{
+		sema.EnumRawValueFieldName: rawValue,
+	}
+
+	return interpreter.NewSimpleCompositeValue(
+		nil,
+		sema.RoundingModeType.ID(),
+		roundingModeStaticType,
+		[]string{sema.EnumRawValueFieldName},
+		fields,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+var roundingModeConstructorValue, RoundingModeCaseValues = nativeEnumValueAndCaseValues(
+	sema.RoundingModeType,
+	sema.RoundingModes,
+	NewRoundingModeCase,
+)
+
+var RoundingModeConstructor = StandardLibraryValue{
+	Name: sema.RoundingModeTypeName,
+	Type: nativeEnumConstructorType(
+		sema.RoundingModeType,
+		sema.RoundingModes,
+	),
+	Value: roundingModeConstructorValue,
+	Kind:  common.DeclarationKindEnum,
+	// Programs declared their own `RoundingMode` types before the built-in type was added
+	IsShadowable: true,
+}
+
+func NewRoundingModeFromValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) sema.RoundingMode {
+	roundingModeValue, ok := value.(*interpreter.SimpleCompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	rawValue, ok := roundingModeValue.GetMember(inter, locationRange, sema.EnumRawValueFieldName).(interpreter.UInt8Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return sema.RoundingMode(rawValue)
+}
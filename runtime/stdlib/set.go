@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// SetConstructorFunction
+
+const setConstructorFunctionDocString = `
+Constructs a set containing the given elements.
+
+Duplicate elements are only contained once in the resulting set.
+`
+
+var setConstructorFunctionType = func() *sema.FunctionType {
+	typeParameter := &sema.TypeParameter{
+		Name:      "T",
+		TypeBound: sema.HashableStructType,
+	}
+
+	elementType := &sema.GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "elements",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: elementType,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			&sema.SetType{
+				ElementType: elementType,
+			},
+		),
+	}
+}()
+
+// SetConstructorFunction is shadowable,
+// as programs were already able to declare their own types named `Set`
+// before the set type was added.
+var SetConstructorFunction = func() StandardLibraryValue {
+	function := NewStandardLibraryFunction(
+		sema.SetTypeName,
+		setConstructorFunctionType,
+		setConstructorFunctionDocString,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			elements, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			typeParameterPair := invocation.TypeParameterTypes.Oldest()
+			if typeParameterPair == nil {
+				panic(errors.NewUnreachableError())
+			}
+
+			setStaticType := interpreter.NewSetStaticType(
+				inter,
+				interpreter.ConvertSemaToStaticType(inter, typeParameterPair.Value),
+			)
+
+			set := interpreter.NewSetValue(inter, locationRange, setStaticType)
+
+			elements.Iterate(inter, func(element interpreter.Value) (resume bool) {
+				_ = set.Insert(inter, locationRange, element)
+				return true
+			})
+
+			return set
+		},
+	)
+	function.IsShadowable = true
+	return function
+}()
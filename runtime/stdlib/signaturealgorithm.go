@@ -47,7 +47,7 @@ func NewSignatureAlgorithmCase(rawValue interpreter.UInt8Value) interpreter.Memb
 	)
 }
 
-var signatureAlgorithmConstructorValue, SignatureAlgorithmCaseValues = cryptoAlgorithmEnumValueAndCaseValues(
+var signatureAlgorithmConstructorValue, SignatureAlgorithmCaseValues = nativeEnumValueAndCaseValues(
 	sema.SignatureAlgorithmType,
 	sema.SignatureAlgorithms,
 	NewSignatureAlgorithmCase,
@@ -55,7 +55,7 @@ var signatureAlgorithmConstructorValue, SignatureAlgorithmCaseValues = cryptoAlg
 
 var SignatureAlgorithmConstructor = StandardLibraryValue{
 	Name: sema.SignatureAlgorithmTypeName,
-	Type: cryptoAlgorithmEnumConstructorType(
+	Type: nativeEnumConstructorType(
 		sema.SignatureAlgorithmType,
 		sema.SignatureAlgorithms,
 	),
@@ -33,6 +33,8 @@ type StandardLibraryValue struct {
 	DocString      string
 	ArgumentLabels []string
 	Kind           common.DeclarationKind
+	// IsShadowable indicates if programs may redeclare the value
+	IsShadowable bool
 }
 
 func (v StandardLibraryValue) ValueDeclarationName() string {
@@ -66,3 +68,7 @@ func (v StandardLibraryValue) ValueDeclarationIsConstant() bool {
 func (v StandardLibraryValue) ValueDeclarationArgumentLabels() []string {
 	return v.ArgumentLabels
 }
+
+func (v StandardLibraryValue) ValueDeclarationIsShadowable() bool {
+	return v.IsShadowable
+}
@@ -2940,6 +2940,179 @@ func TestRuntimeStorageEnumCase(t *testing.T) {
 	)
 }
 
+func TestRuntimeStorageGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	config := DefaultTestInterpreterConfig
+	config.TypeParametersEnabled = true
+	runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountCodes := map[Location][]byte{}
+	var loggedMessages []string
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+		OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+			accountCodes[location] = code
+			return nil
+		},
+		OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+			code = accountCodes[location]
+			return code, nil
+		},
+		OnEmitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		OnProgramLog: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: DeploymentTransaction(
+				"C",
+				[]byte(`
+                  access(all) contract C {
+
+                    access(all) struct Box<T: AnyStruct> {
+                        access(all) let value: T
+
+                        init(value: T) {
+                            self.value = value
+                        }
+                    }
+
+                    access(all) resource Vault<T: AnyStruct> {
+                        access(all) var items: [T]
+
+                        init() {
+                            self.items = []
+                        }
+
+                        access(all) fun deposit(_ item: T) {
+                            self.items.append(item)
+                        }
+                    }
+
+                    access(all) fun createVault<T>(): @Vault<T> {
+                        return <- create Vault<T>()
+                    }
+                  }
+                `),
+			),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store instantiations
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      signer.storage.save(C.Box(value: 42), to: /storage/box)
+                      signer.storage.save(C.Box<String>(value: "hello"), to: /storage/box2)
+
+                      let vault <- C.createVault<C.Box<Int>>()
+                      vault.deposit(C.Box(value: 1))
+                      signer.storage.save(<-vault, to: /storage/vault)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Load instantiations
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      let box = signer.storage.load<C.Box<Int>>(from: /storage/box)!
+                      log(box.value)
+                      log(box.getType())
+
+                      log(signer.storage.type(at: /storage/box2))
+                      log(signer.storage.check<C.Box<Int>>(from: /storage/box2))
+
+                      let vault = signer.storage.borrow<&C.Vault<C.Box<Int>>>(from: /storage/vault)!
+                      log(vault.items[0].value)
+                      log(vault.getType())
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			"42",
+			"Type<A.0000000000000001.C.Box<Int>>()",
+			"Type<A.0000000000000001.C.Box<String>>()",
+			"false",
+			"1",
+			"Type<A.0000000000000001.C.Vault<A.0000000000000001.C.Box<Int>>>()",
+		},
+		loggedMessages,
+	)
+
+	// Export instantiation
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              access(all) fun main(): C.Box<Int> {
+                  return C.Box(value: 3)
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		"A.0000000000000001.C.Box<Int>(value: 3)",
+		result.String(),
+	)
+}
+
 func TestRuntimeStorageReadNoImplicitWrite(t *testing.T) {
 
 	t.Parallel()
@@ -5947,3 +6120,297 @@ func TestRuntimeStorageReferenceAccess(t *testing.T) {
 		require.ErrorAs(t, err, &interpreter.DereferenceError{})
 	})
 }
+
+func TestRuntimeStorageQuery(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	runtime := NewTestInterpreterRuntime()
+	nextTransactionLocation := NewTransactionLocationGenerator()
+	nextScriptLocation := NewScriptLocationGenerator()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+	}
+
+	// Store values
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      signer.storage.save(1, to: /storage/e)
+                      signer.storage.save("a", to: /storage/a)
+                      signer.storage.save(2, to: /storage/c)
+                      signer.storage.save(Int8(3), to: /storage/b)
+                      signer.storage.save(4, to: /storage/d)
+                      signer.storage.save(true, to: /storage/f)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	query := func(t *testing.T, arguments string) (cadence.Value, error) {
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(fmt.Sprintf(
+					`
+                      access(all) fun main(): Account.Storage.QueryResult {
+                          return getAuthAccount<auth(Storage) &Account>(0x1).storage.query(%s)
+                      }
+                    `,
+					arguments,
+				)),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextScriptLocation(),
+			},
+		)
+	}
+
+	storagePath := func(identifier string) cadence.Path {
+		return cadence.Path{
+			Domain:     common.PathDomainStorage,
+			Identifier: identifier,
+		}
+	}
+
+	requireResult := func(t *testing.T, result cadence.Value, paths []string, cursor string) {
+		// Fields are `paths` and `cursor`
+		fields := result.(cadence.Struct).Fields
+
+		expectedPaths := make([]cadence.Value, len(paths))
+		for i, identifier := range paths {
+			expectedPaths[i] = storagePath(identifier)
+		}
+
+		require.Equal(t, expectedPaths, fields[0].(cadence.Array).Values)
+
+		if cursor == "" {
+			require.Equal(t, cadence.NewOptional(nil), fields[1])
+		} else {
+			require.Equal(t, cadence.NewOptional(storagePath(cursor)), fields[1])
+		}
+	}
+
+	t.Run("exact type", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<Int>(), subtypes: false, after: nil, limit: 10")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"c", "d", "e"}, "")
+	})
+
+	t.Run("subtypes", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<Integer>(), subtypes: true, after: nil, limit: 10")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"b", "c", "d", "e"}, "")
+	})
+
+	t.Run("no subtypes", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<Integer>(), subtypes: false, after: nil, limit: 10")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{}, "")
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<AnyStruct>(), subtypes: true, after: nil, limit: 4")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"a", "b", "c", "d"}, "d")
+
+		result, err = query(t, "type: Type<AnyStruct>(), subtypes: true, after: /storage/d, limit: 4")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"e", "f"}, "")
+	})
+
+	t.Run("exact page", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<Int>(), subtypes: false, after: /storage/c, limit: 2")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"d", "e"}, "")
+	})
+
+	t.Run("cursor of non-existing path", func(t *testing.T) {
+
+		result, err := query(t, "type: Type<Int>(), subtypes: false, after: /storage/cc, limit: 1")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"d"}, "d")
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+
+		_, err := query(t, "type: Type<Int>(), subtypes: false, after: nil, limit: 0")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.InvalidStorageQueryLimitError{})
+	})
+
+	t.Run("computation", func(t *testing.T) {
+
+		computation := map[common.ComputationKind]uint{}
+
+		runtimeInterface.OnMeterComputation = func(compKind common.ComputationKind, intensity uint) error {
+			computation[compKind] += intensity
+			return nil
+		}
+		defer func() {
+			runtimeInterface.OnMeterComputation = nil
+		}()
+
+		// All keys are visited, even if the query is after a cursor
+
+		result, err := query(t, "type: Type<Int>(), subtypes: false, after: /storage/d, limit: 1")
+		require.NoError(t, err)
+
+		requireResult(t, result, []string{"e"}, "")
+
+		assert.Equal(t, uint(6), computation[common.ComputationKindStorageQueryKey])
+
+		// Only the values of `e` and `f` are read
+
+		assert.Equal(t, uint(2), computation[common.ComputationKindStorageQueryValueRead])
+	})
+}
+
+func TestRuntimeStorageSetOperations(t *testing.T) {
+
+	t.Parallel()
+
+	signerAddress := common.MustBytesToAddress([]byte{0x42})
+
+	deployTx := DeploymentTransaction("Test", []byte(`
+      access(all) contract Test {
+
+          access(all) enum E: UInt8 {
+              access(all) case a
+              access(all) case b
+              access(all) case c
+          }
+      }
+    `))
+
+	for _, operation := range []string{"union", "intersection", "difference"} {
+
+		operation := operation
+
+		t.Run(operation, func(t *testing.T) {
+
+			t.Parallel()
+
+			runtime := NewTestInterpreterRuntime()
+
+			accountCodes := map[Location][]byte{}
+			var loggedMessages []string
+
+			runtimeInterface := &TestRuntimeInterface{
+				Storage: NewTestLedger(nil, nil),
+				OnGetSigningAccounts: func() ([]Address, error) {
+					return []Address{signerAddress}, nil
+				},
+				OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+				OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+					accountCodes[location] = code
+					return nil
+				},
+				OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+					code = accountCodes[location]
+					return code, nil
+				},
+				OnEmitEvent: func(event cadence.Event) error {
+					return nil
+				},
+				OnProgramLog: func(message string) {
+					loggedMessages = append(loggedMessages, message)
+				},
+			}
+
+			nextTransactionLocation := NewTransactionLocationGenerator()
+
+			executeTransaction := func(code string) {
+				err := runtime.ExecuteTransaction(
+					Script{
+						Source: []byte(code),
+					},
+					Context{
+						Interface: runtimeInterface,
+						Location:  nextTransactionLocation(),
+					},
+				)
+				require.NoError(t, err)
+			}
+
+			executeTransaction(string(deployTx))
+
+			executeTransaction(`
+              import Test from 0x42
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      signer.storage.save(Set<Test.E>([Test.E.a, Test.E.b]), to: /storage/set)
+                  }
+              }
+            `)
+
+			executeTransaction(fmt.Sprintf(
+				`
+                  import Test from 0x42
+
+                  transaction {
+                      prepare(signer: auth(Storage) &Account) {
+                          let set = signer.storage.borrow<&Set<Test.E>>(from: /storage/set)!
+                          let result = set.%s(Set<Test.E>([Test.E.b, Test.E.c]))
+                          log(result.length)
+                      }
+                  }
+                `,
+				operation,
+			))
+
+			executeTransaction(`
+              import Test from 0x42
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      let set = signer.storage.borrow<&Set<Test.E>>(from: /storage/set)!
+                      log(set.contains(Test.E.a))
+                      log(set.contains(Test.E.b))
+                      var count = 0
+                      set.forEach(fun (element: Test.E): Bool {
+                          count = count + 1
+                          return true
+                      })
+                      log(count)
+                      log(signer.storage.load<Set<Test.E>>(from: /storage/set)!.length)
+                  }
+              }
+            `)
+
+			require.Equal(t,
+				[]string{"true", "true", "2", "2"},
+				loggedMessages[1:],
+			)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// accountStorageDomains are the storage domains of an account
+var accountStorageDomains = []string{
+	common.PathDomainStorage.Identifier(),
+	common.PathDomainPrivate.Identifier(),
+	common.PathDomainPublic.Identifier(),
+	StorageDomainContract,
+	stdlib.InboxStorageDomain,
+	stdlib.CapabilityControllerStorageDomain,
+	stdlib.CapabilityControllerTagStorageDomain,
+	stdlib.PathCapabilityStorageDomain,
+	stdlib.AccountCapabilityStorageDomain,
+}
+
+// StorageUsage is the storage used by an account, a storage domain, or a stored value.
+//
+// The size of a value is the size of its encoding in its parent,
+// plus the sizes of the encodings of all slabs which store the value and its nested values.
+// The sizes of the children are included in the size of their parent,
+// the remainder is the overhead of the parent, e.g. the storage map or the container.
+type StorageUsage struct {
+	// Name is the address of the account, the name of the storage domain,
+	// the path or key of a stored value, a field name, a dictionary key, or an array index
+	Name string `json:"name"`
+	// Type is the static type of the value, if any
+	Type string `json:"type,omitempty"`
+	// Size is the number of bytes used, including the children
+	Size     uint64          `json:"size"`
+	Children []*StorageUsage `json:"children,omitempty"`
+}
+
+// SortBySize sorts the children of the usage, and their children,
+// by their size, in descending order. Children with the same size keep their order.
+func (u *StorageUsage) SortBySize() {
+	u.sort(func(a, b *StorageUsage) bool {
+		return a.Size > b.Size
+	})
+}
+
+// SortByName sorts the children of the usage, and their children, by their name.
+func (u *StorageUsage) SortByName() {
+	u.sort(func(a, b *StorageUsage) bool {
+		return a.Name < b.Name
+	})
+}
+
+func (u *StorageUsage) sort(less func(a, b *StorageUsage) bool) {
+	sort.SliceStable(u.Children, func(i, j int) bool {
+		return less(u.Children[i], u.Children[j])
+	})
+	for _, child := range u.Children {
+		child.sort(less)
+	}
+}
+
+// WriteTo writes the usage as a human-readable tree,
+// with each child indented below its parent.
+func (u *StorageUsage) WriteTo(w io.Writer) (int64, error) {
+	var builder strings.Builder
+
+	tabWriter := tabwriter.NewWriter(&builder, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tabWriter, "Name\tSize\tType")
+
+	var write func(usage *StorageUsage, depth int)
+	write = func(usage *StorageUsage, depth int) {
+		_, _ = fmt.Fprintf(
+			tabWriter,
+			"%s%s\t%d\t%s\n",
+			strings.Repeat("  ", depth),
+			usage.Name,
+			usage.Size,
+			usage.Type,
+		)
+		for _, child := range usage.Children {
+			write(child, depth+1)
+		}
+	}
+	write(u, 0)
+
+	_ = tabWriter.Flush()
+
+	n, err := io.WriteString(w, builder.String())
+	return int64(n), err
+}
+
+// AccountUsage returns the storage used by the given account.
+//
+// The usage is attributed to the storage domains of the account,
+// and to the values stored in them. The usage of the stored values
+// is further attributed to their nested values (composite fields,
+// dictionary entries, and array elements), down to the given depth.
+// If the depth is zero, only the usage of the stored values is reported.
+func (s *Storage) AccountUsage(
+	inter *interpreter.Interpreter,
+	address common.Address,
+	maxDepth int,
+) (
+	*StorageUsage,
+	error,
+) {
+	reporter := storageUsageReporter{
+		storage:  s,
+		inter:    inter,
+		address:  address,
+		maxDepth: maxDepth,
+	}
+	return reporter.accountUsage()
+}
+
+type storageUsageReporter struct {
+	storage  *Storage
+	inter    *interpreter.Interpreter
+	address  common.Address
+	maxDepth int
+}
+
+func (r storageUsageReporter) accountUsage() (*StorageUsage, error) {
+	usage := &StorageUsage{
+		Name: r.address.HexWithPrefix(),
+	}
+
+	for _, domain := range accountStorageDomains {
+		storageMap := r.storage.GetStorageMap(r.address, domain, false)
+		if storageMap == nil {
+			continue
+		}
+
+		domainUsage, err := r.domainUsage(domain, storageMap)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Size += domainUsage.Size
+		usage.Children = append(usage.Children, domainUsage)
+	}
+
+	return usage, nil
+}
+
+func (r storageUsageReporter) domainUsage(
+	domain string,
+	storageMap *interpreter.StorageMap,
+) (
+	*StorageUsage,
+	error,
+) {
+	size, err := r.referencedSlabsSize(atree.StorageIDStorable(storageMap.StorageID()))
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &StorageUsage{
+		Name: domain,
+		Size: size,
+	}
+
+	pathDomain := common.PathDomainFromIdentifier(domain)
+
+	iterator := storageMap.Iterator(r.inter)
+	for {
+		key, value := iterator.Next()
+		if key == nil {
+			break
+		}
+
+		var name string
+		switch key := key.(type) {
+		case interpreter.StringAtreeValue:
+			name = string(key)
+			if pathDomain != common.PathDomainUnknown {
+				name = interpreter.PathValue{
+					Domain:     pathDomain,
+					Identifier: name,
+				}.String()
+			}
+
+		case interpreter.Uint64AtreeValue:
+			name = strconv.FormatUint(uint64(key), 10)
+
+		default:
+			return nil, errors.NewUnexpectedError("invalid storage map key: %T", key)
+		}
+
+		valueUsage, err := r.valueUsage(name, value, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Children = append(usage.Children, valueUsage)
+	}
+
+	return usage, nil
+}
+
+func (r storageUsageReporter) valueUsage(
+	name string,
+	value interpreter.Value,
+	depth int,
+) (
+	*StorageUsage,
+	error,
+) {
+	size, err := r.valueSize(value)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &StorageUsage{
+		Name: name,
+		Type: string(value.StaticType(r.inter).ID()),
+		Size: size,
+	}
+
+	if depth < r.maxDepth {
+		usage.Children, err = r.nestedUsages(value, depth+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return usage, nil
+}
+
+func (r storageUsageReporter) nestedUsages(
+	value interpreter.Value,
+	depth int,
+) (
+	children []*StorageUsage,
+	err error,
+) {
+	add := func(name string, value interpreter.Value, extraSize uint64) (resume bool) {
+		var usage *StorageUsage
+		usage, err = r.valueUsage(name, value, depth)
+		if err != nil {
+			return false
+		}
+		usage.Size += extraSize
+		children = append(children, usage)
+		return true
+	}
+
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		innerValue := value.InnerValue(r.inter, interpreter.EmptyLocationRange)
+		return r.nestedUsages(innerValue, depth)
+
+	case *interpreter.CompositeValue:
+		value.ForEachField(
+			r.inter,
+			func(fieldName string, fieldValue interpreter.Value) (resume bool) {
+				return add(fieldName, fieldValue, 0)
+			},
+		)
+
+	case *interpreter.DictionaryValue:
+		value.Iterate(
+			r.inter,
+			func(key, value interpreter.Value) (resume bool) {
+				// The entry is attributed to the key,
+				// so the size of the key is included
+				var keySize uint64
+				keySize, err = r.valueSize(key)
+				if err != nil {
+					return false
+				}
+				return add(key.String(), value, keySize)
+			},
+		)
+
+	case *interpreter.ArrayValue:
+		index := 0
+		value.Iterate(
+			r.inter,
+			func(element interpreter.Value) (resume bool) {
+				name := fmt.Sprintf("[%d]", index)
+				index++
+				return add(name, element, 0)
+			},
+		)
+	}
+
+	return children, err
+}
+
+// valueSize returns the size of the encoding of the stored value in its parent,
+// plus the sizes of all slabs referenced by it.
+func (r storageUsageReporter) valueSize(value interpreter.Value) (uint64, error) {
+	// The value is already stored, so getting its storable does not store it again:
+	// Containers return a reference to their root slab,
+	// and no other value is moved into a separate slab,
+	// as the maximum inline size is not exceeded
+	storable, err := value.Storable(r.storage, atree.Address(r.address), math.MaxUint64)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := interpreter.StorableSize(storable)
+	if err != nil {
+		return 0, err
+	}
+
+	slabsSize, err := r.referencedSlabsSize(storable)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(size) + slabsSize, nil
+}
+
+// referencedSlabsSize returns the sum of the sizes of all slabs
+// which are referenced by the given storable, directly or indirectly.
+func (r storageUsageReporter) referencedSlabsSize(storable atree.Storable) (uint64, error) {
+	var size uint64
+
+	if storageIDStorable, ok := storable.(atree.StorageIDStorable); ok {
+		storageID := atree.StorageID(storageIDStorable)
+
+		slab, found, err := r.storage.Retrieve(storageID)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return 0, atree.NewSlabNotFoundErrorf(storageID, "slab not found for storage usage")
+		}
+
+		// The size of the slab's encoding is used,
+		// as the byte size of root slabs does not include their type information
+		slabSize, err := interpreter.StorableSize(slab)
+		if err != nil {
+			return 0, err
+		}
+
+		size += uint64(slabSize)
+		storable = slab
+	}
+
+	for _, childStorable := range storable.ChildStorables() {
+		childSize, err := r.referencedSlabsSize(childStorable)
+		if err != nil {
+			return 0, err
+		}
+		size += childSize
+	}
+
+	return size, nil
+}
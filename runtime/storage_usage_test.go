@@ -0,0 +1,262 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeStorageAccountUsage(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	ledger := NewTestLedger(nil, nil)
+
+	accountCodes := map[Location][]byte{}
+
+	newRuntimeInterface := func() Interface {
+		return &TestRuntimeInterface{
+			Storage: ledger,
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			OnGetCode: func(location Location) ([]byte, error) {
+				return accountCodes[location], nil
+			},
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
+				return accountCodes[location], nil
+			},
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				accountCodes[location] = code
+				return nil
+			},
+			OnEmitEvent: func(event cadence.Event) error {
+				return nil
+			},
+		}
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: DeploymentTransaction(
+				"Test",
+				[]byte(`
+                  access(all) contract Test {
+
+                      access(all) struct Collection {
+                          access(all) let values: [Int]
+                          access(all) let names: {String: String}
+
+                          init() {
+                              self.values = []
+                              var i = 0
+                              while i < 200 {
+                                  self.values.append(i)
+                                  i = i + 1
+                              }
+                              self.names = {"short": "a", "long": "abcdefghijklmnopqrstuvwxyz"}
+                          }
+                      }
+                  }
+                `),
+			),
+		},
+		Context{
+			Interface: newRuntimeInterface(),
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import Test from 0x1
+
+              transaction {
+                  prepare(signer: auth(Storage, Capabilities) &Account) {
+                      signer.storage.save(1, to: /storage/small)
+                      signer.storage.save(Test.Collection(), to: /storage/large)
+                      let cap = signer.capabilities.storage.issue<&Int>(/storage/small)
+                      signer.capabilities.publish(cap, at: /public/small)
+                  }
+              }
+            `),
+		},
+		Context{
+			Interface: newRuntimeInterface(),
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	storage, inter, err := runtime.Storage(Context{
+		Interface: newRuntimeInterface(),
+	})
+	require.NoError(t, err)
+
+	findChild := func(usage *StorageUsage, name string) *StorageUsage {
+		for _, child := range usage.Children {
+			if child.Name == name {
+				return child
+			}
+		}
+		require.Fail(t, "missing child", name)
+		return nil
+	}
+
+	var requireConsistent func(usage *StorageUsage)
+	requireConsistent = func(usage *StorageUsage) {
+		var childrenSize uint64
+		for _, child := range usage.Children {
+			childrenSize += child.Size
+			requireConsistent(child)
+		}
+		require.LessOrEqual(t, childrenSize, usage.Size, usage.Name)
+	}
+
+	t.Run("attribution", func(t *testing.T) {
+
+		t.Parallel()
+
+		usage, err := storage.AccountUsage(inter, address, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, "0x0000000000000001", usage.Name)
+		requireConsistent(usage)
+
+		// The account's usage is the size of all its slabs
+
+		var slabsSize uint64
+		for key, value := range ledger.StoredValues { //nolint:maprange
+			owner, storageKey, _ := strings.Cut(key, "|")
+			if owner == string(address[:]) && strings.HasPrefix(storageKey, "$") {
+				slabsSize += uint64(len(value))
+			}
+		}
+		assert.Equal(t, slabsSize, usage.Size)
+
+		storageDomain := findChild(usage, "storage")
+		findChild(usage, "contract")
+		findChild(usage, "public")
+		findChild(usage, "cap_con")
+
+		small := findChild(storageDomain, "/storage/small")
+		assert.Equal(t, "Int", small.Type)
+		assert.Empty(t, small.Children)
+
+		large := findChild(storageDomain, "/storage/large")
+		assert.Equal(t, "A.0000000000000001.Test.Collection", large.Type)
+		assert.Greater(t, large.Size, small.Size)
+
+		// Nested values are attributed down to the given depth,
+		// including the child slabs of the array
+
+		values := findChild(large, "values")
+		assert.Equal(t, "[Int]", values.Type)
+		assert.Greater(t, values.Size, uint64(200))
+		assert.Empty(t, values.Children)
+
+		names := findChild(large, "names")
+		assert.Equal(t, "{String:String}", names.Type)
+		assert.Less(t, names.Size, values.Size)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+
+		t.Parallel()
+
+		usage, err := storage.AccountUsage(inter, address, 0)
+		require.NoError(t, err)
+
+		large := findChild(findChild(usage, "storage"), "/storage/large")
+		assert.Empty(t, large.Children)
+
+		usage, err = storage.AccountUsage(inter, address, 2)
+		require.NoError(t, err)
+		requireConsistent(usage)
+
+		large = findChild(findChild(usage, "storage"), "/storage/large")
+		values := findChild(large, "values")
+		assert.Len(t, values.Children, 200)
+		assert.Equal(t, "[0]", values.Children[0].Name)
+
+		names := findChild(large, "names")
+		short := findChild(names, `"short"`)
+		long := findChild(names, `"long"`)
+		assert.Greater(t, long.Size, short.Size)
+	})
+
+	t.Run("sort and output", func(t *testing.T) {
+
+		t.Parallel()
+
+		usage, err := storage.AccountUsage(inter, address, 1)
+		require.NoError(t, err)
+
+		usage.SortBySize()
+
+		storageDomain := usage.Children[0]
+		assert.Equal(t, "storage", storageDomain.Name)
+		assert.Equal(t, "/storage/large", storageDomain.Children[0].Name)
+		assert.Equal(t, "/storage/small", storageDomain.Children[1].Name)
+
+		usage.SortByName()
+
+		assert.Equal(t, "/storage/large", storageDomain.Children[0].Name)
+		assert.Equal(t, "names", storageDomain.Children[0].Children[0].Name)
+
+		var buffer bytes.Buffer
+		_, err = usage.WriteTo(&buffer)
+		require.NoError(t, err)
+
+		output := buffer.String()
+		assert.True(t, strings.HasPrefix(output, "Name "))
+		assert.Contains(t, output, "\n0x0000000000000001 ")
+		assert.Contains(t, output, "\n    /storage/large ")
+		assert.Contains(t, output, "\n      values ")
+
+		encoded, err := json.Marshal(usage)
+		require.NoError(t, err)
+
+		var decoded StorageUsage
+		err = json.Unmarshal(encoded, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, *usage, decoded)
+	})
+}
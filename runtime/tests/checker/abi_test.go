@@ -0,0 +1,89 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func parseAndCheckWithABI(t *testing.T, code string) error {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.NewABIContract(nil, nil))
+
+	_, err := ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+	return err
+}
+
+func TestCheckABI(t *testing.T) {
+
+	t.Parallel()
+
+	err := parseAndCheckWithABI(t, `
+       let a: [UInt8] = ABI.encode([UInt8(1), true, "foo"])
+       let b: [UInt8] = ABI.encodePacked([Int16(-1)])
+       let c: [UInt8] = ABI.encodeWithSignature("foo(uint8)", [UInt8(1)])
+       let d: [AnyStruct] = ABI.decode(types: [Type<UInt8>()], data: a)
+    `)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidABIDecode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("missing argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithABI(t, `
+           let values = ABI.decode([Type<UInt8>()], [])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+		require.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+		require.IsType(t, &sema.MissingArgumentLabelError{}, errs[1])
+	})
+
+	t.Run("invalid types", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckWithABI(t, `
+           let values = ABI.decode(types: [UInt8(1)], data: [])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
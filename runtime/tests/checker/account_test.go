@@ -1412,6 +1412,68 @@ func TestCheckAccountStorageIteration(t *testing.T) {
 	}
 }
 
+func TestCheckAccountStorageQuery(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(storage: &Account.Storage) {
+              let result: Account.Storage.QueryResult =
+                  storage.query(type: Type<Int>(), subtypes: false, after: nil, limit: 10)
+              let paths: [StoragePath] = result.paths
+              let cursor: StoragePath? = result.cursor
+
+              let next = storage.query(type: Type<Int>(), subtypes: true, after: cursor, limit: 10)
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("view", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(storage: &Account.Storage): [StoragePath] {
+              return storage.query(type: Type<Int>(), subtypes: false, after: nil, limit: 10).paths
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("non-storage path cursor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(storage: &Account.Storage) {
+              storage.query(type: Type<Int>(), subtypes: false, after: /public/foo, limit: 10)
+          }
+        `)
+
+		errors := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errors[0])
+	})
+
+	t.Run("missing labels", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(storage: &Account.Storage) {
+              storage.query(Type<Int>(), false, nil, 10)
+          }
+        `)
+
+		errors := RequireCheckerErrors(t, err, 4)
+
+		for _, err := range errors {
+			require.IsType(t, &sema.MissingArgumentLabelError{}, err)
+		}
+	})
+}
+
 func TestCheckAccountInboxPublish(t *testing.T) {
 
 	t.Parallel()
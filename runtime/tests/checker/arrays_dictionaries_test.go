@@ -30,6 +30,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestCheckDictionary(t *testing.T) {
@@ -320,6 +321,242 @@ func TestCheckDictionaryValues(t *testing.T) {
 	)
 }
 
+func TestCheckDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        fun test() {
+            let dict = {"abc": 1, "def": 2}
+            dict.forEach(fun (key: String, value: Int): Bool {
+                return key != "abc" && value > 0
+            })
+        }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        fun test() {
+            let dict = {"abc": 1, "def": 2}
+            dict.forEach(fun (key: String): Bool {
+                return true
+            })
+        }
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let filtered = {"abc": 1, "def": 2}.filter(view fun (key: String, value: Int): Bool {
+            return value > 1
+        })
+    `)
+
+	require.NoError(t, err)
+
+	filteredType := RequireGlobalValue(t, checker.Elaboration, "filtered")
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType:   sema.StringType,
+			ValueType: sema.IntType,
+		},
+		filteredType,
+	)
+}
+
+func TestCheckInvalidDictionaryFilterImpure(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let filtered = {"abc": 1, "def": 2}.filter(fun (key: String, value: Int): Bool {
+            return value > 1
+        })
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckDictionaryMap(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let mapped = {"abc": 1, "def": 2}.map(fun (value: Int): Bool {
+            return value > 1
+        })
+    `)
+
+	require.NoError(t, err)
+
+	mappedType := RequireGlobalValue(t, checker.Elaboration, "mapped")
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType:   sema.StringType,
+			ValueType: sema.BoolType,
+		},
+		mappedType,
+	)
+}
+
+func TestCheckInvalidDictionaryMap(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let mapped = {"abc": 1, "def": 2}.map(fun (value: String): Bool {
+            return true
+        })
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckDictionaryToEntries(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let entries = {"abc": 1, "def": 2}.toEntries()
+        let entry: DictionaryEntry<String, Int> = entries[0]
+        let key: String = entry.key
+        let value: Int = entry.value
+    `)
+
+	require.NoError(t, err)
+
+	entriesType := RequireGlobalValue(t, checker.Elaboration, "entries")
+
+	require.IsType(t, &sema.VariableSizedType{}, entriesType)
+	entryType := entriesType.(*sema.VariableSizedType).Type
+
+	assert.Equal(t,
+		sema.TypeID("DictionaryEntry<String,Int>"),
+		entryType.ID(),
+	)
+}
+
+func TestCheckInvalidDictionaryToEntriesType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let entries: [DictionaryEntry<String, String>] = {"abc": 1, "def": 2}.toEntries()
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckDictionaryEntryShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	// Programs declared their own `DictionaryEntry` types before the built-in type was added,
+	// so such declarations must shadow the built-in type
+
+	t.Run("top-level struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+           struct DictionaryEntry {}
+
+           let entry: DictionaryEntry = DictionaryEntry()
+        `)
+		require.NoError(t, err)
+
+		entryType := RequireGlobalValue(t, checker.Elaboration, "entry")
+		require.IsType(t, &sema.CompositeType{}, entryType)
+		assert.Equal(t,
+			common.NewTypeIDFromQualifiedName(nil, utils.TestLocation, "DictionaryEntry"),
+			entryType.ID(),
+		)
+	})
+
+	t.Run("nested struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           contract C {
+
+               struct DictionaryEntry {
+                   let key: String
+
+                   init(key: String) {
+                       self.key = key
+                   }
+               }
+
+               fun entries(): [DictionaryEntry] {
+                   return [DictionaryEntry(key: "a")]
+               }
+           }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInvalidResourceDictionaryHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, member := range []string{
+		sema.DictionaryTypeForEachFunctionName,
+		sema.DictionaryTypeFilterFunctionName,
+		sema.DictionaryTypeMapFunctionName,
+		sema.DictionaryTypeToEntriesFunctionName,
+	} {
+		member := member
+
+		t.Run(member, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test() {
+                          let xs <- {"x": <-create X()}
+                          let f = xs.%s
+                          destroy xs
+                      }
+                    `,
+					member,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+			assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+		})
+	}
+}
+
 func TestCheckDictionaryEqual(t *testing.T) {
 	t.Parallel()
 
@@ -1327,6 +1564,283 @@ func TestCheckResourceArrayMapInvalid(t *testing.T) {
 	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
+func TestCheckArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test() {
+			let x = [3, 1, 2]
+			let y: [Int] = x.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+				return a < b
+			})
+		}
+
+		fun testFixedSize() {
+			let x: [Int; 3] = [3, 1, 2]
+			let y: [Int; 3] = x.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+				return a > b
+			})
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArraySortInvalidArgs(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("impure comparator", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [3, 1, 2]
+				let y = x.sort(by: fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("wrong parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [3, 1, 2]
+				let y = x.sort(by: view fun (_ a: String, _ b: String): Bool {
+					return a.length < b.length
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [3, 1, 2]
+				let y = x.sort(view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+}
+
+func TestCheckArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test() {
+			let x = [1, 2, 3]
+			let sum: Int = x.reduce(initial: 0, fun (_ sum: Int, _ x: Int): Int {
+				return sum + x
+			})
+			let s: String = x.reduce(initial: "", fun (_ s: String, _ x: Int): String {
+				return s.concat(x.toString())
+			})
+		}
+
+		fun testFixedSize() {
+			let x: [Int; 3] = [1, 2, 3]
+			let product: Int = x.reduce(initial: 1, fun (_ product: Int, _ x: Int): Int {
+				return product * x
+			})
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArrayReduceInvalidArgs(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test() {
+			let x = [1, 2, 3]
+			let sum = x.reduce(initial: 0, fun (_ sum: Int, _ x: String): Int {
+				return sum
+			})
+		}
+	`)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckArrayLastIndex(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test(): Int? {
+			let x = [1, 2, 3, 2]
+			return x.lastIndex(of: 2)
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArrayLastIndexWrongType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test(): Int? {
+			let x = [1, 2, 3]
+			return x.lastIndex(of: "abc")
+		}
+	`)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidResourceLastIndex(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun test(): Int? {
+          let xs <- [<-create X()]
+          return xs.lastIndex(of: <-create X())
+      }
+    `)
+
+	errs := RequireCheckerErrors(t, err, 3)
+
+	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[1])
+	assert.IsType(t, &sema.ResourceLossError{}, errs[2])
+}
+
+func TestCheckArrayPredicateFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		let isEven = view fun (_ x: Int): Bool {
+			return x % 2 == 0
+		}
+
+		fun test() {
+			let x = [1, 2, 3]
+			let index: Int? = x.indexOf(where: isEven)
+			let all: Bool = x.allSatisfy(isEven)
+			let any: Bool = x.anySatisfy(isEven)
+		}
+
+		fun testFixedSize() {
+			let x: [Int; 3] = [1, 2, 3]
+			let index: Int? = x.indexOf(where: isEven)
+			let all: Bool = x.allSatisfy(isEven)
+			let any: Bool = x.anySatisfy(isEven)
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArrayPredicateFunctionsImpurePredicate(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"indexOf(where: isEven)", "allSatisfy(isEven)", "anySatisfy(isEven)"} {
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let isEven = fun (_ x: Int): Bool {
+                          return x %% 2 == 0
+                      }
+
+                      fun test() {
+                          let x = [1, 2, 3]
+                          let result = x.%s
+                      }
+                    `,
+					name,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckInvalidResourceArraySortReduceAndSearch(t *testing.T) {
+
+	t.Parallel()
+
+	for _, member := range []string{
+		sema.ArrayTypeSortFunctionName,
+		sema.ArrayTypeReduceFunctionName,
+		sema.ArrayTypeIndexOfFunctionName,
+		sema.ArrayTypeAllSatisfyFunctionName,
+		sema.ArrayTypeAnySatisfyFunctionName,
+	} {
+		member := member
+
+		t.Run(member, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test() {
+                          let xs <- [<-create X()]
+                          let f = xs.%s
+                          destroy xs
+                      }
+                    `,
+					member,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+			assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+		})
+	}
+}
+
 func TestCheckArrayContains(t *testing.T) {
 
 	t.Parallel()
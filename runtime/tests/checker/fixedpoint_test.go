@@ -657,13 +657,23 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 					),
 				)
 
-				if i <= scale {
+				// Literals without a type annotation are inferred to be 64-bit fixed-point types
+				var expectedErrorCount int
+				if i > scale {
+					expectedErrorCount++
+				}
+				if i > sema.Fix64Scale {
+					expectedErrorCount++
+				}
+
+				if expectedErrorCount == 0 {
 					assert.NoError(t, err)
 				} else {
-					errs := RequireCheckerErrors(t, err, 2)
+					errs := RequireCheckerErrors(t, err, expectedErrorCount)
 
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[1])
+					for _, err := range errs {
+						assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, err)
+					}
 				}
 			}
 		})
@@ -709,3 +719,51 @@ func TestCheckFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFix128LiteralInference(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("conversion", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = Fix128(-0.000000000001)
+          let y = UFix128(0.000000000001)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.Fix128Type,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.UFix128Type,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("without type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = 0.000000000001
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
+	})
+
+	t.Run("conversion to Fix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = Fix64(Fix128(0.000000000001))
+        `)
+		require.NoError(t, err)
+	})
+}
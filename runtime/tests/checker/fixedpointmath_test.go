@@ -0,0 +1,144 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func parseAndCheckFixedPointMath(t *testing.T, code string) error {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.RoundingModeConstructor)
+	baseValueActivation.DeclareValue(stdlib.FixedPointMathContract)
+
+	_, err := ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+	return err
+}
+
+func TestCheckFixedPointMath(t *testing.T) {
+
+	t.Parallel()
+
+	err := parseAndCheckFixedPointMath(t, `
+       let a: UFix64 = FixedPointMath.sqrt(2.0, rounding: RoundingMode.towardZero)
+       let b: Fix64 = FixedPointMath.mulDiv(Fix64(1.0), -2.0, 3.0, rounding: RoundingMode.nearestHalfEven)
+       let c: UFix64 = FixedPointMath.powInt(1.5, 3, rounding: RoundingMode.awayFromZero)
+       let d: UFix64 = FixedPointMath.pow(2.0, -0.5, rounding: RoundingMode.towardNegativeInfinity)
+       let e: UFix64 = FixedPointMath.exp(-1.0, rounding: RoundingMode.towardPositiveInfinity)
+       let f: Fix64 = FixedPointMath.ln(2.0, rounding: RoundingMode.nearestHalfAwayFromZero)
+       let g: Fix64 = FixedPointMath.div(Fix64(1.0), 3.0, rounding: RoundingMode(rawValue: 0)!)
+    `)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidFixedPointMathMismatchedTypes(t *testing.T) {
+
+	t.Parallel()
+
+	err := parseAndCheckFixedPointMath(t, `
+       let x: Fix64 = 1.0
+       let y: UFix64 = 2.0
+       let z = FixedPointMath.div(x, y, rounding: RoundingMode.towardZero)
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+	require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidFixedPointMathNonFixedPoint(t *testing.T) {
+
+	t.Parallel()
+
+	err := parseAndCheckFixedPointMath(t, `
+       let z = FixedPointMath.sqrt(2, rounding: RoundingMode.towardZero)
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+	require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidFixedPointMathMissingRounding(t *testing.T) {
+
+	t.Parallel()
+
+	err := parseAndCheckFixedPointMath(t, `
+       let z = FixedPointMath.sqrt(2.0)
+    `)
+
+	errs := RequireCheckerErrors(t, err, 1)
+	require.IsType(t, &sema.InsufficientArgumentsError{}, errs[0])
+}
+
+func TestCheckRoundingModeShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	// Programs declared their own `RoundingMode` types before the built-in type was added,
+	// so such declarations must shadow the built-in type and constructor
+
+	t.Run("top-level enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckFixedPointMath(t, `
+           enum RoundingMode: UInt8 {
+               case up
+               case down
+           }
+
+           let mode: RoundingMode = RoundingMode.up
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheckFixedPointMath(t, `
+           contract C {
+
+               enum RoundingMode: UInt8 {
+                   case up
+                   case down
+               }
+
+               fun mode(): RoundingMode {
+                   return RoundingMode.down
+               }
+           }
+        `)
+		require.NoError(t, err)
+	})
+}
@@ -1118,3 +1118,355 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
 	})
 }
+
+func parseAndCheckWithTypeParameters(t *testing.T, code string) (*sema.Checker, error) {
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				TypeParametersEnabled: true,
+			},
+			ParseOptions: parser.Config{
+				TypeParametersEnabled: true,
+			},
+		},
+	)
+}
+
+func TestCheckUserDefinedGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithTypeParameters(t, `
+          fun head<T>(_ items: [T]): T? {
+              if items.length == 0 {
+                  return nil
+              }
+              return items[0]
+          }
+
+          let x = head([1, 2, 3])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.IntType},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithTypeParameters(t, `
+          fun wrap<T>(_ value: T): [T] {
+              return [value]
+          }
+
+          let xs = wrap<Int8>(1)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{Type: sema.Int8Type},
+			RequireGlobalValue(t, checker.Elaboration, "xs"),
+		)
+	})
+
+	t.Run("type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          fun double<T: Integer>(_ value: T): Integer {
+              return value + value
+          }
+
+          let x = double(1)
+          let y = double("a")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("unbounded type parameter, resource argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          resource R {}
+
+          fun id<T>(_ value: T): T {
+              return value
+          }
+
+          fun test() {
+              let r <- id(<-create R())
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          resource R {}
+
+          fun id<T: @AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test() {
+              let r <- id(<-create R())
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type parameter in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          fun cast<T>(_ value: AnyStruct): T? {
+              let values: [T] = []
+              return value as? T
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type parameter not in scope outside", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          fun test<T>() {}
+
+          let x: T? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckGenericCompositeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct, inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+          }
+
+          let box = Box(value: 1)
+          let x = box.value
+          let y = box.get()
+        `)
+		require.NoError(t, err)
+
+		boxType := RequireGlobalValue(t, checker.Elaboration, "box")
+		require.IsType(t, &sema.CompositeType{}, boxType)
+		assert.Equal(t, "Box<Int>", boxType.String())
+		assert.Equal(t, sema.TypeID("S.test.Box<Int>"), boxType.ID())
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "x"))
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "y"))
+	})
+
+	t.Run("struct, explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<Int8> = Box<Int8>(value: 1)
+          let x: Int8 = box.value
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("struct, mismatched instantiations", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<String> = Box(value: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("struct, missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T> {}
+
+          let box: Box? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+	})
+
+	t.Run("struct, type bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T: Integer> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box = Box(value: "a")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("struct, generic member function returning instantiation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithTypeParameters(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun map<U>(_ f: fun(T): U): Box<U> {
+                  return Box<U>(value: f(self.value))
+              }
+
+              fun wrap(): Box<Box<T>> {
+                  return Box<Box<T>>(value: self)
+              }
+          }
+
+          let box = Box(value: 1).map(fun (x: Int): String { return "a" })
+          let wrapped = box.wrap()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"Box<String>",
+			RequireGlobalValue(t, checker.Elaboration, "box").String(),
+		)
+		assert.Equal(t,
+			"Box<Box<String>>",
+			RequireGlobalValue(t, checker.Elaboration, "wrapped").String(),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          resource R {}
+
+          resource Holder<T: @AnyResource> {
+              let value: @T
+
+              init(value: @T) {
+                  self.value <- value
+              }
+          }
+
+          fun test() {
+              let holder: @Holder<@R> <- create Holder(value: <-create R())
+              destroy holder
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTypeParameters(t, `
+          contract C<T> {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidTypeParameterizedCompositeError{}, errs[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              struct Box<T> {}
+            `,
+			ParseAndCheckOptions{
+				ParseOptions: parser.Config{
+					TypeParametersEnabled: true,
+				},
+			},
+		)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidTypeParameterizedCompositeError{}, errs[0])
+	})
+}
@@ -0,0 +1,321 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckGuardStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("return", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: Int?): Int {
+              guard let y = x else {
+                  return 0
+              }
+              let z: Int = y
+              return z
+          }
+        `)
+
+		require.NoError(t, err)
+
+		assert.NotNil(t, checker)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t, `
+          fun test(x: Int?): Int {
+              guard var y = x else {
+                  panic("no value")
+              }
+              y = y + 1
+              return y
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(xs: [Int?]) {
+              for x in xs {
+                  guard let y = x else {
+                      continue
+                  }
+                  guard let z = x else {
+                      break
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?): Int {
+              guard let y: Int = x else {
+                  return 0
+              }
+              return y
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("fall through", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?) {
+              guard let y = x else {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingGuardElseExitError{}, errs[0])
+	})
+
+	t.Run("potential exit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?, z: Bool) {
+              guard let y = x else {
+                  if z {
+                      return
+                  }
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingGuardElseExitError{}, errs[0])
+	})
+
+	t.Run("non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              guard let y = x else {
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("binding not available in else", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?): Int {
+              guard let y = x else {
+                  return y
+              }
+              return y
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int?) {
+              let y = 1
+              guard let y = x else {
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckGuardStatementResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("optional binding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R?) {
+              guard let r2 <- r else {
+                  return
+              }
+              destroy r2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("optional binding, loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R?) {
+              guard let r2 <- r else {
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("failable cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @AnyResource) {
+              guard let r2 <- r as? @R else {
+                  destroy r
+                  return
+              }
+              destroy r2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("failable cast, loss in else", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @AnyResource) {
+              guard let r2 <- r as? @R else {
+                  return
+              }
+              destroy r2
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("failable cast, use after", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @AnyResource) {
+              guard let r2 <- r as? @R else {
+                  destroy r
+                  return
+              }
+              destroy r
+              destroy r2
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("invalidation in else with jump", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(xs: [Int?]) {
+              let r <- create R()
+              for x in xs {
+                  guard let y = x else {
+                      destroy r
+                      break
+                  }
+              }
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+}
@@ -123,3 +123,99 @@ func TestCheckInvalidRLPDecodeList(t *testing.T) {
 	require.IsType(t, mismatch, errs[0])
 	require.IsType(t, mismatch, errs[1])
 }
+
+func TestCheckRLPEncodeString(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.RLPContract)
+
+	_, err := ParseAndCheckWithOptions(t,
+		`
+           let l: [UInt8] = RLP.encodeString([0, 1, 2])
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidRLPEncodeString(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.RLPContract)
+
+	_, err := ParseAndCheckWithOptions(t,
+		`
+           let l: String = RLP.encodeString("string")
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+
+	errs := RequireCheckerErrors(t, err, 2)
+	var mismatch *sema.TypeMismatchError
+	require.IsType(t, mismatch, errs[0])
+	require.IsType(t, mismatch, errs[1])
+}
+
+func TestCheckRLPEncodeList(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.RLPContract)
+
+	_, err := ParseAndCheckWithOptions(t,
+		`
+           let l: [UInt8] = RLP.encodeList([[0], RLP.encodeString([1, 2])])
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidRLPEncodeList(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.RLPContract)
+
+	_, err := ParseAndCheckWithOptions(t,
+		`
+           let l: [[UInt8]] = RLP.encodeList("string")
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+
+	errs := RequireCheckerErrors(t, err, 2)
+	var mismatch *sema.TypeMismatchError
+	require.IsType(t, mismatch, errs[0])
+	require.IsType(t, mismatch, errs[1])
+}
@@ -0,0 +1,339 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func parseAndCheckSet(t *testing.T, code string) (*sema.Checker, error) {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+}
+
+func TestCheckSet(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := parseAndCheckSet(t, `
+       fun test() {
+           let set: Set<Int> = Set([1, 2, 3])
+           let inferred = Set<String>([])
+
+           let length: Int = set.length
+           let contains: Bool = set.contains(1)
+
+           let union: Set<Int> = set.union(Set([4]))
+           let intersection: Set<Int> = set.intersection(Set([1]))
+           let difference: Set<Int> = set.difference(Set([2]))
+
+           set.forEach(fun (element: Int): Bool {
+               return true
+           })
+
+           let equal: Bool = set == union
+       }
+
+       fun mutate(_ set: auth(Mutate) &Set<Int>) {
+           let inserted: Bool = set.insert(4)
+           let removed: Bool = set.remove(1)
+       }
+    `)
+	require.NoError(t, err)
+}
+
+func TestCheckSetTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t,
+		sema.TypeID("Set<Int>"),
+		(&sema.SetType{ElementType: sema.IntType}).ID(),
+	)
+
+	assert.Equal(t,
+		sema.TypeID("Set<String?>"),
+		(&sema.SetType{
+			ElementType: &sema.OptionalType{
+				Type: sema.StringType,
+			},
+		}).ID(),
+	)
+}
+
+func TestCheckSetSubtyping(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := parseAndCheckSet(t, `
+       let set: Set<Integer> = Set<Int>([1])
+       let hashable: Set<HashableStruct> = set
+       let any: AnyStruct = hashable
+    `)
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidSetElementType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("non-hashable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           let set: Set<[Int]> = Set([[1]])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		require.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           resource R {}
+
+           fun test(set: Set<@R>) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("mismatched insert", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           fun test() {
+               let set = Set<Int>([])
+               set.insert("1")
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           let set: Set = Set([1])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+	})
+}
+
+func TestCheckSetMutationRequiresEntitlement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("insert", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           fun test(set: &Set<Int>) {
+               set.insert(1)
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("remove", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           fun test(set: &Set<Int>) {
+               set.remove(1)
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("insert, Insert entitlement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           fun test(set: auth(Insert) &Set<Int>) {
+               set.insert(1)
+               set.contains(1)
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("remove, Remove entitlement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           fun test(set: auth(Remove) &Set<Int>) {
+               set.remove(1)
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSetLeastCommonSuperType(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := parseAndCheckSet(t, `
+       let sets = [Set<Int>([1]), Set<Int8>([2])]
+    `)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: &sema.SetType{
+				ElementType: sema.SignedIntegerType,
+			},
+		},
+		RequireGlobalValue(t, checker.Elaboration, "sets"),
+	)
+}
+
+func TestCheckSetShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	// Programs declared their own `Set` types before the built-in type was added,
+	// so such declarations must shadow the built-in type and constructor
+
+	t.Run("top-level struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckSet(t, `
+           struct Set {
+               let name: String
+
+               init(name: String) {
+                   self.name = name
+               }
+           }
+
+           let set: Set = Set(name: "test")
+        `)
+		require.NoError(t, err)
+
+		setType := RequireGlobalValue(t, checker.Elaboration, "set")
+		require.IsType(t, &sema.CompositeType{}, setType)
+	})
+
+	t.Run("nested resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           contract C {
+
+               resource Set {
+                   let name: String
+
+                   init(name: String) {
+                       self.name = name
+                   }
+               }
+
+               let sets: @{UInt32: Set}
+
+               fun createSet(name: String): @Set {
+                   return <-create Set(name: name)
+               }
+
+               fun borrowSet(id: UInt32): &Set? {
+                   return &self.sets[id]
+               }
+
+               init() {
+                   self.sets <- {}
+               }
+           }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("built-in outside of declaring scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckSet(t, `
+           contract C {
+               struct Set {}
+           }
+
+           let set: Set<Int> = Set([1])
+        `)
+		require.NoError(t, err)
+
+		setType := RequireGlobalValue(t, checker.Elaboration, "set")
+		require.IsType(t, &sema.SetType{}, setType)
+	})
+
+	t.Run("redeclaration in same scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckSet(t, `
+           struct Set {}
+           struct Set {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+		require.IsType(t, &sema.RedeclarationError{}, errs[0])
+		require.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+}
@@ -534,3 +534,236 @@ func TestCheckStringReplaceAllTypeMissingArgumentLabelWith(t *testing.T) {
 
 	assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
 }
+
+func TestCheckStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = "Abc".toUpper()
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
+func TestCheckStringSearch(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+		let s = "👪.❤️.Abc"
+		let a = s.contains("❤️")
+		let b = s.index(of: "Abc")
+		let c = s.startsWith("👪")
+		let d = s.endsWith("Abc")
+		let e = s.count(of: ".")
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.BoolType,
+		RequireGlobalValue(t, checker.Elaboration, "a"),
+	)
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: sema.IntType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "b"),
+	)
+	assert.Equal(t,
+		sema.BoolType,
+		RequireGlobalValue(t, checker.Elaboration, "c"),
+	)
+	assert.Equal(t,
+		sema.BoolType,
+		RequireGlobalValue(t, checker.Elaboration, "d"),
+	)
+	assert.Equal(t,
+		sema.IntType,
+		RequireGlobalValue(t, checker.Elaboration, "e"),
+	)
+}
+
+func TestCheckStringSearchTypeMismatch(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		let a: Character = "x"
+		let b = "Abc".contains(1)
+		let c = "Abc".startsWith(a)
+	`)
+
+	errs := RequireCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+}
+
+func TestCheckStringSearchMissingArgumentLabel(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		let a = "Abc".index("b")
+		let b = "Abc".count("b")
+	`)
+
+	errs := RequireCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[1])
+}
+
+func TestCheckStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = "  Abc ".trim()
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
+func TestCheckStringPad(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+		let a = "1".padStart(toLength: 3, with: "0")
+		let b = "1".padEnd(toLength: 3, with: "0")
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "a"),
+	)
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "b"),
+	)
+}
+
+func TestCheckStringPadTypeMismatch(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		let a = "1".padStart(toLength: 3, with: "00")
+		let b = "1".padEnd(toLength: "3", with: "0")
+	`)
+
+	errs := RequireCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.InvalidCharacterLiteralError{}, errs[0])
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+}
+
+func TestCheckStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let a = "abc"
+            let b: Character = "d"
+            let c = 1
+            let d = 2.5
+            let e: Address = 0x1
+            let f = /storage/foo
+            let x = "\(a) \(b) \(c) \(d) \(e) \(f) \(c + 1)"
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+            let a = 1
+            let x = "\("nested \(a)")"
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("invalid, bool", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let x = "\(true)"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateValueTypeError{}, errs[0])
+	})
+
+	t.Run("invalid, optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let a: Int? = 1
+            let x = "\(a)"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateValueTypeError{}, errs[0])
+	})
+
+	t.Run("invalid, array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let x = "\([1, 2])"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateValueTypeError{}, errs[0])
+	})
+
+	t.Run("invalid, undeclared", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+            let x = "\(y)"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
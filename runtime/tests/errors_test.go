@@ -20,8 +20,12 @@ package tests
 
 import (
 	"fmt"
+	goast "go/ast"
+	goparser "go/parser"
+	"go/token"
 	"go/types"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"golang.org/x/tools/go/packages"
@@ -30,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/parser"
 )
@@ -75,6 +80,9 @@ func TestErrorInterfaceConformance(t *testing.T) {
 		interpreter.Error{},
 		runtime.Error{},
 		interpreter.StackTraceError{},
+		interpreter.PositionedError{},
+		// Reported wrapped in an external error, as cancellation is not deterministic
+		runtime.ExecutionCanceledError{},
 	}
 
 	errorsToSkip := make(map[string]any)
@@ -93,6 +101,7 @@ func TestErrorInterfaceConformance(t *testing.T) {
 		},
 		"github.com/onflow/cadence/runtime",
 		"github.com/onflow/cadence/runtime/interpreter",
+		"github.com/onflow/cadence/runtime/meter",
 		"github.com/onflow/cadence/runtime/sema",
 		"github.com/onflow/cadence/runtime/parser",
 		"github.com/onflow/cadence/runtime/stdlib",
@@ -145,3 +154,111 @@ func TestErrorInterfaceConformance(t *testing.T) {
 		}
 	}
 }
+
+// TestErrorCodes checks that all user errors have an error code,
+// and that no error code is used more than once.
+//
+// The source files are inspected syntactically,
+// as the error codes must be constant.
+func TestErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	pkgs, err := packages.Load(
+		&packages.Config{
+			Mode: packages.NeedName | packages.NeedFiles,
+		},
+		"github.com/onflow/cadence/runtime/...",
+	)
+	require.NoError(t, err)
+
+	// The old parser is the parser of Cadence before v1.0.
+	// It is only used to parse the existing code of contracts in legacy contract updates,
+	// and its errors are reported wrapped in an InvalidContractDeploymentError, which has an error code
+	packagesToSkip := map[string]struct{}{
+		"github.com/onflow/cadence/runtime/old_parser":       {},
+		"github.com/onflow/cadence/runtime/old_parser/lexer": {},
+	}
+
+	userErrors := map[string]struct{}{}
+	errorCodes := map[string]errors.ErrorCode{}
+	errorCodeUsers := map[errors.ErrorCode][]string{}
+
+	receiverTypeName := func(funcDecl *goast.FuncDecl) string {
+		receiverType := funcDecl.Recv.List[0].Type
+		if starExpr, ok := receiverType.(*goast.StarExpr); ok {
+			receiverType = starExpr.X
+		}
+		// Receivers of methods of generic types have type parameters
+		switch indexExpr := receiverType.(type) {
+		case *goast.IndexExpr:
+			receiverType = indexExpr.X
+		case *goast.IndexListExpr:
+			receiverType = indexExpr.X
+		}
+		return receiverType.(*goast.Ident).Name
+	}
+
+	for _, pkg := range pkgs {
+		if _, ok := packagesToSkip[pkg.PkgPath]; ok {
+			continue
+		}
+
+		for _, path := range pkg.GoFiles {
+			file, err := goparser.ParseFile(token.NewFileSet(), path, nil, 0)
+			require.NoError(t, err)
+
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*goast.FuncDecl)
+				if !ok || funcDecl.Recv == nil {
+					continue
+				}
+
+				typeName := pkg.PkgPath + "." + receiverTypeName(funcDecl)
+
+				switch funcDecl.Name.Name {
+				case "IsUserError":
+					userErrors[typeName] = struct{}{}
+
+				case "ErrorCode":
+					statements := funcDecl.Body.List
+					require.Len(t, statements, 1, typeName)
+					returnStatement, ok := statements[0].(*goast.ReturnStmt)
+					require.True(t, ok, typeName)
+					literal, ok := returnStatement.Results[0].(*goast.BasicLit)
+					require.True(t, ok, "%s: error code must be an integer literal", typeName)
+
+					value, err := strconv.ParseUint(literal.Value, 10, 16)
+					require.NoError(t, err, typeName)
+					code := errors.ErrorCode(value)
+
+					errorCodes[typeName] = code
+					errorCodeUsers[code] = append(errorCodeUsers[code], typeName)
+				}
+			}
+		}
+	}
+
+	require.NotEmpty(t, userErrors)
+
+	for typeName := range userErrors { // nolint:maprange
+		_, hasCode := errorCodes[typeName]
+		assert.True(t, hasCode, "user error %s has no error code", typeName)
+	}
+
+	for typeName, code := range errorCodes { // nolint:maprange
+		_, isUserError := userErrors[typeName]
+		assert.True(t, isUserError, "%s has an error code, but is not a user error", typeName)
+
+		category := code.Category()
+		assert.True(t,
+			category > errors.ErrorCategoryUnknown && category <= errors.ErrorCategoryArgument,
+			"error code %s of %s has an invalid category",
+			code,
+			typeName,
+		)
+	}
+
+	for code, typeNames := range errorCodeUsers { // nolint:maprange
+		assert.Len(t, typeNames, 1, "error code %s is used more than once: %v", code, typeNames)
+	}
+}
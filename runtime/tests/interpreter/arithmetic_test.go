@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -550,6 +551,51 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.Fix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			subtract: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			divide: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-1), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+			},
+		},
 		sema.UIntType: {
 			subtract: testCalls{
 				underflow: testCall{
@@ -720,6 +766,29 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.UFix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+				},
+			},
+			subtract: testCalls{
+				underflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2), interpreter.EmptyLocationRange),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+				},
+			},
+		},
 	}
 
 	// Verify all test cases exist
@@ -0,0 +1,1079 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/activations"
+	"github.com/onflow/cadence/runtime/bbq"
+	"github.com/onflow/cadence/runtime/bbq/compiler"
+	"github.com/onflow/cadence/runtime/bbq/vm"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// parseCheckAndInterpretWithPanic is like parseCheckAndInterpret,
+// but also declares the panic function
+func parseCheckAndInterpretWithPanic(t testing.TB, code string) *interpreter.Interpreter {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.PanicFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.PanicFunction)
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: &interpreter.Config{
+				BaseActivationHandler: func(_ common.Location) *interpreter.VariableActivation {
+					return baseActivation
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	return inter
+}
+
+func compileBytecode(t testing.TB, inter *interpreter.Interpreter) *bbq.Program {
+	program, err := compiler.NewCompiler(
+		inter.Program.Program,
+		inter.Program.Elaboration,
+	).Compile()
+	require.NoError(t, err)
+	return program
+}
+
+// testBothEngines invokes the function `test` of the given program
+// with the interpreter and with the bytecode VM,
+// and asserts that both engines produce the same result
+func testBothEngines(t *testing.T, code string, arguments ...interpreter.Value) {
+
+	inter := parseCheckAndInterpretWithPanic(t, code)
+	expected, expectedErr := inter.Invoke("test", arguments...)
+
+	vmInter := parseCheckAndInterpretWithPanic(t, code)
+	program := compileBytecode(t, vmInter)
+	actual, actualErr := vm.NewVM(program, vmInter).Invoke("test", arguments...)
+
+	if expectedErr != nil {
+		require.Error(t, actualErr)
+
+		var expectedInterpreterErr, actualInterpreterErr interpreter.Error
+		require.ErrorAs(t, expectedErr, &expectedInterpreterErr)
+		require.ErrorAs(t, actualErr, &actualInterpreterErr)
+
+		assert.Equal(t,
+			reflect.TypeOf(unwrapPositionedError(expectedInterpreterErr.Err)),
+			reflect.TypeOf(unwrapPositionedError(actualInterpreterErr.Err)),
+		)
+		return
+	}
+
+	require.NoError(t, actualErr)
+
+	assert.Equal(t, expected.String(), actual.String())
+	assert.True(t,
+		expected.StaticType(inter).Equal(actual.StaticType(vmInter)),
+		"expected static type %s, got %s",
+		expected.StaticType(inter),
+		actual.StaticType(vmInter),
+	)
+}
+
+// unwrapPositionedError returns the error wrapped by the given positioned error.
+// The VM does not track the current statement,
+// so it does not add position information to errors
+func unwrapPositionedError(err error) error {
+	positionedErr, ok := err.(interpreter.PositionedError)
+	if ok {
+		return positionedErr.Err
+	}
+	return err
+}
+
+func TestInterpretBytecodeDifferential(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		code      string
+		arguments []interpreter.Value
+	}
+
+	testCases := []testCase{
+		{
+			name: "recursion",
+			code: `
+              fun fib(_ n: Int): Int {
+                  if n < 2 {
+                     return n
+                  }
+                  return fib(n - 1) + fib(n - 2)
+              }
+
+              fun test(_ n: Int): Int {
+                  return fib(n)
+              }
+            `,
+			arguments: []interpreter.Value{
+				interpreter.NewUnmeteredIntValueFromInt64(14),
+			},
+		},
+		{
+			name: "while",
+			code: `
+              fun test(): Int {
+                  var x = 0
+                  while x < 5 {
+                      x = x + 2
+                  }
+                  return x
+              }
+            `,
+		},
+		{
+			name: "while, break and continue",
+			code: `
+              fun test(): Int {
+                  var i = 0
+                  var x = 0
+                  while true {
+                      i = i + 1
+                      if i > 10 {
+                          break
+                      }
+                      if i % 2 == 0 {
+                          continue
+                      }
+                      x = x + i
+                  }
+                  return x
+              }
+            `,
+		},
+		{
+			name: "while, return",
+			code: `
+              fun test(): Int {
+                  var x = 0
+                  while x < 10 {
+                      x = x + 2
+                      if x > 5 {
+                          return x
+                      }
+                  }
+                  return x
+              }
+            `,
+		},
+		{
+			name: "if, else",
+			code: `
+              fun sign(_ x: Int): Int {
+                  if x > 0 {
+                      return 1
+                  } else if x < 0 {
+                      return -1
+                  } else {
+                      return 0
+                  }
+              }
+
+              fun test(): [Int] {
+                  return [sign(3), sign(-3), sign(0)]
+              }
+            `,
+		},
+		{
+			name: "for-in with index",
+			code: `
+              fun test(): [Int] {
+                  let xs = [10, 20, 30]
+                  var values: [Int] = []
+                  for i, x in xs {
+                      if i == 1 {
+                          continue
+                      }
+                      values.append(i)
+                      values.append(x)
+                  }
+                  return values
+              }
+            `,
+		},
+		{
+			name: "for-in, nested, break",
+			code: `
+              fun test(): Int {
+                  var sum = 0
+                  for x in [1, 2, 3] {
+                      for y in [10, 20, 30] {
+                          if y > 20 {
+                              break
+                          }
+                          sum = sum + x * y
+                      }
+                  }
+                  return sum
+              }
+            `,
+		},
+		{
+			name: "for-in, return",
+			code: `
+              fun test(): String {
+                  for s in ["a", "b", "c"] {
+                      if s == "b" {
+                          return s
+                      }
+                  }
+                  return "none"
+              }
+            `,
+		},
+		{
+			name: "array functions",
+			code: `
+              fun test(): [Int] {
+                  let xs: [Int] = []
+                  xs.append(1)
+                  xs.appendAll([2, 3])
+                  xs.insert(at: 0, 0)
+                  xs[1] = 42
+                  let removed = xs.removeLast()
+                  xs.append(xs.length)
+                  xs.append(removed)
+                  return xs
+              }
+            `,
+		},
+		{
+			name: "array copy semantics",
+			code: `
+              fun test(): [[Int]] {
+                  let xs = [1, 2]
+                  let ys = xs
+                  ys.append(3)
+                  let nested = [xs, ys]
+                  nested[0].append(4)
+                  return [xs, ys, nested[0], nested[1]]
+              }
+            `,
+		},
+		{
+			name: "array out of bounds",
+			code: `
+              fun test(): Int {
+                  let xs = [1, 2]
+                  return xs[2]
+              }
+            `,
+		},
+		{
+			name: "struct",
+			code: `
+              struct Counter {
+                  var count: Int
+
+                  init(count: Int) {
+                      self.count = count
+                  }
+
+                  fun increment(by amount: Int): Int {
+                      self.count = self.count + amount
+                      return self.count
+                  }
+              }
+
+              fun test(): [Int] {
+                  let counter = Counter(count: 1)
+                  counter.increment(by: 2)
+                  let copy = counter
+                  copy.increment(by: 10)
+                  return [counter.count, copy.count]
+              }
+            `,
+		},
+		{
+			name: "struct result",
+			code: `
+              struct Point {
+                  let x: Int
+                  let y: Int
+
+                  init(x: Int, y: Int) {
+                      self.x = x
+                      self.y = y
+                  }
+              }
+
+              fun test(): Point {
+                  return Point(x: 1, y: 2)
+              }
+            `,
+		},
+		{
+			name: "structs in array",
+			code: `
+              struct S {
+                  var value: Int
+
+                  init(_ value: Int) {
+                      self.value = value
+                  }
+              }
+
+              fun test(): [Int] {
+                  let structs = [S(1), S(2)]
+                  let first = structs[0]
+                  first.value = 10
+                  structs[1].value = 20
+                  var values: [Int] = []
+                  for s in structs {
+                      values.append(s.value)
+                  }
+                  values.append(first.value)
+                  return values
+              }
+            `,
+		},
+		{
+			name: "initializer with early return",
+			code: `
+              struct S {
+                  var x: Int
+
+                  init(_ x: Int) {
+                      self.x = x
+                      if x > 0 {
+                          return
+                      }
+                      self.x = 0
+                  }
+              }
+
+              fun test(): [Int] {
+                  return [S(1).x, S(-1).x]
+              }
+            `,
+		},
+		{
+			name: "struct field of struct type",
+			code: `
+              struct Inner {
+                  let value: String
+
+                  init(value: String) {
+                      self.value = value
+                  }
+
+                  fun get(): String {
+                      return self.value
+                  }
+              }
+
+              struct Outer {
+                  let inner: Inner
+
+                  init() {
+                      self.inner = Inner(value: "inner")
+                  }
+              }
+
+              fun test(): String {
+                  return Outer().inner.get()
+              }
+            `,
+		},
+		{
+			name: "interface",
+			code: `
+              struct interface Shape {
+                  fun area(): Int
+              }
+
+              struct Square: Shape {
+                  let side: Int
+
+                  init(side: Int) {
+                      self.side = side
+                  }
+
+                  fun area(): Int {
+                      return self.side * self.side
+                  }
+              }
+
+              struct Rectangle: Shape {
+                  let width: Int
+                  let height: Int
+
+                  init(width: Int, height: Int) {
+                      self.width = width
+                      self.height = height
+                  }
+
+                  fun area(): Int {
+                      return self.width * self.height
+                  }
+              }
+
+              fun test(): Int {
+                  let shapes: [{Shape}] = [Square(side: 2), Rectangle(width: 2, height: 3)]
+                  var total = 0
+                  for shape in shapes {
+                      total = total + shape.area()
+                  }
+                  return total
+              }
+            `,
+		},
+		{
+			name: "optionals",
+			code: `
+              fun find(_ xs: [Int], _ value: Int): Int? {
+                  for i, x in xs {
+                      if x == value {
+                          return i
+                      }
+                  }
+                  return nil
+              }
+
+              fun test(): [Int] {
+                  let xs = [5, 6, 7]
+                  var values: [Int] = []
+                  values.append(find(xs, 6) ?? -1)
+                  values.append(find(xs, 8) ?? -1)
+                  values.append(find(xs, 7)!)
+                  if let index = find(xs, 5) {
+                      values.append(index)
+                  } else {
+                      values.append(-2)
+                  }
+                  if let index = find(xs, 9) {
+                      values.append(index)
+                  } else {
+                      values.append(-2)
+                  }
+                  return values
+              }
+            `,
+		},
+		{
+			name: "optional result",
+			code: `
+              fun test(): Int?? {
+                  let x: Int? = nil
+                  return x
+              }
+            `,
+		},
+		{
+			name: "force nil",
+			code: `
+              fun test(): Int {
+                  let x: Int? = nil
+                  return x!
+              }
+            `,
+		},
+		{
+			name: "overflow",
+			code: `
+              fun test(): UInt8 {
+                  let x: UInt8 = 255
+                  return x + 1
+              }
+            `,
+		},
+		{
+			name: "division by zero",
+			code: `
+              fun test(): Int {
+                  let x = 0
+                  return 1 / x
+              }
+            `,
+		},
+		{
+			name: "integer types",
+			code: `
+              fun test(): [AnyStruct] {
+                  let a: UInt8 = 200
+                  let b: Int64 = -5
+                  let c: Word8 = 255
+                  let d: UFix64 = 1.5
+                  let e: Fix64 = -2.25
+                  let f: Address = 0x1
+                  return [a / 3, b * 2, c + 1, d * 2.0, e + 0.25, f]
+              }
+            `,
+		},
+		{
+			name: "bitwise",
+			code: `
+              fun test(): [Int] {
+                  let x = 0b1100
+                  let y = 0b1010
+                  return [x | y, x & y, x ^ y, x << 2, x >> 2]
+              }
+            `,
+		},
+		{
+			name: "logical operators",
+			code: `
+              fun fail(): Bool {
+                  panic("evaluated")
+              }
+
+              fun test(): [Bool] {
+                  return [
+                      true || fail(),
+                      false && fail(),
+                      false || true,
+                      true && false,
+                      !true
+                  ]
+              }
+            `,
+		},
+		{
+			name: "conditional expression",
+			code: `
+              fun max(_ a: Int, _ b: Int): Int {
+                  return a > b ? a : b
+              }
+
+              fun test(): [Int] {
+                  return [max(1, 2), max(3, 2)]
+              }
+            `,
+		},
+		{
+			name: "strings",
+			code: `
+              fun test(): [AnyStruct] {
+                  let s = "hello"
+                  let c: Character = "x"
+                  return [s.length, s.concat(" world"), s == "hello", c, s.utf8[0]]
+              }
+            `,
+		},
+		{
+			name: "equality",
+			code: `
+              fun test(): [Bool] {
+                  let x: Int? = 1
+                  let s: String? = "a"
+                  return [x == 1, x != nil, 1 == 2, s == "a", s == nil]
+              }
+            `,
+		},
+		{
+			name: "panic",
+			code: `
+              fun test() {
+                  panic("test")
+              }
+            `,
+		},
+		{
+			name: "built-in functions",
+			code: `
+              fun test(): [AnyStruct] {
+                  let x: Int = 3
+                  return [x.toString(), UInt8(x), x.getType(), [1, 2].contains(2)]
+              }
+            `,
+		},
+		{
+			name: "locals in loop",
+			code: `
+              fun test(): [Int] {
+                  let values: [Int] = []
+                  var i = 0
+                  while i < 3 {
+                      let doubled = i * 2
+                      var squared = i
+                      squared = squared * i
+                      values.append(doubled + squared)
+                      i = i + 1
+                  }
+                  return values
+              }
+            `,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBothEngines(t, testCase.code, testCase.arguments...)
+		})
+	}
+}
+
+// bytecodeExistingTestsPrefix is the name prefix of the tests
+// which are run by TestInterpretBytecodeExistingTests to collect programs
+const bytecodeExistingTestsPrefix = "TestInterpretBytecodeExistingTests/interpreter/"
+
+// bytecodeTestPrograms are the programs interpreted by the existing tests
+// run by TestInterpretBytecodeExistingTests, keyed by test name
+var bytecodeTestPrograms = struct {
+	sync.Mutex
+	codes map[string][]string
+}{
+	codes: map[string][]string{},
+}
+
+// recordBytecodeTestProgram records the given program,
+// if it is interpreted by an existing test run by TestInterpretBytecodeExistingTests
+func recordBytecodeTestProgram(t testing.TB, code string) {
+	name := t.Name()
+	if !strings.HasPrefix(name, bytecodeExistingTestsPrefix) {
+		return
+	}
+
+	bytecodeTestPrograms.Lock()
+	defer bytecodeTestPrograms.Unlock()
+
+	bytecodeTestPrograms.codes[name] = append(bytecodeTestPrograms.codes[name], code)
+}
+
+// bytecodeExistingTestsUnsupported are the existing tests run by TestInterpretBytecodeExistingTests,
+// which are known to have no programs which can be compared yet,
+// as they use features not supported by the compiler yet
+var bytecodeExistingTestsUnsupported = map[string]struct{}{
+	"TestInterpretArrayFunctionEntitlements":                           {}, // constant declarations
+	"TestInterpretFunctionWithoutResultAndPostTestConditionWithResult": {}, // function conditions
+	"TestInterpretFunctionPostTestConditionWithBefore":                 {}, // variable declarations
+	"TestInterpretDictionaryMutation":                                  {}, // casting expressions, dictionary literals
+	"TestInterpretContainerMutationAfterNilCoalescing":                 {}, // dictionary literals
+	"TestInterpretPassBuiltinByValue":                                  {}, // function types
+	"TestInterpretDictionaryFunctionEntitlements":                      {}, // constant declarations
+	"TestInterpretDynamicCastingNumber":                                {}, // casting expressions
+	"TestInterpretDynamicCastingVoid":                                  {}, // casting expressions
+	"TestInterpretDynamicCastingString":                                {}, // casting expressions
+	"TestInterpretDynamicCastingBool":                                  {}, // casting expressions
+	"TestInterpretDynamicCastingAddress":                               {}, // casting expressions
+	"TestInterpretDynamicCastingStruct":                                {}, // casting expressions
+	"TestInterpretDynamicCastingSome":                                  {}, // casting expressions
+	"TestInterpretDynamicCastingArray":                                 {}, // casting expressions
+	"TestInterpretDynamicCastingDictionary":                            {}, // dictionary literals
+	"TestInterpretFunctionTypeCasting":                                 {}, // casting expressions, functions as values, function types
+	"TestInterpretReferenceCasting":                                    {}, // casting expressions, dictionary literals
+	"TestInterpretEntitlementMappingFields":                            {}, // entitlement declarations
+	"TestInterpretEntitlementMappingAccessors":                         {}, // entitlement declarations
+	"TestInterpretEntitledReferenceCollections":                        {}, // entitlement declarations
+	"TestInterpretEntitlementMappingComplexFields":                     {}, // entitlement declarations
+	"TestInterpretEqualityOnNumericSuperTypes":                         {}, // casting expressions
+	"TestInterpretForStatementCapturing":                               {}, // function types
+	"TestInterpretGuardStatementInLoop":                                {}, // guard statements
+	"TestInterpretIntegerLiteralTypeConversionInAssignment":            {}, // variable declarations
+	"TestInterpretIntegerLiteralTypeConversionInAssignmentOptional":    {}, // variable declarations
+	"TestInterpretInterfaceDefaultImplementation":                      {}, // interface functions with code
+	"TestInterpretNoHoisting":                                          {}, // constant declarations
+	"TestInterpretGlobalVariableAssignment":                            {}, // variable declarations
+	"TestInterpretConstantRedeclaration":                               {}, // constant declarations
+	"TestInterpretArrayIndexingAssignment":                             {}, // constant declarations
+	"TestInterpretExpressionStatement":                                 {}, // variable declarations
+	"TestInterpretStructureConstructorUseInInitializerAndFunction":     {}, // functions as values
+	"TestInterpretStructureConstructorUseInFunction":                   {}, // functions as values
+	"TestInterpretUseBeforeDeclaration":                                {}, // variable declarations
+	"TestInterpretOptionalAssignment":                                  {}, // variable declarations
+	"TestInterpretSomeReturnValueFromDictionary":                       {}, // dictionary literals
+	"TestInterpretDictionaryIndexingAssignmentExisting":                {}, // constant declarations
+	"TestInterpretDictionaryIndexingAssignmentNew":                     {}, // constant declarations
+	"TestInterpretDictionaryIndexingAssignmentNil":                     {}, // constant declarations
+	"TestInterpretDictionaryEquality":                                  {}, // dictionary literals
+	"TestInterpretStructureFunctionBindingInside":                      {}, // function types
+	"TestInterpretStructureFunctionBindingOutside":                     {}, // function types
+	"TestInterpretArrayAppend":                                         {}, // constant declarations
+	"TestInterpretArrayAppendBound":                                    {}, // function types
+	"TestInterpretArrayAppendAllBound":                                 {}, // function types
+	"TestInterpretArrayConcatBound":                                    {}, // function types
+	"TestInterpretInvalidArrayRemoveFirst":                             {}, // constant declarations
+	"TestInterpretInvalidArrayRemoveLast":                              {}, // constant declarations
+	"TestInterpretStringConcatBound":                                   {}, // function types
+	"TestInterpretDictionaryKeys":                                      {}, // dictionary literals
+	"TestInterpretDictionaryForEach":                                   {}, // dictionary literals
+	"TestInterpretDictionaryFilter":                                    {}, // constant declarations
+	"TestInterpretDictionaryMap":                                       {}, // dictionary literals
+	"TestInterpretDictionaryToEntries":                                 {}, // dictionary literals
+	"TestInterpretDictionaryValues":                                    {}, // dictionary literals
+	"TestInterpretSwapVariables":                                       {}, // swap statements
+	"TestInterpretSwapArrayAndField":                                   {}, // swap statements
+	"TestInterpretInterfaceInitializer":                                {}, // interface initializers with code
+	"TestInterpretOptionalChainingArgumentEvaluation":                  {}, // variable declarations
+	"TestInterpretReferenceUseAfterCopy":                               {}, // casting expressions
+	"TestInterpretForce":                                               {}, // constant declarations
+	"TestInterpretInternalAssignment":                                  {}, // dictionary literals
+	"TestInterpretCopyOnReturn":                                        {}, // constant declarations
+	"TestInterpretMissingMember":                                       {}, // constant declarations
+	"TestInterpretArrayFirstIndex":                                     {}, // constant declarations
+	"TestInterpretArrayFirstIndexDoesNotExist":                         {}, // constant declarations
+	"TestInterpretArraySort":                                           {}, // function expressions
+	"TestInterpretArrayToVariableSized":                                {}, // constant declarations
+	"TestInterpretArrayToConstantSized":                                {}, // constant declarations
+	"TestInterpretConditionsWrapperFunctionType":                       {}, // interface functions with code
+	"TestInterpretContainerVariance":                                   {}, // dictionary literals
+	"TestInterpretRecursiveValueString":                                {}, // dictionary literals
+}
+
+// TestInterpretBytecodeExistingTests runs a subset of the existing interpreter tests,
+// and then invokes the function `test` of each program interpreted by them
+// with the interpreter and with the bytecode VM.
+//
+// Programs which have no function `test` without parameters,
+// or which use features not supported by the compiler yet, are not compared.
+// Each test must have compared programs, unless it is listed in bytecodeExistingTestsUnsupported.
+func TestInterpretBytecodeExistingTests(t *testing.T) {
+
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"TestInterpretArrayFunctionEntitlements":                           TestInterpretArrayFunctionEntitlements,
+		"TestInterpretAddressFromBytes":                                    TestInterpretAddressFromBytes,
+		"TestInterpretAddressFromString":                                   TestInterpretAddressFromString,
+		"TestInterpretCharacterUtf8Field":                                  TestInterpretCharacterUtf8Field,
+		"TestInterpretFunctionWithoutResultAndPostTestConditionWithResult": TestInterpretFunctionWithoutResultAndPostTestConditionWithResult,
+		"TestInterpretFunctionPostTestConditionWithBefore":                 TestInterpretFunctionPostTestConditionWithBefore,
+		"TestInterpetArrayMutation":                                        TestInterpetArrayMutation,
+		"TestInterpretDictionaryMutation":                                  TestInterpretDictionaryMutation,
+		"TestInterpretContainerMutationAfterNilCoalescing":                 TestInterpretContainerMutationAfterNilCoalescing,
+		"TestInterpretInnerContainerMutationWhileIteratingOuter":           TestInterpretInnerContainerMutationWhileIteratingOuter,
+		"TestInterpretPassBuiltinByValue":                                  TestInterpretPassBuiltinByValue,
+		"TestInterpretDictionaryFunctionEntitlements":                      TestInterpretDictionaryFunctionEntitlements,
+		"TestInterpretDynamicCastingNumber":                                TestInterpretDynamicCastingNumber,
+		"TestInterpretDynamicCastingVoid":                                  TestInterpretDynamicCastingVoid,
+		"TestInterpretDynamicCastingString":                                TestInterpretDynamicCastingString,
+		"TestInterpretDynamicCastingBool":                                  TestInterpretDynamicCastingBool,
+		"TestInterpretDynamicCastingAddress":                               TestInterpretDynamicCastingAddress,
+		"TestInterpretDynamicCastingStruct":                                TestInterpretDynamicCastingStruct,
+		"TestInterpretDynamicCastingSome":                                  TestInterpretDynamicCastingSome,
+		"TestInterpretDynamicCastingArray":                                 TestInterpretDynamicCastingArray,
+		"TestInterpretDynamicCastingDictionary":                            TestInterpretDynamicCastingDictionary,
+		"TestInterpretFunctionTypeCasting":                                 TestInterpretFunctionTypeCasting,
+		"TestInterpretReferenceCasting":                                    TestInterpretReferenceCasting,
+		"TestInterpretEntitlementMappingFields":                            TestInterpretEntitlementMappingFields,
+		"TestInterpretEntitlementMappingAccessors":                         TestInterpretEntitlementMappingAccessors,
+		"TestInterpretEntitledReferenceCollections":                        TestInterpretEntitledReferenceCollections,
+		"TestInterpretEntitlementMappingComplexFields":                     TestInterpretEntitlementMappingComplexFields,
+		"TestInterpretEqualityOnNumericSuperTypes":                         TestInterpretEqualityOnNumericSuperTypes,
+		"TestInterpretFixedPointConversions":                               TestInterpretFixedPointConversions,
+		"TestInterpretFix128":                                              TestInterpretFix128,
+		"TestInterpretForStatement":                                        TestInterpretForStatement,
+		"TestInterpretForStatementWithIndex":                               TestInterpretForStatementWithIndex,
+		"TestInterpretForStatementWithStoredIndex":                         TestInterpretForStatementWithStoredIndex,
+		"TestInterpretForStatementWithReturn":                              TestInterpretForStatementWithReturn,
+		"TestInterpretForStatementWithContinue":                            TestInterpretForStatementWithContinue,
+		"TestInterpretForStatementWithBreak":                               TestInterpretForStatementWithBreak,
+		"TestInterpretForStatementEmpty":                                   TestInterpretForStatementEmpty,
+		"TestInterpretForString":                                           TestInterpretForString,
+		"TestInterpretForStatementCapturing":                               TestInterpretForStatementCapturing,
+		"TestInterpretGuardStatementInLoop":                                TestInterpretGuardStatementInLoop,
+		"TestInterpretAddressConversion":                                   TestInterpretAddressConversion,
+		"TestInterpretIntegerLiteralTypeConversionInAssignment":            TestInterpretIntegerLiteralTypeConversionInAssignment,
+		"TestInterpretIntegerLiteralTypeConversionInAssignmentOptional":    TestInterpretIntegerLiteralTypeConversionInAssignmentOptional,
+		"TestInterpretIntegerLiteralTypeConversionInReturn":                TestInterpretIntegerLiteralTypeConversionInReturn,
+		"TestInterpretIntegerLiteralTypeConversionInReturnOptional":        TestInterpretIntegerLiteralTypeConversionInReturnOptional,
+		"TestInterpretInterfaceDefaultImplementation":                      TestInterpretInterfaceDefaultImplementation,
+		"TestInterpretDeclarations":                                        TestInterpretDeclarations,
+		"TestInterpretNoHoisting":                                          TestInterpretNoHoisting,
+		"TestInterpretVariableAssignment":                                  TestInterpretVariableAssignment,
+		"TestInterpretGlobalVariableAssignment":                            TestInterpretGlobalVariableAssignment,
+		"TestInterpretConstantRedeclaration":                               TestInterpretConstantRedeclaration,
+		"TestInterpretArrayEquality":                                       TestInterpretArrayEquality,
+		"TestInterpretArrayIndexing":                                       TestInterpretArrayIndexing,
+		"TestInterpretArrayIndexingAssignment":                             TestInterpretArrayIndexingAssignment,
+		"TestInterpretStringSlicing":                                       TestInterpretStringSlicing,
+		"TestInterpretExpressionStatement":                                 TestInterpretExpressionStatement,
+		"TestInterpretStructureSelfUseInInitializer":                       TestInterpretStructureSelfUseInInitializer,
+		"TestInterpretStructureConstructorUseInInitializerAndFunction":     TestInterpretStructureConstructorUseInInitializerAndFunction,
+		"TestInterpretStructureSelfUseInFunction":                          TestInterpretStructureSelfUseInFunction,
+		"TestInterpretStructureConstructorUseInFunction":                   TestInterpretStructureConstructorUseInFunction,
+		"TestInterpretStructureFunctionMutatesSelf":                        TestInterpretStructureFunctionMutatesSelf,
+		"TestInterpretStructCopyOnDeclaration":                             TestInterpretStructCopyOnDeclaration,
+		"TestInterpretStructCopyOnDeclarationModifiedWithStructFunction":   TestInterpretStructCopyOnDeclarationModifiedWithStructFunction,
+		"TestInterpretStructCopyOnIdentifierAssignment":                    TestInterpretStructCopyOnIdentifierAssignment,
+		"TestInterpretStructCopyOnIndexingAssignment":                      TestInterpretStructCopyOnIndexingAssignment,
+		"TestInterpretStructCopyOnMemberAssignment":                        TestInterpretStructCopyOnMemberAssignment,
+		"TestInterpretStructCopyOnPassing":                                 TestInterpretStructCopyOnPassing,
+		"TestInterpretArrayCopy":                                           TestInterpretArrayCopy,
+		"TestInterpretStructCopyInArray":                                   TestInterpretStructCopyInArray,
+		"TestInterpretUseBeforeDeclaration":                                TestInterpretUseBeforeDeclaration,
+		"TestInterpretOptionalParameterInvokedInternal":                    TestInterpretOptionalParameterInvokedInternal,
+		"TestInterpretOptionalAssignment":                                  TestInterpretOptionalAssignment,
+		"TestInterpretNilReturnValue":                                      TestInterpretNilReturnValue,
+		"TestInterpretSomeReturnValue":                                     TestInterpretSomeReturnValue,
+		"TestInterpretSomeReturnValueFromDictionary":                       TestInterpretSomeReturnValueFromDictionary,
+		"TestInterpretDictionaryIndexingAssignmentExisting":                TestInterpretDictionaryIndexingAssignmentExisting,
+		"TestInterpretDictionaryIndexingAssignmentNew":                     TestInterpretDictionaryIndexingAssignmentNew,
+		"TestInterpretDictionaryIndexingAssignmentNil":                     TestInterpretDictionaryIndexingAssignmentNil,
+		"TestInterpretDictionaryEquality":                                  TestInterpretDictionaryEquality,
+		"TestInterpretComparison":                                          TestInterpretComparison,
+		"TestInterpretStructureFunctionBindingInside":                      TestInterpretStructureFunctionBindingInside,
+		"TestInterpretStructureFunctionBindingOutside":                     TestInterpretStructureFunctionBindingOutside,
+		"TestInterpretArrayAppend":                                         TestInterpretArrayAppend,
+		"TestInterpretArrayAppendBound":                                    TestInterpretArrayAppendBound,
+		"TestInterpretArrayAppendAll":                                      TestInterpretArrayAppendAll,
+		"TestInterpretArrayAppendAllBound":                                 TestInterpretArrayAppendAllBound,
+		"TestInterpretArrayConcat":                                         TestInterpretArrayConcat,
+		"TestInterpretArrayConcatBound":                                    TestInterpretArrayConcatBound,
+		"TestInterpretArrayConcatDoesNotModifyOriginalArray":               TestInterpretArrayConcatDoesNotModifyOriginalArray,
+		"TestInterpretInvalidArrayRemoveFirst":                             TestInterpretInvalidArrayRemoveFirst,
+		"TestInterpretInvalidArrayRemoveLast":                              TestInterpretInvalidArrayRemoveLast,
+		"TestInterpretArraySlicing":                                        TestInterpretArraySlicing,
+		"TestInterpretStringConcat":                                        TestInterpretStringConcat,
+		"TestInterpretStringConcatBound":                                   TestInterpretStringConcatBound,
+		"TestInterpretDictionaryKeys":                                      TestInterpretDictionaryKeys,
+		"TestInterpretDictionaryForEach":                                   TestInterpretDictionaryForEach,
+		"TestInterpretDictionaryFilter":                                    TestInterpretDictionaryFilter,
+		"TestInterpretDictionaryMap":                                       TestInterpretDictionaryMap,
+		"TestInterpretDictionaryToEntries":                                 TestInterpretDictionaryToEntries,
+		"TestInterpretDictionaryValues":                                    TestInterpretDictionaryValues,
+		"TestInterpretSwapVariables":                                       TestInterpretSwapVariables,
+		"TestInterpretSwapArrayAndField":                                   TestInterpretSwapArrayAndField,
+		"TestInterpretInterfaceInitializer":                                TestInterpretInterfaceInitializer,
+		"TestInterpretOptionalChainingArgumentEvaluation":                  TestInterpretOptionalChainingArgumentEvaluation,
+		"TestInterpretHexDecode":                                           TestInterpretHexDecode,
+		"TestInterpretReferenceUseAfterCopy":                               TestInterpretReferenceUseAfterCopy,
+		"TestInterpretForce":                                               TestInterpretForce,
+		"TestInterpretInternalAssignment":                                  TestInterpretInternalAssignment,
+		"TestInterpretVoidReturn_":                                         TestInterpretVoidReturn_,
+		"TestInterpretCopyOnReturn":                                        TestInterpretCopyOnReturn,
+		"TestInterpretMissingMember":                                       TestInterpretMissingMember,
+		"TestInterpretArrayTypeInference":                                  TestInterpretArrayTypeInference,
+		"TestInterpretArrayFirstIndex":                                     TestInterpretArrayFirstIndex,
+		"TestInterpretArrayFirstIndexDoesNotExist":                         TestInterpretArrayFirstIndexDoesNotExist,
+		"TestInterpretArraySort":                                           TestInterpretArraySort,
+		"TestInterpretArrayToVariableSized":                                TestInterpretArrayToVariableSized,
+		"TestInterpretArrayToConstantSized":                                TestInterpretArrayToConstantSized,
+		"TestInterpretConditionsWrapperFunctionType":                       TestInterpretConditionsWrapperFunctionType,
+		"TestInterpretContainerVariance":                                   TestInterpretContainerVariance,
+		"TestInterpretRecursiveValueString":                                TestInterpretRecursiveValueString,
+		"TestInterpretStringFunction":                                      TestInterpretStringFunction,
+		"TestInterpretStringDecodeHex":                                     TestInterpretStringDecodeHex,
+		"TestInterpretStringEncodeHex":                                     TestInterpretStringEncodeHex,
+		"TestInterpretStringFromCharacters":                                TestInterpretStringFromCharacters,
+		"TestInterpretStringUtf8Field":                                     TestInterpretStringUtf8Field,
+		"TestInterpretStringToLower":                                       TestInterpretStringToLower,
+		"TestInterpretStringAccess":                                        TestInterpretStringAccess,
+		"TestInterpretCharacterLiteralType":                                TestInterpretCharacterLiteralType,
+		"TestInterpretOneCharacterStringLiteralType":                       TestInterpretOneCharacterStringLiteralType,
+		"TestInterpretCharacterLiteralTypeNoAnnotation":                    TestInterpretCharacterLiteralTypeNoAnnotation,
+		"TestInterpretConvertCharacterToString":                            TestInterpretConvertCharacterToString,
+		"TestInterpretStringToUpper":                                       TestInterpretStringToUpper,
+		"TestInterpretWhileStatement":                                      TestInterpretWhileStatement,
+		"TestInterpretWhileStatementWithReturn":                            TestInterpretWhileStatementWithReturn,
+		"TestInterpretWhileStatementWithContinue":                          TestInterpretWhileStatementWithContinue,
+		"TestInterpretWhileStatementWithBreak":                             TestInterpretWhileStatementWithBreak,
+	}
+
+	// Run the existing tests with the interpreter,
+	// which records the programs they interpret.
+	// The group only completes when all (parallel) subtests have completed
+
+	t.Run("interpreter", func(t *testing.T) {
+		for name, test := range tests { //nolint:maprange
+			t.Run(name, test)
+		}
+	})
+
+	bytecodeTestPrograms.Lock()
+	var names []string
+	for name := range bytecodeTestPrograms.codes { //nolint:maprange
+		if strings.HasPrefix(name, bytecodeExistingTestsPrefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	codes := make([][]string, len(names))
+	for i, name := range names {
+		codes[i] = bytecodeTestPrograms.codes[name]
+	}
+	bytecodeTestPrograms.Unlock()
+
+	require.NotEmpty(t, names)
+
+	// comparedCounts are the number of compared programs, by existing test
+	comparedCounts := map[string]int{}
+
+	for i, name := range names {
+		codes := codes[i]
+
+		name = strings.TrimPrefix(name, bytecodeExistingTestsPrefix)
+		testName, _, _ := strings.Cut(name, "/")
+
+		t.Run(name, func(t *testing.T) {
+			for _, code := range codes {
+				code := code
+
+				t.Run("", func(t *testing.T) {
+					reason := unsupportedBytecodeTestProgramReason(t, code)
+					if reason != "" {
+						t.Log(reason)
+						return
+					}
+
+					comparedCounts[testName]++
+
+					testBothEngines(t, code)
+				})
+			}
+		})
+	}
+
+	// Programs which are not supported are not compared.
+	// Ensure that each existing test has compared programs,
+	// unless it is known to have none, so missing coverage is not silently skipped
+
+	for testName := range tests { //nolint:maprange
+		_, unsupported := bytecodeExistingTestsUnsupported[testName]
+		compared := comparedCounts[testName] > 0
+
+		if unsupported && compared {
+			t.Errorf("%s has compared programs, remove it from the unsupported tests", testName)
+		} else if !unsupported && !compared {
+			t.Errorf("%s has no compared programs", testName)
+		}
+	}
+
+	for testName := range bytecodeExistingTestsUnsupported { //nolint:maprange
+		if _, ok := tests[testName]; !ok {
+			t.Errorf("unsupported test %s is not an existing test", testName)
+		}
+	}
+}
+
+// unsupportedBytecodeTestProgramReason returns why the program can not be compared
+// with the interpreter and with the bytecode VM, if it can not be:
+// The program must have a function `test` without parameters,
+// and it must not use features not supported by the compiler yet.
+func unsupportedBytecodeTestProgramReason(t *testing.T, code string) string {
+
+	inter := parseCheckAndInterpretWithPanic(t, code)
+
+	hasTestFunction := false
+	for _, declaration := range inter.Program.Program.FunctionDeclarations() {
+		if declaration.Identifier.Identifier == "test" &&
+			len(declaration.ParameterList.Parameters) == 0 {
+
+			hasTestFunction = true
+			break
+		}
+	}
+	if !hasTestFunction {
+		return "no function `test` without parameters"
+	}
+
+	_, err := compiler.NewCompiler(
+		inter.Program.Program,
+		inter.Program.Elaboration,
+	).Compile()
+
+	var unsupportedErr *compiler.UnsupportedError
+	if errors.As(err, &unsupportedErr) {
+		return unsupportedErr.Error()
+	}
+	require.NoError(t, err)
+
+	return ""
+}
+
+func TestInterpretBytecodeUnsupported(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {}
+
+      fun test() {}
+    `)
+
+	_, err := compiler.NewCompiler(
+		inter.Program.Program,
+		inter.Program.Elaboration,
+	).Compile()
+
+	var unsupportedErr *compiler.UnsupportedError
+	require.True(t, errors.As(err, &unsupportedErr))
+}
+
+func BenchmarkBytecodeFib(b *testing.B) {
+
+	const code = `
+      fun fib(_ n: Int): Int {
+          if n < 2 {
+             return n
+          }
+          return fib(n - 1) + fib(n - 2)
+      }
+    `
+
+	argument := interpreter.NewUnmeteredIntValueFromInt64(20)
+
+	b.Run("interpreter", func(b *testing.B) {
+		inter := parseCheckAndInterpret(b, code)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_, err := inter.Invoke("fib", argument)
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("vm", func(b *testing.B) {
+		inter := parseCheckAndInterpret(b, code)
+		machine := vm.NewVM(compileBytecode(b, inter), inter)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_, err := machine.Invoke("fib", argument)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,12 +86,20 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			// The string representation always has the full scale of the type.
+			// Literals of the abstract fixed-point types are 64-bit
+			var scale uint = sema.Fix64Scale
+			if rangedType, ok := ty.(sema.FractionalRangedType); ok {
+				scale = rangedType.Scale()
+			}
+			padding := strings.Repeat("0", int(scale)-2)
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewUnmeteredStringValue("-12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("-12.34" + padding)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewUnmeteredStringValue("12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("12.34" + padding)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -526,12 +535,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42.0":  {0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 255, 255, 255, 255, 255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42.0":  {0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	sizes := map[string]uint{
@@ -548,6 +568,8 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 		"UInt64":  sema.UInt64TypeSize,
 		"Fix64":   sema.Fix64TypeSize,
 		"UFix64":  sema.UFix64TypeSize,
+		"Fix128":  sema.Fix128TypeSize,
+		"UFix128": sema.UFix128TypeSize,
 		"Word64":  sema.Word64TypeSize,
 		"Int128":  sema.Int128TypeSize,
 		"UInt128": sema.UInt128TypeSize,
@@ -790,6 +812,12 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 251, 197, 32, 0]":        interpreter.NewUnmeteredFix64Value(4224_000_000),          // 42.24
 			"[255, 255, 255, 255, 250, 10, 31, 0]": interpreter.NewUnmeteredFix64Value(-1 * sema.Fix64Factor), // -1.0
 		},
+		"Fix128": {
+			"[0]":  interpreter.NewUnmeteredFix128Value(big.NewInt(0)),
+			"[42]": interpreter.NewUnmeteredFix128Value(big.NewInt(42)),
+			"[0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0]":      interpreter.NewUnmeteredFix128Value(testFix128RawValue("42.0")),
+			"[255, 255, 255, 255, 255, 255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0]": interpreter.NewUnmeteredFix128Value(testFix128RawValue("-1.0")),
+		},
 		// UFix*
 		"UFix64": {
 			"[0, 0, 0, 0, 0, 0, 0, 0]":      interpreter.NewUnmeteredUFix64Value(0),
@@ -797,6 +825,12 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 250, 86, 234, 0]": interpreter.NewUnmeteredUFix64Value(42 * sema.Fix64Factor), // 42.0
 			"[0, 0, 0, 0, 251, 197, 32, 0]": interpreter.NewUnmeteredUFix64Value(4224_000_000),          // 42.24
 		},
+		"UFix128": {
+			"[0]":  interpreter.NewUnmeteredUFix128Value(big.NewInt(0)),
+			"[42]": interpreter.NewUnmeteredUFix128Value(big.NewInt(42)),
+			"[0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0]":                     interpreter.NewUnmeteredUFix128Value(testFix128RawValue("42.24")),
+			"[255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255]": interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	invalidTests := map[string][]string{
@@ -887,6 +921,14 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0]",
 			"[0, 22, 0, 0, 0, 0, 0, 0, 0]",
 		},
+		"Fix128": {
+			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+			"[0, 22, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+		},
+		"UFix128": {
+			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+			"[0, 22, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -57,6 +57,9 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 		"Fix64": interpreter.NewUnmeteredFix64Value(123000000),
 		// UFix*
 		"UFix64": interpreter.NewUnmeteredUFix64Value(123000000),
+		// 128-bit
+		"Fix128":  interpreter.NewUnmeteredFix128Value(testFix128RawValue("1.23")),
+		"UFix128": interpreter.NewUnmeteredUFix128Value(testFix128RawValue("1.23")),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -111,9 +114,20 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	}
 }
 
+// testFix128RawValue returns the raw value of the given decimal string at the scale of Fix128
+func testFix128RawValue(s string) *big.Int {
+	value, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewUnmeteredFix128Value(testFix128RawValue("50.0")),
+	"UFix128": interpreter.NewUnmeteredUFix128Value(testFix128RawValue("50.0")),
 }
 
 func init() {
@@ -577,6 +591,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.NewUnmeteredUFix64Value(0),
 			max: interpreter.NewUnmeteredUFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+			max: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+			max: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -642,6 +664,30 @@ func TestInterpretStringFixedPointConversion(t *testing.T) {
 			[]*big.Int{sema.UFix64TypeMinIntBig, sema.UFix64TypeMaxIntBig, bigZero, bigOne},
 			[]*big.Int{sema.UFix64TypeMinFractionalBig, sema.UFix64TypeMaxFractionalBig, bigZero, bigOne},
 		},
+		{
+			"Fix128",
+			func(isNeg bool, decimal, fractional *big.Int, scale uint) (interpreter.Value, error) {
+				fixedVal, err := fixedpoint.NewFix128(isNeg, decimal, fractional, scale)
+				if err != nil {
+					return nil, err
+				}
+				return interpreter.NewUnmeteredFix128Value(fixedVal), nil
+			},
+			[]*big.Int{sema.Fix128TypeMinIntBig, sema.Fix128TypeMaxIntBig, bigZero, bigOne},
+			[]*big.Int{sema.UFix128TypeMinFractionalBig, sema.Fix128TypeMaxFractionalBig, bigZero, bigOne},
+		},
+		{
+			"UFix128",
+			func(_ bool, decimal, fractional *big.Int, scale uint) (interpreter.Value, error) {
+				fixedVal, err := fixedpoint.NewUFix128(decimal, fractional, scale)
+				if err != nil {
+					return nil, err
+				}
+				return interpreter.NewUnmeteredUFix128Value(fixedVal), nil
+			},
+			[]*big.Int{sema.UFix128TypeMinIntBig, sema.UFix128TypeMaxIntBig, bigZero, bigOne},
+			[]*big.Int{sema.UFix128TypeMinFractionalBig, sema.UFix128TypeMaxFractionalBig, bigZero, bigOne},
+		},
 	}
 
 	genCases := func(intComponents, fracComponents []*big.Int) []testcase {
@@ -718,3 +764,184 @@ func TestInterpretStringFixedPointConversion(t *testing.T) {
 	}
 
 }
+
+func TestInterpretFix128(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal precision", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix128 = -0.000000000000000000000001
+          let y = UFix128(0.123456789012345678901234)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(big.NewInt(-1)),
+			inter.Globals.Get("x").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(testFix128RawValue("0.123456789012345678901234")),
+			inter.Globals.Get("y").GetValue(),
+		)
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Fix128 = 1.000000000000000000000001
+          let b: Fix128 = 2.0
+          let sum = a + b
+          let difference = a - b
+          let product = a * b
+          let quotient = a / b
+          let remainder = b % a
+        `)
+
+		for name, expected := range map[string]string{
+			"sum":        "3.000000000000000000000001",
+			"difference": "-0.999999999999999999999999",
+			"product":    "2.000000000000000000000002",
+			"quotient":   "0.5",
+			"remainder":  "0.999999999999999999999999",
+		} {
+			AssertValuesEqual(
+				t,
+				inter,
+				interpreter.NewUnmeteredFix128Value(testFix128RawValue(expected)),
+				inter.Globals.Get(name).GetValue(),
+			)
+		}
+	})
+
+	t.Run("saturating arithmetic", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Fix128.max.saturatingAdd(1.0)
+          let b = Fix128.min.saturatingSubtract(1.0)
+          let c = UFix128.min.saturatingSubtract(1.0)
+          let d = UFix128.max.saturatingMultiply(2.0)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+			inter.Globals.Get("a").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+			inter.Globals.Get("b").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+			inter.Globals.Get("c").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+			inter.Globals.Get("d").GetValue(),
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              return Fix128.max + 1.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("Fix64 to Fix128 and back", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.23456789
+          let y = Fix128(x)
+          let z = Fix64(y)
+          let truncated = UFix64(UFix128(0.123456789999))
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(testFix128RawValue("-1.23456789")),
+			inter.Globals.Get("y").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix64Value(-123456789),
+			inter.Globals.Get("z").GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix64Value(12345678),
+			inter.Globals.Get("truncated").GetValue(),
+		)
+	})
+
+	t.Run("invalid Fix128 > max Fix64 to Fix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix64 {
+              return Fix64(Fix128.max)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("invalid negative Fix128 to UFix128", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: Fix128 = -0.000000000000000000000001
+              return UFix128(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+}
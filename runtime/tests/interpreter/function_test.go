@@ -26,6 +26,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
 	"github.com/onflow/cadence/runtime/tests/utils"
@@ -290,3 +291,212 @@ func TestInterpretResultVariable(t *testing.T) {
 		require.ErrorAs(t, err, &interpreter.InvalidatedResourceReferenceError{})
 	})
 }
+
+func parseCheckAndInterpretWithTypeParameters(t *testing.T, code string) *interpreter.Interpreter {
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				TypeParametersEnabled: true,
+			},
+			ParseOptions: parser.Config{
+				TypeParametersEnabled: true,
+			},
+		},
+	)
+	require.NoError(t, err)
+	return inter
+}
+
+func TestInterpretUserDefinedGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithTypeParameters(t, `
+          fun wrap<T>(_ value: T): [T] {
+              return [value]
+          }
+
+          fun test(): String {
+              return wrap(1).getType().identifier
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.AssertValuesEqual(t,
+			inter,
+			interpreter.NewUnmeteredStringValue("[Int]"),
+			result,
+		)
+	})
+
+	t.Run("explicit type argument, cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithTypeParameters(t, `
+          fun cast<T>(_ value: AnyStruct): T? {
+              return value as? T
+          }
+
+          fun test(): [Bool] {
+              return [
+                  cast<Int>(1) != nil,
+                  cast<String>(1) != nil
+              ]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.AssertValuesEqual(t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("returned closure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithTypeParameters(t, `
+          fun mk<T>(_ x: T): fun(): T {
+              return fun(): T {
+                  return x
+              }
+          }
+
+          fun test(): [String] {
+              let f = mk<String>("a")
+              return [f(), f.getType().identifier]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.AssertValuesEqual(t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("fun():String"),
+			),
+			result,
+		)
+	})
+}
+
+func TestInterpretGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithTypeParameters(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun map<U>(_ f: fun(T): U): Box<U> {
+                  return Box<U>(value: f(self.value))
+              }
+          }
+
+          fun test(): [AnyStruct] {
+              let box = Box(value: 1)
+              let mapped = box.map(fun (x: Int): String { return x.toString() })
+              return [
+                  box.value,
+                  mapped.value,
+                  box.getType().identifier,
+                  mapped.getType().identifier,
+                  box.getType() == Type<Box<Int>>(),
+                  box.getType() == Type<Box<String>>()
+              ]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.AssertValuesEqual(t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredStringValue("1"),
+				interpreter.NewUnmeteredStringValue("S.test.Box<Int>"),
+				interpreter.NewUnmeteredStringValue("S.test.Box<String>"),
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithTypeParameters(t, `
+          resource R {}
+
+          resource Holder<T: @AnyResource> {
+              let value: @T
+
+              init(value: @T) {
+                  self.value <- value
+              }
+          }
+
+          fun test(): String {
+              let holder <- create Holder(value: <-create R())
+              let identifier = holder.getType().identifier
+              destroy holder
+              return identifier
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.AssertValuesEqual(t,
+			inter,
+			interpreter.NewUnmeteredStringValue("S.test.Holder<S.test.R>"),
+			result,
+		)
+	})
+}
@@ -0,0 +1,215 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/activations"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func TestInterpretGuardStatement(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      var branch = 0
+
+      fun test(x: Int?): Int {
+          guard var y = x else {
+              branch = 2
+              return 0
+          }
+          branch = 1
+          y = y + 1
+          return y
+      }
+    `)
+
+	t.Run("2", func(t *testing.T) {
+		value, err := inter.Invoke(
+			"test",
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+		)
+		require.NoError(t, err)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			value,
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			inter.Globals.Get("branch").GetValue(),
+		)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		value, err := inter.Invoke("test", interpreter.Nil)
+		require.NoError(t, err)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+			value,
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			inter.Globals.Get("branch").GetValue(),
+		)
+	})
+}
+
+func TestInterpretGuardStatementInLoop(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let xs: [Int?] = [1, nil, 2, nil, 3, 4]
+          var sum = 0
+          for x in xs {
+              guard let y = x else {
+                  continue
+              }
+              if y == 4 {
+                  break
+              }
+              sum = sum + y
+          }
+          return sum
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(6),
+		value,
+	)
+}
+
+func TestInterpretGuardStatementPanic(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.PanicFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.PanicFunction)
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test(x: Int?): Int {
+              guard let y = x else {
+                  panic("missing value")
+              }
+              return y
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: &interpreter.Config{
+				BaseActivationHandler: func(_ common.Location) *interpreter.VariableActivation {
+					return baseActivation
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test", interpreter.Nil)
+	RequireError(t, err)
+
+	var panicErr stdlib.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	require.Equal(t, "missing value", panicErr.Message)
+}
+
+func TestInterpretGuardStatementResourceFailableCast(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          let id: Int
+
+          init(id: Int) {
+              self.id = id
+          }
+      }
+
+      resource S {}
+
+      fun test(_ r: @AnyResource): Int {
+          guard let r2 <- r as? @R else {
+              destroy r
+              return 0
+          }
+          let id = r2.id
+          destroy r2
+          return id
+      }
+
+      fun testR(): Int {
+          return test(<-create R(id: 42))
+      }
+
+      fun testS(): Int {
+          return test(<-create S())
+      }
+    `)
+
+	value, err := inter.Invoke("testR")
+	require.NoError(t, err)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		value,
+	)
+
+	value, err = inter.Invoke("testS")
+	require.NoError(t, err)
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(0),
+		value,
+	)
+}
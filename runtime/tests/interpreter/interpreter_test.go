@@ -47,10 +47,13 @@ import (
 type ParseCheckAndInterpretOptions struct {
 	Config             *interpreter.Config
 	CheckerConfig      *sema.Config
+	ParseOptions       parser.Config
 	HandleCheckerError func(error)
 }
 
 func parseCheckAndInterpret(t testing.TB, code string) *interpreter.Interpreter {
+	recordBytecodeTestProgram(t, code)
+
 	inter, err := parseCheckAndInterpretWithOptions(t, code, ParseCheckAndInterpretOptions{
 		// attachments should be on by default in tests
 		CheckerConfig: &sema.Config{
@@ -173,7 +176,8 @@ func parseCheckAndInterpretWithOptionsAndMemoryMetering(
 	checker, err := checker.ParseAndCheckWithOptionsAndMemoryMetering(t,
 		code,
 		checker.ParseAndCheckOptions{
-			Config: options.CheckerConfig,
+			Config:       options.CheckerConfig,
+			ParseOptions: options.ParseOptions,
 		},
 		memoryGauge,
 	)
@@ -4360,17 +4364,18 @@ func TestInterpretImportError(t *testing.T) {
 		)
 	require.NoError(t, printErr)
 	assert.Equal(t,
-		" --> test:5:17\n"+
+		"Traceback (most recent call last):\n"+
+			" --> test:5:17\n"+
 			"  |\n"+
 			"5 |           return answer()\n"+
-			"  |                  ^^^^^^^^\n"+
+			"  |                  ^^^^^^^^ call to `answer`\n"+
 			"\n"+
 			" --> imported2:5:17\n"+
 			"  |\n"+
 			"5 |           return realAnswer()\n"+
-			"  |                  ^^^^^^^^^^^^\n"+
+			"  |                  ^^^^^^^^^^^^ call to `realAnswer`\n"+
 			"\n"+
-			"error: panic: ?!\n"+
+			"error[E3046]: panic: ?!\n"+
 			" --> imported1:3:17\n"+
 			"  |\n"+
 			"3 |           return panic(\"?!\")\n"+
@@ -6395,6 +6400,245 @@ func TestInterpretDictionaryForEachKey(t *testing.T) {
 	}
 }
 
+func TestInterpretDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          var sum = 0
+          dict.forEach(fun (key: String, value: Int): Bool {
+              if dict[key] != value {
+                  sum = -100
+              }
+              sum = sum + value
+              return true
+          })
+          return sum
+      }
+
+      fun testStop(): Int {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          var count = 0
+          dict.forEach(fun (key: String, value: Int): Bool {
+              count = count + 1
+              return false
+          })
+          return count
+      }
+
+      fun testMutation() {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          dict.forEach(fun (key: String, value: Int): Bool {
+              dict[key.concat("x")] = value
+              return true
+          })
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(6),
+		value,
+	)
+
+	value, err = inter.Invoke("testStop")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		value,
+	)
+
+	_, err = inter.Invoke("testMutation")
+	RequireError(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+}
+
+func TestInterpretDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let dict = {"a": 1, "b": 2, "c": 3, "d": 4}
+
+      fun test(): {String: Int} {
+          return dict.filter(view fun (key: String, value: Int): Bool {
+              return value % 2 == 0 || key == "a"
+          })
+      }
+
+      fun original(): {String: Int} {
+          return dict
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewDictionaryValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.DictionaryStaticType{
+				KeyType:   interpreter.PrimitiveStaticTypeString,
+				ValueType: interpreter.PrimitiveStaticTypeInt,
+			},
+			interpreter.NewUnmeteredStringValue("a"), interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredStringValue("b"), interpreter.NewUnmeteredIntValueFromInt64(2),
+			interpreter.NewUnmeteredStringValue("d"), interpreter.NewUnmeteredIntValueFromInt64(4),
+		),
+		value,
+	)
+
+	// Original dictionary remains unchanged
+
+	value, err = inter.Invoke("original")
+	require.NoError(t, err)
+
+	require.Equal(t, 4, value.(*interpreter.DictionaryValue).Count())
+}
+
+func TestInterpretDictionaryMap(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): {String: String} {
+          let dict = {"a": 1, "b": 2}
+          return dict.map(fun (value: Int): String {
+              return (value * 10).toString()
+          })
+      }
+
+      fun testLookup(): String? {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          let mapped = dict.map(fun (value: Int): Bool {
+              return value > 1
+          })
+          if mapped["a"]! || !mapped["b"]! || !mapped["c"]! {
+              return nil
+          }
+          mapped["d"] = true
+          return mapped.length.toString()
+      }
+
+      fun testMutation() {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          dict.map(fun (value: Int): Int {
+              dict.remove(key: "c")
+              dict["d"] = value
+              return value
+          })
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewDictionaryValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.DictionaryStaticType{
+				KeyType:   interpreter.PrimitiveStaticTypeString,
+				ValueType: interpreter.PrimitiveStaticTypeString,
+			},
+			interpreter.NewUnmeteredStringValue("a"), interpreter.NewUnmeteredStringValue("10"),
+			interpreter.NewUnmeteredStringValue("b"), interpreter.NewUnmeteredStringValue("20"),
+		),
+		value,
+	)
+
+	value, err = inter.Invoke("testLookup")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredStringValue("4"),
+		),
+		value,
+	)
+
+	_, err = inter.Invoke("testMutation")
+	RequireError(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+}
+
+func TestInterpretDictionaryToEntries(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let dict = {"a": 1, "b": 2, "c": 3}
+          let entries: [DictionaryEntry<String, Int>] = dict.toEntries()
+          if entries.length != 3 {
+              return -1
+          }
+
+          var sum = 0
+          for entry in entries {
+              if dict[entry.key] != entry.value {
+                  return -1
+              }
+              sum = sum + entry.value
+          }
+          return sum
+      }
+
+      fun testType(): Type {
+          let dict = {"a": 1}
+          return dict.toEntries()[0].getType()
+      }
+
+      fun testEmpty(): [DictionaryEntry<Int, Bool>] {
+          let dict: {Int: Bool} = {}
+          return dict.toEntries()
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(6),
+		value,
+	)
+
+	value, err = inter.Invoke("testType")
+	require.NoError(t, err)
+
+	require.IsType(t, interpreter.TypeValue{}, value)
+	assert.Equal(t,
+		interpreter.TypeID("DictionaryEntry<String,Int>"),
+		value.(interpreter.TypeValue).Type.ID(),
+	)
+
+	value, err = inter.Invoke("testEmpty")
+	require.NoError(t, err)
+
+	require.IsType(t, &interpreter.ArrayValue{}, value)
+	assert.Equal(t, 0, value.(*interpreter.ArrayValue).Count())
+}
+
 func TestInterpretDictionaryValues(t *testing.T) {
 
 	t.Parallel()
@@ -7493,11 +7737,19 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.NewUnmeteredFix64Value(123000000),
 			ty:    sema.Fix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewUnmeteredFix128Value(testFix128RawValue("1.23")),
+			ty:    sema.Fix128Type,
+		},
 		// UFix*
 		"UFix64": {
 			value: interpreter.NewUnmeteredUFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"UFix128": {
+			value: interpreter.NewUnmeteredUFix128Value(testFix128RawValue("1.23")),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
@@ -8576,7 +8828,7 @@ func TestInterpretContractAccountFieldUse(t *testing.T) {
 			},
 		)
 		require.Error(t, err)
-		assert.ErrorContains(t, err, "error: member `account` is used before it has been initialized")
+		assert.ErrorContains(t, err, "error[E3025]: member `account` is used before it has been initialized")
 	})
 }
 
@@ -11108,6 +11360,382 @@ func TestInterpretArrayMap(t *testing.T) {
 	})
 }
 
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable sized", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			let xs = [3, 1, 4, 1, 5, 9, 2, 6]
+
+			fun sorted(): [Int] {
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+
+			fun original(): [Int] {
+				return xs
+			}
+		`)
+
+		value, err := inter.Invoke("sorted")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(4),
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+				interpreter.NewUnmeteredIntValueFromInt64(6),
+				interpreter.NewUnmeteredIntValueFromInt64(9),
+			),
+			value,
+		)
+
+		// Original array remains unchanged
+
+		value, err = inter.Invoke("original")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(4),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+				interpreter.NewUnmeteredIntValueFromInt64(9),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(6),
+			),
+			value,
+		)
+	})
+
+	t.Run("constant sized", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): [Int; 3] {
+				let xs: [Int; 3] = [1, 3, 2]
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a > b
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.ConstantSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+					Size: 3,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			struct Bid {
+				let bidder: String
+				let amount: Int
+
+				init(bidder: String, amount: Int) {
+					self.bidder = bidder
+					self.amount = amount
+				}
+			}
+
+			fun test(): [String] {
+				let bids = [
+					Bid(bidder: "a", amount: 10),
+					Bid(bidder: "b", amount: 30),
+					Bid(bidder: "c", amount: 10),
+					Bid(bidder: "d", amount: 20),
+					Bid(bidder: "e", amount: 30),
+					Bid(bidder: "f", amount: 10)
+				]
+				let sorted = bids.sort(by: view fun (_ a: Bid, _ b: Bid): Bool {
+					return a.amount > b.amount
+				})
+				return sorted.map(fun (_ bid: Bid): String {
+					return bid.bidder
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredStringValue("b"),
+				interpreter.NewUnmeteredStringValue("e"),
+				interpreter.NewUnmeteredStringValue("d"),
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("c"),
+				interpreter.NewUnmeteredStringValue("f"),
+			),
+			value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): [Int] {
+				let xs: [Int] = []
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+			),
+			value,
+		)
+	})
+}
+
+func TestInterpretArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let xs = [1, 2, 3, 4]
+
+		fun sum(): Int {
+			return xs.reduce(initial: 0, fun (_ sum: Int, _ x: Int): Int {
+				return sum + x
+			})
+		}
+
+		fun join(): String {
+			return xs.reduce(initial: "", fun (_ s: String, _ x: Int): String {
+				return s.concat(x.toString())
+			})
+		}
+
+		fun empty(): Int {
+			let xs: [Int; 0] = []
+			return xs.reduce(initial: 42, fun (_ sum: Int, _ x: Int): Int {
+				return sum + x
+			})
+		}
+
+		fun mutation(): Int {
+			let xs = [1, 2, 3]
+			return xs.reduce(initial: 0, fun (_ sum: Int, _ x: Int): Int {
+				xs.append(x)
+				xs.removeFirst()
+				return sum + x
+			})
+		}
+	`)
+
+	value, err := inter.Invoke("sum")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(10),
+		value,
+	)
+
+	value, err = inter.Invoke("join")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("1234"),
+		value,
+	)
+
+	value, err = inter.Invoke("empty")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		value,
+	)
+
+	_, err = inter.Invoke("mutation")
+	RequireError(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+}
+
+func TestInterpretArrayLastIndex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let xs = [1, 2, 3, 2, 1]
+
+		fun found(): Int? {
+			return xs.lastIndex(of: 2)
+		}
+
+		fun notFound(): Int? {
+			return xs.lastIndex(of: 5)
+		}
+	`)
+
+	value, err := inter.Invoke("found")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		),
+		value,
+	)
+
+	value, err = inter.Invoke("notFound")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.Nil,
+		value,
+	)
+}
+
+func TestInterpretArrayPredicateFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let xs = [1, 3, 4, 5, 6]
+		let empty: [Int] = []
+
+		let isEven = view fun (_ x: Int): Bool {
+			return x % 2 == 0
+		}
+
+		let isPositive = view fun (_ x: Int): Bool {
+			return x > 0
+		}
+
+		fun indexOfEven(): Int? {
+			return xs.indexOf(where: isEven)
+		}
+
+		fun indexOfEvenEmpty(): Int? {
+			return empty.indexOf(where: isEven)
+		}
+
+		fun allEven(): Bool {
+			return xs.allSatisfy(isEven)
+		}
+
+		fun allPositive(): Bool {
+			return xs.allSatisfy(isPositive)
+		}
+
+		fun allEvenEmpty(): Bool {
+			return empty.allSatisfy(isEven)
+		}
+
+		fun anyEven(): Bool {
+			return xs.anySatisfy(isEven)
+		}
+
+		fun anyNegative(): Bool {
+			return xs.anySatisfy(view fun (_ x: Int): Bool {
+				return x < 0
+			})
+		}
+
+		fun anyEvenEmpty(): Bool {
+			return empty.anySatisfy(isEven)
+		}
+	`)
+
+	for name, expected := range map[string]interpreter.Value{
+		"indexOfEven": interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+		),
+		"indexOfEvenEmpty": interpreter.Nil,
+		"allEven":          interpreter.FalseValue,
+		"allPositive":      interpreter.TrueValue,
+		"allEvenEmpty":     interpreter.TrueValue,
+		"anyEven":          interpreter.TrueValue,
+		"anyNegative":      interpreter.FalseValue,
+		"anyEvenEmpty":     interpreter.FalseValue,
+	} {
+		value, err := inter.Invoke(name)
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, expected, value)
+	}
+}
+
 func TestInterpretArrayToVariableSized(t *testing.T) {
 	t.Parallel()
 
@@ -387,6 +387,27 @@ func TestInterpretArrayMetering(t *testing.T) {
 		assert.Equal(t, uint64(19), meter.getMemory(common.MemoryKindPrimitiveStaticType))
 		assert.Equal(t, uint64(6), meter.getMemory(common.MemoryKindVariableSizedStaticType))
 	})
+
+	t.Run("sort", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x: [Int8] = [3, 1, 2]
+              let y = x.sort(by: view fun (_ a: Int8, _ b: Int8): Bool {
+                  return a < b
+              })
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 3 for the sorted values, 3 for the buffer
+		assert.Equal(t, uint64(6), meter.getMemory(common.MemoryKindValueSlice))
+	})
 }
 
 func TestInterpretDictionaryMetering(t *testing.T) {
@@ -6841,6 +6862,63 @@ func TestInterpretStringMetering(t *testing.T) {
 		// 1 + 4 (max UTF8 encoding)
 		assert.Equal(t, uint64(5), meter.getMemory(common.MemoryKindStringValue))
 	})
+
+	t.Run("toUpper, ASCII", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "abc".toUpper()
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 3 (ABC)
+		assert.Equal(t, uint64(4), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("trim", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "  ab ".trim()
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 2 (ab)
+		assert.Equal(t, uint64(3), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("padStart", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "1".padStart(toLength: 3, with: "0")
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 3 (001)
+		assert.Equal(t, uint64(4), meter.getMemory(common.MemoryKindStringValue))
+	})
 }
 
 func TestInterpretCharacterMetering(t *testing.T) {
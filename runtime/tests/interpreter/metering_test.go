@@ -19,6 +19,7 @@
 package interpreter_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -482,6 +483,200 @@ func TestInterpretArrayFunctionsComputationMetering(t *testing.T) {
 
 		assert.Equal(t, uint(6), computationMeteredValues[common.ComputationKindLoop])
 	})
+
+	t.Run("sort", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = [3, 1, 2]
+                let y = x.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+                    return a < b
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 3 iterations and 4 comparisons
+		assert.Equal(t, uint(7), computationMeteredValues[common.ComputationKindLoop])
+	})
+
+	t.Run("reduce", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = [1, 2, 3, 4]
+                let y = x.reduce(initial: 0, fun (_ sum: Int, _ x: Int): Int {
+                    return sum + x
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(5), computationMeteredValues[common.ComputationKindLoop])
+	})
+
+	t.Run("anySatisfy", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = [1, 2, 3, 4, 5]
+                let y = x.anySatisfy(view fun (_ x: Int): Bool {
+                    return x == 3
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		// iteration stops at the first match
+		assert.Equal(t, uint(3), computationMeteredValues[common.ComputationKindLoop])
+	})
+}
+
+func TestInterpretDictionaryFunctionsComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("forEach", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = {1: 1, 2: 2, 3: 3}
+                x.forEach(fun (key: Int, value: Int): Bool {
+                    return true
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(3), computationMeteredValues[common.ComputationKindLoop])
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = {1: 1, 2: 2, 3: 3, 4: 4}
+                let y = x.filter(view fun (key: Int, value: Int): Bool {
+                    return key % 2 == 0
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(5), computationMeteredValues[common.ComputationKindLoop])
+	})
+
+	t.Run("map", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = {1: 1, 2: 2, 3: 3, 4: 4}
+                let y = x.map(fun (value: Int): Int {
+                    return value * 2
+                })
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(5), computationMeteredValues[common.ComputationKindLoop])
+	})
+
+	t.Run("toEntries", func(t *testing.T) {
+		t.Parallel()
+
+		computationMeteredValues := make(map[common.ComputationKind]uint)
+		inter, err := parseCheckAndInterpretWithOptions(t, `
+            fun main() {
+                let x = {1: 1, 2: 2, 3: 3}
+                let y = x.toEntries()
+            }`,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						computationMeteredValues[compKind] += intensity
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(4), computationMeteredValues[common.ComputationKindLoop])
+	})
 }
 
 func TestInterpretStdlibComputationMetering(t *testing.T) {
@@ -512,3 +707,48 @@ func TestInterpretStdlibComputationMetering(t *testing.T) {
 		assert.Equal(t, uint(4), computationMeteredValues[common.ComputationKindLoop])
 	})
 }
+
+func TestInterpretStringFunctionsComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string, expected uint) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			computationMeteredValues := make(map[common.ComputationKind]uint)
+			inter, err := parseCheckAndInterpretWithOptions(t,
+				fmt.Sprintf(
+					`
+                      fun main() {
+                          let x = %s
+                      }
+                    `,
+					code,
+				),
+				ParseCheckAndInterpretOptions{
+					Config: &interpreter.Config{
+						OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+							computationMeteredValues[compKind] += intensity
+						},
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			_, err = inter.Invoke("main")
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, computationMeteredValues[common.ComputationKindLoop])
+		})
+	}
+
+	// One loop iteration per character and one for the end of the string
+	test("contains", `"abcd".contains("x")`, 5)
+	// One additional loop iteration per character of each occurrence,
+	// to check that the occurrence ends on a character boundary
+	test("count", `"abab".count(of: "b")`, 7)
+	test("startsWith", `"abcd".startsWith("ab")`, 2)
+	test("trim", `" ab ".trim()`, 4)
+	test("padStart", `"1".padStart(toLength: 4, with: "0")`, 3)
+}
@@ -1926,8 +1926,10 @@ func TestInterpretDereference(t *testing.T) {
 		t.Parallel()
 
 		expectedValues := map[sema.Type]interpreter.FixedPointValue{
-			sema.UFix64Type: interpreter.NewUnmeteredUFix64Value(4224_000_000),
-			sema.Fix64Type:  interpreter.NewUnmeteredFix64Value(4224_000_000),
+			sema.UFix64Type:  interpreter.NewUnmeteredUFix64Value(4224_000_000),
+			sema.Fix64Type:   interpreter.NewUnmeteredFix64Value(4224_000_000),
+			sema.UFix128Type: interpreter.NewUnmeteredUFix128Value(testFix128RawValue("42.24")),
+			sema.Fix128Type:  interpreter.NewUnmeteredFix128Value(testFix128RawValue("42.24")),
 		}
 
 		for _, typ := range sema.AllFixedPointTypes {
@@ -0,0 +1,285 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/activations"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func parseCheckAndInterpretWithSet(t *testing.T, code string) (*interpreter.Interpreter, error) {
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.SetConstructorFunction)
+
+	return parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: &interpreter.Config{
+				BaseActivationHandler: func(common.Location) *interpreter.VariableActivation {
+					return baseActivation
+				},
+			},
+		},
+	)
+}
+
+func TestInterpretSet(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithSet(t, `
+      let set = Set([1, 2, 2, 3])
+
+      fun testLength(): Int {
+          return set.length
+      }
+
+      fun testContains(): [Bool] {
+          return [set.contains(1), set.contains(4)]
+      }
+
+      fun testInsert(): [AnyStruct] {
+          let set = Set<String>([])
+          let first = set.insert("a")
+          let second = set.insert("a")
+          return [first, second, set.length]
+      }
+
+      fun testRemove(): [AnyStruct] {
+          let set = Set(["a", "b"])
+          let first = set.remove("a")
+          let second = set.remove("a")
+          return [first, second, set.length, set.contains("b")]
+      }
+
+      fun testUnion(): [AnyStruct] {
+          let union = set.union(Set([3, 4]))
+          return [union.length, union.contains(4), set.length]
+      }
+
+      fun testIntersection(): [AnyStruct] {
+          let intersection = set.intersection(Set([2, 3, 4]))
+          return [intersection.length, intersection.contains(1), intersection.contains(2)]
+      }
+
+      fun testDifference(): [AnyStruct] {
+          let difference = set.difference(Set([2, 3, 4]))
+          return [difference.length, difference.contains(1), difference.contains(2)]
+      }
+
+      fun testForEach(): Int {
+          var sum = 0
+          set.forEach(fun (element: Int): Bool {
+              sum = sum + element
+              return true
+          })
+          return sum
+      }
+
+      fun testForEachEarlyExit(): Int {
+          var count = 0
+          set.forEach(fun (element: Int): Bool {
+              count = count + 1
+              return false
+          })
+          return count
+      }
+
+      fun testEqual(): [Bool] {
+          return [
+              set == Set([3, 2, 1]),
+              set == Set([1, 2])
+          ]
+      }
+
+      fun testCopy(): [Int] {
+          let copy = set
+          copy.insert(4)
+          return [set.length, copy.length]
+      }
+    `)
+	require.NoError(t, err)
+
+	test := func(name string, expected interpreter.Value) {
+		t.Run(name, func(t *testing.T) {
+			value, err := inter.Invoke(name)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, expected, value)
+		})
+	}
+
+	newArray := func(elementType interpreter.StaticType, values ...interpreter.Value) interpreter.Value {
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: elementType,
+			},
+			common.ZeroAddress,
+			values...,
+		)
+	}
+
+	newAnyStructArray := func(values ...interpreter.Value) interpreter.Value {
+		return newArray(interpreter.PrimitiveStaticTypeAnyStruct, values...)
+	}
+
+	newBoolArray := func(values ...interpreter.Value) interpreter.Value {
+		return newArray(interpreter.PrimitiveStaticTypeBool, values...)
+	}
+
+	test("testLength", interpreter.NewUnmeteredIntValueFromInt64(3))
+	test("testContains",
+		newBoolArray(interpreter.TrueValue, interpreter.FalseValue),
+	)
+	test("testInsert",
+		newAnyStructArray(
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		),
+	)
+	test("testRemove",
+		newAnyStructArray(
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.TrueValue,
+		),
+	)
+	test("testUnion",
+		newAnyStructArray(
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+			interpreter.TrueValue,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		),
+	)
+	test("testIntersection",
+		newAnyStructArray(
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			interpreter.FalseValue,
+			interpreter.TrueValue,
+		),
+	)
+	test("testDifference",
+		newAnyStructArray(
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+		),
+	)
+	test("testForEach", interpreter.NewUnmeteredIntValueFromInt64(6))
+	test("testForEachEarlyExit", interpreter.NewUnmeteredIntValueFromInt64(1))
+	test("testEqual",
+		newBoolArray(interpreter.TrueValue, interpreter.FalseValue),
+	)
+	test("testCopy",
+		newArray(
+			interpreter.PrimitiveStaticTypeInt,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+		),
+	)
+}
+
+func TestInterpretSetType(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithSet(t, `
+      let a = SetType(Type<Int>())!
+      let b = SetType(Type<[Int]>())
+      let c = Set([1]).getType()
+    `)
+	require.NoError(t, err)
+
+	setType := interpreter.TypeValue{
+		Type: &interpreter.SetStaticType{
+			ElementType: interpreter.PrimitiveStaticTypeInt,
+		},
+	}
+
+	AssertValuesEqual(t, inter, setType, inter.Globals.Get("a").GetValue())
+	AssertValuesEqual(t, inter, interpreter.Nil, inter.Globals.Get("b").GetValue())
+	AssertValuesEqual(t, inter, setType, inter.Globals.Get("c").GetValue())
+}
+
+func TestInterpretSetShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithSet(t, `
+      struct Set {
+          let name: String
+
+          init(name: String) {
+              self.name = name
+          }
+      }
+
+      let name = Set(name: "test").name
+    `)
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("test"),
+		inter.Globals.Get("name").GetValue(),
+	)
+}
+
+func TestInterpretSetMutationWhileIterating(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := parseCheckAndInterpretWithSet(t, `
+      fun test() {
+          let set = Set([1, 2, 3])
+          set.forEach(fun (element: Int): Bool {
+              set.insert(element + 10)
+              return true
+          })
+      }
+    `)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	RequireError(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+}
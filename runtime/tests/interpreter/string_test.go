@@ -643,3 +643,237 @@ func TestInterpretStringReplaceAll(t *testing.T) {
 	testCase(t, "testEmptyOf", interpreter.NewUnmeteredStringValue("1a1b1c1"))
 	testCase(t, "testNoMatch", interpreter.NewUnmeteredStringValue("pqrS;asdf"))
 }
+
+func TestInterpretStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          return "Flowers ❤️ ß".toUpper()
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredStringValue("FLOWERS ❤️ ß"),
+		result,
+	)
+}
+
+func TestInterpretStringSearch(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let s = "👪.❤️.Abc.❤️"
+		let flags = "🇺🇸🇩🇪"
+
+		fun contains(): [Bool] {
+			return [
+				s.contains("❤️.A"),
+				s.contains(""),
+				s.contains("abc"),
+				// The regional indicators of the two flags must not be matched across the flags
+				flags.contains("🇸🇩"),
+				flags.contains("🇩🇪")
+			]
+		}
+
+		fun index(): [Int?] {
+			return [
+				s.index(of: "❤️"),
+				s.index(of: "Abc"),
+				s.index(of: ""),
+				s.index(of: "abc"),
+				flags.index(of: "🇸🇩"),
+				flags.index(of: "🇩🇪")
+			]
+		}
+
+		fun count(): [Int] {
+			return [
+				s.count(of: "❤️"),
+				s.count(of: "."),
+				s.count(of: ""),
+				"aaaa".count(of: "aa"),
+				flags.count(of: "🇸🇩")
+			]
+		}
+
+		fun startsWith(): [Bool] {
+			return [
+				s.startsWith("👪."),
+				s.startsWith(""),
+				s.startsWith("."),
+				flags.startsWith("🇺")
+			]
+		}
+
+		fun endsWith(): [Bool] {
+			return [
+				s.endsWith(".❤️"),
+				s.endsWith(""),
+				s.endsWith("Abc"),
+				flags.endsWith("🇪")
+			]
+		}
+	`)
+
+	bools := func(values ...bool) []interpreter.Value {
+		result := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			result[i] = interpreter.AsBoolValue(value)
+		}
+		return result
+	}
+
+	ints := func(values ...int64) []interpreter.Value {
+		result := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			result[i] = interpreter.NewUnmeteredIntValueFromInt64(value)
+		}
+		return result
+	}
+
+	testCase := func(t *testing.T, funcName string, expected []interpreter.Value) {
+		t.Run(funcName, func(t *testing.T) {
+			result, err := inter.Invoke(funcName)
+			require.NoError(t, err)
+
+			AssertValueSlicesEqual(
+				t,
+				inter,
+				expected,
+				ArrayElements(inter, result.(*interpreter.ArrayValue)),
+			)
+		})
+	}
+
+	testCase(t, "contains", bools(true, true, false, false, true))
+	testCase(t, "count", ints(2, 3, 10, 2, 0))
+	testCase(t, "startsWith", bools(true, true, false, false))
+	testCase(t, "endsWith", bools(true, true, false, false))
+
+	testCase(t,
+		"index",
+		[]interpreter.Value{
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(2)),
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(4)),
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(0)),
+			interpreter.Nil,
+			interpreter.Nil,
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(1)),
+		},
+	)
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		fun trim(): String {
+			return " \t 👪 ❤️\n ".trim()
+		}
+		fun trimNothing(): String {
+			return "Abc".trim()
+		}
+		fun trimAll(): String {
+			return " \n\t".trim()
+		}
+		fun trimEmpty(): String {
+			return "".trim()
+		}
+	`)
+
+	testCase := func(t *testing.T, funcName string, expected *interpreter.StringValue) {
+		t.Run(funcName, func(t *testing.T) {
+			result, err := inter.Invoke(funcName)
+			require.NoError(t, err)
+
+			RequireValuesEqual(
+				t,
+				inter,
+				expected,
+				result,
+			)
+		})
+	}
+
+	testCase(t, "trim", interpreter.NewUnmeteredStringValue("👪 ❤️"))
+	testCase(t, "trimNothing", interpreter.NewUnmeteredStringValue("Abc"))
+	testCase(t, "trimAll", interpreter.NewUnmeteredStringValue(""))
+	testCase(t, "trimEmpty", interpreter.NewUnmeteredStringValue(""))
+}
+
+func TestInterpretStringPad(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		fun padStart(): String {
+			return "42".padStart(toLength: 5, with: "0")
+		}
+		fun padEnd(): String {
+			return "👪".padEnd(toLength: 3, with: "❤️")
+		}
+		fun padShorter(): String {
+			return "Abc".padStart(toLength: 2, with: "x")
+		}
+		fun padNegative(): String {
+			return "Abc".padEnd(toLength: -1, with: "x")
+		}
+	`)
+
+	testCase := func(t *testing.T, funcName string, expected *interpreter.StringValue) {
+		t.Run(funcName, func(t *testing.T) {
+			result, err := inter.Invoke(funcName)
+			require.NoError(t, err)
+
+			RequireValuesEqual(
+				t,
+				inter,
+				expected,
+				result,
+			)
+		})
+	}
+
+	testCase(t, "padStart", interpreter.NewUnmeteredStringValue("00042"))
+	testCase(t, "padEnd", interpreter.NewUnmeteredStringValue("👪❤️❤️"))
+	testCase(t, "padShorter", interpreter.NewUnmeteredStringValue("Abc"))
+	testCase(t, "padNegative", interpreter.NewUnmeteredStringValue("Abc"))
+}
+
+func TestInterpretStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+        let a = "abc"
+        let b: Character = "d"
+        let c = 1
+        let d = 2.5
+        let e: Address = 0x1
+        let f = /storage/foo
+        let x = "\(a) \(b) \(c) \(d) \(e) \(f)"
+        let y = "\("nested \(c + 1)")\t\\(c)"
+    `)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("abc d 1 2.50000000 0x0000000000000001 /storage/foo"),
+		inter.Globals.Get("x").GetValue(),
+	)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("nested 2\t\\(c)"),
+		inter.Globals.Get("y").GetValue(),
+	)
+}
@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"flag"
 	"time"
 
 	"github.com/onflow/atree"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/meter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
 )
@@ -120,6 +122,9 @@ type TestRuntimeInterface struct {
 	OnMemoryUsed        func() (uint64, error)
 	OnInteractionUsed   func() (uint64, error)
 	OnGenerateAccountID func(address common.Address) (uint64, error)
+	// Meter meters computation and memory, if OnMeterComputation and OnMeterMemory are not set.
+	// If not set, a meter is created when metering is enabled with the -cadence.meter flag
+	Meter *meter.Meter
 
 	lastUUID            uint64
 	accountIDs          map[common.Address]uint64
@@ -355,8 +360,69 @@ func (i *TestRuntimeInterface) GenerateUUID() (uint64, error) {
 	return i.OnGenerateUUID()
 }
 
+var meterFlag = flag.Bool(
+	"cadence.meter",
+	false,
+	"meter computation and memory of test runtime interfaces, with the default weights",
+)
+
+var meterWeightsFlag = flag.String(
+	"cadence.meterWeights",
+	"",
+	"JSON or YAML file with the weights of computation and memory kinds for test runtime interfaces (implies -cadence.meter)",
+)
+
+var computationLimitFlag = flag.Uint64(
+	"cadence.computationLimit",
+	0,
+	"limit of the weighted computation of test runtime interfaces (implies -cadence.meter)",
+)
+
+var memoryLimitFlag = flag.Uint64(
+	"cadence.memoryLimit",
+	0,
+	"limit of the weighted memory of test runtime interfaces (implies -cadence.meter)",
+)
+
+// meter returns the meter of the interface, if any.
+// If metering is enabled through the flags, a meter is created on demand
+func (i *TestRuntimeInterface) meter() *meter.Meter {
+	if i.Meter != nil {
+		return i.Meter
+	}
+
+	if !*meterFlag &&
+		*meterWeightsFlag == "" &&
+		*computationLimitFlag == 0 &&
+		*memoryLimitFlag == 0 {
+
+		return nil
+	}
+
+	weights := meter.DefaultWeights()
+	if *meterWeightsFlag != "" {
+		var err error
+		weights, err = meter.LoadWeights(*meterWeightsFlag)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	i.Meter = meter.New(
+		weights,
+		meter.Limits{
+			Computation: *computationLimitFlag,
+			Memory:      *memoryLimitFlag,
+		},
+	)
+	return i.Meter
+}
+
 func (i *TestRuntimeInterface) MeterComputation(compKind common.ComputationKind, intensity uint) error {
 	if i.OnMeterComputation == nil {
+		if usageMeter := i.meter(); usageMeter != nil {
+			return usageMeter.MeterComputation(compKind, intensity)
+		}
 		return nil
 	}
 	return i.OnMeterComputation(compKind, intensity)
@@ -558,6 +624,9 @@ func (i *TestRuntimeInterface) RecordTrace(
 
 func (i *TestRuntimeInterface) MeterMemory(usage common.MemoryUsage) error {
 	if i.OnMeterMemory == nil {
+		if usageMeter := i.meter(); usageMeter != nil {
+			return usageMeter.MeterMemory(usage)
+		}
 		return nil
 	}
 
@@ -566,6 +635,9 @@ func (i *TestRuntimeInterface) MeterMemory(usage common.MemoryUsage) error {
 
 func (i *TestRuntimeInterface) ComputationUsed() (uint64, error) {
 	if i.OnComputationUsed == nil {
+		if usageMeter := i.meter(); usageMeter != nil {
+			return usageMeter.ComputationUsed(), nil
+		}
 		return 0, nil
 	}
 
@@ -574,6 +646,9 @@ func (i *TestRuntimeInterface) ComputationUsed() (uint64, error) {
 
 func (i *TestRuntimeInterface) MemoryUsed() (uint64, error) {
 	if i.OnMemoryUsed == nil {
+		if usageMeter := i.meter(); usageMeter != nil {
+			return usageMeter.MemoryUsed(), nil
+		}
 		return 0, nil
 	}
 
@@ -0,0 +1,304 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// TraceID identifies an execution trace.
+type TraceID [16]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanID identifies a span of an execution trace.
+type SpanID [spanIDLength]byte
+
+const spanIDLength = 8
+
+func (id SpanID) IsZero() bool {
+	return id == SpanID{}
+}
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// TraceSpan is a span of an execution trace,
+// e.g. the execution of a transaction, an import,
+// a function invocation, or a value operation.
+type TraceSpan struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// ParentSpanID is the ID of the parent span.
+	// It is zero for the root span.
+	ParentSpanID SpanID
+	Name         string
+	// Location is the location of the program which performed the operation
+	Location  Location
+	StartTime time.Time
+	EndTime   time.Time
+	// ComputationUsed is the computation metered during the span,
+	// including the computation of its children
+	ComputationUsed uint64
+	Attributes      []attribute.KeyValue
+	// Err is the error the execution failed with, if any.
+	// It is only set for the root span.
+	Err error
+}
+
+// SpanExporter exports the spans of execution traces,
+// e.g. to stdout, to a file, or to a span exporter of the OpenTelemetry SDK,
+// see NewOpenTelemetrySpanExporter.
+type SpanExporter interface {
+	// ExportSpans exports the spans of one execution.
+	// The spans are exported after the execution finished.
+	// Parents precede their children, so the first span is the root span,
+	// and siblings are ordered by their start time.
+	//
+	// Errors must be handled by the exporter, they do not affect the execution.
+	ExportSpans(ctx context.Context, spans []*TraceSpan)
+}
+
+const (
+	traceSpanNameParse     = "parse"
+	traceSpanNameCheck     = "check"
+	traceSpanNameInterpret = "interpret"
+)
+
+// openSpan is a span which started, but has not ended yet
+type openSpan struct {
+	span *TraceSpan
+	// computationUsed is the computation used when the span started
+	computationUsed uint64
+	// trace is true if the span is ended by recording a trace,
+	// see spanTracingInterface.RecordTrace
+	trace bool
+}
+
+// spanTracingInterface is an Interface which builds a tree of spans
+// from the operations of an execution,
+// and delegates to the wrapped Interface.
+//
+// A span is started when its operation starts, and ended when its operation ends.
+// Operations are nested, so the open spans form a stack:
+// The parent of a started span is the innermost open span.
+type spanTracingInterface struct {
+	wrappedInterface
+	exporter SpanExporter
+	// spans are all spans in the order they started,
+	// so parents precede their children
+	spans []*TraceSpan
+	// parents are the parents of the spans.
+	// The root span has no parent
+	parents map[*TraceSpan]*TraceSpan
+	// open are the spans which have not ended yet, innermost last.
+	// The root span is the first
+	open            []openSpan
+	computationUsed uint64
+}
+
+var _ Interface = &spanTracingInterface{}
+
+// spanTracerProvider is implemented by Interfaces which trace spans,
+// or which wrap an Interface which traces spans, see wrappedInterface
+type spanTracerProvider interface {
+	spanTracer() *spanTracingInterface
+}
+
+// spanTracerOf returns the span tracer of the given Interface,
+// or nil if the execution is not traced as a tree of spans.
+func spanTracerOf(runtimeInterface Interface) *spanTracingInterface {
+	provider, ok := runtimeInterface.(spanTracerProvider)
+	if !ok {
+		return nil
+	}
+	return provider.spanTracer()
+}
+
+func (i *spanTracingInterface) spanTracer() *spanTracingInterface {
+	return i
+}
+
+// withSpanTracing returns an Interface which traces the execution as a tree of spans,
+// which are exported to the given exporter when the execution is finished, if any.
+// If no exporter is given, the Interface is returned as-is, and the returned tracer is nil.
+func withSpanTracing(
+	runtimeInterface Interface,
+	exporter SpanExporter,
+	name string,
+	location Location,
+) (Interface, *spanTracingInterface) {
+	if exporter == nil {
+		return runtimeInterface, nil
+	}
+	tracer := &spanTracingInterface{
+		wrappedInterface: wrappedInterface{Interface: runtimeInterface},
+		exporter:         exporter,
+		parents:          map[*TraceSpan]*TraceSpan{},
+	}
+	tracer.startSpan(name, location, false)
+	return tracer, tracer
+}
+
+// startSpan starts a span as a child of the innermost open span.
+// The name of a span which is ended by recording a trace is only known when it ends.
+func (i *spanTracingInterface) startSpan(name string, location Location, trace bool) *TraceSpan {
+	span := &TraceSpan{
+		Name:      name,
+		Location:  location,
+		StartTime: time.Now(),
+	}
+
+	if len(i.open) > 0 {
+		i.parents[span] = i.open[len(i.open)-1].span
+	}
+
+	i.spans = append(i.spans, span)
+	i.open = append(
+		i.open,
+		openSpan{
+			span:            span,
+			computationUsed: i.computationUsed,
+			trace:           trace,
+		},
+	)
+
+	return span
+}
+
+// endSpan ends the given span.
+// Spans nested in it which have not ended yet, e.g. because their operation failed,
+// are ended as well.
+// It is a no-op if the span already ended.
+func (i *spanTracingInterface) endSpan(span *TraceSpan) {
+	for index := len(i.open) - 1; index >= 0; index-- {
+		if i.open[index].span != span {
+			continue
+		}
+
+		for len(i.open) > index {
+			i.endInnermostSpan()
+		}
+		return
+	}
+}
+
+func (i *spanTracingInterface) endInnermostSpan() *TraceSpan {
+	lastIndex := len(i.open) - 1
+	open := i.open[lastIndex]
+	i.open = i.open[:lastIndex]
+
+	span := open.span
+	span.EndTime = time.Now()
+	span.ComputationUsed = i.computationUsed - open.computationUsed
+	return span
+}
+
+// finish finishes the root span and exports the trace.
+// It is a no-op if the tracer is nil.
+func (i *spanTracingInterface) finish(ctx context.Context, err error) {
+	if i == nil {
+		return
+	}
+
+	spans := i.spans
+	root := spans[0]
+	root.Err = err
+	i.endSpan(root)
+
+	// Generate random IDs.
+	// If no random IDs can be generated, fall back to IDs which are still unique within the trace
+
+	var traceID TraceID
+	spanIDs := make([]byte, len(spans)*spanIDLength)
+
+	_, randErr := rand.Read(traceID[:])
+	if randErr == nil {
+		_, randErr = rand.Read(spanIDs)
+	}
+	if randErr != nil {
+		binary.BigEndian.PutUint64(traceID[8:], uint64(root.StartTime.UnixNano()))
+	}
+
+	for index, span := range spans {
+		span.TraceID = traceID
+		if randErr != nil {
+			binary.BigEndian.PutUint64(span.SpanID[:], uint64(index+1))
+		} else {
+			copy(span.SpanID[:], spanIDs[index*spanIDLength:])
+		}
+	}
+
+	// Parents precede their children, so their IDs are already assigned
+
+	for _, span := range spans {
+		parent, ok := i.parents[span]
+		if ok {
+			span.ParentSpanID = parent.SpanID
+		}
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	i.exporter.ExportSpans(ctx, spans)
+}
+
+// startTrace starts the span of an operation which is ended by recording a trace.
+func (i *spanTracingInterface) startTrace(location Location) {
+	i.startSpan("", location, true)
+}
+
+// RecordTrace ends the span of the innermost operation, see startTrace,
+// and forwards the trace.
+func (i *spanTracingInterface) RecordTrace(
+	operation string,
+	location Location,
+	duration time.Duration,
+	attrs []attribute.KeyValue,
+) {
+	// Only end the span if it was started for a trace,
+	// as traces might also be recorded without being started
+	if len(i.open) > 0 && i.open[len(i.open)-1].trace {
+		span := i.endInnermostSpan()
+		span.Name = operation
+		span.Attributes = attrs
+	}
+
+	i.Interface.RecordTrace(operation, location, duration, attrs)
+}
+
+func (i *spanTracingInterface) MeterComputation(kind common.ComputationKind, intensity uint) error {
+	// Record the computation even if it exceeds the limit,
+	// as it was used by the span which failed
+	i.computationUsed += uint64(intensity)
+	return i.Interface.MeterComputation(kind, intensity)
+}
@@ -0,0 +1,454 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceAttributeLocation        = "cadence.location"
+	traceAttributeComputationUsed = "cadence.computation_used"
+
+	otlpServiceName = "cadence"
+	otlpScopeName   = "github.com/onflow/cadence/runtime"
+
+	// otlpSpanKindInternal is the OTLP span kind for internal operations
+	otlpSpanKindInternal = 1
+	// otlpStatusCodeError is the OTLP status code for failed operations
+	otlpStatusCodeError = 2
+)
+
+// OTLPJSONSpanExporter is a SpanExporter which writes traces in the OTLP JSON encoding,
+// one trace per line. This is the format of the OpenTelemetry Collector's file exporter,
+// so the written traces can be imported by the Collector's file receiver,
+// and converted to any other format the Collector supports.
+type OTLPJSONSpanExporter struct {
+	writer io.Writer
+	err    error
+	mutex  sync.Mutex
+}
+
+var _ SpanExporter = &OTLPJSONSpanExporter{}
+
+func NewOTLPJSONSpanExporter(writer io.Writer) *OTLPJSONSpanExporter {
+	return &OTLPJSONSpanExporter{
+		writer: writer,
+	}
+}
+
+// Err returns the first error which occurred when exporting, if any.
+func (e *OTLPJSONSpanExporter) Err() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.err
+}
+
+func (e *OTLPJSONSpanExporter) ExportSpans(_ context.Context, spans []*TraceSpan) {
+	data := otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{
+						newOTLPKeyValue(attribute.String("service.name", otlpServiceName)),
+					},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name: otlpScopeName,
+						},
+						Spans: newOTLPSpans(spans),
+					},
+				},
+			},
+		},
+	}
+
+	encoded, err := json.Marshal(data)
+	if err == nil {
+		encoded = append(encoded, '\n')
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err == nil {
+		_, err = e.writer.Write(encoded)
+	}
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+}
+
+// otlpTracesData is the OTLP JSON encoding of a TracesData message,
+// see https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+// otlpSpan is the OTLP JSON encoding of a span.
+// IDs are encoded as hex strings, and 64-bit integers as decimal strings
+type otlpSpan struct {
+	Status            *otlpStatus    `json:"status,omitempty"`
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Kind              int            `json:"kind"`
+}
+
+type otlpStatus struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+func newOTLPSpans(spans []*TraceSpan) []otlpSpan {
+	result := make([]otlpSpan, 0, len(spans))
+
+	for _, span := range spans {
+		otlpSpan := otlpSpan{
+			TraceID:           span.TraceID.String(),
+			SpanID:            span.SpanID.String(),
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+		}
+
+		if !span.ParentSpanID.IsZero() {
+			otlpSpan.ParentSpanID = span.ParentSpanID.String()
+		}
+
+		for _, attr := range traceSpanAttributes(span) {
+			otlpSpan.Attributes = append(otlpSpan.Attributes, newOTLPKeyValue(attr))
+		}
+
+		if span.Err != nil {
+			otlpSpan.Status = &otlpStatus{
+				Code:    otlpStatusCodeError,
+				Message: span.Err.Error(),
+			}
+		}
+
+		result = append(result, otlpSpan)
+	}
+
+	return result
+}
+
+// traceSpanAttributes returns the attributes of the span,
+// including the location and the computation used
+func traceSpanAttributes(span *TraceSpan) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(span.Attributes)+2)
+	if span.Location != nil {
+		attrs = append(attrs, attribute.String(traceAttributeLocation, span.Location.ID()))
+	}
+	attrs = append(attrs, attribute.Int64(traceAttributeComputationUsed, int64(span.ComputationUsed)))
+	return append(attrs, span.Attributes...)
+}
+
+func newOTLPKeyValue(attr attribute.KeyValue) otlpKeyValue {
+	return otlpKeyValue{
+		Key:   string(attr.Key),
+		Value: newOTLPAnyValue(attr.Value),
+	}
+}
+
+func newOTLPAnyValue(value attribute.Value) otlpAnyValue {
+	switch value.Type() {
+	case attribute.BOOL:
+		return newOTLPBoolValue(value.AsBool())
+
+	case attribute.INT64:
+		return newOTLPIntValue(value.AsInt64())
+
+	case attribute.FLOAT64:
+		return newOTLPDoubleValue(value.AsFloat64())
+
+	case attribute.STRING:
+		return newOTLPStringValue(value.AsString())
+
+	case attribute.BOOLSLICE:
+		values := value.AsBoolSlice()
+		array := make([]otlpAnyValue, 0, len(values))
+		for _, element := range values {
+			array = append(array, newOTLPBoolValue(element))
+		}
+		return newOTLPArrayValue(array)
+
+	case attribute.INT64SLICE:
+		values := value.AsInt64Slice()
+		array := make([]otlpAnyValue, 0, len(values))
+		for _, element := range values {
+			array = append(array, newOTLPIntValue(element))
+		}
+		return newOTLPArrayValue(array)
+
+	case attribute.FLOAT64SLICE:
+		values := value.AsFloat64Slice()
+		array := make([]otlpAnyValue, 0, len(values))
+		for _, element := range values {
+			array = append(array, newOTLPDoubleValue(element))
+		}
+		return newOTLPArrayValue(array)
+
+	case attribute.STRINGSLICE:
+		values := value.AsStringSlice()
+		array := make([]otlpAnyValue, 0, len(values))
+		for _, element := range values {
+			array = append(array, newOTLPStringValue(element))
+		}
+		return newOTLPArrayValue(array)
+
+	default:
+		return newOTLPStringValue(value.Emit())
+	}
+}
+
+func newOTLPBoolValue(value bool) otlpAnyValue {
+	return otlpAnyValue{BoolValue: &value}
+}
+
+func newOTLPIntValue(value int64) otlpAnyValue {
+	encoded := strconv.FormatInt(value, 10)
+	return otlpAnyValue{IntValue: &encoded}
+}
+
+func newOTLPDoubleValue(value float64) otlpAnyValue {
+	return otlpAnyValue{DoubleValue: &value}
+}
+
+func newOTLPStringValue(value string) otlpAnyValue {
+	return otlpAnyValue{StringValue: &value}
+}
+
+func newOTLPArrayValue(values []otlpAnyValue) otlpAnyValue {
+	return otlpAnyValue{
+		ArrayValue: &otlpArrayValue{
+			Values: values,
+		},
+	}
+}
+
+// TextSpanExporter is a SpanExporter which writes traces as human-readable trees,
+// e.g. to stdout. Each span is written on a separate line,
+// indented by its depth in the tree.
+type TextSpanExporter struct {
+	writer io.Writer
+	err    error
+	mutex  sync.Mutex
+}
+
+var _ SpanExporter = &TextSpanExporter{}
+
+func NewTextSpanExporter(writer io.Writer) *TextSpanExporter {
+	return &TextSpanExporter{
+		writer: writer,
+	}
+}
+
+// Err returns the first error which occurred when exporting, if any.
+func (e *TextSpanExporter) Err() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.err
+}
+
+func (e *TextSpanExporter) ExportSpans(_ context.Context, spans []*TraceSpan) {
+	var buffer bytes.Buffer
+
+	// Parents precede their children, so the depth of the parent is always known
+	depths := make(map[SpanID]int, len(spans))
+
+	for _, span := range spans {
+		depth := 0
+		if !span.ParentSpanID.IsZero() {
+			depth = depths[span.ParentSpanID] + 1
+		}
+		depths[span.SpanID] = depth
+
+		buffer.WriteString(strings.Repeat("  ", depth))
+		buffer.WriteString(span.Name)
+		_, _ = fmt.Fprintf(&buffer, " %s", span.EndTime.Sub(span.StartTime))
+
+		for _, attr := range traceSpanAttributes(span) {
+			_, _ = fmt.Fprintf(&buffer, " %s=%s", attr.Key, attr.Value.Emit())
+		}
+
+		if span.Err != nil {
+			_, _ = fmt.Fprintf(&buffer, " error=%q", span.Err.Error())
+		}
+
+		buffer.WriteByte('\n')
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	_, err := e.writer.Write(buffer.Bytes())
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+}
+
+// OpenTelemetrySpanExporter is a SpanExporter which exports traces
+// to a span exporter of the OpenTelemetry SDK, e.g. an OTLP exporter.
+// The wrapped exporter is not shut down by this exporter.
+type OpenTelemetrySpanExporter struct {
+	exporter sdktrace.SpanExporter
+	resource *resource.Resource
+	err      error
+	mutex    sync.Mutex
+}
+
+var _ SpanExporter = &OpenTelemetrySpanExporter{}
+
+func NewOpenTelemetrySpanExporter(exporter sdktrace.SpanExporter) *OpenTelemetrySpanExporter {
+	return &OpenTelemetrySpanExporter{
+		exporter: exporter,
+		resource: resource.NewSchemaless(
+			attribute.String("service.name", otlpServiceName),
+		),
+	}
+}
+
+// Err returns the first error which occurred when exporting, if any.
+func (e *OpenTelemetrySpanExporter) Err() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.err
+}
+
+func (e *OpenTelemetrySpanExporter) ExportSpans(ctx context.Context, spans []*TraceSpan) {
+	err := e.exporter.ExportSpans(ctx, e.newReadOnlySpans(spans))
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *OpenTelemetrySpanExporter) newReadOnlySpans(spans []*TraceSpan) []sdktrace.ReadOnlySpan {
+	childSpanCounts := make(map[SpanID]int, len(spans))
+	for _, span := range spans {
+		if !span.ParentSpanID.IsZero() {
+			childSpanCounts[span.ParentSpanID]++
+		}
+	}
+
+	result := make([]sdktrace.ReadOnlySpan, 0, len(spans))
+
+	for _, span := range spans {
+		stub := tracetest.SpanStub{
+			Name: span.Name,
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID(span.TraceID),
+				SpanID:     trace.SpanID(span.SpanID),
+				TraceFlags: trace.FlagsSampled,
+			}),
+			SpanKind:       trace.SpanKindInternal,
+			StartTime:      span.StartTime,
+			EndTime:        span.EndTime,
+			Attributes:     traceSpanAttributes(span),
+			ChildSpanCount: childSpanCounts[span.SpanID],
+			Resource:       e.resource,
+			InstrumentationLibrary: instrumentation.Scope{
+				Name: otlpScopeName,
+			},
+		}
+
+		if !span.ParentSpanID.IsZero() {
+			stub.Parent = trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID(span.TraceID),
+				SpanID:     trace.SpanID(span.ParentSpanID),
+				TraceFlags: trace.FlagsSampled,
+			})
+		}
+
+		if span.Err != nil {
+			stub.Status = sdktrace.Status{
+				Code:        codes.Error,
+				Description: span.Err.Error(),
+			}
+		}
+
+		result = append(result, stub.Snapshot())
+	}
+
+	return result
+}
@@ -0,0 +1,401 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	. "github.com/onflow/cadence/runtime/tests/runtime_utils"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+type testSpanExporter struct {
+	spans []*TraceSpan
+}
+
+var _ SpanExporter = &testSpanExporter{}
+
+func (e *testSpanExporter) ExportSpans(_ context.Context, spans []*TraceSpan) {
+	e.spans = append(e.spans, spans...)
+}
+
+func TestRuntimeSpanTracing(t *testing.T) {
+
+	t.Parallel()
+
+	helperLocation := common.IdentifierLocation("helper")
+	scriptLocation := common.ScriptLocation{0x1}
+
+	helper := []byte(`
+      access(all) fun numbers(): [Int] {
+          return [1, 2, 3]
+      }
+
+      access(all) fun double(_ x: Int): Int {
+          return x * 2
+      }
+    `)
+
+	execute := func(
+		t *testing.T,
+		script string,
+		exporter SpanExporter,
+		meterComputation func(common.ComputationKind, uint) error,
+	) error {
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			OnGetCode: func(location Location) ([]byte, error) {
+				if location == helperLocation {
+					return helper, nil
+				}
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			},
+			OnMeterComputation: meterComputation,
+		}
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface:    runtimeInterface,
+				Location:     scriptLocation,
+				SpanExporter: exporter,
+			},
+		)
+		return err
+	}
+
+	const script = `
+      import helper
+
+      access(all) fun main(): Int {
+          let values = numbers()
+          return double(values.length)
+      }
+    `
+
+	t.Run("tree", func(t *testing.T) {
+
+		t.Parallel()
+
+		exporter := &testSpanExporter{}
+
+		err := execute(t, script, exporter, nil)
+		require.NoError(t, err)
+
+		spans := exporter.spans
+		require.NotEmpty(t, spans)
+
+		spansByID := map[SpanID]*TraceSpan{}
+		for _, span := range spans {
+			require.NotContains(t, spansByID, span.SpanID)
+			spansByID[span.SpanID] = span
+		}
+
+		parent := func(span *TraceSpan) *TraceSpan {
+			return spansByID[span.ParentSpanID]
+		}
+
+		find := func(name string, location Location) *TraceSpan {
+			for _, span := range spans {
+				if span.Name == name && span.Location == location {
+					return span
+				}
+			}
+			require.Fail(t, "missing span", name)
+			return nil
+		}
+
+		root := spans[0]
+		assert.Equal(t, "script", root.Name)
+		assert.Equal(t, scriptLocation, root.Location)
+		assert.True(t, root.ParentSpanID.IsZero())
+		assert.NoError(t, root.Err)
+
+		for _, span := range spans[1:] {
+			assert.Equal(t, root.TraceID, span.TraceID)
+
+			// Parents precede their children, and children end before their parents
+			spanParent := parent(span)
+			require.NotNil(t, spanParent, span.Name)
+			assert.False(t, span.EndTime.After(spanParent.EndTime))
+			assert.LessOrEqual(t, span.ComputationUsed, spanParent.ComputationUsed)
+		}
+
+		// The imported program is parsed and checked when the script is checked
+
+		scriptCheck := find("check", scriptLocation)
+		assert.Equal(t, root, parent(scriptCheck))
+
+		helperCheck := find("check", helperLocation)
+		assert.Equal(t, scriptCheck, parent(helperCheck))
+
+		// Imports and function invocations are children of the interpretation
+
+		interpret := find("interpret", scriptLocation)
+		assert.Equal(t, root, parent(interpret))
+
+		importSpan := find("import.helper", scriptLocation)
+		assert.Equal(t, interpret, parent(importSpan))
+
+		numbers := find("function.numbers", scriptLocation)
+		assert.Equal(t, interpret, parent(numbers))
+		assert.Contains(t, numbers.Attributes, attribute.Int("line", 5))
+		assert.Contains(t, numbers.Attributes, attribute.Int("column", 23))
+
+		double := find("function.double", scriptLocation)
+		assert.Equal(t, interpret, parent(double))
+		assert.True(t, numbers.EndTime.Before(double.EndTime))
+
+		// Value operations are children of the function invocation which performed them
+
+		construct := find("array.construct", helperLocation)
+		assert.Equal(t, numbers, parent(construct))
+
+		// Computation is attributed to the spans
+
+		assert.Positive(t, double.ComputationUsed)
+		assert.Positive(t, interpret.ComputationUsed)
+		assert.Equal(t, interpret.ComputationUsed, root.ComputationUsed)
+	})
+
+	t.Run("error", func(t *testing.T) {
+
+		t.Parallel()
+
+		exporter := &testSpanExporter{}
+
+		err := execute(
+			t,
+			`
+              access(all) fun main() {
+                  panic("test")
+              }
+            `,
+			exporter,
+			nil,
+		)
+		RequireError(t, err)
+
+		spans := exporter.spans
+		require.NotEmpty(t, spans)
+		root := spans[0]
+		assert.Equal(t, "script", root.Name)
+		assert.Equal(t, err, root.Err)
+
+		// The spans of the failed operations are ended, too
+
+		var names []string
+		for _, span := range spans {
+			names = append(names, span.Name)
+			assert.False(t, span.EndTime.Before(span.StartTime), span.Name)
+		}
+		assert.Equal(t,
+			[]string{
+				"script",
+				"parse",
+				"check",
+				"interpret",
+				"function.panic",
+			},
+			names,
+		)
+		assert.Equal(t, spans[3].SpanID, spans[4].ParentSpanID)
+	})
+
+	t.Run("computation", func(t *testing.T) {
+
+		t.Parallel()
+
+		exporter := &testSpanExporter{}
+
+		var computationUsed uint64
+
+		err := execute(
+			t,
+			script,
+			exporter,
+			func(_ common.ComputationKind, intensity uint) error {
+				computationUsed += uint64(intensity)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+
+		spans := exporter.spans
+		require.NotEmpty(t, spans)
+
+		root := spans[0]
+		assert.Equal(t, computationUsed, root.ComputationUsed)
+
+		// The computation of a span includes the computation of its children
+
+		childComputationUsed := map[SpanID]uint64{}
+		for _, span := range spans[1:] {
+			childComputationUsed[span.ParentSpanID] += span.ComputationUsed
+		}
+		for _, span := range spans {
+			assert.LessOrEqual(t, childComputationUsed[span.SpanID], span.ComputationUsed, span.Name)
+		}
+	})
+
+	t.Run("OTLP JSON", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		exporter := NewOTLPJSONSpanExporter(&buffer)
+
+		err := execute(t, script, exporter, nil)
+		require.NoError(t, err)
+		require.NoError(t, exporter.Err())
+
+		lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+		require.Len(t, lines, 1)
+
+		type keyValue struct {
+			Value map[string]any `json:"value"`
+			Key   string         `json:"key"`
+		}
+
+		var data struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Scope struct {
+						Name string `json:"name"`
+					} `json:"scope"`
+					Spans []struct {
+						TraceID           string     `json:"traceId"`
+						SpanID            string     `json:"spanId"`
+						ParentSpanID      string     `json:"parentSpanId"`
+						Name              string     `json:"name"`
+						StartTimeUnixNano string     `json:"startTimeUnixNano"`
+						EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+						Attributes        []keyValue `json:"attributes"`
+					} `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+
+		err = json.Unmarshal([]byte(lines[0]), &data)
+		require.NoError(t, err)
+
+		require.Len(t, data.ResourceSpans, 1)
+		require.Len(t, data.ResourceSpans[0].ScopeSpans, 1)
+
+		scopeSpans := data.ResourceSpans[0].ScopeSpans[0]
+		assert.Equal(t, "github.com/onflow/cadence/runtime", scopeSpans.Scope.Name)
+
+		spans := scopeSpans.Spans
+		require.NotEmpty(t, spans)
+
+		root := spans[0]
+		assert.Equal(t, "script", root.Name)
+		assert.Empty(t, root.ParentSpanID)
+		assert.Len(t, root.TraceID, 32)
+		assert.Len(t, root.SpanID, 16)
+		assert.NotEmpty(t, root.StartTimeUnixNano)
+		assert.NotEmpty(t, root.EndTimeUnixNano)
+
+		assert.Contains(
+			t,
+			root.Attributes,
+			keyValue{
+				Key: "cadence.location",
+				Value: map[string]any{
+					"stringValue": scriptLocation.ID(),
+				},
+			},
+		)
+
+		for _, span := range spans[1:] {
+			assert.Equal(t, root.TraceID, span.TraceID)
+			assert.NotEmpty(t, span.ParentSpanID)
+		}
+	})
+
+	t.Run("OpenTelemetry", func(t *testing.T) {
+
+		t.Parallel()
+
+		inMemoryExporter := tracetest.NewInMemoryExporter()
+		exporter := NewOpenTelemetrySpanExporter(inMemoryExporter)
+
+		err := execute(t, script, exporter, nil)
+		require.NoError(t, err)
+		require.NoError(t, exporter.Err())
+
+		spans := inMemoryExporter.GetSpans()
+		require.NotEmpty(t, spans)
+
+		root := spans[0]
+		assert.Equal(t, "script", root.Name)
+		assert.False(t, root.Parent.IsValid())
+		assert.True(t, root.SpanContext.IsValid())
+		assert.Equal(t, "github.com/onflow/cadence/runtime", root.InstrumentationLibrary.Name)
+		assert.Contains(t,
+			root.Attributes,
+			attribute.String("cadence.location", scriptLocation.ID()),
+		)
+		assert.Equal(t, codes.Unset, root.Status.Code)
+
+		childSpanCount := 0
+		for _, span := range spans[1:] {
+			assert.Equal(t, root.SpanContext.TraceID(), span.SpanContext.TraceID())
+			assert.True(t, span.Parent.IsValid())
+			if span.Parent.SpanID() == root.SpanContext.SpanID() {
+				childSpanCount++
+			}
+		}
+		assert.Equal(t, childSpanCount, root.ChildSpanCount)
+	})
+
+	t.Run("text", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		exporter := NewTextSpanExporter(&buffer)
+
+		err := execute(t, script, exporter, nil)
+		require.NoError(t, err)
+		require.NoError(t, exporter.Err())
+
+		output := buffer.String()
+		assert.True(t, strings.HasPrefix(output, "script "))
+		assert.Contains(t, output, "\n  interpret ")
+		assert.Contains(t, output, "\n    function.numbers ")
+		assert.Contains(t, output, "\n      array.construct ")
+	})
+}
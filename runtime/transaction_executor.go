@@ -34,6 +34,7 @@ type interpreterTransactionExecutorPreparation struct {
 	transactionType  *sema.TransactionType
 	storage          *Storage
 	program          *interpreter.Program
+	tracer           *spanTracingInterface
 	preprocessOnce   sync.Once
 }
 
@@ -104,7 +105,17 @@ func (executor *interpreterTransactionExecutor) preprocess() (err error) {
 		codesAndPrograms,
 	)
 
-	runtimeInterface := context.Interface
+	runtimeInterface := withReadWriteSetRecording(
+		context.Interface,
+		context.ReadWriteSet,
+	)
+	runtimeInterface = withProgramCache(runtimeInterface, context.ProgramCache)
+	runtimeInterface, executor.tracer = withSpanTracing(
+		runtimeInterface,
+		context.SpanExporter,
+		"transaction",
+		location,
+	)
 
 	storage := NewStorage(runtimeInterface, runtimeInterface)
 	executor.storage = storage
@@ -119,6 +130,10 @@ func (executor *interpreterTransactionExecutor) preprocess() (err error) {
 		storage,
 		context.CoverageReport,
 	)
+	environment.ConfigureExecution(ExecutionOptions{
+		Context:  context.Context,
+		Deadline: context.Deadline,
+	})
 	executor.environment = environment
 
 	program, err := environment.ParseAndCheckProgram(
@@ -234,6 +249,10 @@ func (executor *interpreterTransactionExecutor) authorizerValues(
 }
 
 func (executor *interpreterTransactionExecutor) execute() (err error) {
+	defer func() {
+		executor.tracer.finish(executor.context.Context, err)
+	}()
+
 	err = executor.Preprocess()
 	if err != nil {
 		return err
@@ -167,6 +167,8 @@ var Word256Type = PrimitiveType(interpreter.PrimitiveStaticTypeWord256)
 
 var Fix64Type = PrimitiveType(interpreter.PrimitiveStaticTypeFix64)
 var UFix64Type = PrimitiveType(interpreter.PrimitiveStaticTypeUFix64)
+var Fix128Type = PrimitiveType(interpreter.PrimitiveStaticTypeFix128)
+var UFix128Type = PrimitiveType(interpreter.PrimitiveStaticTypeUFix128)
 
 var PathType = PrimitiveType(interpreter.PrimitiveStaticTypePath)
 var CapabilityPathType = PrimitiveType(interpreter.PrimitiveStaticTypeCapabilityPath)
@@ -409,6 +411,52 @@ func (t *InclusiveRangeType) Equal(other Type) bool {
 	return t.ElementType.Equal(otherType.ElementType)
 }
 
+// SetType
+
+type SetType struct {
+	ElementType Type
+	typeID      string
+}
+
+var _ Type = &SetType{}
+
+func NewSetType(
+	elementType Type,
+) *SetType {
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func NewMeteredSetType(
+	gauge common.MemoryGauge,
+	elementType Type,
+) *SetType {
+	common.UseMemory(gauge, common.CadenceSetTypeMemoryUsage)
+	return NewSetType(elementType)
+}
+
+func (*SetType) isType() {}
+
+func (t *SetType) ID() string {
+	if t.typeID == "" {
+		t.typeID = fmt.Sprintf(
+			"Set<%s>",
+			t.ElementType.ID(),
+		)
+	}
+	return t.typeID
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherType, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherType.ElementType)
+}
+
 // Field
 
 type Field struct {
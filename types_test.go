@@ -77,6 +77,12 @@ func TestType_ID(t *testing.T) {
 			},
 			"{String:Int}",
 		},
+		{
+			&SetType{
+				ElementType: StringType,
+			},
+			"Set<String>",
+		},
 		{
 			&StructType{
 				QualifiedIdentifier: "Foo",
@@ -542,6 +548,46 @@ func TestTypeEquality(t *testing.T) {
 		})
 	})
 
+	t.Run("set type", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("equal", func(t *testing.T) {
+			t.Parallel()
+
+			source := &SetType{
+				ElementType: IntType,
+			}
+			target := &SetType{
+				ElementType: IntType,
+			}
+			assert.True(t, source.Equal(target))
+		})
+
+		t.Run("different element types", func(t *testing.T) {
+			t.Parallel()
+
+			source := &SetType{
+				ElementType: IntType,
+			}
+			target := &SetType{
+				ElementType: StringType,
+			}
+			assert.False(t, source.Equal(target))
+		})
+
+		t.Run("different type", func(t *testing.T) {
+			t.Parallel()
+
+			source := &SetType{
+				ElementType: IntType,
+			}
+			target := &VariableSizedArrayType{
+				ElementType: IntType,
+			}
+			assert.False(t, source.Equal(target))
+		})
+	})
+
 	t.Run("struct type", func(t *testing.T) {
 		t.Parallel()
 
@@ -1506,6 +1506,167 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+type Fix128 struct {
+	Value *big.Int
+}
+
+var _ Value = Fix128{}
+
+var Fix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+var fix128MinExceededError = errors.NewDefaultUserError("value exceeds min of Fix128")
+var fix128MaxExceededError = errors.NewDefaultUserError("value exceeds max of Fix128")
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{Value: v}, nil
+}
+
+func NewFix128FromParts(negative bool, integer int, fraction *big.Int) (Fix128, error) {
+	v, err := fixedpoint.NewFix128(
+		negative,
+		new(big.Int).SetInt64(int64(integer)),
+		fraction,
+		fixedpoint.Fix128Scale,
+	)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{Value: v}, nil
+}
+
+// NewFix128FromRawFixedPointNumber returns a Fix128 for the given raw (scaled) value
+func NewFix128FromRawFixedPointNumber(n *big.Int) (Fix128, error) {
+	if n.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128{}, fix128MinExceededError
+	}
+	if n.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128{}, fix128MaxExceededError
+	}
+	return Fix128{Value: n}, nil
+}
+
+func NewMeteredFix128(gauge common.MemoryGauge, constructor func() (string, error)) (Fix128, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return Fix128{}, err
+	}
+	return NewFix128(value)
+}
+
+func NewMeteredFix128FromRawFixedPointNumber(gauge common.MemoryGauge, n *big.Int) (Fix128, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewFix128FromRawFixedPointNumber(n)
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return Fix128Type
+}
+
+func (v Fix128) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Fix128) ToGoValue() any {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToSizedBigEndianBytes(v.Value, sema.Fix128TypeSize)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+type UFix128 struct {
+	Value *big.Int
+}
+
+var _ Value = UFix128{}
+
+var UFix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+var ufix128MinExceededError = errors.NewDefaultUserError("value exceeds min of UFix128")
+var ufix128MaxExceededError = errors.NewDefaultUserError("value exceeds max of UFix128")
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{Value: v}, nil
+}
+
+func NewUFix128FromParts(integer int, fraction *big.Int) (UFix128, error) {
+	v, err := fixedpoint.NewUFix128(
+		new(big.Int).SetInt64(int64(integer)),
+		fraction,
+		fixedpoint.Fix128Scale,
+	)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{Value: v}, nil
+}
+
+// NewUFix128FromRawFixedPointNumber returns a UFix128 for the given raw (scaled) value
+func NewUFix128FromRawFixedPointNumber(n *big.Int) (UFix128, error) {
+	if n.Sign() < 0 {
+		return UFix128{}, ufix128MinExceededError
+	}
+	if n.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128{}, ufix128MaxExceededError
+	}
+	return UFix128{Value: n}, nil
+}
+
+func NewMeteredUFix128(gauge common.MemoryGauge, constructor func() (string, error)) (UFix128, error) {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return UFix128{}, err
+	}
+	return NewUFix128(value)
+}
+
+func NewMeteredUFix128FromRawFixedPointNumber(gauge common.MemoryGauge, n *big.Int) (UFix128, error) {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUFix128FromRawFixedPointNumber(n)
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return UFix128Type
+}
+
+func (v UFix128) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v UFix128) ToGoValue() any {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToSizedBigEndianBytes(v.Value, sema.UFix128TypeSize)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
 // Array
 
 type Array struct {
@@ -1660,6 +1821,71 @@ func NewMeteredKeyValuePair(gauge common.MemoryGauge, key, value Value) KeyValue
 	}
 }
 
+// Set
+
+type Set struct {
+	SetType  *SetType
+	Elements []Value
+}
+
+var _ Value = Set{}
+
+func NewSet(elements []Value) Set {
+	return Set{Elements: elements}
+}
+
+func NewMeteredSet(
+	gauge common.MemoryGauge,
+	size int,
+	constructor func() ([]Value, error),
+) (Set, error) {
+	common.UseMemory(gauge, common.CadenceSetValueMemoryUsage)
+
+	elements, err := constructor()
+	if err != nil {
+		return Set{}, err
+	}
+	return NewSet(elements), err
+}
+
+func (Set) isValue() {}
+
+func (v Set) Type() Type {
+	if v.SetType == nil {
+		// Return nil Type instead of Type referencing nil *SetType,
+		// so caller can check if v's type is nil and also prevent nil pointer dereference.
+		return nil
+	}
+	return v.SetType
+}
+
+func (v Set) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Set) WithType(setType *SetType) Set {
+	v.SetType = setType
+	return v
+}
+
+func (v Set) ToGoValue() any {
+	ret := make([]any, len(v.Elements))
+
+	for i, e := range v.Elements {
+		ret[i] = e.ToGoValue()
+	}
+
+	return ret
+}
+
+func (v Set) String() string {
+	elements := make([]string, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.String()
+	}
+	return format.Set(elements)
+}
+
 // Struct
 
 type Struct struct {
@@ -44,6 +44,8 @@ type valueTestCase struct {
 func newValueTestCases() map[string]valueTestCase {
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("128.000000000000000000000001")
+	fix128, _ := NewFix128("-0.5")
 
 	testFunctionType := NewFunctionType(
 		FunctionPurityUnspecified,
@@ -167,6 +169,16 @@ func newValueTestCases() map[string]valueTestCase {
 			string:       "-32.11000000",
 			expectedType: Fix64Type,
 		},
+		"UFix128": {
+			value:        ufix128,
+			string:       "128.000000000000000000000001",
+			expectedType: UFix128Type,
+		},
+		"Fix128": {
+			value:        fix128,
+			string:       "-0.500000000000000000000000",
+			expectedType: Fix128Type,
+		},
 		"Void": {
 			value:        NewVoid(),
 			string:       "()",
@@ -221,6 +233,17 @@ func newValueTestCases() map[string]valueTestCase {
 			},
 			string: "{\"key\": \"value\"}",
 		},
+		"Set": {
+			value: NewSet([]Value{
+				String("a"),
+				String("b"),
+			}),
+			exampleType: NewSetType(StringType),
+			withType: func(value Value, ty Type) Value {
+				return value.(Set).WithType(ty.(*SetType))
+			},
+			string: "Set([\"a\", \"b\"])",
+		},
 		"InclusiveRange": {
 			value:       NewInclusiveRange(NewInt(85), NewInt(-85), NewInt(-2)),
 			exampleType: NewInclusiveRangeType(IntType),
@@ -635,6 +658,16 @@ func TestNumberValue_ToBigEndianBytes(t *testing.T) {
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"Fix128": {
+			Fix128{Value: big.NewInt(0)}:  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			Fix128{Value: big.NewInt(42)}: {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			Fix128{Value: big.NewInt(-1)}: {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+		"UFix128": {
+			UFix128{Value: big.NewInt(0)}:          {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			UFix128{Value: big.NewInt(42)}:         {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42},
+			UFix128{Value: sema.UFix128TypeMaxBig}: {255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
 	}
 
 	// Ensure the test cases are complete